@@ -78,9 +78,9 @@ export const ProjectService = {
         );
       }
 
-      const data = await response.json();
+      const data = (await response.json()) as { items: unknown[] };
 
-      const projects = (data as unknown[]).map(mapProject);
+      const projects = data.items.map(mapProject);
 
       return projects;
     } catch (error) {
@@ -8,31 +8,66 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/server"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/worker"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/middleware"
 	flagUtils "github.com/fingertips18/fingertips18.github.io/backend/pkg/utils"
 	"github.com/joho/godotenv"
 )
 
 // Define constants for flags to improve manageability
 const (
-	FlagEnv                  = "env"
-	FlagClientURL            = "client-url"
-	FlagPort                 = "port"
-	FlagAuthToken            = "auth-token"
-	FlagEmailJSServiceID     = "emailjs-service-id"
-	FlagEmailJSTemplateID    = "emailjs-template-id"
-	FlagEmailJSPublicKey     = "emailjs-public-key"
-	FlagEmailJSPrivateKey    = "emailjs-private-key"
-	FlagGoogleMeasurementID  = "google-measurement-id"
-	FlagGoogleAPISecret      = "google-api-secret" // #nosec
-	FlagDatabaseURL          = "database-url"
-	FlagUsername             = "username"
-	FlagPassword             = "password"
-	FlagUploadthingSecretKey = "uploadthing-secret-key"
+	FlagEnv                     = "env"
+	FlagClientURL               = "client-url"
+	FlagPort                    = "port"
+	FlagAuthToken               = "auth-token"
+	FlagEmailProvider           = "email-provider"
+	FlagEmailFrom               = "email-from"
+	FlagEmailTo                 = "email-to"
+	FlagEmailDir                = "email-dir"
+	FlagEmailTemplateDir        = "email-template-dir"
+	FlagEmailAutoReply          = "email-auto-reply"
+	FlagEmailJSServiceID        = "emailjs-service-id"
+	FlagEmailJSTemplateID       = "emailjs-template-id"
+	FlagEmailJSPublicKey        = "emailjs-public-key"
+	FlagEmailJSPrivateKey       = "emailjs-private-key"
+	FlagSMTPHost                = "smtp-host"
+	FlagSMTPPort                = "smtp-port"
+	FlagSMTPUsername            = "smtp-username"
+	FlagSMTPPassword            = "smtp-password" // #nosec
+	FlagGoogleMeasurementID     = "google-measurement-id"
+	FlagGoogleAPISecret         = "google-api-secret" // #nosec
+	FlagAnalyticsSalt           = "analytics-salt"    // #nosec
+	FlagAnalyticsCountryHeader  = "analytics-country-header"
+	FlagDatabaseURL             = "database-url"
+	FlagUsername                = "username"
+	FlagPasswordHash            = "password-hash"
+	FlagJWTSecret               = "jwt-secret"
+	FlagAccessTokenTTL          = "access-token-ttl"
+	FlagRefreshTokenTTL         = "refresh-token-ttl"
+	FlagUploadthingSecretKey    = "uploadthing-secret-key"
+	FlagCacheMaxAge             = "cache-max-age"
+	FlagCacheStale              = "cache-stale-while-revalidate"
+	FlagRepositoryCacheTTL      = "repository-cache-ttl"
+	FlagEmailRateLimit          = "email-rate-limit"
+	FlagAnalyticsRateLimit      = "analytics-rate-limit"
+	FlagAnalyticsBatchRateLimit = "analytics-batch-rate-limit"
+	FlagAuthRateLimit           = "auth-rate-limit"
+	FlagTrustedProxies          = "trusted-proxies"
+	FlagCaptchaProvider         = "captcha-provider"
+	FlagCaptchaSecret           = "captcha-secret" // #nosec
+	FlagSpamBlocklist           = "spam-blocklist"
+	FlagSpamMaxLinks            = "spam-max-links"
+	FlagSpamThreshold           = "spam-threshold"
+	FlagOutboxPollInterval      = "outbox-poll-interval"
+	FlagOutboxMaxAttempts       = "outbox-max-attempts"
 )
 
 // @title Portfolio Backend API
@@ -47,37 +82,105 @@ const (
 // @name Authorization
 func main() {
 	var (
-		flagEnvironment          = flag.String(FlagEnv, "local", "Environment")
-		flagClientURL            = flag.String(FlagClientURL, "http://localhost:5378", "Client URL")
-		flagPort                 = flag.String(FlagPort, "8080", "Port server")
-		flagAuthToken            = flag.String(FlagAuthToken, "", "Basic token auth")
-		flagEmailJSServiceID     = flag.String(FlagEmailJSServiceID, "", "EmailJS Service ID")
-		flagEmailJSTemplateID    = flag.String(FlagEmailJSTemplateID, "", "EmailJS Template ID")
-		flagEmailJSPublicKey     = flag.String(FlagEmailJSPublicKey, "", "EmailJS Public Key")
-		flagEmailJSPrivateKey    = flag.String(FlagEmailJSPrivateKey, "", "EmailJS Private Key")
-		flagGoogleMeasurementID  = flag.String(FlagGoogleMeasurementID, "", "Google Measurement ID")
-		flagGoogleAPISecret      = flag.String(FlagGoogleAPISecret, "", "Google API Secret")
-		flagDatabaseURL          = flag.String(FlagDatabaseURL, "", "Postgres Database URL")
-		flagUsername             = flag.String(FlagUsername, "", "Backend Username Access")
-		flagPassword             = flag.String(FlagPassword, "", "Backend Password Access")
-		flagUploadthingSecretKey = flag.String(FlagUploadthingSecretKey, "", "Uploadthing Secret Key")
+		flagEnvironment             = flag.String(FlagEnv, "local", "Environment")
+		flagClientURL               = flag.String(FlagClientURL, "http://localhost:5378", "Client URL")
+		flagPort                    = flag.String(FlagPort, "8080", "Port server")
+		flagAuthToken               = flag.String(FlagAuthToken, "", "Basic token auth")
+		flagEmailProvider           = flag.String(FlagEmailProvider, "", "Email provider (emailjs, smtp or file; defaults to file locally and emailjs otherwise)")
+		flagEmailFrom               = flag.String(FlagEmailFrom, "", "Sender address of SMTP and file emails")
+		flagEmailTo                 = flag.String(FlagEmailTo, "", "Address SMTP and file contact form emails are delivered to")
+		flagEmailDir                = flag.String(FlagEmailDir, "tmp/emails", "Directory the file email provider writes .eml files to")
+		flagEmailTemplateDir        = flag.String(FlagEmailTemplateDir, "", "Directory of email templates overriding the embedded ones, e.g. auto_reply.html.tmpl")
+		flagEmailAutoReply          = flag.Bool(FlagEmailAutoReply, false, "Send contact form senders an automatic reply (needs a captcha secret unless the email provider is file)")
+		flagEmailJSServiceID        = flag.String(FlagEmailJSServiceID, "", "EmailJS Service ID")
+		flagEmailJSTemplateID       = flag.String(FlagEmailJSTemplateID, "", "EmailJS Template ID")
+		flagEmailJSPublicKey        = flag.String(FlagEmailJSPublicKey, "", "EmailJS Public Key")
+		flagEmailJSPrivateKey       = flag.String(FlagEmailJSPrivateKey, "", "EmailJS Private Key")
+		flagSMTPHost                = flag.String(FlagSMTPHost, "", "SMTP server host")
+		flagSMTPPort                = flag.Int(FlagSMTPPort, 587, "SMTP server port (the connection is always upgraded with STARTTLS)")
+		flagSMTPUsername            = flag.String(FlagSMTPUsername, "", "SMTP username (empty skips authentication)")
+		flagSMTPPassword            = flag.String(FlagSMTPPassword, "", "SMTP password")
+		flagGoogleMeasurementID     = flag.String(FlagGoogleMeasurementID, "", "Google Measurement ID (empty disables forwarding page views to GA4)")
+		flagGoogleAPISecret         = flag.String(FlagGoogleAPISecret, "", "Google API Secret (empty disables forwarding page views to GA4)")
+		flagAnalyticsSalt           = flag.String(FlagAnalyticsSalt, "", "Secret keying the visitor ID hashes (empty uses a random one per restart)")
+		flagAnalyticsCountryHeader  = flag.String(FlagAnalyticsCountryHeader, "CF-IPCountry", "Request header carrying the visitor's country code")
+		flagDatabaseURL             = flag.String(FlagDatabaseURL, "", "Postgres Database URL")
+		flagUsername                = flag.String(FlagUsername, "", "Backend Username Access")
+		flagPasswordHash            = flag.String(FlagPasswordHash, "", "Backend Password Access (bcrypt hash)")
+		flagJWTSecret               = flag.String(FlagJWTSecret, "", "Secret signing admin access tokens")
+		flagAccessTokenTTL          = flag.Duration(FlagAccessTokenTTL, 15*time.Minute, "Lifetime of admin access tokens")
+		flagRefreshTokenTTL         = flag.Duration(FlagRefreshTokenTTL, 7*24*time.Hour, "Lifetime of admin refresh tokens")
+		flagUploadthingSecretKey    = flag.String(FlagUploadthingSecretKey, "", "Uploadthing Secret Key")
+		flagCacheMaxAge             = flag.Duration(FlagCacheMaxAge, 0, "Cache-Control max-age for public lists (0 revalidates every read)")
+		flagCacheStale              = flag.Duration(FlagCacheStale, 0, "Cache-Control stale-while-revalidate for public lists")
+		flagRepositoryCacheTTL      = flag.Duration(FlagRepositoryCacheTTL, 5*time.Minute, "TTL of cached project, skill and education reads (0 disables the cache)")
+		flagTrustedProxies          = flag.String(FlagTrustedProxies, "", "Comma-separated IPs or CIDRs of proxies whose X-Forwarded-For is trusted")
+		flagCaptchaProvider         = flag.String(FlagCaptchaProvider, "turnstile", "Captcha provider of the contact form (turnstile or hcaptcha)")
+		flagCaptchaSecret           = flag.String(FlagCaptchaSecret, "", "Captcha secret key (empty disables captcha verification)")
+		flagSpamBlocklist           = flag.String(FlagSpamBlocklist, "", "Comma-separated terms that mark contact form messages as spam")
+		flagSpamMaxLinks            = flag.Int(FlagSpamMaxLinks, domain.DefaultSpamMaxLinks, "Links a contact form message may contain before it scores as spam")
+		flagSpamThreshold           = flag.Int(FlagSpamThreshold, domain.DefaultSpamThreshold, "Spam score at which contact form messages are dropped")
+		flagOutboxPollInterval      = flag.Duration(FlagOutboxPollInterval, worker.DefaultOutboxPollInterval, "How often the email outbox is checked for emails due")
+		flagOutboxMaxAttempts       = flag.Int(FlagOutboxMaxAttempts, worker.DefaultOutboxMaxAttempts, "Send attempts before an outbox email is dead-lettered")
+		flagEmailRateLimit          = middleware.RateLimit{Requests: 5, Window: 10 * time.Minute}
+		flagAnalyticsRateLimit      = middleware.RateLimit{Requests: 60, Window: time.Minute}
+		flagAnalyticsBatchRateLimit = middleware.RateLimit{Requests: 6, Window: time.Minute}
+		flagAuthRateLimit           = middleware.RateLimit{Requests: 10, Window: 15 * time.Minute}
 	)
 
+	flag.TextVar(&flagEmailRateLimit, FlagEmailRateLimit, flagEmailRateLimit, "Contact form sends allowed per client IP, as <requests>/<window> (0 disables the limit)")
+	flag.TextVar(&flagAnalyticsRateLimit, FlagAnalyticsRateLimit, flagAnalyticsRateLimit, "Page views and single analytics events accepted per client IP, as <requests>/<window> (0 disables the limit)")
+	flag.TextVar(&flagAnalyticsBatchRateLimit, FlagAnalyticsBatchRateLimit, flagAnalyticsBatchRateLimit, "Analytics event batches, of up to 25 events each, accepted per client IP, as <requests>/<window> (0 disables the limit)")
+	flag.TextVar(&flagAuthRateLimit, FlagAuthRateLimit, flagAuthRateLimit, "Admin logins, and separately token refreshes, allowed per client IP, as <requests>/<window> (0 disables the limit)")
+
 	flag.Parse()
 
 	flagUtils.Require(
 		FlagAuthToken,
-		FlagEmailJSServiceID,
-		FlagEmailJSTemplateID,
-		FlagEmailJSPublicKey,
-		FlagGoogleMeasurementID,
-		FlagGoogleAPISecret,
 		FlagDatabaseURL,
 		FlagUsername,
-		FlagPassword,
+		FlagPasswordHash,
+		FlagJWTSecret,
 		FlagUploadthingSecretKey,
 	)
 
+	// Local development writes emails to disk unless told otherwise, so it
+	// needs no provider credentials
+	emailProvider := *flagEmailProvider
+	if emailProvider == "" {
+		if *flagEnvironment == "local" {
+			emailProvider = client.EmailProviderFile
+		} else {
+			emailProvider = client.EmailProviderEmailJS
+		}
+	}
+
+	emailFrom := *flagEmailFrom
+	emailTo := *flagEmailTo
+	switch emailProvider {
+	case client.EmailProviderEmailJS:
+		flagUtils.Require(
+			FlagEmailJSServiceID,
+			FlagEmailJSTemplateID,
+			FlagEmailJSPublicKey,
+		)
+	case client.EmailProviderSMTP:
+		flagUtils.Require(
+			FlagSMTPHost,
+			FlagEmailFrom,
+			FlagEmailTo,
+		)
+	case client.EmailProviderFile:
+		if emailFrom == "" {
+			emailFrom = "Portfolio <noreply@localhost>"
+		}
+		if emailTo == "" {
+			emailTo = "owner@localhost"
+		}
+	default:
+		log.Fatalf("Unknown email provider %q", emailProvider)
+	}
+
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatal("Error loading .env file")
@@ -91,12 +194,19 @@ func main() {
 	emailJSTemplateID := *flagEmailJSTemplateID
 	emailJSPublicKey := *flagEmailJSPublicKey
 	emailJSPrivateKey := *flagEmailJSPrivateKey
+	smtpPassword := *flagSMTPPassword
 	googleMeasurementID := *flagGoogleMeasurementID
 	googleAPISecret := *flagGoogleAPISecret
+	analyticsSalt := *flagAnalyticsSalt
 	databaseURL := *flagDatabaseURL
 	username := *flagUsername
-	password := *flagPassword
+	passwordHash := *flagPasswordHash
+	jwtSecret := *flagJWTSecret
 	uploadthingSecretKey := *flagUploadthingSecretKey
+	captchaSecret := *flagCaptchaSecret
+	trustedProxies := splitList(*flagTrustedProxies)
+	spamBlocklist := splitList(*flagSpamBlocklist)
+
 	if *flagEnvironment != "local" {
 		data, err := os.ReadFile(*flagPort)
 		if err != nil {
@@ -147,18 +257,40 @@ func main() {
 			emailJSPrivateKey = string(data)
 		}
 
-		data, err = os.ReadFile(*flagGoogleMeasurementID)
-		if err != nil {
-			log.Printf("Failed to read google measurement ID from file, using flag value: %v", *flagGoogleMeasurementID)
-		} else {
-			googleMeasurementID = string(data)
+		if smtpPassword != "" {
+			data, err = os.ReadFile(smtpPassword)
+			if err != nil {
+				log.Printf("Failed to read SMTP password from file, using flag value: %v", smtpPassword)
+			} else {
+				smtpPassword = strings.TrimSpace(string(data))
+			}
 		}
 
-		data, err = os.ReadFile(*flagGoogleAPISecret)
-		if err != nil {
-			log.Printf("Failed to read google API secret from file, using flag value: %v", *flagGoogleAPISecret)
-		} else {
-			googleAPISecret = string(data)
+		if googleMeasurementID != "" {
+			data, err = os.ReadFile(googleMeasurementID)
+			if err != nil {
+				log.Printf("Failed to read google measurement ID from file, using flag value: %v", googleMeasurementID)
+			} else {
+				googleMeasurementID = strings.TrimSpace(string(data))
+			}
+		}
+
+		if googleAPISecret != "" {
+			data, err = os.ReadFile(googleAPISecret)
+			if err != nil {
+				log.Printf("Failed to read google API secret from file, using flag value: %v", googleAPISecret)
+			} else {
+				googleAPISecret = strings.TrimSpace(string(data))
+			}
+		}
+
+		if analyticsSalt != "" {
+			data, err = os.ReadFile(analyticsSalt)
+			if err != nil {
+				log.Printf("Failed to read analytics salt from file, using flag value: %v", analyticsSalt)
+			} else {
+				analyticsSalt = strings.TrimSpace(string(data))
+			}
 		}
 
 		data, err = os.ReadFile(*flagDatabaseURL)
@@ -175,11 +307,20 @@ func main() {
 			username = string(data)
 		}
 
-		data, err = os.ReadFile(*flagPassword)
+		// Secret files usually end in a newline, which would break the bcrypt
+		// comparison and change the signing key.
+		data, err = os.ReadFile(*flagPasswordHash)
+		if err != nil {
+			log.Printf("Failed to read password hash from file, using flag value: %v", *flagPasswordHash)
+		} else {
+			passwordHash = strings.TrimSpace(string(data))
+		}
+
+		data, err = os.ReadFile(*flagJWTSecret)
 		if err != nil {
-			log.Printf("Failed to read password from file, using flag value: %v", *flagPassword)
+			log.Printf("Failed to read JWT secret from file, using flag value: %v", *flagJWTSecret)
 		} else {
-			password = string(data)
+			jwtSecret = strings.TrimSpace(string(data))
 		}
 
 		data, err = os.ReadFile(*flagUploadthingSecretKey)
@@ -188,6 +329,15 @@ func main() {
 		} else {
 			uploadthingSecretKey = string(data)
 		}
+
+		if captchaSecret != "" {
+			data, err = os.ReadFile(captchaSecret)
+			if err != nil {
+				log.Printf("Failed to read captcha secret from file, using flag value: %v", captchaSecret)
+			} else {
+				captchaSecret = strings.TrimSpace(string(data))
+			}
+		}
 	} else {
 		if port == "" {
 			port = os.Getenv("PORT")
@@ -217,6 +367,10 @@ func main() {
 			emailJSPrivateKey = os.Getenv("EMAILJS_PRIVATE_KEY")
 		}
 
+		if smtpPassword == "" {
+			smtpPassword = os.Getenv("SMTP_PASSWORD")
+		}
+
 		if googleMeasurementID == "" {
 			googleMeasurementID = os.Getenv("GOOGLE_MEASUREMENT_ID")
 		}
@@ -225,6 +379,10 @@ func main() {
 			googleAPISecret = os.Getenv("GOOGLE_API_SECRET")
 		}
 
+		if analyticsSalt == "" {
+			analyticsSalt = os.Getenv("ANALYTICS_SALT")
+		}
+
 		if databaseURL == "" {
 			databaseURL = os.Getenv("DATABASE_URL")
 		}
@@ -233,13 +391,27 @@ func main() {
 			username = os.Getenv("USERNAME")
 		}
 
-		if password == "" {
-			password = os.Getenv("PASSWORD")
+		if passwordHash == "" {
+			passwordHash = os.Getenv("PASSWORD_HASH")
+		}
+
+		if jwtSecret == "" {
+			jwtSecret = os.Getenv("JWT_SECRET")
 		}
 
 		if uploadthingSecretKey == "" {
 			uploadthingSecretKey = os.Getenv("UPLOADTHING_SECRET_KEY")
 		}
+
+		if captchaSecret == "" {
+			captchaSecret = os.Getenv("CAPTCHA_SECRET")
+		}
+	}
+
+	// Auto replies go to whatever address the contact form is given, so
+	// without a captcha anyone could have them sent to any inbox
+	if *flagEmailAutoReply && emailProvider != client.EmailProviderFile && captchaSecret == "" {
+		log.Fatalf("--%s needs --%s with the %s email provider", FlagEmailAutoReply, FlagCaptchaSecret, emailProvider)
 	}
 
 	// Setup database
@@ -248,20 +420,50 @@ func main() {
 	// Setup server
 	s := server.New(
 		server.Config{
-			Environment:          *flagEnvironment,
-			ClientURL:            clientURL,
-			Port:                 port,
-			AuthToken:            authToken,
-			EmailJSServiceID:     emailJSServiceID,
-			EmailJSTemplateID:    emailJSTemplateID,
-			EmailJSPublicKey:     emailJSPublicKey,
-			EmailJSPrivateKey:    emailJSPrivateKey,
-			GoogleMeasurementID:  googleMeasurementID,
-			GoogleAPISecret:      googleAPISecret,
-			Username:             username,
-			Password:             password,
-			UploadthingSecretKey: uploadthingSecretKey,
-			DatabaseAPI:          database,
+			Environment:             *flagEnvironment,
+			ClientURL:               clientURL,
+			Port:                    port,
+			AuthToken:               authToken,
+			EmailProvider:           emailProvider,
+			EmailFrom:               emailFrom,
+			EmailTo:                 emailTo,
+			EmailDir:                *flagEmailDir,
+			EmailTemplateDir:        *flagEmailTemplateDir,
+			EmailAutoReply:          *flagEmailAutoReply,
+			EmailJSServiceID:        emailJSServiceID,
+			EmailJSTemplateID:       emailJSTemplateID,
+			EmailJSPublicKey:        emailJSPublicKey,
+			EmailJSPrivateKey:       emailJSPrivateKey,
+			SMTPHost:                *flagSMTPHost,
+			SMTPPort:                *flagSMTPPort,
+			SMTPUsername:            *flagSMTPUsername,
+			SMTPPassword:            smtpPassword,
+			GoogleMeasurementID:     googleMeasurementID,
+			GoogleAPISecret:         googleAPISecret,
+			AnalyticsSalt:           analyticsSalt,
+			AnalyticsCountryHeader:  *flagAnalyticsCountryHeader,
+			Username:                username,
+			PasswordHash:            passwordHash,
+			JWTSecret:               jwtSecret,
+			AccessTokenTTL:          *flagAccessTokenTTL,
+			RefreshTokenTTL:         *flagRefreshTokenTTL,
+			UploadthingSecretKey:    uploadthingSecretKey,
+			CacheMaxAge:             *flagCacheMaxAge,
+			CacheStale:              *flagCacheStale,
+			RepositoryCacheTTL:      *flagRepositoryCacheTTL,
+			EmailRateLimit:          flagEmailRateLimit,
+			AnalyticsRateLimit:      flagAnalyticsRateLimit,
+			AnalyticsBatchRateLimit: flagAnalyticsBatchRateLimit,
+			AuthRateLimit:           flagAuthRateLimit,
+			TrustedProxies:          trustedProxies,
+			CaptchaProvider:         *flagCaptchaProvider,
+			CaptchaSecret:           captchaSecret,
+			SpamBlocklist:           spamBlocklist,
+			SpamMaxLinks:            *flagSpamMaxLinks,
+			SpamThreshold:           *flagSpamThreshold,
+			OutboxPollInterval:      *flagOutboxPollInterval,
+			OutboxMaxAttempts:       *flagOutboxMaxAttempts,
+			DatabaseAPI:             database,
 		},
 	)
 
@@ -290,3 +492,14 @@ func main() {
 
 	log.Println("Server exiting...")
 }
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCaptchaVerifier(t *testing.T) {
+	turnstile, err := NewCaptchaVerifier(CaptchaConfig{Provider: CaptchaProviderTurnstile})
+	require.NoError(t, err)
+	assert.Equal(t, captchaVerifyURLs[CaptchaProviderTurnstile], turnstile.(*captchaVerifier).verifyURL)
+
+	hCaptcha, err := NewCaptchaVerifier(CaptchaConfig{Provider: CaptchaProviderHCaptcha})
+	require.NoError(t, err)
+	assert.Equal(t, captchaVerifyURLs[CaptchaProviderHCaptcha], hCaptcha.(*captchaVerifier).verifyURL)
+
+	_, err = NewCaptchaVerifier(CaptchaConfig{Provider: "recaptcha"})
+	assert.EqualError(t, err, `unknown captcha provider "recaptcha"`)
+}
+
+func TestCaptchaVerifier_Verify(t *testing.T) {
+	type Given struct {
+		token    string
+		remoteIP string
+		status   int
+		body     string
+	}
+	type Expected struct {
+		ok       bool
+		err      string
+		called   bool
+		remoteIP string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"valid token": {
+			given: Given{
+				token:    "good-token",
+				remoteIP: "203.0.113.7",
+				status:   http.StatusOK,
+				body:     `{"success": true}`,
+			},
+			expected: Expected{ok: true, called: true, remoteIP: "203.0.113.7"},
+		},
+		"invalid token": {
+			given: Given{
+				token:  "bad-token",
+				status: http.StatusOK,
+				body:   `{"success": false, "error-codes": ["invalid-input-response"]}`,
+			},
+			expected: Expected{ok: false, called: true},
+		},
+		"empty token": {
+			given:    Given{},
+			expected: Expected{ok: false},
+		},
+		"provider error": {
+			given: Given{
+				token:  "good-token",
+				status: http.StatusInternalServerError,
+				body:   "down",
+			},
+			expected: Expected{
+				called: true,
+				err:    "failed to verify captcha: [status=500 Internal Server Error,message=down]",
+			},
+		},
+		"malformed response": {
+			given: Given{
+				token:  "good-token",
+				status: http.StatusOK,
+				body:   "not json",
+			},
+			expected: Expected{called: true, err: "failed to decode response"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				called = true
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/x-www-form-urlencoded", r.Header.Get("Content-Type"))
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "secret", r.PostForm.Get("secret"))
+				assert.Equal(t, tt.given.token, r.PostForm.Get("response"))
+				assert.Equal(t, tt.expected.remoteIP, r.PostForm.Get("remoteip"))
+
+				w.WriteHeader(tt.given.status)
+				w.Write([]byte(tt.given.body))
+			}))
+			defer server.Close()
+
+			verifier, err := NewCaptchaVerifier(
+				CaptchaConfig{
+					Secret:    "secret",
+					VerifyURL: server.URL,
+				},
+			)
+			require.NoError(t, err)
+
+			ok, err := verifier.Verify(context.Background(), tt.given.token, tt.given.remoteIP)
+
+			if tt.expected.err != "" {
+				assert.ErrorContains(t, err, tt.expected.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected.ok, ok)
+			assert.Equal(t, tt.expected.called, called)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+// Email providers supported by NewEmailSender.
+const (
+	EmailProviderEmailJS = "emailjs"
+	EmailProviderSMTP    = "smtp"
+	EmailProviderFile    = "file"
+)
+
+// Email is an outgoing email. Text and HTML are its bodies; either may be
+// empty. Params are the variables of providers that render emails from a
+// template of their own (EmailJS), which ignore the bodies.
+type Email struct {
+	To      string
+	ReplyTo string
+	Subject string
+	Text    string
+	HTML    string
+	Params  map[string]string
+}
+
+type EmailSender interface {
+	Send(ctx context.Context, email Email) error
+}
+
+// EmailJSConfig holds the EmailJS service, template and keys emails are
+// sent with. TemplateParams are sent along with every email's Params.
+type EmailJSConfig struct {
+	ServiceID      string
+	TemplateID     string
+	PublicKey      string
+	PrivateKey     string
+	TemplateParams map[string]string
+}
+
+// SMTPConfig holds the SMTP server emails are relayed through. The
+// connection is always upgraded with STARTTLS; Username and Password are
+// optional.
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+}
+
+type EmailConfig struct {
+	// Provider is EmailProviderEmailJS, EmailProviderSMTP or EmailProviderFile.
+	Provider string
+	// From is the sender address of SMTP and file emails.
+	From    string
+	EmailJS EmailJSConfig
+	SMTP    SMTPConfig
+	// Dir is where the file provider writes its .eml files.
+	Dir     string
+	HttpAPI HttpAPI
+}
+
+// NewEmailSender creates the EmailSender of cfg.Provider. It returns an
+// error for an unknown provider or when the settings the provider needs are
+// missing.
+func NewEmailSender(cfg EmailConfig) (EmailSender, error) {
+	switch cfg.Provider {
+	case EmailProviderEmailJS:
+		return newEmailJSSender(cfg)
+	case EmailProviderSMTP:
+		return newSMTPSender(cfg)
+	case EmailProviderFile:
+		return newFileSender(cfg)
+	default:
+		return nil, fmt.Errorf("unknown email provider %q", cfg.Provider)
+	}
+}
+
+// parseFrom validates the sender address of the providers building their
+// own MIME messages.
+func parseFrom(from string) (*mail.Address, error) {
+	if from == "" {
+		return nil, errors.New("email sender address missing")
+	}
+
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("email sender address invalid: %w", err)
+	}
+
+	return address, nil
+}
+
+// buildMessage renders email as a MIME message from from, dated date. It is
+// a multipart/alternative message when email has both a text and an HTML
+// body, a single part otherwise. Returns the message and the bare address
+// of its recipient.
+func buildMessage(from *mail.Address, email Email, date time.Time) ([]byte, string, error) {
+	to, err := mail.ParseAddress(email.To)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse recipient: %w", err)
+	}
+
+	header := textproto.MIMEHeader{}
+	header.Set("From", from.String())
+	header.Set("To", to.String())
+	if email.ReplyTo != "" {
+		replyTo, err := mail.ParseAddress(email.ReplyTo)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to parse reply-to: %w", err)
+		}
+		header.Set("Reply-To", replyTo.String())
+	}
+	header.Set("Subject", mime.QEncoding.Encode("utf-8", email.Subject))
+	header.Set("Date", date.Format(time.RFC1123Z))
+	header.Set("Message-ID", fmt.Sprintf("<%s@%s>", utils.GenerateKey(), domainOf(from.Address)))
+	header.Set("MIME-Version", "1.0")
+
+	var body bytes.Buffer
+	switch {
+	case email.Text != "" && email.HTML != "":
+		parts := multipart.NewWriter(&body)
+		header.Set("Content-Type", "multipart/alternative; boundary="+parts.Boundary())
+
+		for _, part := range []struct {
+			contentType string
+			content     string
+		}{
+			{"text/plain; charset=utf-8", email.Text},
+			{"text/html; charset=utf-8", email.HTML},
+		} {
+			w, err := parts.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {part.contentType},
+				"Content-Transfer-Encoding": {"quoted-printable"},
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("failed to create message part: %w", err)
+			}
+			if err := writeQuotedPrintable(w, part.content); err != nil {
+				return nil, "", err
+			}
+		}
+
+		if err := parts.Close(); err != nil {
+			return nil, "", fmt.Errorf("failed to close message parts: %w", err)
+		}
+	case email.HTML != "":
+		header.Set("Content-Type", "text/html; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		if err := writeQuotedPrintable(&body, email.HTML); err != nil {
+			return nil, "", err
+		}
+	default:
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		if err := writeQuotedPrintable(&body, email.Text); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var msg bytes.Buffer
+	for _, key := range []string{"From", "To", "Reply-To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type", "Content-Transfer-Encoding"} {
+		if value := header.Get(key); value != "" {
+			fmt.Fprintf(&msg, "%s: %s\r\n", key, value)
+		}
+	}
+	msg.WriteString("\r\n")
+	msg.Write(body.Bytes())
+
+	return msg.Bytes(), to.Address, nil
+}
+
+// writeQuotedPrintable writes content to w as quoted-printable with CRLF
+// line endings.
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return fmt.Errorf("failed to encode message body: %w", err)
+	}
+	if err := qp.Close(); err != nil {
+		return fmt.Errorf("failed to encode message body: %w", err)
+	}
+	return nil
+}
+
+// domainOf returns the domain of address, used to scope Message-IDs.
+func domainOf(address string) string {
+	if i := strings.LastIndex(address, "@"); i >= 0 {
+		return address[i+1:]
+	}
+	return "localhost"
+}
@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"maps"
+	"net/http"
+	"time"
+)
+
+const emailJSSendURL = "https://api.emailjs.com/api/v1.0/email/send"
+
+type emailJSSender struct {
+	cfg     EmailJSConfig
+	sendURL string
+	httpAPI HttpAPI
+}
+
+// emailJSPayload is the body of an EmailJS send request.
+type emailJSPayload struct {
+	ServiceID      string            `json:"service_id"`
+	TemplateID     string            `json:"template_id"`
+	UserID         string            `json:"user_id"`
+	AccessToken    string            `json:"accessToken,omitempty"`
+	TemplateParams map[string]string `json:"template_params"`
+}
+
+func newEmailJSSender(cfg EmailConfig) (EmailSender, error) {
+	if cfg.EmailJS.ServiceID == "" || cfg.EmailJS.TemplateID == "" || cfg.EmailJS.PublicKey == "" {
+		return nil, errors.New("emailjs service ID, template ID or public key missing")
+	}
+
+	httpAPI := cfg.HttpAPI
+	if httpAPI == nil {
+		httpAPI = NewHTTPAPI(30 * time.Second)
+	}
+
+	return &emailJSSender{
+		cfg:     cfg.EmailJS,
+		sendURL: emailJSSendURL,
+		httpAPI: httpAPI,
+	}, nil
+}
+
+// Send sends email through the configured EmailJS template, which renders
+// it from the configured TemplateParams and email.Params.
+// Returns an error if the request fails or if the response status is not OK.
+func (s *emailJSSender) Send(ctx context.Context, email Email) error {
+	log.Printf("Sending email via EmailJS")
+
+	params := make(map[string]string, len(s.cfg.TemplateParams)+len(email.Params))
+	maps.Copy(params, s.cfg.TemplateParams)
+	maps.Copy(params, email.Params)
+
+	body, err := json.Marshal(emailJSPayload{
+		ServiceID:      s.cfg.ServiceID,
+		TemplateID:     s.cfg.TemplateID,
+		UserID:         s.cfg.PublicKey,
+		AccessToken:    s.cfg.PrivateKey,
+		TemplateParams: params,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.sendURL, bytes.NewBuffer(body))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpAPI.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("failed to send: [status=%s,message=%s]", resp.Status, string(respBody))
+	}
+
+	log.Printf("Email sent successfully via EmailJS")
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+type fileSender struct {
+	from *mail.Address
+	dir  string
+	now  func() time.Time
+}
+
+func newFileSender(cfg EmailConfig) (EmailSender, error) {
+	if cfg.Dir == "" {
+		return nil, errors.New("email directory missing")
+	}
+
+	from, err := parseFrom(cfg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileSender{
+		from: from,
+		dir:  cfg.Dir,
+		now:  time.Now,
+	}, nil
+}
+
+// Send writes email to a new .eml file in the configured directory, which
+// is created if needed, instead of sending it. Files are named after the
+// time they were written, so they list in the order emails were sent, and
+// open in any mail client.
+func (s *fileSender) Send(ctx context.Context, email Email) error {
+	now := s.now()
+
+	msg, _, err := buildMessage(s.from, email, now)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(s.dir, 0o750); err != nil {
+		return fmt.Errorf("failed to create email directory: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405.000000000Z"), utils.GenerateKey())
+	path := filepath.Join(s.dir, name)
+	if err := os.WriteFile(path, msg, 0o600); err != nil {
+		return fmt.Errorf("failed to write email: %w", err)
+	}
+
+	log.Printf("Email written to %s", path)
+
+	return nil
+}
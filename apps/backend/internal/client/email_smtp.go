@@ -0,0 +1,126 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+const defaultSMTPPort = 587
+
+type smtpSender struct {
+	from      *mail.Address
+	addr      string
+	host      string
+	username  string
+	password  string
+	tlsConfig *tls.Config
+	now       func() time.Time
+}
+
+func newSMTPSender(cfg EmailConfig) (EmailSender, error) {
+	if cfg.SMTP.Host == "" {
+		return nil, errors.New("smtp host missing")
+	}
+
+	from, err := parseFrom(cfg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	port := cfg.SMTP.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+
+	return &smtpSender{
+		from:     from,
+		addr:     net.JoinHostPort(cfg.SMTP.Host, strconv.Itoa(port)),
+		host:     cfg.SMTP.Host,
+		username: cfg.SMTP.Username,
+		password: cfg.SMTP.Password,
+		tlsConfig: &tls.Config{
+			ServerName: cfg.SMTP.Host,
+			MinVersion: tls.VersionTLS12,
+		},
+		now: time.Now,
+	}, nil
+}
+
+// Send relays email through the SMTP server. The connection is upgraded with
+// STARTTLS before anything else is sent, and a server that does not offer it
+// is refused, so credentials and messages never travel in the clear. The
+// client authenticates with PLAIN when a username is configured.
+func (s *smtpSender) Send(ctx context.Context, email Email) error {
+	msg, to, err := buildMessage(s.from, email, s.now())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Sending email via SMTP (%s)", s.addr)
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	// Unblock the session when ctx is cancelled before its deadline
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to greet SMTP server: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); !ok {
+		return errors.New("failed to send: SMTP server does not support STARTTLS")
+	}
+	if err := c.StartTLS(s.tlsConfig); err != nil {
+		return fmt.Errorf("failed to start TLS: %w", err)
+	}
+
+	if s.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+
+	if err := c.Mail(s.from.Address); err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+	if err := c.Rcpt(to); err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+	if _, err := w.Write(msg); err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to send: %w", err)
+	}
+
+	if err := c.Quit(); err != nil {
+		return fmt.Errorf("failed to close SMTP session: %w", err)
+	}
+
+	log.Printf("Email sent successfully via SMTP")
+
+	return nil
+}
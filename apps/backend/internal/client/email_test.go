@@ -0,0 +1,470 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var emailTestNow = time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC)
+
+func newTestEmail() Email {
+	return Email{
+		To:      "Owner <owner@example.com>",
+		ReplyTo: `"Jane Doe" <jane@example.com>`,
+		Subject: "Héllo",
+		Text:    "Nice portfolio!\nBye",
+		HTML:    "<p>Nice portfolio!</p>",
+		Params:  map[string]string{"name": "Jane Doe"},
+	}
+}
+
+func TestNewEmailSender(t *testing.T) {
+	tests := map[string]struct {
+		cfg EmailConfig
+		err string
+	}{
+		"emailjs": {
+			cfg: EmailConfig{
+				Provider: EmailProviderEmailJS,
+				EmailJS:  EmailJSConfig{ServiceID: "service", TemplateID: "template", PublicKey: "public"},
+			},
+		},
+		"emailjs without keys": {
+			cfg: EmailConfig{Provider: EmailProviderEmailJS},
+			err: "emailjs service ID, template ID or public key missing",
+		},
+		"smtp": {
+			cfg: EmailConfig{
+				Provider: EmailProviderSMTP,
+				From:     "Portfolio <noreply@example.com>",
+				SMTP:     SMTPConfig{Host: "smtp.example.com"},
+			},
+		},
+		"smtp without host": {
+			cfg: EmailConfig{Provider: EmailProviderSMTP, From: "noreply@example.com"},
+			err: "smtp host missing",
+		},
+		"smtp without sender": {
+			cfg: EmailConfig{Provider: EmailProviderSMTP, SMTP: SMTPConfig{Host: "smtp.example.com"}},
+			err: "email sender address missing",
+		},
+		"file": {
+			cfg: EmailConfig{Provider: EmailProviderFile, From: "noreply@example.com", Dir: "tmp/emails"},
+		},
+		"file without directory": {
+			cfg: EmailConfig{Provider: EmailProviderFile, From: "noreply@example.com"},
+			err: "email directory missing",
+		},
+		"file with invalid sender": {
+			cfg: EmailConfig{Provider: EmailProviderFile, From: "not an address", Dir: "tmp/emails"},
+			err: "email sender address invalid: mail: no angle-addr",
+		},
+		"unknown provider": {
+			cfg: EmailConfig{Provider: "sendgrid"},
+			err: `unknown email provider "sendgrid"`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			sender, err := NewEmailSender(tt.cfg)
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				assert.Nil(t, sender)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, sender)
+			}
+		})
+	}
+
+	smtp, err := NewEmailSender(EmailConfig{Provider: EmailProviderSMTP, From: "noreply@example.com", SMTP: SMTPConfig{Host: "smtp.example.com"}})
+	require.NoError(t, err)
+	assert.Equal(t, "smtp.example.com:587", smtp.(*smtpSender).addr)
+}
+
+func TestBuildMessage(t *testing.T) {
+	from := &mail.Address{Name: "Portfolio", Address: "noreply@example.com"}
+
+	t.Run("multipart text and html", func(t *testing.T) {
+		raw, to, err := buildMessage(from, newTestEmail(), emailTestNow)
+		require.NoError(t, err)
+		assert.Equal(t, "owner@example.com", to)
+
+		msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+		require.NoError(t, err)
+
+		assert.Equal(t, `"Portfolio" <noreply@example.com>`, msg.Header.Get("From"))
+		assert.Equal(t, `"Owner" <owner@example.com>`, msg.Header.Get("To"))
+		assert.Equal(t, `"Jane Doe" <jane@example.com>`, msg.Header.Get("Reply-To"))
+		assert.Equal(t, "Thu, 12 Feb 2026 09:00:00 +0000", msg.Header.Get("Date"))
+		assert.True(t, strings.HasSuffix(msg.Header.Get("Message-ID"), "@example.com>"))
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Héllo", subject)
+
+		mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		parts := multipart.NewReader(msg.Body, params["boundary"])
+		for _, expected := range []struct {
+			contentType string
+			body        string
+		}{
+			{"text/plain; charset=utf-8", "Nice portfolio!\r\nBye"},
+			{"text/html; charset=utf-8", "<p>Nice portfolio!</p>"},
+		} {
+			part, err := parts.NextPart()
+			require.NoError(t, err)
+			assert.Equal(t, expected.contentType, part.Header.Get("Content-Type"))
+
+			// NextPart decodes quoted-printable parts
+			body, err := io.ReadAll(part)
+			require.NoError(t, err)
+			assert.Equal(t, expected.body, string(body))
+		}
+
+		_, err = parts.NextPart()
+		assert.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("text only", func(t *testing.T) {
+		email := newTestEmail()
+		email.HTML = ""
+		email.ReplyTo = ""
+
+		raw, _, err := buildMessage(from, email, emailTestNow)
+		require.NoError(t, err)
+
+		msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain; charset=utf-8", msg.Header.Get("Content-Type"))
+		assert.Equal(t, "quoted-printable", msg.Header.Get("Content-Transfer-Encoding"))
+		assert.Empty(t, msg.Header.Get("Reply-To"))
+	})
+
+	t.Run("header injection is rejected", func(t *testing.T) {
+		email := newTestEmail()
+		email.ReplyTo = "jane@example.com\r\nBcc: everyone@example.com"
+
+		_, _, err := buildMessage(from, email, emailTestNow)
+		assert.ErrorContains(t, err, "failed to parse reply-to")
+	})
+
+	t.Run("invalid recipient", func(t *testing.T) {
+		email := newTestEmail()
+		email.To = ""
+
+		_, _, err := buildMessage(from, email, emailTestNow)
+		assert.ErrorContains(t, err, "failed to parse recipient")
+	})
+}
+
+func TestEmailJSSender_Send(t *testing.T) {
+	tests := map[string]struct {
+		status int
+		body   string
+		err    string
+	}{
+		"sent": {
+			status: http.StatusOK,
+			body:   "OK",
+		},
+		"rejected": {
+			status: http.StatusBadRequest,
+			body:   "The template ID is invalid",
+			err:    "failed to send: [status=400 Bad Request,message=The template ID is invalid]",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var payload emailJSPayload
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			sender, err := NewEmailSender(EmailConfig{
+				Provider: EmailProviderEmailJS,
+				EmailJS: EmailJSConfig{
+					ServiceID:      "service_xxx",
+					TemplateID:     "template_xxx",
+					PublicKey:      "user_xxx",
+					PrivateKey:     "secret_xxx",
+					TemplateParams: map[string]string{"site": "portfolio", "name": "overridden"},
+				},
+			})
+			require.NoError(t, err)
+			sender.(*emailJSSender).sendURL = server.URL
+
+			err = sender.Send(context.Background(), newTestEmail())
+
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, emailJSPayload{
+				ServiceID:      "service_xxx",
+				TemplateID:     "template_xxx",
+				UserID:         "user_xxx",
+				AccessToken:    "secret_xxx",
+				TemplateParams: map[string]string{"site": "portfolio", "name": "Jane Doe"},
+			}, payload)
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		sender, err := NewEmailSender(EmailConfig{
+			Provider: EmailProviderEmailJS,
+			EmailJS:  EmailJSConfig{ServiceID: "service", TemplateID: "template", PublicKey: "public"},
+		})
+		require.NoError(t, err)
+		sender.(*emailJSSender).sendURL = "http://127.0.0.1:1"
+
+		err = sender.Send(context.Background(), newTestEmail())
+		assert.ErrorContains(t, err, "failed to send HTTP request")
+	})
+}
+
+func TestFileSender_Send(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "emails")
+
+	sender, err := NewEmailSender(EmailConfig{Provider: EmailProviderFile, From: "noreply@example.com", Dir: dir})
+	require.NoError(t, err)
+	sender.(*fileSender).now = func() time.Time { return emailTestNow }
+
+	require.NoError(t, sender.Send(context.Background(), newTestEmail()))
+	require.NoError(t, sender.Send(context.Background(), newTestEmail()))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+	assert.True(t, strings.HasPrefix(filepath.Base(files[0]), "20260212T090000.000000000Z-"))
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+	assert.Equal(t, "<noreply@example.com>", msg.Header.Get("From"))
+	assert.Equal(t, `"Owner" <owner@example.com>`, msg.Header.Get("To"))
+
+	err = sender.Send(context.Background(), Email{To: "nobody"})
+	assert.ErrorContains(t, err, "failed to parse recipient")
+}
+
+// smtpSession is what the fake SMTP server received during one session.
+type smtpSession struct {
+	tls  bool
+	auth string
+	from string
+	to   string
+	data string
+}
+
+// startFakeSMTPServer serves a single SMTP session on a local port and
+// returns its address, the TLS config a client needs to trust it and the
+// session once it ends. STARTTLS is only offered when starttls is set.
+func startFakeSMTPServer(t *testing.T, starttls bool) (string, *tls.Config, <-chan smtpSession) {
+	t.Helper()
+
+	// Borrow the self-signed certificate of httptest, valid for example.com
+	tlsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	cert := tlsServer.TLS.Certificates[0]
+	roots := x509.NewCertPool()
+	roots.AddCert(tlsServer.Certificate())
+	tlsServer.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { listener.Close() })
+
+	sessions := make(chan smtpSession, 1)
+
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.SetDeadline(time.Now().Add(5 * time.Second))
+
+		var session smtpSession
+		defer func() { sessions <- session }()
+
+		reader := bufio.NewReader(conn)
+		reply := func(lines ...string) {
+			for _, line := range lines {
+				io.WriteString(conn, line+"\r\n")
+			}
+		}
+
+		reply("220 fake ESMTP")
+		for {
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+			switch {
+			case verb == "EHLO" && starttls && !session.tls:
+				reply("250-fake", "250 STARTTLS")
+			case verb == "EHLO":
+				reply("250-fake", "250 AUTH PLAIN")
+			case verb == "STARTTLS":
+				reply("220 ready")
+				tlsConn := tls.Server(conn, &tls.Config{Certificates: []tls.Certificate{cert}})
+				if err := tlsConn.Handshake(); err != nil {
+					return
+				}
+				conn = tlsConn
+				reader = bufio.NewReader(conn)
+				session.tls = true
+			case verb == "AUTH":
+				session.auth = line
+				reply("235 authenticated")
+			case verb == "MAIL":
+				session.from = line
+				reply("250 ok")
+			case verb == "RCPT":
+				session.to = line
+				reply("250 ok")
+			case verb == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					dataLine, err := reader.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if dataLine == ".\r\n" {
+						break
+					}
+					data.WriteString(dataLine)
+				}
+				session.data = data.String()
+				reply("250 queued")
+			case verb == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("502 unknown command")
+			}
+		}
+	}()
+
+	return listener.Addr().String(), &tls.Config{RootCAs: roots, ServerName: "example.com"}, sessions
+}
+
+func newTestSMTPSender(t *testing.T, addr string, tlsConfig *tls.Config, username string) *smtpSender {
+	t.Helper()
+
+	host, port, err := net.SplitHostPort(addr)
+	require.NoError(t, err)
+
+	sender, err := NewEmailSender(EmailConfig{
+		Provider: EmailProviderSMTP,
+		From:     "Portfolio <noreply@example.com>",
+		SMTP:     SMTPConfig{Host: host, Username: username, Password: "secret"},
+	})
+	require.NoError(t, err)
+
+	smtp := sender.(*smtpSender)
+	smtp.addr = net.JoinHostPort(host, port)
+	smtp.tlsConfig = tlsConfig
+	smtp.now = func() time.Time { return emailTestNow }
+	return smtp
+}
+
+func TestSMTPSender_Send(t *testing.T) {
+	t.Run("sends over STARTTLS with PLAIN auth", func(t *testing.T) {
+		addr, tlsConfig, sessions := startFakeSMTPServer(t, true)
+		sender := newTestSMTPSender(t, addr, tlsConfig, "user")
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		require.NoError(t, sender.Send(ctx, newTestEmail()))
+
+		session := <-sessions
+		assert.True(t, session.tls)
+		assert.Equal(t, "AUTH PLAIN "+base64.StdEncoding.EncodeToString([]byte("\x00user\x00secret")), session.auth)
+		assert.Equal(t, "MAIL FROM:<noreply@example.com>", session.from)
+		assert.Equal(t, "RCPT TO:<owner@example.com>", session.to)
+
+		msg, err := mail.ReadMessage(strings.NewReader(session.data))
+		require.NoError(t, err)
+		assert.Equal(t, `"Jane Doe" <jane@example.com>`, msg.Header.Get("Reply-To"))
+		assert.True(t, strings.HasPrefix(msg.Header.Get("Content-Type"), "multipart/alternative; boundary="))
+	})
+
+	t.Run("skips auth without a username", func(t *testing.T) {
+		addr, tlsConfig, sessions := startFakeSMTPServer(t, true)
+		sender := newTestSMTPSender(t, addr, tlsConfig, "")
+
+		require.NoError(t, sender.Send(context.Background(), newTestEmail()))
+
+		session := <-sessions
+		assert.True(t, session.tls)
+		assert.Empty(t, session.auth)
+	})
+
+	t.Run("refuses a server without STARTTLS", func(t *testing.T) {
+		addr, tlsConfig, sessions := startFakeSMTPServer(t, false)
+		sender := newTestSMTPSender(t, addr, tlsConfig, "user")
+
+		err := sender.Send(context.Background(), newTestEmail())
+		assert.EqualError(t, err, "failed to send: SMTP server does not support STARTTLS")
+
+		session := <-sessions
+		assert.Empty(t, session.auth)
+		assert.Empty(t, session.data)
+	})
+
+	t.Run("untrusted certificate", func(t *testing.T) {
+		addr, _, _ := startFakeSMTPServer(t, true)
+		sender := newTestSMTPSender(t, addr, &tls.Config{ServerName: "example.com"}, "user")
+
+		err := sender.Send(context.Background(), newTestEmail())
+		assert.ErrorContains(t, err, "failed to start TLS")
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		sender := newTestSMTPSender(t, "127.0.0.1:1", nil, "")
+
+		err := sender.Send(context.Background(), newTestEmail())
+		assert.ErrorContains(t, err, "failed to connect to SMTP server")
+	})
+}
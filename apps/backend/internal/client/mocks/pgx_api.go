@@ -39,6 +39,68 @@ func (_m *MockPgxAPI) EXPECT() *MockPgxAPI_Expecter {
 	return &MockPgxAPI_Expecter{mock: &_m.Mock}
 }
 
+// Begin provides a mock function for the type MockPgxAPI
+func (_mock *MockPgxAPI) Begin(ctx context.Context) (pgx.Tx, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 pgx.Tx
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (pgx.Tx, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) pgx.Tx); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pgx.Tx)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockPgxAPI_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type MockPgxAPI_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockPgxAPI_Expecter) Begin(ctx interface{}) *MockPgxAPI_Begin_Call {
+	return &MockPgxAPI_Begin_Call{Call: _e.mock.On("Begin", ctx)}
+}
+
+func (_c *MockPgxAPI_Begin_Call) Run(run func(ctx context.Context)) *MockPgxAPI_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockPgxAPI_Begin_Call) Return(tx pgx.Tx, err error) *MockPgxAPI_Begin_Call {
+	_c.Call.Return(tx, err)
+	return _c
+}
+
+func (_c *MockPgxAPI_Begin_Call) RunAndReturn(run func(ctx context.Context) (pgx.Tx, error)) *MockPgxAPI_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type MockPgxAPI
 func (_mock *MockPgxAPI) Close() {
 	_mock.Called()
@@ -13,6 +13,7 @@ type PgxAPI interface {
 	QueryRow(ctx context.Context, query string, args ...any) pgx.Row
 	Exec(ctx context.Context, query string, args ...any) (pgconn.CommandTag, error)
 	Query(ctx context.Context, query string, args ...any) (pgx.Rows, error)
+	Begin(ctx context.Context) (pgx.Tx, error)
 	Close()
 }
 
@@ -74,6 +75,13 @@ func (p *pgxClient) Query(ctx context.Context, query string, args ...any) (pgx.R
 	return p.pool.Query(ctx, query, args...)
 }
 
+// Begin starts a new transaction on a connection acquired from the pool.
+// The returned pgx.Tx must be finished with either Commit or Rollback, which
+// also releases the underlying connection back to the pool.
+func (p *pgxClient) Begin(ctx context.Context) (pgx.Tx, error) {
+	return p.pool.Begin(ctx)
+}
+
 // Close releases all resources used by the pgxClient by closing its underlying connection pool.
 func (p *pgxClient) Close() {
 	p.pool.Close()
@@ -2,6 +2,8 @@ package database
 
 import (
 	"context"
+	"fmt"
+	"log"
 
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
 	"github.com/jackc/pgx/v5"
@@ -35,6 +37,44 @@ func (c commandTagWrapper) RowsAffected() int64 {
 	return c.CommandTag.RowsAffected()
 }
 
+type txWrapper struct {
+	tx          pgx.Tx
+	afterCommit []func()
+}
+
+func (t *txWrapper) QueryRow(ctx context.Context, query string, args ...any) Row {
+	return rowWrapper{t.tx.QueryRow(ctx, query, args...)}
+}
+
+func (t *txWrapper) Exec(ctx context.Context, query string, args ...any) (CommandTag, error) {
+	tag, err := t.tx.Exec(ctx, query, args...)
+	return commandTagWrapper{tag}, err
+}
+
+func (t *txWrapper) Query(ctx context.Context, query string, args ...any) (Rows, error) {
+	rows, err := t.tx.Query(ctx, query, args...)
+	return rowsWrapper{rows}, err
+}
+
+// Commit commits the transaction and, once it has succeeded, runs the
+// functions registered with AfterCommit in registration order.
+func (t *txWrapper) Commit(ctx context.Context) error {
+	if err := t.tx.Commit(ctx); err != nil {
+		return err
+	}
+
+	for _, fn := range t.afterCommit {
+		fn()
+	}
+	t.afterCommit = nil
+
+	return nil
+}
+
+func (t *txWrapper) Rollback(ctx context.Context) error { return t.tx.Rollback(ctx) }
+
+func (t *txWrapper) AfterCommit(fn func()) { t.afterCommit = append(t.afterCommit, fn) }
+
 // --- Interfaces ---
 
 type Row interface {
@@ -52,10 +92,30 @@ type CommandTag interface {
 	RowsAffected() int64
 }
 
-type DatabaseAPI interface {
+// Querier is the query surface shared by the connection pool and by an open
+// transaction. Repositories depend on Querier so the same code can run
+// against either one.
+type Querier interface {
 	QueryRow(ctx context.Context, query string, args ...any) Row
 	Exec(ctx context.Context, query string, args ...any) (CommandTag, error)
 	Query(ctx context.Context, query string, args ...any) (Rows, error)
+}
+
+// Tx is an open database transaction. It must be finished with exactly one
+// call to Commit or Rollback.
+type Tx interface {
+	Querier
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+	// AfterCommit registers fn to run once the transaction has committed.
+	// Functions registered on a transaction that is rolled back never run.
+	AfterCommit(fn func())
+}
+
+type DatabaseAPI interface {
+	Querier
+	Begin(ctx context.Context) (Tx, error)
+	WithTx(ctx context.Context, fn func(tx Tx) error) error
 	Close()
 }
 
@@ -92,6 +152,47 @@ func (d *database) Query(ctx context.Context, query string, args ...any) (Rows,
 	return rowsWrapper{rows}, err
 }
 
+// Begin starts a new transaction on the underlying pool.
+func (d *database) Begin(ctx context.Context) (Tx, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	return &txWrapper{tx: tx}, nil
+}
+
+// WithTx runs fn inside a single transaction. The transaction is committed
+// when fn returns nil and rolled back when fn returns an error or panics.
+// The error returned by fn is passed through unchanged so callers can keep
+// using errors.Is/errors.As on it; a failed rollback is only logged.
+func (d *database) WithTx(ctx context.Context, fn func(tx Tx) error) error {
+	tx, err := d.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback(ctx)
+			panic(p)
+		}
+	}()
+
+	if err := fn(tx); err != nil {
+		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			log.Printf("Failed to rollback transaction: %v", rbErr)
+		}
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
 func (d *database) Close() {
 	d.pool.Close()
 }
@@ -0,0 +1,230 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockClient "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakePgxTx records how a transaction was finished. Only Commit and Rollback
+// are implemented; the embedded pgx.Tx is nil and panics on any other call.
+type fakePgxTx struct {
+	pgx.Tx
+	commitErr   error
+	rollbackErr error
+	committed   bool
+	rolledBack  bool
+}
+
+func (f *fakePgxTx) Commit(ctx context.Context) error {
+	if f.commitErr != nil {
+		return f.commitErr
+	}
+	f.committed = true
+	return nil
+}
+
+func (f *fakePgxTx) Rollback(ctx context.Context) error {
+	f.rolledBack = true
+	return f.rollbackErr
+}
+
+type databaseTestFixture struct {
+	t           *testing.T
+	mockPgxAPI  *mockClient.MockPgxAPI
+	fakeTx      *fakePgxTx
+	databaseAPI DatabaseAPI
+}
+
+func newDatabaseTestFixture(t *testing.T) *databaseTestFixture {
+	mockPgxAPI := new(mockClient.MockPgxAPI)
+	fakeTx := &fakePgxTx{}
+
+	return &databaseTestFixture{
+		t:           t,
+		mockPgxAPI:  mockPgxAPI,
+		fakeTx:      fakeTx,
+		databaseAPI: &database{pool: mockPgxAPI},
+	}
+}
+
+func TestDatabase_WithTx(t *testing.T) {
+	fnErr := errors.New("fn failure")
+
+	type Given struct {
+		beginErr    error
+		commitErr   error
+		rollbackErr error
+		fnErr       error
+	}
+	type Expected struct {
+		err        string
+		errIs      error
+		committed  bool
+		rolledBack bool
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"commits when fn succeeds": {
+			expected: Expected{
+				committed: true,
+			},
+		},
+		"rolls back and passes the fn error through": {
+			given: Given{
+				fnErr: fnErr,
+			},
+			expected: Expected{
+				err:        "fn failure",
+				errIs:      fnErr,
+				rolledBack: true,
+			},
+		},
+		"passes the fn error through when rollback fails": {
+			given: Given{
+				fnErr:       fnErr,
+				rollbackErr: errors.New("rollback failure"),
+			},
+			expected: Expected{
+				err:        "fn failure",
+				errIs:      fnErr,
+				rolledBack: true,
+			},
+		},
+		"wraps commit error": {
+			given: Given{
+				commitErr: pgx.ErrTxCommitRollback,
+			},
+			expected: Expected{
+				err:   "failed to commit transaction: commit unexpectedly resulted in rollback",
+				errIs: pgx.ErrTxCommitRollback,
+			},
+		},
+		"begin error": {
+			given: Given{
+				beginErr: errors.New("connection refused"),
+			},
+			expected: Expected{
+				err: "failed to begin transaction: connection refused",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newDatabaseTestFixture(t)
+			f.fakeTx.commitErr = tt.given.commitErr
+			f.fakeTx.rollbackErr = tt.given.rollbackErr
+
+			if tt.given.beginErr != nil {
+				f.mockPgxAPI.EXPECT().Begin(mock.Anything).Return(nil, tt.given.beginErr)
+			} else {
+				f.mockPgxAPI.EXPECT().Begin(mock.Anything).Return(f.fakeTx, nil)
+			}
+
+			called := false
+			err := f.databaseAPI.WithTx(context.Background(), func(tx Tx) error {
+				called = true
+				return tt.given.fnErr
+			})
+
+			if tt.expected.err != "" {
+				assert.EqualError(t, err, tt.expected.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.expected.errIs != nil {
+				assert.ErrorIs(t, err, tt.expected.errIs)
+			}
+			if tt.given.fnErr != nil {
+				// The fn error must come back as is, not wrapped
+				assert.Same(t, tt.given.fnErr, err)
+			}
+			assert.Equal(t, tt.given.beginErr == nil, called)
+			assert.Equal(t, tt.expected.committed, f.fakeTx.committed)
+			assert.Equal(t, tt.expected.rolledBack, f.fakeTx.rolledBack)
+
+			f.mockPgxAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDatabase_WithTx_Panic(t *testing.T) {
+	f := newDatabaseTestFixture(t)
+	f.mockPgxAPI.EXPECT().Begin(mock.Anything).Return(f.fakeTx, nil)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		_ = f.databaseAPI.WithTx(context.Background(), func(tx Tx) error {
+			panic("boom")
+		})
+	})
+
+	assert.True(t, f.fakeTx.rolledBack)
+	assert.False(t, f.fakeTx.committed)
+
+	f.mockPgxAPI.AssertExpectations(t)
+}
+
+func TestDatabase_WithTx_AfterCommit(t *testing.T) {
+	type Given struct {
+		commitErr error
+		fnErr     error
+	}
+	type Expected struct {
+		calls []string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"runs hooks in order after commit": {
+			expected: Expected{
+				calls: []string{"first", "second"},
+			},
+		},
+		"skips hooks when fn fails": {
+			given: Given{
+				fnErr: errors.New("fn failure"),
+			},
+		},
+		"skips hooks when commit fails": {
+			given: Given{
+				commitErr: errors.New("commit failure"),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newDatabaseTestFixture(t)
+			f.fakeTx.commitErr = tt.given.commitErr
+			f.mockPgxAPI.EXPECT().Begin(mock.Anything).Return(f.fakeTx, nil)
+
+			var calls []string
+			_ = f.databaseAPI.WithTx(context.Background(), func(tx Tx) error {
+				for _, name := range []string{"first", "second"} {
+					tx.AfterCommit(func() {
+						// Hooks must only see a committed transaction
+						assert.True(t, f.fakeTx.committed)
+						calls = append(calls, name)
+					})
+				}
+				assert.Empty(t, calls)
+				return tt.given.fnErr
+			})
+
+			assert.Equal(t, tt.expected.calls, calls)
+
+			f.mockPgxAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_skill_created_at_id;
+DROP INDEX IF EXISTS idx_education_created_at_id;
+DROP INDEX IF EXISTS idx_project_created_at_id;
@@ -0,0 +1,4 @@
+-- Support keyset pagination on (created_at, id)
+CREATE INDEX IF NOT EXISTS idx_project_created_at_id ON project(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_education_created_at_id ON education(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_skill_created_at_id ON skill(created_at, id);
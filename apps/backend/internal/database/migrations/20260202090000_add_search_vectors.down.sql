@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_education_search_vector;
+DROP INDEX IF EXISTS idx_skill_search_vector;
+DROP INDEX IF EXISTS idx_project_search_vector;
+
+ALTER TABLE education DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE skill DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE project DROP COLUMN IF EXISTS search_vector;
+
+DROP FUNCTION IF EXISTS immutable_array_to_string(TEXT[], TEXT);
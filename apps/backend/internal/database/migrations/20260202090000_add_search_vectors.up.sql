@@ -0,0 +1,30 @@
+-- array_to_string is only STABLE, so wrap it to use it in a generated column
+CREATE OR REPLACE FUNCTION immutable_array_to_string(arr TEXT[], sep TEXT)
+RETURNS TEXT AS $$
+    SELECT array_to_string(arr, sep);
+$$ LANGUAGE sql IMMUTABLE PARALLEL SAFE;
+
+-- Projects are prose, so stem them with the english configuration
+ALTER TABLE project
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(sub_title, '')), 'B') ||
+    setweight(to_tsvector('english', immutable_array_to_string(tags, ' ')), 'B') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'C')
+) STORED;
+
+-- Skill labels and school names are proper nouns, so index them unstemmed
+ALTER TABLE skill
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    to_tsvector('simple', coalesce(label, ''))
+) STORED;
+
+ALTER TABLE education
+ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(main_school->>'name', '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(jsonb_path_query_array(school_periods, '$[*].name'), '[]'::jsonb)), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_project_search_vector ON project USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_skill_search_vector ON skill USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS idx_education_search_vector ON education USING GIN (search_vector);
@@ -0,0 +1,2 @@
+-- Serve the tag filters (&& and @>) from an index
+CREATE INDEX IF NOT EXISTS idx_project_tags ON project USING GIN (tags);
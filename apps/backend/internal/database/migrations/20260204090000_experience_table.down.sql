@@ -0,0 +1,4 @@
+-- Company logos are stored in file under the 'experiences' parent
+DELETE FROM file WHERE parent_table = 'experiences';
+
+DROP TABLE IF EXISTS experience;
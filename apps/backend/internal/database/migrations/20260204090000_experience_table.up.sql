@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS experience (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    position TEXT NOT NULL,
+    company TEXT NOT NULL,
+    link TEXT NOT NULL DEFAULT '',
+    setup TEXT CHECK (setup IN ('remote', 'on-site', 'hybrid')) NOT NULL,
+    start_date TIMESTAMPTZ NOT NULL,
+    end_date TIMESTAMPTZ,                       -- NULL while the position is current
+    highlights TEXT[] NOT NULL DEFAULT '{}',
+    skills TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    CHECK (end_date IS NULL OR end_date > start_date)
+);
+
+-- Support keyset pagination on (created_at, id)
+CREATE INDEX IF NOT EXISTS idx_experience_created_at_id ON experience(created_at, id);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS project_skill (
+    project_id UUID NOT NULL REFERENCES project(id) ON DELETE CASCADE,
+    skill_id UUID NOT NULL REFERENCES skill(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (project_id, skill_id)
+);
+
+-- The primary key serves lookups by project; this one serves GET /skill/{id}/projects
+CREATE INDEX IF NOT EXISTS idx_project_skill_skill_id ON project_skill(skill_id);
+
+-- Backfill links from tags that name an existing skill (case-insensitive)
+INSERT INTO project_skill (project_id, skill_id)
+SELECT DISTINCT p.id, s.id
+FROM project p
+CROSS JOIN LATERAL unnest(p.tags) AS tag
+JOIN skill s ON lower(s.label) = lower(btrim(tag))
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,25 @@
+DROP INDEX IF EXISTS idx_experience_deleted_at;
+DROP INDEX IF EXISTS idx_skill_deleted_at;
+DROP INDEX IF EXISTS idx_education_deleted_at;
+DROP INDEX IF EXISTS idx_project_deleted_at;
+
+-- Trashed rows cannot survive without the column that hides them
+DELETE FROM file WHERE deleted_at IS NOT NULL;
+DELETE FROM project WHERE deleted_at IS NOT NULL;
+DELETE FROM skill WHERE deleted_at IS NOT NULL;
+DELETE FROM education WHERE deleted_at IS NOT NULL;
+DELETE FROM experience WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_skill_label_active;
+ALTER TABLE skill ADD CONSTRAINT skill_label_key UNIQUE (label);
+
+ALTER TABLE project DROP CONSTRAINT IF EXISTS project_education_id_fkey;
+ALTER TABLE project
+    ADD CONSTRAINT project_education_id_fkey
+    FOREIGN KEY (education_id) REFERENCES education(id) ON DELETE CASCADE;
+
+ALTER TABLE experience DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE file DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE skill DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE education DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE project DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,22 @@
+-- Soft delete: rows are hidden by deleted_at instead of being removed
+ALTER TABLE project ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE education ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE skill ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE file ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+ALTER TABLE experience ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Purging an education must no longer take its projects with it
+ALTER TABLE project DROP CONSTRAINT IF EXISTS project_education_id_fkey;
+ALTER TABLE project
+    ADD CONSTRAINT project_education_id_fkey
+    FOREIGN KEY (education_id) REFERENCES education(id) ON DELETE SET NULL;
+
+-- A trashed skill should not block reusing its label
+ALTER TABLE skill DROP CONSTRAINT IF EXISTS skill_label_key;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_skill_label_active ON skill(label) WHERE deleted_at IS NULL;
+
+-- Support the trash listing
+CREATE INDEX IF NOT EXISTS idx_project_deleted_at ON project(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_education_deleted_at ON education(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_skill_deleted_at ON skill(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_experience_deleted_at ON experience(deleted_at) WHERE deleted_at IS NOT NULL;
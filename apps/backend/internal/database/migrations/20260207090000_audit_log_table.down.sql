@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_audit_log_entity;
+DROP INDEX IF EXISTS idx_audit_log_created_at_id;
+DROP TABLE IF EXISTS audit_log;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    entity TEXT CHECK (entity IN ('project', 'education', 'skill', 'file')) NOT NULL,
+    entity_id TEXT NOT NULL,                    -- the parent ID for bulk file operations
+    action TEXT CHECK (action IN ('create', 'update', 'delete', 'restore', 'purge')) NOT NULL,
+    diff JSONB NOT NULL DEFAULT '{}',           -- {"field": {"before": ..., "after": ...}}
+    request_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Support the audit listing, newest first, with and without an entity filter
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at_id ON audit_log(created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_log_entity ON audit_log(entity, entity_id, created_at DESC);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS refresh_token (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    family_id UUID NOT NULL,                    -- shared by every token rotated from one login
+    subject TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,            -- hex SHA-256 of the token handed to the client
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Revoke a whole family on logout or when a rotated token is replayed
+CREATE INDEX IF NOT EXISTS idx_refresh_token_family_id ON refresh_token(family_id);
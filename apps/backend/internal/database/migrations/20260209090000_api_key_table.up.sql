@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS api_key (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    key_hash TEXT NOT NULL UNIQUE,              -- hex SHA-256 of the key handed to the client
+    scopes TEXT[] NOT NULL,                     -- subset of 'read', 'projects:write', 'files:write'
+    expires_at TIMESTAMPTZ,                     -- NULL never expires
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT api_key_scopes_check CHECK (
+        cardinality(scopes) > 0
+        AND scopes <@ ARRAY['read', 'projects:write', 'files:write']::TEXT[]
+    )
+);
+
+-- Support the admin listing, newest first
+CREATE INDEX IF NOT EXISTS idx_api_key_created_at_id ON api_key(created_at DESC, id DESC);
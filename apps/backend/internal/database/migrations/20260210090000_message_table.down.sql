@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_message_created_at_id;
+DROP TABLE IF EXISTS message;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS message (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    email TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    message TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',     -- 'pending', 'sent' or 'failed'
+    attempts INTEGER NOT NULL DEFAULT 0,        -- delivery attempts made so far
+    provider_response TEXT,                     -- what the email provider answered on the last attempt
+    read_at TIMESTAMPTZ,
+    archived_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT message_status_check CHECK (status IN ('pending', 'sent', 'failed'))
+);
+
+-- Support the inbox listing, newest first, with archived messages filtered out
+CREATE INDEX IF NOT EXISTS idx_message_created_at_id ON message(created_at DESC, id DESC) WHERE archived_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_email_outbox_message_id;
+DROP INDEX IF EXISTS idx_email_outbox_next_attempt_at;
+DROP TABLE IF EXISTS email_outbox;
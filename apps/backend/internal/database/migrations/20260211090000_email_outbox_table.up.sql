@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS email_outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    message_id UUID NOT NULL REFERENCES message(id) ON DELETE CASCADE,
+    status TEXT NOT NULL DEFAULT 'pending',     -- 'pending', 'sent' or 'dead'
+    attempts INTEGER NOT NULL DEFAULT 0,        -- delivery attempts made so far
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT,                            -- why the last attempt failed
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT email_outbox_status_check CHECK (status IN ('pending', 'sent', 'dead'))
+);
+
+-- Support the worker picking up the pending emails that are due, oldest first
+CREATE INDEX IF NOT EXISTS idx_email_outbox_next_attempt_at ON email_outbox(next_attempt_at) WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_email_outbox_message_id ON email_outbox(message_id);
@@ -0,0 +1,3 @@
+ALTER TABLE email_outbox
+DROP CONSTRAINT IF EXISTS email_outbox_template_check,
+DROP COLUMN template;
@@ -0,0 +1,5 @@
+-- Which email of the message the row delivers; rows queued before the
+-- auto-reply existed all notify the owner
+ALTER TABLE email_outbox
+ADD COLUMN template TEXT NOT NULL DEFAULT 'owner_notification',
+ADD CONSTRAINT email_outbox_template_check CHECK (template IN ('owner_notification', 'auto_reply'));
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_page_view_created_at;
+DROP TABLE IF EXISTS page_view;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS page_view (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    path TEXT NOT NULL,
+    title TEXT NOT NULL,
+    referrer TEXT NOT NULL DEFAULT '',          -- referring host, '' for direct visits
+    ua_family TEXT NOT NULL DEFAULT '',         -- browser family, e.g. 'Chrome' or 'Bot'
+    country TEXT NOT NULL DEFAULT '',           -- ISO 3166-1 alpha-2 code, '' when unknown
+    visitor_id TEXT NOT NULL,                   -- salted hash of IP and user agent, rotated daily
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Support the dashboards, which aggregate page views over a date range
+CREATE INDEX IF NOT EXISTS idx_page_view_created_at ON page_view(created_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS analytics_event (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    path TEXT NOT NULL DEFAULT '',              -- path of the page the event happened on, '' when unknown
+    params JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ua_family TEXT NOT NULL DEFAULT '',
+    country TEXT NOT NULL DEFAULT '',
+    visitor_id TEXT NOT NULL,                   -- same daily rotating hash as page_view.visitor_id
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    CONSTRAINT analytics_event_name_check CHECK (name IN ('project_click', 'resume_download', 'outbound_link', 'contact_submit'))
+);
+
+-- Support the dashboards, which aggregate events over a date range
+CREATE INDEX IF NOT EXISTS idx_analytics_event_created_at ON analytics_event(created_at);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS experience_skill (
+    experience_id UUID NOT NULL REFERENCES experience(id) ON DELETE CASCADE,
+    skill_id UUID NOT NULL REFERENCES skill(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (experience_id, skill_id)
+);
+
+-- The primary key serves lookups by experience; this one serves GET /skill/{id}/experiences
+CREATE INDEX IF NOT EXISTS idx_experience_skill_skill_id ON experience_skill(skill_id);
+
+-- Backfill links from free-text skills that name an existing skill (case-insensitive)
+INSERT INTO experience_skill (experience_id, skill_id)
+SELECT DISTINCT e.id, s.id
+FROM experience e
+CROSS JOIN LATERAL unnest(e.skills) AS skill
+JOIN skill s ON lower(s.label) = lower(btrim(skill)) AND s.deleted_at IS NULL
+ON CONFLICT DO NOTHING;
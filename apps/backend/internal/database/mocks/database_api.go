@@ -38,6 +38,68 @@ func (_m *MockDatabaseAPI) EXPECT() *MockDatabaseAPI_Expecter {
 	return &MockDatabaseAPI_Expecter{mock: &_m.Mock}
 }
 
+// Begin provides a mock function for the type MockDatabaseAPI
+func (_mock *MockDatabaseAPI) Begin(ctx context.Context) (database.Tx, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Begin")
+	}
+
+	var r0 database.Tx
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (database.Tx, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) database.Tx); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(database.Tx)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockDatabaseAPI_Begin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Begin'
+type MockDatabaseAPI_Begin_Call struct {
+	*mock.Call
+}
+
+// Begin is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockDatabaseAPI_Expecter) Begin(ctx interface{}) *MockDatabaseAPI_Begin_Call {
+	return &MockDatabaseAPI_Begin_Call{Call: _e.mock.On("Begin", ctx)}
+}
+
+func (_c *MockDatabaseAPI_Begin_Call) Run(run func(ctx context.Context)) *MockDatabaseAPI_Begin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabaseAPI_Begin_Call) Return(tx database.Tx, err error) *MockDatabaseAPI_Begin_Call {
+	_c.Call.Return(tx, err)
+	return _c
+}
+
+func (_c *MockDatabaseAPI_Begin_Call) RunAndReturn(run func(ctx context.Context) (database.Tx, error)) *MockDatabaseAPI_Begin_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function for the type MockDatabaseAPI
 func (_mock *MockDatabaseAPI) Close() {
 	_mock.Called()
@@ -310,3 +372,60 @@ func (_c *MockDatabaseAPI_QueryRow_Call) RunAndReturn(run func(ctx context.Conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// WithTx provides a mock function for the type MockDatabaseAPI
+func (_mock *MockDatabaseAPI) WithTx(ctx context.Context, fn func(tx database.Tx) error) error {
+	ret := _mock.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, func(tx database.Tx) error) error); ok {
+		r0 = returnFunc(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockDatabaseAPI_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockDatabaseAPI_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(tx database.Tx) error
+func (_e *MockDatabaseAPI_Expecter) WithTx(ctx interface{}, fn interface{}) *MockDatabaseAPI_WithTx_Call {
+	return &MockDatabaseAPI_WithTx_Call{Call: _e.mock.On("WithTx", ctx, fn)}
+}
+
+func (_c *MockDatabaseAPI_WithTx_Call) Run(run func(ctx context.Context, fn func(tx database.Tx) error)) *MockDatabaseAPI_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 func(tx database.Tx) error
+		if args[1] != nil {
+			arg1 = args[1].(func(tx database.Tx) error)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockDatabaseAPI_WithTx_Call) Return(err error) *MockDatabaseAPI_WithTx_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockDatabaseAPI_WithTx_Call) RunAndReturn(run func(ctx context.Context, fn func(tx database.Tx) error) error) *MockDatabaseAPI_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
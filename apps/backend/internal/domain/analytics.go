@@ -1,10 +1,25 @@
 package domain
 
-import "errors"
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
 
 type PageView struct {
+	AnalyticsClient
 	PageLocation string `json:"location"`
 	PageTitle    string `json:"title"`
+	// Referrer is the document.referrer of the page, empty for direct visits.
+	Referrer string `json:"referrer,omitempty"`
+	// EngagementTimeMsec is how long the previous page was in focus, in milliseconds.
+	EngagementTimeMsec int64 `json:"engagement_time_msec,omitempty"`
 }
 
 func (p PageView) Validate() error {
@@ -14,6 +29,403 @@ func (p PageView) Validate() error {
 	if p.PageTitle == "" {
 		return errors.New("pageTitle missing")
 	}
+	if err := validateEngagementTime(p.EngagementTimeMsec); err != nil {
+		return err
+	}
+
+	return p.AnalyticsClient.Validate()
+}
+
+// Analytics client limits. The client ID cookie is not renewed, so a browser
+// keeping cookies gets a new client ID every ClientIDLifetime, and a session
+// ends after SessionTimeout without page views or events, as in GA4.
+const (
+	ClientIDLifetime      = 30 * 24 * time.Hour
+	SessionTimeout        = 30 * time.Minute
+	MaxEngagementTimeMsec = int64(time.Hour / time.Millisecond)
+)
+
+var (
+	clientIDPattern  = regexp.MustCompile(`^[A-Za-z0-9._-]{8,64}$`)
+	sessionIDPattern = regexp.MustCompile(`^[0-9]{1,20}$`)
+)
+
+// AnalyticsClient identifies the browser sending page views and events, and
+// its current session, across requests. Both are optional: the server falls
+// back to its own cookies when they are empty.
+type AnalyticsClient struct {
+	// ClientID is chosen by the browser, e.g. a random ID kept in local storage.
+	ClientID string `json:"client_id,omitempty"`
+	// SessionID is numeric, e.g. the unix time the session started.
+	SessionID string `json:"session_id,omitempty"`
+}
+
+func (c AnalyticsClient) Validate() error {
+	if c.ClientID != "" && !IsValidClientID(c.ClientID) {
+		return errors.New("client_id invalid: must be 8 to 64 letters, digits, dots, dashes or underscores")
+	}
+	if c.SessionID != "" && !IsValidSessionID(c.SessionID) {
+		return errors.New("session_id invalid: must be numeric")
+	}
+
+	return nil
+}
+
+func IsValidClientID(clientID string) bool {
+	return clientIDPattern.MatchString(clientID)
+}
+
+func IsValidSessionID(sessionID string) bool {
+	return sessionIDPattern.MatchString(sessionID)
+}
+
+func validateEngagementTime(msec int64) error {
+	if msec < 0 || msec > MaxEngagementTimeMsec {
+		return fmt.Errorf("engagement_time_msec must be between 0 and %d", MaxEngagementTimeMsec)
+	}
+	return nil
+}
+
+// Path returns the path of the page location, "/" when it has none. Query
+// strings and fragments are dropped so they do not split page counts.
+func (p PageView) Path() string {
+	u, err := url.Parse(p.PageLocation)
+	if err != nil || u.Path == "" {
+		return "/"
+	}
+	return u.Path
+}
+
+// ReferrerHost returns the host the visitor came from, without a leading
+// "www.". It is empty for direct visits, unparsable referrers and
+// navigation within the site itself.
+func (p PageView) ReferrerHost() string {
+	referrer, err := url.Parse(p.Referrer)
+	if err != nil || referrer.Hostname() == "" {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(referrer.Hostname()), "www.")
+	if location, err := url.Parse(p.PageLocation); err == nil {
+		if strings.TrimPrefix(strings.ToLower(location.Hostname()), "www.") == host {
+			return ""
+		}
+	}
+
+	return host
+}
+
+// AnalyticsEventName is the name of a custom analytics event, as sent to
+// GA4 and stored first-party.
+type AnalyticsEventName string
+
+const (
+	EventProjectClick   AnalyticsEventName = "project_click"
+	EventResumeDownload AnalyticsEventName = "resume_download"
+	EventOutboundLink   AnalyticsEventName = "outbound_link"
+	EventContactSubmit  AnalyticsEventName = "contact_submit"
+)
+
+// AnalyticsEventNames lists every custom analytics event.
+var AnalyticsEventNames = []AnalyticsEventName{
+	EventProjectClick,
+	EventResumeDownload,
+	EventOutboundLink,
+	EventContactSubmit,
+}
+
+func (n AnalyticsEventName) IsValid() bool {
+	switch n {
+	case EventProjectClick, EventResumeDownload, EventOutboundLink, EventContactSubmit:
+		return true
+	default:
+		return false
+	}
+}
+
+// analyticsEventParams lists the params each event accepts, mapped to
+// whether they are required.
+var analyticsEventParams = map[AnalyticsEventName]map[string]bool{
+	EventProjectClick: {
+		"project_id":    true,
+		"project_title": false,
+		"link_url":      false,
+	},
+	EventResumeDownload: {
+		"file_name": false,
+	},
+	EventOutboundLink: {
+		"link_url":  true,
+		"link_text": false,
+	},
+	EventContactSubmit: {},
+}
+
+// Analytics event limits. Param values follow the GA4 limit of 100
+// characters, except URLs which may be as long as a page location.
+const (
+	MaxAnalyticsEvents         = 25
+	MaxAnalyticsParamLength    = 100
+	MaxAnalyticsURLParamLength = 1000
+)
+
+// AnalyticsEvent is a custom analytics event, e.g. a click on a project.
+type AnalyticsEvent struct {
+	Name AnalyticsEventName `json:"name"`
+	// PageLocation is the URL of the page the event happened on, if known.
+	PageLocation string            `json:"location,omitempty"`
+	Params       map[string]string `json:"params,omitempty"`
+	// EngagementTimeMsec is how long the page was in focus before the event, in milliseconds.
+	EngagementTimeMsec int64 `json:"engagement_time_msec,omitempty"`
+}
+
+// Validate checks the event name and its params: every required param must
+// be set, no other params are accepted and link_url must be an absolute
+// http(s) URL.
+func (e AnalyticsEvent) Validate() error {
+	if !e.Name.IsValid() {
+		return fmt.Errorf("name invalid = %s", e.Name)
+	}
+	if err := validateEngagementTime(e.EngagementTimeMsec); err != nil {
+		return err
+	}
+
+	accepted := analyticsEventParams[e.Name]
+	for key, value := range e.Params {
+		if _, ok := accepted[key]; !ok {
+			return fmt.Errorf("param %s not accepted by %s", key, e.Name)
+		}
+
+		maxLength := MaxAnalyticsParamLength
+		if key == "link_url" {
+			maxLength = MaxAnalyticsURLParamLength
+		}
+		if len(value) > maxLength {
+			return fmt.Errorf("param %s longer than %d characters", key, maxLength)
+		}
+	}
+
+	for key, required := range accepted {
+		if required && e.Params[key] == "" {
+			return fmt.Errorf("param %s missing", key)
+		}
+	}
+
+	if linkURL, ok := e.Params["link_url"]; ok && linkURL != "" {
+		u, err := url.Parse(linkURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("param link_url invalid: must be an absolute http(s) URL")
+		}
+	}
 
 	return nil
 }
+
+// Path returns the path of the page the event happened on, "" when unknown.
+func (e AnalyticsEvent) Path() string {
+	if e.PageLocation == "" {
+		return ""
+	}
+	return PageView{PageLocation: e.PageLocation}.Path()
+}
+
+// AnalyticsEventRequest is a single event along with the client sending it.
+type AnalyticsEventRequest struct {
+	AnalyticsEvent
+	AnalyticsClient
+}
+
+func (r AnalyticsEventRequest) Validate() error {
+	if err := r.AnalyticsEvent.Validate(); err != nil {
+		return err
+	}
+	return r.AnalyticsClient.Validate()
+}
+
+// AnalyticsEventBatch is a batch of up to MaxAnalyticsEvents events, all
+// sent by the same client.
+type AnalyticsEventBatch struct {
+	AnalyticsClient
+	Events []AnalyticsEvent `json:"events"`
+}
+
+func (b AnalyticsEventBatch) Validate() error {
+	if len(b.Events) == 0 {
+		return errors.New("events missing")
+	}
+	if len(b.Events) > MaxAnalyticsEvents {
+		return fmt.Errorf("too many events: %d, at most %d", len(b.Events), MaxAnalyticsEvents)
+	}
+	for i, event := range b.Events {
+		if err := event.Validate(); err != nil {
+			return fmt.Errorf("event %d: %w", i, err)
+		}
+	}
+
+	return b.AnalyticsClient.Validate()
+}
+
+// Visitor describes who viewed a page without identifying them: neither
+// the IP address nor the full user agent is kept.
+type Visitor struct {
+	// ID is a hash of the browser's client ID, so the stored ID cannot be
+	// traced back to the ID or cookie the browser sent.
+	ID string
+	// SessionID identifies the visitor's current session. It is forwarded to
+	// the sinks but not stored.
+	SessionID string
+	UAFamily  string
+	// Country is the ISO 3166-1 alpha-2 code of the visitor's country, if known.
+	Country string
+}
+
+// NewVisitor returns the visitor of a request from the browser with
+// clientID in session sessionID, with userAgent and the country header
+// country. salt keys the visitor ID hash.
+func NewVisitor(salt []byte, clientID, sessionID, userAgent, country string) Visitor {
+	return Visitor{
+		ID:        VisitorID(salt, clientID),
+		SessionID: sessionID,
+		UAFamily:  UAFamily(userAgent),
+		Country:   NormalizeCountry(country),
+	}
+}
+
+// VisitorID returns the ID of the visitor with clientID: an HMAC-SHA256
+// keyed by salt, so it cannot be linked to the client ID without the salt.
+func VisitorID(salt []byte, clientID string) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(clientID))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// DailyClientID returns a client ID for the browser at ip with userAgent
+// on the UTC day of now, for browsers that sent none: an HMAC-SHA256 keyed
+// by salt, so it cannot be reversed into an IP address without the salt.
+// Browsers not keeping the cookie it is issued in are counted once a day.
+func DailyClientID(salt []byte, now time.Time, ip, userAgent string) string {
+	mac := hmac.New(sha256.New, salt)
+	fmt.Fprintf(mac, "%s\x00%s\x00%s", now.UTC().Format(time.DateOnly), ip, userAgent)
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// uaFamilies maps user agent tokens to browser families, most specific
+// first: Edge and Opera also announce Chrome, and Chrome announces Safari.
+var uaFamilies = []struct {
+	token  string
+	family string
+}{
+	{"edg", "Edge"},
+	{"opr/", "Opera"},
+	{"opera", "Opera"},
+	{"samsungbrowser", "Samsung Internet"},
+	{"firefox", "Firefox"},
+	{"fxios", "Firefox"},
+	{"crios", "Chrome"},
+	{"chrome", "Chrome"},
+	{"safari", "Safari"},
+}
+
+var botPattern = regexp.MustCompile(`(?i)bot|crawl|spider|slurp|headless|lighthouse|curl|wget|python-requests`)
+
+// UAFamily returns the browser family of userAgent: "Bot" for crawlers and
+// scripts, "Other" when it is not recognized.
+func UAFamily(userAgent string) string {
+	if userAgent == "" {
+		return "Other"
+	}
+	if botPattern.MatchString(userAgent) {
+		return "Bot"
+	}
+
+	lower := strings.ToLower(userAgent)
+	for _, f := range uaFamilies {
+		if strings.Contains(lower, f.token) {
+			return f.family
+		}
+	}
+
+	return "Other"
+}
+
+var countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// NormalizeCountry returns country as an upper case ISO 3166-1 alpha-2
+// code, or "" when it is not one. The unknown ("XX") and Tor ("T1") codes
+// of Cloudflare are dropped.
+func NormalizeCountry(country string) string {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if !countryPattern.MatchString(country) || country == "XX" || country == "T1" {
+		return ""
+	}
+	return country
+}
+
+// Analytics range defaults and limits.
+const (
+	DefaultAnalyticsDays  = 30
+	MaxAnalyticsDays      = 366
+	DefaultAnalyticsLimit = 10
+	MaxAnalyticsLimit     = 100
+)
+
+// AnalyticsRange selects the UTC days From through To, both inclusive, of
+// the analytics dashboards. Limit caps the top pages and referrers.
+type AnalyticsRange struct {
+	From  time.Time
+	To    time.Time
+	Limit int
+}
+
+func (r AnalyticsRange) Validate() error {
+	if r.From.IsZero() || r.To.IsZero() {
+		return errors.New("from and to missing")
+	}
+	if r.To.Before(r.From) {
+		return errors.New("from must not be after to")
+	}
+	if days := r.Days(); days > MaxAnalyticsDays {
+		return fmt.Errorf("range too long: %d days, at most %d", days, MaxAnalyticsDays)
+	}
+
+	return nil
+}
+
+// Days returns the number of days in the range.
+func (r AnalyticsRange) Days() int {
+	return int(r.To.Sub(r.From).Hours()/24) + 1
+}
+
+// End returns the instant the range ends at, exclusive: midnight after To.
+func (r AnalyticsRange) End() time.Time {
+	return r.To.AddDate(0, 0, 1)
+}
+
+// AnalyticsCount is how often a page or referrer was viewed, or an event
+// happened, and for how many visitors.
+type AnalyticsCount struct {
+	Key      string
+	Views    int
+	Visitors int
+}
+
+// AnalyticsSummary aggregates the page views and events of an
+// AnalyticsRange. Visitors are counted by visitor ID, which rotates with the
+// client ID.
+type AnalyticsSummary struct {
+	PageViews    int
+	Visitors     int
+	TopPages     []AnalyticsCount
+	TopReferrers []AnalyticsCount
+	// Events counts every custom event by name.
+	Events []AnalyticsCount
+	// TopProjects counts project_click events by project ID.
+	TopProjects []AnalyticsCount
+}
+
+// AnalyticsDay holds the page views and unique visitors of one UTC day.
+type AnalyticsDay struct {
+	Date      time.Time
+	PageViews int
+	Visitors  int
+}
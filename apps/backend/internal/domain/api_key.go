@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type APIKeyScope string
+
+const (
+	ScopeRead          APIKeyScope = "read"
+	ScopeProjectsWrite APIKeyScope = "projects:write"
+	ScopeFilesWrite    APIKeyScope = "files:write"
+)
+
+func (s APIKeyScope) IsValid() bool {
+	switch s {
+	case ScopeRead, ScopeProjectsWrite, ScopeFilesWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIKey is a long-lived credential for machine clients such as CI jobs. Only
+// the SHA-256 hash of the key handed out on creation is kept. A nil ExpiresAt
+// never expires; a revoked key stays listed but no longer authenticates.
+type APIKey struct {
+	ID         string
+	Name       string
+	KeyHash    string
+	Scopes     []APIKeyScope
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ValidatePayload checks the fields an admin sets on an API key: a name and
+// at least one known scope.
+func (k APIKey) ValidatePayload() error {
+	if strings.TrimSpace(k.Name) == "" {
+		return errors.New("name missing")
+	}
+	if len(k.Scopes) == 0 {
+		return errors.New("scopes missing")
+	}
+
+	seen := make(map[APIKeyScope]bool, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		if !scope.IsValid() {
+			return fmt.Errorf("scope invalid = %s", scope)
+		}
+		if seen[scope] {
+			return fmt.Errorf("scope duplicated = %s", scope)
+		}
+		seen[scope] = true
+	}
+
+	return nil
+}
+
+// Active reports whether the key can authenticate at now.
+func (k APIKey) Active(now time.Time) bool {
+	return k.RevokedAt == nil && (k.ExpiresAt == nil || now.Before(*k.ExpiresAt))
+}
@@ -0,0 +1,131 @@
+package domain
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type AuditEntity string
+
+const (
+	AuditProject   AuditEntity = "project"
+	AuditEducation AuditEntity = "education"
+	AuditSkill     AuditEntity = "skill"
+	AuditFile      AuditEntity = "file"
+)
+
+func (e AuditEntity) IsValid() bool {
+	switch e {
+	case AuditProject, AuditEducation, AuditSkill, AuditFile:
+		return true
+	default:
+		return false
+	}
+}
+
+type AuditAction string
+
+const (
+	AuditCreate  AuditAction = "create"
+	AuditUpdate  AuditAction = "update"
+	AuditDelete  AuditAction = "delete"
+	AuditRestore AuditAction = "restore"
+	AuditPurge   AuditAction = "purge"
+)
+
+// AuditChange holds the JSON value of a single field before and after a
+// mutation. A side is omitted when the field did not exist on it, e.g. Before
+// on a create.
+type AuditChange struct {
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+}
+
+// AuditEntry records one mutation of an entity. Diff is keyed by the JSON
+// field name and only holds the fields that changed.
+type AuditEntry struct {
+	ID        string                 `json:"id"`
+	Entity    AuditEntity            `json:"entity"`
+	EntityID  string                 `json:"entity_id"`
+	Action    AuditAction            `json:"action"`
+	Diff      map[string]AuditChange `json:"diff"`
+	RequestID string                 `json:"request_id"`
+	CreatedAt time.Time              `json:"created_at"`
+}
+
+// AuditFilter narrows the audit log listing. Nil fields are not filtered on;
+// From is inclusive and To exclusive.
+type AuditFilter struct {
+	Entity   *AuditEntity
+	EntityID *string
+	From     *time.Time
+	To       *time.Time
+	Limit    int32
+}
+
+func (f AuditFilter) Validate() error {
+	if f.Entity != nil && !f.Entity.IsValid() {
+		return fmt.Errorf("entity invalid = %s", *f.Entity)
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return errors.New("from must be before to")
+	}
+
+	return nil
+}
+
+// DiffJSON compares the JSON encodings of before and after field by field and
+// returns the fields whose values differ. A nil before or after is treated as
+// an object with no fields, so a create lists every field under After and a
+// delete every field under Before.
+func DiffJSON(before, after any) (map[string]AuditChange, error) {
+	beforeFields, err := jsonFields(before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode before: %w", err)
+	}
+
+	afterFields, err := jsonFields(after)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode after: %w", err)
+	}
+
+	diff := make(map[string]AuditChange)
+	for key, b := range beforeFields {
+		if a, ok := afterFields[key]; !ok || !bytes.Equal(a, b) {
+			diff[key] = AuditChange{Before: b, After: a}
+		}
+	}
+	for key, a := range afterFields {
+		if _, ok := beforeFields[key]; !ok {
+			diff[key] = AuditChange{After: a}
+		}
+	}
+
+	return diff, nil
+}
+
+// jsonFields encodes v and splits the resulting object into its top-level
+// fields. A nil v yields no fields.
+func jsonFields(v any) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if v == nil {
+		return fields, nil
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(raw, []byte("null")) {
+		return fields, nil
+	}
+
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+// RefreshToken is a stored admin refresh token. Only the SHA-256 hash of the
+// token handed to the client is kept. Tokens issued from one login share a
+// FamilyID, so a replayed token can revoke every token derived from it.
+type RefreshToken struct {
+	ID        string
+	FamilyID  string
+	Subject   string
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
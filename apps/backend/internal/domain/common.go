@@ -1,8 +1,84 @@
 package domain
 
+import (
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
 type SortBy string
 
 const (
 	CreatedAt SortBy = "created_at"
 	UpdatedAt SortBy = "updated_at"
 )
+
+// PageInfo describes where a page of list results sits within the full,
+// filtered result set.
+type PageInfo struct {
+	Total      int64
+	Page       int32
+	PageSize   int32
+	HasNext    bool
+	NextCursor string
+}
+
+// Cursor marks the last row of a keyset-paginated page. Rows are ordered by
+// (created_at, id), so the next page starts strictly after this pair.
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Encode returns the opaque, URL-safe string form of the cursor that is handed
+// to clients as next_cursor.
+func (c Cursor) Encode() string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a string produced by Cursor.Encode. The ID must be a
+// UUID, so a tampered cursor is rejected before it reaches the database.
+func DecodeCursor(s string) (*Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.New("cursor encoding invalid")
+	}
+
+	createdAt, id, ok := strings.Cut(string(raw), "|")
+	if !ok || id == "" {
+		return nil, errors.New("cursor format invalid")
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, errors.New("cursor format invalid")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return nil, errors.New("cursor timestamp invalid")
+	}
+
+	return &Cursor{CreatedAt: t, ID: id}, nil
+}
+
+// CollectionVersion summarises the state of a collection for conditional GETs.
+// LastModified is the newest write to any of its rows, trashing included, and
+// Count is the number of live rows, which catches hard deletes that leave no
+// timestamp behind. Both are zero for an empty table.
+type CollectionVersion struct {
+	LastModified time.Time
+	Count        int64
+}
+
+// Merge folds other into v: the newer write wins and the live row counts add
+// up. It combines the tables a response is assembled from into one version.
+func (v CollectionVersion) Merge(other CollectionVersion) CollectionVersion {
+	if other.LastModified.After(v.LastModified) {
+		v.LastModified = other.LastModified
+	}
+	v.Count += other.Count
+	return v
+}
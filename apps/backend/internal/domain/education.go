@@ -40,6 +40,7 @@ type EducationFilter struct {
 	PageSize      int32
 	SortBy        *SortBy
 	SortAscending bool
+	Cursor        *Cursor
 }
 
 func (el EducationLevel) isValid() bool {
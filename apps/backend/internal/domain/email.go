@@ -1,12 +1,23 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
 
 type SendEmail struct {
 	Name    string `json:"name"`
 	Email   string `json:"email"`
 	Subject string `json:"subject"`
 	Message string `json:"message"`
+	// Website is a honeypot: the contact form hides it from people, so only
+	// bots fill it in.
+	Website string `json:"website,omitempty"`
+	// CaptchaToken is the Turnstile or hCaptcha response token of the form.
+	CaptchaToken string `json:"captcha_token,omitempty"`
 }
 
 func (s SendEmail) Validate() error {
@@ -22,3 +33,100 @@ func (s SendEmail) Validate() error {
 
 	return nil
 }
+
+// IsHoneypotFilled reports whether the hidden honeypot field was filled in.
+func (s SendEmail) IsHoneypotFilled() bool {
+	return strings.TrimSpace(s.Website) != ""
+}
+
+// Spam scoring weights. A message scoring SpamThreshold or more is spam.
+const (
+	SpamScorePerExtraLink   = 2
+	SpamScorePerBlockedTerm = 3
+	DefaultSpamMaxLinks     = 2
+	DefaultSpamThreshold    = 5
+)
+
+var linkPattern = regexp.MustCompile(`(?i)\bhttps?://|\bwww\.|\[url[=\]]`)
+
+// SpamRules are the content heuristics contact form messages are scored with.
+type SpamRules struct {
+	// MaxLinks is how many links a message may contain for free; every link
+	// beyond it adds SpamScorePerExtraLink.
+	MaxLinks int
+	// Blocklist lists terms, matched case-insensitively against every field,
+	// that each add SpamScorePerBlockedTerm.
+	Blocklist []string
+}
+
+// SpamScore is the result of scoring a message with SpamRules.
+type SpamScore struct {
+	Score   int
+	Reasons []string
+}
+
+// Score rates how likely s is spam.
+func (r SpamRules) Score(s SendEmail) SpamScore {
+	var score SpamScore
+
+	text := strings.Join([]string{s.Name, s.Email, s.Subject, s.Message}, "\n")
+
+	if links := len(linkPattern.FindAllStringIndex(text, -1)); links > r.MaxLinks {
+		score.Score += (links - r.MaxLinks) * SpamScorePerExtraLink
+		score.Reasons = append(score.Reasons, fmt.Sprintf("links = %d", links))
+	}
+
+	lower := strings.ToLower(text)
+	for _, term := range r.Blocklist {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if term != "" && strings.Contains(lower, term) {
+			score.Score += SpamScorePerBlockedTerm
+			score.Reasons = append(score.Reasons, "blocked term = "+term)
+		}
+	}
+
+	return score
+}
+
+// EmailTemplate names an email rendered from a contact form message.
+type EmailTemplate string
+
+const (
+	// EmailOwnerNotification tells the site owner about a new message.
+	EmailOwnerNotification EmailTemplate = "owner_notification"
+	// EmailAutoReply confirms to the sender that their message arrived.
+	EmailAutoReply EmailTemplate = "auto_reply"
+)
+
+// EmailTemplates lists every EmailTemplate.
+var EmailTemplates = []EmailTemplate{EmailOwnerNotification, EmailAutoReply}
+
+func (t EmailTemplate) IsValid() bool {
+	switch t {
+	case EmailOwnerNotification, EmailAutoReply:
+		return true
+	default:
+		return false
+	}
+}
+
+// EmailTemplateData is what email templates are rendered with: the contact
+// form message and when it was sent.
+type EmailTemplateData struct {
+	Name    string
+	Email   string
+	Subject string
+	Message string
+	Date    time.Time
+}
+
+// NewEmailTemplateData returns the template data of send, sent at date.
+func NewEmailTemplateData(send SendEmail, date time.Time) EmailTemplateData {
+	return EmailTemplateData{
+		Name:    send.Name,
+		Email:   send.Email,
+		Subject: send.Subject,
+		Message: send.Message,
+		Date:    date,
+	}
+}
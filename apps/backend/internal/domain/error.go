@@ -1,5 +1,23 @@
 package domain
 
+import (
+	"fmt"
+	"time"
+)
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
+
+// ConflictError is returned by a versioned update when the stored row no longer
+// carries the version the caller read. Current is the row's updated_at at the
+// time of the failed write.
+type ConflictError struct {
+	Entity  string
+	ID      string
+	Current time.Time
+}
+
+func (e *ConflictError) Error() string {
+	return fmt.Sprintf("%s %s was modified at %s", e.Entity, e.ID, e.Current.UTC().Format(time.RFC3339Nano))
+}
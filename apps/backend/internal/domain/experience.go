@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type WorkSetup string
+
+const (
+	Remote WorkSetup = "remote"
+	OnSite WorkSetup = "on-site"
+	Hybrid WorkSetup = "hybrid"
+)
+
+func (ws WorkSetup) isValid() bool {
+	switch ws {
+	case Remote, OnSite, Hybrid:
+		return true
+	default:
+		return false
+	}
+}
+
+// Experience is a single position in the work history. A nil EndDate marks
+// the position as current. The company logo is not part of the row; it is
+// stored as a File with ParentTable ExperienceTable and role Image.
+type Experience struct {
+	Id         string     `json:"id"`
+	Position   string     `json:"position"`
+	Company    string     `json:"company"`
+	Link       string     `json:"link,omitempty"`
+	Setup      WorkSetup  `json:"setup"`
+	StartDate  time.Time  `json:"start_date"`
+	EndDate    *time.Time `json:"end_date,omitempty"`
+	Highlights []string   `json:"highlights"`
+	Skills     []string   `json:"skills"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+type ExperienceFilter struct {
+	Page          int32
+	PageSize      int32
+	SortBy        *SortBy
+	SortAscending bool
+	Setup         *WorkSetup
+	Cursor        *Cursor
+}
+
+func (e Experience) ValidatePayload() error {
+	if strings.TrimSpace(e.Position) == "" {
+		return errors.New("position missing")
+	}
+	if strings.TrimSpace(e.Company) == "" {
+		return errors.New("company missing")
+	}
+	if e.Link != "" {
+		if err := isValidURL(e.Link); err != nil {
+			return fmt.Errorf("link %w", err)
+		}
+	}
+	if e.Setup == "" {
+		return errors.New("setup missing")
+	}
+	if !e.Setup.isValid() {
+		return fmt.Errorf("setup invalid = %s", e.Setup)
+	}
+	if e.StartDate.IsZero() {
+		return errors.New("start date missing")
+	}
+	if e.EndDate != nil && !e.EndDate.After(e.StartDate) {
+		return errors.New("end date must be after start date")
+	}
+
+	for i, h := range e.Highlights {
+		if strings.TrimSpace(h) == "" {
+			return fmt.Errorf("highlight[%d] is empty", i)
+		}
+	}
+	for i, s := range e.Skills {
+		if strings.TrimSpace(s) == "" {
+			return fmt.Errorf("skill[%d] is empty", i)
+		}
+	}
+
+	return nil
+}
+
+func (e Experience) ValidateResponse() error {
+	if e.Id == "" {
+		return errors.New("ID missing")
+	}
+
+	if err := e.ValidatePayload(); err != nil {
+		return err
+	}
+
+	if e.CreatedAt.IsZero() {
+		return errors.New("createdAt missing")
+	}
+
+	if e.UpdatedAt.IsZero() {
+		return errors.New("updatedAt missing")
+	}
+
+	if e.UpdatedAt.Before(e.CreatedAt) {
+		return errors.New("updatedAt before createdAt")
+	}
+
+	return nil
+}
@@ -14,9 +14,10 @@ import (
 type ParentTable string
 
 const (
-	ProjectTable   ParentTable = "projects"
-	UserTable      ParentTable = "users"
-	EducationTable ParentTable = "educations"
+	ProjectTable    ParentTable = "projects"
+	UserTable       ParentTable = "users"
+	EducationTable  ParentTable = "educations"
+	ExperienceTable ParentTable = "experiences"
 	// Add other valid parent table names as needed
 )
 
@@ -50,7 +51,7 @@ func (pt ParentTable) isValid() error {
 	}
 
 	switch pt {
-	case ProjectTable, UserTable, EducationTable:
+	case ProjectTable, UserTable, EducationTable, ExperienceTable:
 		return nil
 	default:
 		return errors.New("parent_table invalid")
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// MessageStatus tracks the delivery of a contact form message to the inbox
+// of the site owner.
+type MessageStatus string
+
+const (
+	MessagePending MessageStatus = "pending"
+	MessageSent    MessageStatus = "sent"
+	MessageFailed  MessageStatus = "failed"
+)
+
+func (s MessageStatus) IsValid() bool {
+	switch s {
+	case MessagePending, MessageSent, MessageFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// Message is a stored contact form submission. ProviderResponse holds what
+// the email provider answered on the last delivery attempt, if anything.
+type Message struct {
+	ID               string
+	Name             string
+	Email            string
+	Subject          string
+	Message          string
+	Status           MessageStatus
+	Attempts         int
+	ProviderResponse string
+	ReadAt           *time.Time
+	ArchivedAt       *time.Time
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// NewMessage returns the pending message storing send.
+func NewMessage(send SendEmail) Message {
+	return Message{
+		Name:    send.Name,
+		Email:   send.Email,
+		Subject: send.Subject,
+		Message: send.Message,
+		Status:  MessagePending,
+	}
+}
+
+// SendEmail returns the email m was submitted as.
+func (m Message) SendEmail() SendEmail {
+	return SendEmail{
+		Name:    m.Name,
+		Email:   m.Email,
+		Subject: m.Subject,
+		Message: m.Message,
+	}
+}
+
+// MessageFilter narrows the inbox listing. Nil fields are not filtered on.
+// Archived selects archived messages instead of the ones still in the inbox.
+type MessageFilter struct {
+	Page     int32
+	PageSize int32
+	Status   *MessageStatus
+	Read     *bool
+	Archived bool
+	Cursor   *Cursor
+}
+
+func (f MessageFilter) Validate() error {
+	if f.Status != nil && !f.Status.IsValid() {
+		return fmt.Errorf("status invalid = %s", *f.Status)
+	}
+
+	return nil
+}
+
+// MessageUpdate marks a message read or unread and archives or unarchives
+// it. Nil fields are left unchanged.
+type MessageUpdate struct {
+	Read     *bool
+	Archived *bool
+}
+
+func (u MessageUpdate) Validate() error {
+	if u.Read == nil && u.Archived == nil {
+		return errors.New("read or archived missing")
+	}
+
+	return nil
+}
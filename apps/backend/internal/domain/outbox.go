@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// OutboxStatus tracks an email waiting in the outbox. Failed attempts keep
+// the email pending until it runs out of attempts and is dead-lettered.
+type OutboxStatus string
+
+const (
+	OutboxPending OutboxStatus = "pending"
+	OutboxSent    OutboxStatus = "sent"
+	OutboxDead    OutboxStatus = "dead"
+)
+
+func (s OutboxStatus) IsValid() bool {
+	switch s {
+	case OutboxPending, OutboxSent, OutboxDead:
+		return true
+	default:
+		return false
+	}
+}
+
+// OutboxEmail is an email queued for delivery of the message MessageID.
+// Template is the email sent; Email holds the message it is rendered from,
+// read when the email is claimed for delivery.
+type OutboxEmail struct {
+	ID            string
+	MessageID     string
+	Template      EmailTemplate
+	Status        OutboxStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	Email         SendEmail
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
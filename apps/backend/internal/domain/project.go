@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/fingertips18/fingertips18.github.io/backend/pkg/metadata"
+	"github.com/google/uuid"
 )
 
 type ProjectType string
@@ -31,12 +32,31 @@ type Project struct {
 	UpdatedAt   time.Time   `json:"updated_at"`
 }
 
+// TagMatch selects how ProjectFilter.Tags is applied: TagMatchAny keeps
+// projects with at least one of the tags, TagMatchAll only those with every tag.
+type TagMatch string
+
+const (
+	TagMatchAny TagMatch = "any"
+	TagMatchAll TagMatch = "all"
+)
+
 type ProjectFilter struct {
 	Page          int32
 	PageSize      int32
 	SortBy        *SortBy
 	SortAscending bool
 	Type          *ProjectType
+	EducationID   *string
+	Tags          []string
+	TagMatch      TagMatch
+	Cursor        *Cursor
+}
+
+// TagCount is a distinct project tag with the number of projects using it.
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
 }
 
 type ProjectIDResponse struct {
@@ -84,6 +104,11 @@ func (p Project) ValidatePayload(blurHashAPI metadata.BlurHashAPI) error {
 	if p.Link == "" {
 		return errors.New("link missing")
 	}
+	if p.EducationID != "" {
+		if _, err := uuid.Parse(p.EducationID); err != nil {
+			return errors.New("educationId invalid")
+		}
+	}
 
 	return nil
 }
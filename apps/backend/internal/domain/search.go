@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type SearchHitType string
+
+const (
+	ProjectHit   SearchHitType = "project"
+	SkillHit     SearchHitType = "skill"
+	EducationHit SearchHitType = "education"
+)
+
+func (t SearchHitType) isValid() bool {
+	switch t {
+	case ProjectHit, SkillHit, EducationHit:
+		return true
+	default:
+		return false
+	}
+}
+
+// SearchHit is a single ranked match from a full-text search. Snippet holds
+// the matching fragments of the source text with every matched term wrapped
+// in <mark></mark>.
+type SearchHit struct {
+	Type    SearchHitType `json:"type"`
+	ID      string        `json:"id"`
+	Title   string        `json:"title"`
+	Snippet string        `json:"snippet"`
+	Rank    float64       `json:"rank"`
+}
+
+// SearchFilter narrows a full-text search. An empty Types searches every
+// entity type.
+type SearchFilter struct {
+	Query string
+	Types []SearchHitType
+	Limit int32
+}
+
+const maxSearchQueryLength = 200
+
+func (f SearchFilter) Validate() error {
+	query := strings.TrimSpace(f.Query)
+	if query == "" {
+		return errors.New("query missing")
+	}
+	if len(query) > maxSearchQueryLength {
+		return fmt.Errorf("query longer than %d characters", maxSearchQueryLength)
+	}
+
+	for _, t := range f.Types {
+		if !t.isValid() {
+			return fmt.Errorf("type invalid = %s", t)
+		}
+	}
+
+	return nil
+}
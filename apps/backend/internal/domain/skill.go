@@ -41,6 +41,7 @@ type SkillFilter struct {
 	SortBy        *SortBy
 	SortAscending bool
 	Category      *SkillCategory
+	Cursor        *Cursor
 }
 
 var hexColorRegex = regexp.MustCompile(`^#[0-9A-Fa-f]{3}([0-9A-Fa-f]{3})?$`)
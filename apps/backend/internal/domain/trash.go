@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+type TrashEntity string
+
+const (
+	TrashedProject    TrashEntity = "project"
+	TrashedEducation  TrashEntity = "education"
+	TrashedSkill      TrashEntity = "skill"
+	TrashedExperience TrashEntity = "experience"
+)
+
+func (e TrashEntity) IsValid() bool {
+	switch e {
+	case TrashedProject, TrashedEducation, TrashedSkill, TrashedExperience:
+		return true
+	default:
+		return false
+	}
+}
+
+// TrashItem is a soft-deleted row waiting in the trash. Title is the label the
+// entity is shown under elsewhere (project title, skill label, school name,
+// position at company).
+type TrashItem struct {
+	Entity    TrashEntity `json:"entity"`
+	ID        string      `json:"id"`
+	Title     string      `json:"title"`
+	DeletedAt time.Time   `json:"deleted_at"`
+}
+
+// TrashFilter narrows the trash listing. An empty Entities lists every
+// entity type.
+type TrashFilter struct {
+	Entities []TrashEntity
+	Limit    int32
+}
+
+func (f TrashFilter) Validate() error {
+	for _, e := range f.Entities {
+		if !e.IsValid() {
+			return fmt.Errorf("entity invalid = %s", e)
+		}
+	}
+
+	return nil
+}
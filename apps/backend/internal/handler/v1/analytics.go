@@ -2,60 +2,139 @@ package v1
 
 import (
 	"bytes"
+	"crypto/rand"
 	"encoding/json"
+	"log"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
 	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+// Cookies identifying the browser and its session when it sends no client
+// ID or session ID itself.
+const (
+	analyticsClientCookie  = "analytics_cid"
+	analyticsSessionCookie = "analytics_sid"
 )
 
 type AnalyticsHandler interface {
 	http.Handler
 	PageView(w http.ResponseWriter, r *http.Request)
+	Event(w http.ResponseWriter, r *http.Request)
+	Events(w http.ResponseWriter, r *http.Request)
+	Summary(w http.ResponseWriter, r *http.Request)
+	Timeseries(w http.ResponseWriter, r *http.Request)
 }
 
 type AnalyticsServiceConfig struct {
+	DatabaseAPI database.DatabaseAPI
+	// GoogleMeasurementID and GoogleAPISecret enable forwarding page views and
+	// events to Google Analytics 4. They are only stored first-party when either is empty.
 	GoogleMeasurementID string
 	GoogleAPISecret     string
+	// VisitorSalt keys the visitor ID hashes. When empty a random salt is
+	// used, so visitors are counted anew after every restart.
+	VisitorSalt string
+	// CountryHeader is the request header carrying the visitor's country code,
+	// set by the CDN in front of the server. Defaults to CF-IPCountry.
+	CountryHeader string
 
 	analyticsRepo v1.AnalyticsRepository
+	timeProvider  domain.TimeProvider
 }
 
 type analyticsServiceHandler struct {
 	analyticsRepo v1.AnalyticsRepository
+	visitorSalt   []byte
+	countryHeader string
+	timeProvider  domain.TimeProvider
 }
 
 // NewAnalyticsServiceHandler creates a new instance of AnalyticsHandler using the provided AnalyticsServiceConfig.
 // If the analytics repository is not provided in the config, it initializes a default AnalyticsRepository
-// with the given Google Measurement ID and API Secret from the config.
+// storing page views in the "page_view" table and events in the "analytics_event" table,
+// forwarding them to Google Analytics 4 when both
+// the Google Measurement ID and API Secret are set.
 // Returns an implementation of AnalyticsHandler.
 func NewAnalyticsServiceHandler(cfg AnalyticsServiceConfig) AnalyticsHandler {
 	analyticsRepo := cfg.analyticsRepo
 	if analyticsRepo == nil {
+		var sinks []v1.AnalyticsSink
+		if cfg.GoogleMeasurementID != "" && cfg.GoogleAPISecret != "" {
+			sinks = append(sinks, v1.NewGA4Sink(
+				v1.GA4SinkConfig{
+					GoogleMeasurementID: cfg.GoogleMeasurementID,
+					GoogleAPISecret:     cfg.GoogleAPISecret,
+				},
+			))
+		}
+
 		analyticsRepo = v1.NewAnalyticsRepository(
 			v1.AnalyticsRepositoryConfig{
-				GoogleMeasurementID: cfg.GoogleMeasurementID,
-				GoogleAPISecret:     cfg.GoogleAPISecret,
+				DatabaseAPI:   cfg.DatabaseAPI,
+				PageViewTable: "page_view",
+				EventTable:    "analytics_event",
+				Sinks:         sinks,
 			},
 		)
 	}
 
+	visitorSalt := []byte(cfg.VisitorSalt)
+	if len(visitorSalt) == 0 {
+		log.Println("No analytics salt configured: visitor IDs reset on restart")
+		visitorSalt = make([]byte, 32)
+		rand.Read(visitorSalt)
+	}
+
+	countryHeader := cfg.CountryHeader
+	if countryHeader == "" {
+		countryHeader = "CF-IPCountry"
+	}
+
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
 	return &analyticsServiceHandler{
 		analyticsRepo: analyticsRepo,
+		visitorSalt:   visitorSalt,
+		countryHeader: countryHeader,
+		timeProvider:  timeProvider,
 	}
 }
 
 // ServeHTTP handles HTTP requests routed to the analytics service handler.
 // It inspects the request path after trimming the "/analytics" prefix and dispatches
-// the request to the appropriate handler method. Currently, it supports the "/page-view"
-// endpoint by invoking the PageView handler. For any other paths, it responds with a 404 Not Found.
+// the request to the appropriate handler method:
+//   - "/page-view" -> h.PageView(w, r)
+//   - "/event" -> h.Event(w, r)
+//   - "/events" -> h.Events(w, r)
+//   - "/summary" -> h.Summary(w, r)
+//   - "/timeseries" -> h.Timeseries(w, r)
+//
+// For any other paths, it responds with a 404 Not Found.
 func (h *analyticsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := strings.TrimPrefix(r.URL.Path, "/analytics")
 
 	switch path {
 	case "/page-view":
 		h.PageView(w, r)
+	case "/event":
+		h.Event(w, r)
+	case "/events":
+		h.Events(w, r)
+	case "/summary":
+		h.Summary(w, r)
+	case "/timeseries":
+		h.Timeseries(w, r)
 	default:
 		http.NotFound(w, r)
 	}
@@ -63,20 +142,21 @@ func (h *analyticsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 
 // PageView handles HTTP POST requests for recording a page view analytics event.
 // It expects a JSON payload in the request body containing the required fields
-// PageLocation and PageTitle. If the request method is not POST, or if the JSON
-// is invalid or missing required fields, it responds with an appropriate HTTP error.
-// On success, it records the page view using the analytics repository and responds
-// with a JSON status message.
+// PageLocation and PageTitle, and optionally the Referrer, engagement time and the
+// client and session IDs. If the request method is not POST, or if the JSON is invalid,
+// missing required fields or carries a malformed client, it responds with an appropriate
+// HTTP error. On success, it records the page view using the analytics repository, along
+// with the visitor (see visitor), and responds with a JSON status message.
 //
-// @Security ApiKeyAuth
 // @Summary Record a page view
-// @Description Logs a page view with the provided location and title. Returns a confirmation message.
+// @Description Logs a page view with the provided location, title and referrer. The visitor is identified by the client_id sent, or else by a first-party cookie rotating every 30 days, and the session by the session_id sent, or else by a cookie expiring after 30 idle minutes. Returns a confirmation message.
 // @Tags analytics
 // @Accept json
 // @Produce json
 // @Param pageView body domain.PageView true "Page view payload"
 // @Success 202 {object} map[string]string "Confirmation message"
 // @Failure 400 {object} domain.ErrorResponse
+// @Failure 429 {object} domain.ErrorResponse
 // @Failure 500 {object} domain.ErrorResponse
 // @Router /analytics/page-view [post]
 func (h *analyticsServiceHandler) PageView(w http.ResponseWriter, r *http.Request) {
@@ -93,7 +173,12 @@ func (h *analyticsServiceHandler) PageView(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if err := h.analyticsRepo.PageView(req); err != nil {
+	if err := req.AnalyticsClient.Validate(); err != nil {
+		http.Error(w, "Invalid client: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.analyticsRepo.PageView(r.Context(), req, h.visitor(w, r, req.AnalyticsClient)); err != nil {
 		http.Error(w, "Failed to view page: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -114,3 +199,381 @@ func (h *analyticsServiceHandler) PageView(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
 }
+
+// Event handles HTTP POST requests for recording a custom analytics event, e.g. a
+// click on a project. It expects a JSON domain.AnalyticsEvent in the request body
+// whose name is one of project_click, resume_download, outbound_link or contact_submit,
+// with the params that event accepts, and optionally the client and session IDs as in
+// PageView. Invalid JSON or an invalid event is a 400. On success, it records the event
+// with the visitor, as PageView does, and responds with a JSON status message.
+//
+// @Summary Record an analytics event
+// @Description Logs a custom event. project_click requires project_id and accepts project_title and link_url; resume_download accepts file_name; outbound_link requires link_url and accepts link_text; contact_submit takes no params.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param event body domain.AnalyticsEventRequest true "Analytics event"
+// @Success 200 {object} map[string]string "Confirmation message"
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 429 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /analytics/event [post]
+func (h *analyticsServiceHandler) Event(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var req domain.AnalyticsEventRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		http.Error(w, "Invalid event: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	visitor := h.visitor(w, r, req.AnalyticsClient)
+	if err := h.analyticsRepo.Events(r.Context(), []domain.AnalyticsEvent{req.AnalyticsEvent}, visitor); err != nil {
+		http.Error(w, "Failed to record event: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := map[string]string{
+		"message": "Event recorded successfully",
+		"name":    string(req.Name),
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Events handles HTTP POST requests for recording a batch of up to 25 custom
+// analytics events at once, e.g. those a page queued while offline. Every event
+// is validated as in Event; when any is invalid, none is recorded and the
+// response is a 400 naming its index.
+//
+// @Summary Record a batch of analytics events
+// @Description Logs up to 25 custom events at once. Each event is validated as by POST /analytics/event; one invalid event rejects the whole batch.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param events body domain.AnalyticsEventBatch true "Analytics events"
+// @Success 200 {object} map[string]any "Confirmation message and number of events recorded"
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 429 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /analytics/events [post]
+func (h *analyticsServiceHandler) Events(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var req domain.AnalyticsEventBatch
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	if err := req.Validate(); err != nil {
+		http.Error(w, "Invalid events: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.analyticsRepo.Events(r.Context(), req.Events, h.visitor(w, r, req.AnalyticsClient)); err != nil {
+		http.Error(w, "Failed to record events: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := map[string]any{
+		"message":  "Events recorded successfully",
+		"recorded": len(req.Events),
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// visitor returns the visitor making r, sent by client, along with its user
+// agent family and country header.
+//
+// The client ID is the one client sent, else the one of the client ID cookie.
+// Browsers with neither are issued a cookie holding a daily hash of their IP
+// address and user agent. The cookie is not renewed, so the ID rotates every
+// domain.ClientIDLifetime. The session ID is the one client sent, else the one
+// of the session cookie, else the unix time of r; the session cookie is renewed
+// on every request so it expires after domain.SessionTimeout of inactivity.
+func (h *analyticsServiceHandler) visitor(w http.ResponseWriter, r *http.Request, client domain.AnalyticsClient) domain.Visitor {
+	now := h.timeProvider()
+
+	clientID := client.ClientID
+	if clientID == "" {
+		if cookie, err := r.Cookie(analyticsClientCookie); err == nil && domain.IsValidClientID(cookie.Value) {
+			clientID = cookie.Value
+		} else {
+			clientID = domain.DailyClientID(h.visitorSalt, now, clientIP(r), r.UserAgent())
+			http.SetCookie(w, analyticsCookie(analyticsClientCookie, clientID, domain.ClientIDLifetime))
+		}
+	}
+
+	sessionID := client.SessionID
+	if sessionID == "" {
+		if cookie, err := r.Cookie(analyticsSessionCookie); err == nil && domain.IsValidSessionID(cookie.Value) {
+			sessionID = cookie.Value
+		} else {
+			sessionID = strconv.FormatInt(now.Unix(), 10)
+		}
+		http.SetCookie(w, analyticsCookie(analyticsSessionCookie, sessionID, domain.SessionTimeout))
+	}
+
+	return domain.NewVisitor(h.visitorSalt, clientID, sessionID, r.UserAgent(), r.Header.Get(h.countryHeader))
+}
+
+// analyticsCookie returns the analytics cookie name holding value for maxAge.
+// The frontend is served from another site, so the cookie is SameSite=None.
+func analyticsCookie(name, value string, maxAge time.Duration) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/analytics",
+		MaxAge:   int(maxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteNoneMode,
+	}
+}
+
+// Summary handles GET /analytics/summary and returns the page views and visitors
+// of a date range, along with its most viewed pages and referrers, the number of
+// every event and the most clicked projects.
+//
+// Query parameters:
+//   - from: optional first day (YYYY-MM-DD, UTC); defaults to 29 days before to.
+//   - to: optional last day (YYYY-MM-DD, UTC), inclusive; defaults to today.
+//   - limit: maximum number of top pages, referrers, events and projects (default 10, max 100).
+//
+// Responses:
+//   - 200 OK with a dto.AnalyticsSummaryResponse.
+//   - 400 Bad Request for a malformed date, from after to or a range over 366 days.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the analytics query fails.
+//
+// @Security ApiKeyAuth
+// @Summary Summarize page views
+// @Description Returns the page views and unique visitors of a date range with its top pages and referrers, the number of every event and the most clicked projects.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param from query string false "First day, YYYY-MM-DD (default 29 days before to)"
+// @Param to query string false "Last day, YYYY-MM-DD, inclusive (default today)"
+// @Param limit query int false "Maximum number of top pages, referrers, events and projects (default 10, max 100)"
+// @Success 200 {object} dto.AnalyticsSummaryResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /analytics/summary [get]
+func (h *analyticsServiceHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	dateRange, ok := h.parseRange(w, r)
+	if !ok {
+		return
+	}
+
+	summary, err := h.analyticsRepo.Summary(r.Context(), dateRange)
+	if err != nil {
+		http.Error(w, "Failed to summarize analytics: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := dto.AnalyticsSummaryResponse{
+		From:         dateRange.From.Format(time.DateOnly),
+		To:           dateRange.To.Format(time.DateOnly),
+		PageViews:    summary.PageViews,
+		Visitors:     summary.Visitors,
+		TopPages:     toAnalyticsCountDTOs(summary.TopPages),
+		TopReferrers: toAnalyticsCountDTOs(summary.TopReferrers),
+		Events:       toAnalyticsEventCountDTOs(summary.Events),
+		TopProjects:  toAnalyticsEventCountDTOs(summary.TopProjects),
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Timeseries handles GET /analytics/timeseries and returns the page views and
+// unique visitors of every day of a date range, oldest first. Days without
+// page views are included with zero counts.
+//
+// Query parameters:
+//   - from: optional first day (YYYY-MM-DD, UTC); defaults to 29 days before to.
+//   - to: optional last day (YYYY-MM-DD, UTC), inclusive; defaults to today.
+//
+// Responses:
+//   - 200 OK with a dto.AnalyticsTimeseriesResponse.
+//   - 400 Bad Request for a malformed date, from after to or a range over 366 days.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the analytics query fails.
+//
+// @Security ApiKeyAuth
+// @Summary Daily page views
+// @Description Returns the page views and unique visitors of every day of a date range, oldest first.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Param from query string false "First day, YYYY-MM-DD (default 29 days before to)"
+// @Param to query string false "Last day, YYYY-MM-DD, inclusive (default today)"
+// @Success 200 {object} dto.AnalyticsTimeseriesResponse
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /analytics/timeseries [get]
+func (h *analyticsServiceHandler) Timeseries(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	dateRange, ok := h.parseRange(w, r)
+	if !ok {
+		return
+	}
+
+	days, err := h.analyticsRepo.Timeseries(r.Context(), dateRange)
+	if err != nil {
+		http.Error(w, "Failed to list daily analytics: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	dayDTOs := make([]dto.AnalyticsDayDTO, len(days))
+	for i, day := range days {
+		dayDTOs[i] = dto.AnalyticsDayDTO{
+			Date:      day.Date.Format(time.DateOnly),
+			PageViews: day.PageViews,
+			Visitors:  day.Visitors,
+		}
+	}
+
+	resp := dto.AnalyticsTimeseriesResponse{
+		From: dateRange.From.Format(time.DateOnly),
+		To:   dateRange.To.Format(time.DateOnly),
+		Days: dayDTOs,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// parseRange reads the from, to and limit query parameters of r into a
+// validated domain.AnalyticsRange, defaulting to the last 30 days ending
+// today (UTC). It responds with 400 Bad Request and returns false when they
+// are invalid.
+func (h *analyticsServiceHandler) parseRange(w http.ResponseWriter, r *http.Request) (domain.AnalyticsRange, bool) {
+	q := r.URL.Query()
+
+	today := h.timeProvider().UTC().Truncate(24 * time.Hour)
+	dateRange := domain.AnalyticsRange{
+		To:    today,
+		Limit: int(utils.GetQueryInt32(q, "limit", domain.DefaultAnalyticsLimit)),
+	}
+
+	for _, bound := range []struct {
+		key  string
+		dest *time.Time
+	}{
+		{"from", &dateRange.From},
+		{"to", &dateRange.To},
+	} {
+		raw := q.Get(bound.key)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.DateOnly, raw)
+		if err != nil {
+			http.Error(w, "Invalid "+bound.key+" date: must be YYYY-MM-DD", http.StatusBadRequest)
+			return domain.AnalyticsRange{}, false
+		}
+		*bound.dest = t
+	}
+
+	if dateRange.From.IsZero() {
+		dateRange.From = dateRange.To.AddDate(0, 0, 1-domain.DefaultAnalyticsDays)
+	}
+
+	// Clamp limit to valid range
+	if dateRange.Limit < 1 {
+		dateRange.Limit = domain.DefaultAnalyticsLimit
+	} else if dateRange.Limit > domain.MaxAnalyticsLimit {
+		dateRange.Limit = domain.MaxAnalyticsLimit
+	}
+
+	if err := dateRange.Validate(); err != nil {
+		http.Error(w, "Invalid date range: "+err.Error(), http.StatusBadRequest)
+		return domain.AnalyticsRange{}, false
+	}
+
+	return dateRange, true
+}
+
+// toAnalyticsCountDTOs converts the page or referrer counts to their DTOs.
+func toAnalyticsCountDTOs(counts []domain.AnalyticsCount) []dto.AnalyticsCountDTO {
+	countDTOs := make([]dto.AnalyticsCountDTO, len(counts))
+	for i, count := range counts {
+		countDTOs[i] = dto.AnalyticsCountDTO{
+			Key:      count.Key,
+			Views:    count.Views,
+			Visitors: count.Visitors,
+		}
+	}
+	return countDTOs
+}
+
+// toAnalyticsEventCountDTOs converts the event or project counts to their DTOs.
+func toAnalyticsEventCountDTOs(counts []domain.AnalyticsCount) []dto.AnalyticsEventCountDTO {
+	countDTOs := make([]dto.AnalyticsEventCountDTO, len(counts))
+	for i, count := range counts {
+		countDTOs[i] = dto.AnalyticsEventCountDTO{
+			Key:      count.Key,
+			Count:    count.Views,
+			Visitors: count.Visitors,
+		}
+	}
+	return countDTOs
+}
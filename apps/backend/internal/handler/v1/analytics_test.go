@@ -2,19 +2,39 @@ package v1
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
 	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
+const (
+	testVisitorSalt = "test-salt"
+	testUserAgent   = "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0"
+)
+
+var testAnalyticsTime = time.Date(2026, 2, 13, 9, 30, 0, 0, time.UTC)
+
+// testVisitor returns the visitor of a request from country without a client
+// or analytics cookies. httptest requests come from 192.0.2.1.
+func testVisitor(country string) domain.Visitor {
+	clientID := domain.DailyClientID([]byte(testVisitorSalt), testAnalyticsTime, "192.0.2.1", testUserAgent)
+	sessionID := strconv.FormatInt(testAnalyticsTime.Unix(), 10)
+	return domain.NewVisitor([]byte(testVisitorSalt), clientID, sessionID, testUserAgent, country)
+}
+
 type analyticsHandlerTestFixture struct {
 	t                 *testing.T
 	mockAnalyticsRepo *mockRepo.MockAnalyticsRepository
@@ -26,7 +46,9 @@ func newAnalyticsHandlerTestFixture(t *testing.T) *analyticsHandlerTestFixture {
 
 	analyticsHandler := NewAnalyticsServiceHandler(
 		AnalyticsServiceConfig{
+			VisitorSalt:   testVisitorSalt,
 			analyticsRepo: mockAnalyticsRepo,
+			timeProvider:  func() time.Time { return testAnalyticsTime },
 		},
 	)
 
@@ -44,6 +66,8 @@ func TestAnalyticsServiceHandler_PageView(t *testing.T) {
 	}
 	validBody, _ := json.Marshal(validReq)
 
+	visitor := testVisitor("ph")
+
 	type Given struct {
 		method   string
 		body     string
@@ -64,7 +88,7 @@ func TestAnalyticsServiceHandler_PageView(t *testing.T) {
 				body:   string(validBody),
 				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
 					m.EXPECT().
-						PageView(validReq).
+						PageView(mock.Anything, validReq, visitor).
 						Return(nil)
 				},
 			},
@@ -97,13 +121,23 @@ func TestAnalyticsServiceHandler_PageView(t *testing.T) {
 				body: "Invalid JSON in request body\n",
 			},
 		},
+		"invalid client": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"location":"http://example.com/home","title":"Homepage","client_id":"a b"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid client: client_id invalid: must be 8 to 64 letters, digits, dots, dashes or underscores\n",
+			},
+		},
 		"repo error": {
 			given: Given{
 				method: http.MethodPost,
 				body:   string(validBody),
 				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
 					m.EXPECT().
-						PageView(validReq).
+						PageView(mock.Anything, validReq, visitor).
 						Return(errors.New("tracking failed"))
 				},
 			},
@@ -123,6 +157,8 @@ func TestAnalyticsServiceHandler_PageView(t *testing.T) {
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/analytics/page-view", strings.NewReader(tt.given.body))
+			req.Header.Set("User-Agent", testUserAgent)
+			req.Header.Set("CF-IPCountry", "ph")
 			w := httptest.NewRecorder()
 
 			f.analyticsHandler.(*analyticsServiceHandler).PageView(w, req)
@@ -161,7 +197,7 @@ func TestAnalyticsServiceHandler_PageView_Routing(t *testing.T) {
 
 	// Mock expectation
 	f.mockAnalyticsRepo.EXPECT().
-		PageView(validReq).
+		PageView(mock.Anything, validReq, mock.AnythingOfType("domain.Visitor")).
 		Return(nil)
 
 	// Create request
@@ -185,3 +221,789 @@ func TestAnalyticsServiceHandler_PageView_Routing(t *testing.T) {
 
 	f.mockAnalyticsRepo.AssertExpectations(t)
 }
+
+func TestAnalyticsServiceHandler_PageView_Visitor(t *testing.T) {
+	validReq := domain.PageView{
+		PageLocation: "http://example.com/home",
+		PageTitle:    "Homepage",
+	}
+	validBody, _ := json.Marshal(validReq)
+
+	var visitors []domain.Visitor
+	record := func(_ context.Context, _ domain.PageView, visitor domain.Visitor) {
+		visitors = append(visitors, visitor)
+	}
+
+	mockAnalyticsRepo := new(mockRepo.MockAnalyticsRepository)
+	mockAnalyticsRepo.EXPECT().
+		PageView(mock.Anything, validReq, mock.Anything).
+		Run(record).
+		Return(nil)
+
+	now := testAnalyticsTime
+	handler := NewAnalyticsServiceHandler(
+		AnalyticsServiceConfig{
+			VisitorSalt:   testVisitorSalt,
+			CountryHeader: "X-Country",
+			analyticsRepo: mockAnalyticsRepo,
+			timeProvider:  func() time.Time { return now },
+		},
+	)
+
+	view := func(ip string) {
+		req := httptest.NewRequest(http.MethodPost, "/analytics/page-view", bytes.NewReader(validBody))
+		req.RemoteAddr = ip + ":1234"
+		req.Header.Set("User-Agent", testUserAgent)
+		req.Header.Set("X-Country", "DE")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	view("203.0.113.7")
+	view("203.0.113.7")
+	view("203.0.113.8")
+	now = now.Add(24 * time.Hour)
+	view("203.0.113.7")
+
+	if assert.Len(t, visitors, 4) {
+		assert.Equal(t, "Firefox", visitors[0].UAFamily)
+		assert.Equal(t, "DE", visitors[0].Country)
+		assert.Len(t, visitors[0].ID, 32)
+		assert.NotContains(t, visitors[0].ID, "203.0.113.7")
+
+		assert.Equal(t, visitors[0].ID, visitors[1].ID, "same visitor on the same day")
+		assert.NotEqual(t, visitors[0].ID, visitors[2].ID, "different visitors")
+		assert.NotEqual(t, visitors[0].ID, visitors[3].ID, "visitor IDs without cookies rotate daily")
+	}
+
+	mockAnalyticsRepo.AssertExpectations(t)
+}
+
+func TestAnalyticsServiceHandler_PageView_Client(t *testing.T) {
+	pageView := domain.PageView{
+		PageLocation: "http://example.com/home",
+		PageTitle:    "Homepage",
+	}
+
+	var visitors []domain.Visitor
+	record := func(_ context.Context, _ domain.PageView, visitor domain.Visitor) {
+		visitors = append(visitors, visitor)
+	}
+
+	mockAnalyticsRepo := new(mockRepo.MockAnalyticsRepository)
+	mockAnalyticsRepo.EXPECT().
+		PageView(mock.Anything, mock.Anything, mock.Anything).
+		Run(record).
+		Return(nil)
+
+	now := testAnalyticsTime
+	handler := NewAnalyticsServiceHandler(
+		AnalyticsServiceConfig{
+			VisitorSalt:   testVisitorSalt,
+			analyticsRepo: mockAnalyticsRepo,
+			timeProvider:  func() time.Time { return now },
+		},
+	)
+
+	// view sends a page view from client with cookies from 192.0.2.1 and
+	// returns the cookies set in response
+	view := func(client domain.AnalyticsClient, cookies ...*http.Cookie) map[string]*http.Cookie {
+		req := pageView
+		req.AnalyticsClient = client
+		body, _ := json.Marshal(req)
+
+		r := httptest.NewRequest(http.MethodPost, "/analytics/page-view", bytes.NewReader(body))
+		r.Header.Set("User-Agent", testUserAgent)
+		for _, cookie := range cookies {
+			r.AddCookie(cookie)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		set := map[string]*http.Cookie{}
+		for _, cookie := range w.Result().Cookies() {
+			set[cookie.Name] = cookie
+		}
+		return set
+	}
+
+	t.Run("issues client and session cookies", func(t *testing.T) {
+		visitors = nil
+		now = testAnalyticsTime
+
+		set := view(domain.AnalyticsClient{})
+
+		clientCookie := set[analyticsClientCookie]
+		if assert.NotNil(t, clientCookie) {
+			assert.Equal(t, domain.DailyClientID([]byte(testVisitorSalt), now, "192.0.2.1", testUserAgent), clientCookie.Value)
+			assert.Equal(t, "/analytics", clientCookie.Path)
+			assert.Equal(t, 30*24*60*60, clientCookie.MaxAge)
+			assert.True(t, clientCookie.HttpOnly)
+			assert.True(t, clientCookie.Secure)
+			assert.Equal(t, http.SameSiteNoneMode, clientCookie.SameSite)
+		}
+
+		sessionCookie := set[analyticsSessionCookie]
+		if assert.NotNil(t, sessionCookie) {
+			assert.Equal(t, strconv.FormatInt(now.Unix(), 10), sessionCookie.Value)
+			assert.Equal(t, 30*60, sessionCookie.MaxAge)
+		}
+
+		assert.Equal(t, []domain.Visitor{testVisitor("")}, visitors)
+	})
+
+	t.Run("keeps the visitor ID of the client cookie across days", func(t *testing.T) {
+		visitors = nil
+		now = testAnalyticsTime
+
+		first := view(domain.AnalyticsClient{})
+		now = now.Add(3 * 24 * time.Hour)
+		second := view(domain.AnalyticsClient{}, first[analyticsClientCookie])
+
+		assert.NotContains(t, second, analyticsClientCookie, "the client cookie is not renewed")
+		if assert.Len(t, visitors, 2) {
+			assert.Equal(t, visitors[0].ID, visitors[1].ID)
+			assert.NotEqual(t, visitors[0].SessionID, visitors[1].SessionID, "the session cookie expired")
+		}
+	})
+
+	t.Run("keeps and renews the session cookie", func(t *testing.T) {
+		visitors = nil
+		now = testAnalyticsTime
+
+		first := view(domain.AnalyticsClient{})
+		now = now.Add(20 * time.Minute)
+		second := view(domain.AnalyticsClient{}, first[analyticsClientCookie], first[analyticsSessionCookie])
+
+		if assert.Len(t, visitors, 2) {
+			assert.Equal(t, visitors[0].SessionID, visitors[1].SessionID)
+		}
+		if assert.NotNil(t, second[analyticsSessionCookie]) {
+			assert.Equal(t, first[analyticsSessionCookie].Value, second[analyticsSessionCookie].Value)
+			assert.Equal(t, 30*60, second[analyticsSessionCookie].MaxAge)
+		}
+	})
+
+	t.Run("prefers the client sent over cookies", func(t *testing.T) {
+		visitors = nil
+		now = testAnalyticsTime
+
+		client := domain.AnalyticsClient{ClientID: "1234567890.1700000000", SessionID: "1700000000"}
+		set := view(client, &http.Cookie{Name: analyticsClientCookie, Value: "cookie-client-id"})
+		view(client)
+
+		assert.Empty(t, set, "no cookies are needed")
+		if assert.Len(t, visitors, 2) {
+			assert.Equal(t, domain.VisitorID([]byte(testVisitorSalt), client.ClientID), visitors[0].ID)
+			assert.NotContains(t, visitors[0].ID, client.ClientID)
+			assert.Equal(t, "1700000000", visitors[0].SessionID)
+			assert.Equal(t, visitors[0], visitors[1])
+		}
+	})
+
+	t.Run("replaces malformed cookies", func(t *testing.T) {
+		visitors = nil
+		now = testAnalyticsTime
+
+		set := view(
+			domain.AnalyticsClient{},
+			&http.Cookie{Name: analyticsClientCookie, Value: "bad"},
+			&http.Cookie{Name: analyticsSessionCookie, Value: "not-a-number"},
+		)
+
+		assert.Contains(t, set, analyticsClientCookie)
+		assert.Contains(t, set, analyticsSessionCookie)
+		assert.Equal(t, []domain.Visitor{testVisitor("")}, visitors)
+	})
+
+	mockAnalyticsRepo.AssertExpectations(t)
+}
+
+func TestAnalyticsServiceHandler_Event(t *testing.T) {
+	validReq := domain.AnalyticsEvent{
+		Name:         domain.EventProjectClick,
+		PageLocation: "http://example.com/projects",
+		Params:       map[string]string{"project_id": "p1"},
+	}
+	validBody, _ := json.Marshal(validReq)
+
+	visitor := testVisitor("")
+
+	type Given struct {
+		method   string
+		body     string
+		mockRepo func(m *mockRepo.MockAnalyticsRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Events(mock.Anything, []domain.AnalyticsEvent{validReq}, visitor).
+						Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(map[string]string{
+					"message": "Event recorded successfully",
+					"name":    "project_click",
+				}),
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodGet,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+		"invalid json": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"unknown event": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"signup"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid event: name invalid = signup\n",
+			},
+		},
+		"missing param": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"outbound_link","params":{"link_text":"GitHub"}}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid event: param link_url missing\n",
+			},
+		},
+		"invalid session": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"contact_submit","session_id":"abc"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid event: session_id invalid: must be numeric\n",
+			},
+		},
+		"unaccepted param": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"contact_submit","params":{"email":"jane@example.com"}}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid event: param email not accepted by contact_submit\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Events(mock.Anything, mock.Anything, mock.Anything).
+						Return(errors.New("insert failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to record event: insert failed\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAnalyticsRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/analytics/event", strings.NewReader(tt.given.body))
+			req.Header.Set("User-Agent", testUserAgent)
+			w := httptest.NewRecorder()
+
+			f.analyticsHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockAnalyticsRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsServiceHandler_Events(t *testing.T) {
+	events := []domain.AnalyticsEvent{
+		{Name: domain.EventResumeDownload, Params: map[string]string{"file_name": "resume.pdf"}},
+		{Name: domain.EventOutboundLink, Params: map[string]string{"link_url": "https://github.com/fingertips18"}},
+	}
+	validBody, _ := json.Marshal(domain.AnalyticsEventBatch{Events: events})
+
+	tooMany := make([]domain.AnalyticsEvent, domain.MaxAnalyticsEvents+1)
+	for i := range tooMany {
+		tooMany[i] = domain.AnalyticsEvent{Name: domain.EventContactSubmit}
+	}
+	tooManyBody, _ := json.Marshal(domain.AnalyticsEventBatch{Events: tooMany})
+
+	type Given struct {
+		method   string
+		body     string
+		mockRepo func(m *mockRepo.MockAnalyticsRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Events(mock.Anything, events, mock.AnythingOfType("domain.Visitor")).
+						Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"message":"Events recorded successfully","recorded":2}`,
+			},
+		},
+		"client of the batch": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"client_id":"1234567890.1700000000","session_id":"1700000000","events":[{"name":"contact_submit","engagement_time_msec":5000}]}`,
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Events(
+							mock.Anything,
+							[]domain.AnalyticsEvent{{Name: domain.EventContactSubmit, EngagementTimeMsec: 5000}},
+							domain.NewVisitor([]byte(testVisitorSalt), "1234567890.1700000000", "1700000000", "", ""),
+						).
+						Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"message":"Events recorded successfully","recorded":1}`,
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodGet,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+		"invalid json": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"events":{}}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"no events": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"events":[]}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid events: events missing\n",
+			},
+		},
+		"too many events": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(tooManyBody),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid events: too many events: 26, at most 25\n",
+			},
+		},
+		"invalid engagement time": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"events":[{"name":"contact_submit","engagement_time_msec":-1}]}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid events: event 0: engagement_time_msec must be between 0 and 3600000\n",
+			},
+		},
+		"invalid event": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"events":[{"name":"contact_submit"},{"name":"outbound_link","params":{"link_url":"javascript:alert(1)"}}]}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid events: event 1: param link_url invalid: must be an absolute http(s) URL\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Events(mock.Anything, mock.Anything, mock.Anything).
+						Return(errors.New("insert failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to record events: insert failed\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAnalyticsRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/analytics/events", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.analyticsHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockAnalyticsRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsServiceHandler_Summary(t *testing.T) {
+	today := time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC)
+
+	summary := &domain.AnalyticsSummary{
+		PageViews: 42,
+		Visitors:  17,
+		TopPages: []domain.AnalyticsCount{
+			{Key: "/", Views: 30, Visitors: 15},
+		},
+		TopReferrers: []domain.AnalyticsCount{
+			{Key: "google.com", Views: 8, Visitors: 7},
+		},
+		Events: []domain.AnalyticsCount{
+			{Key: "project_click", Views: 9, Visitors: 4},
+		},
+		TopProjects: []domain.AnalyticsCount{
+			{Key: "p1", Views: 6, Visitors: 3},
+		},
+	}
+
+	type Given struct {
+		method   string
+		query    string
+		mockRepo func(m *mockRepo.MockAnalyticsRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"defaults to the last 30 days": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Summary(mock.Anything, domain.AnalyticsRange{
+							From:  time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC),
+							To:    today,
+							Limit: 10,
+						}).
+						Return(summary, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.AnalyticsSummaryResponse{
+					From:         "2026-01-15",
+					To:           "2026-02-13",
+					PageViews:    42,
+					Visitors:     17,
+					TopPages:     []dto.AnalyticsCountDTO{{Key: "/", Views: 30, Visitors: 15}},
+					TopReferrers: []dto.AnalyticsCountDTO{{Key: "google.com", Views: 8, Visitors: 7}},
+					Events:       []dto.AnalyticsEventCountDTO{{Key: "project_click", Count: 9, Visitors: 4}},
+					TopProjects:  []dto.AnalyticsEventCountDTO{{Key: "p1", Count: 6, Visitors: 3}},
+				}),
+			},
+		},
+		"explicit range and clamped limit": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=2026-02-01&to=2026-02-07&limit=500",
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Summary(mock.Anything, domain.AnalyticsRange{
+							From:  time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+							To:    time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC),
+							Limit: 100,
+						}).
+						Return(&domain.AnalyticsSummary{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"from":"2026-02-01","to":"2026-02-07","page_views":0,"visitors":0,"top_pages":[],"top_referrers":[],"events":[],"top_projects":[]}`,
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+		"malformed date": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=01/02/2026",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid from date: must be YYYY-MM-DD\n",
+			},
+		},
+		"from after to": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=2026-02-07&to=2026-02-01",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid date range: from must not be after to\n",
+			},
+		},
+		"range too long": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=2024-01-01",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid date range: range too long: 775 days, at most 366\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Summary(mock.Anything, mock.Anything).
+						Return(nil, errors.New("query failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to summarize analytics: query failed\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAnalyticsRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/analytics/summary"+tt.given.query, nil)
+			w := httptest.NewRecorder()
+
+			f.analyticsHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockAnalyticsRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsServiceHandler_Timeseries(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)
+
+	type Given struct {
+		method   string
+		query    string
+		mockRepo func(m *mockRepo.MockAnalyticsRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=2026-02-01&to=2026-02-02",
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Timeseries(mock.Anything, domain.AnalyticsRange{From: from, To: to, Limit: 10}).
+						Return([]domain.AnalyticsDay{
+							{Date: from, PageViews: 5, Visitors: 3},
+							{Date: to},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.AnalyticsTimeseriesResponse{
+					From: "2026-02-01",
+					To:   "2026-02-02",
+					Days: []dto.AnalyticsDayDTO{
+						{Date: "2026-02-01", PageViews: 5, Visitors: 3},
+						{Date: "2026-02-02"},
+					},
+				}),
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodDelete,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+		"malformed date": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?to=tomorrow",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid to date: must be YYYY-MM-DD\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockAnalyticsRepository) {
+					m.EXPECT().
+						Timeseries(mock.Anything, mock.Anything).
+						Return(nil, errors.New("query failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list daily analytics: query failed\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAnalyticsRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/analytics/timeseries"+tt.given.query, nil)
+			w := httptest.NewRecorder()
+
+			f.analyticsHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockAnalyticsRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/jackc/pgx/v5"
+)
+
+// apiKeyPrefix starts every API key, so the auth interceptor only looks up
+// bearer tokens that can be one.
+const apiKeyPrefix = "fk_"
+
+type APIKeyHandler interface {
+	http.Handler
+	Create(w http.ResponseWriter, r *http.Request)
+	List(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request, id string)
+	Update(w http.ResponseWriter, r *http.Request, id string)
+	Revoke(w http.ResponseWriter, r *http.Request, id string)
+	// ResolveAPIKey implements middleware.APIKeyResolver.
+	ResolveAPIKey(ctx context.Context, key string) ([]string, bool, error)
+}
+
+type APIKeyServiceConfig struct {
+	DatabaseAPI database.DatabaseAPI
+
+	apiKeyRepo   v1.APIKeyRepository
+	timeProvider domain.TimeProvider
+}
+
+type apiKeyServiceHandler struct {
+	apiKeyRepo   v1.APIKeyRepository
+	timeProvider domain.TimeProvider
+}
+
+// NewAPIKeyServiceHandler creates and returns an APIKeyHandler configured using the provided
+// APIKeyServiceConfig. If cfg.apiKeyRepo is nil, a default repository is constructed via
+// v1.NewAPIKeyRepository using cfg.DatabaseAPI and the "api_key" table.
+func NewAPIKeyServiceHandler(cfg APIKeyServiceConfig) APIKeyHandler {
+	apiKeyRepo := cfg.apiKeyRepo
+	if apiKeyRepo == nil {
+		apiKeyRepo = v1.NewAPIKeyRepository(
+			v1.APIKeyRepositoryConfig{
+				DatabaseAPI: cfg.DatabaseAPI,
+				APIKeyTable: "api_key",
+			},
+		)
+	}
+
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &apiKeyServiceHandler{
+		apiKeyRepo:   apiKeyRepo,
+		timeProvider: timeProvider,
+	}
+}
+
+// ServeHTTP implements http.Handler for apiKeyServiceHandler.
+//
+// Routes:
+//   - GET    /api-keys      -> h.List(w, r)
+//   - POST   /api-keys      -> h.Create(w, r)
+//   - GET    /api-keys/{id} -> h.Get(w, r, id)
+//   - PUT    /api-keys/{id} -> h.Update(w, r, id)
+//   - DELETE /api-keys/{id} -> h.Revoke(w, r, id)
+//
+// A trailing slash is ignored. Unknown routes receive a 404 Not Found response.
+func (h *apiKeyServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	// GET / POST /api-keys
+	case path == "/api-keys":
+		switch r.Method {
+		case http.MethodGet:
+			h.List(w, r)
+		case http.MethodPost:
+			h.Create(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+
+	// GET / PUT / DELETE /api-keys/{id}
+	case strings.HasPrefix(path, "/api-keys/"):
+		id := strings.TrimPrefix(path, "/api-keys/")
+
+		if id == "" || strings.Contains(id, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			h.Get(w, r, id)
+		case http.MethodPut:
+			h.Update(w, r, id)
+		case http.MethodDelete:
+			h.Revoke(w, r, id)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+
+	default:
+		http.NotFound(w, r)
+		return
+	}
+}
+
+// Create handles POST /api-keys. It generates a new key with the requested
+// name, scopes and optional expiry and stores only its hash, so the key in
+// the response can't be shown again.
+//
+// Responses:
+//   - 201 Created with a dto.CreateAPIKeyResponse.
+//   - 400 Bad Request for malformed JSON, a missing name, unknown or duplicated scopes, or an expiry in the past.
+//   - 405 Method Not Allowed for non-POST requests.
+//   - 500 Internal Server Error when generating or storing the key fails.
+//
+// @Security ApiKeyAuth
+// @Summary Create an API key
+// @Description Creates a scoped API key. The key is returned once and only its hash is stored.
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param apiKey body dto.CreateAPIKeyRequest true "API key payload"
+// @Success 201 {object} dto.CreateAPIKeyResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys [post]
+func (h *apiKeyServiceHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var createReq dto.CreateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	apiKey := domain.APIKey{
+		Name:      createReq.Name,
+		Scopes:    toAPIKeyScopes(createReq.Scopes),
+		ExpiresAt: createReq.ExpiresAt,
+	}
+
+	if err := h.validatePayload(apiKey); err != nil {
+		http.Error(w, "Invalid API key payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	secret, err := newSecret()
+	if err != nil {
+		http.Error(w, "Failed to generate API key: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	key := apiKeyPrefix + secret
+	apiKey.KeyHash = hashSecret(key)
+
+	if err := h.apiKeyRepo.Create(r.Context(), &apiKey); err != nil {
+		http.Error(w, "Failed to create API key: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := dto.CreateAPIKeyResponse{
+		APIKeyDTO: toAPIKeyDTO(apiKey),
+		Key:       key,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(buf.Bytes())
+}
+
+// List handles GET /api-keys and returns every key, revoked and expired ones
+// included, newest first.
+//
+// Responses:
+//   - 200 OK with a dto.APIKeyListResponse.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the query fails.
+//
+// @Security ApiKeyAuth
+// @Summary List API keys
+// @Description Lists every API key with its scopes, expiry, last use and revocation, newest first.
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Success 200 {object} dto.APIKeyListResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys [get]
+func (h *apiKeyServiceHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	apiKeys, err := h.apiKeyRepo.List(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to list API keys: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]dto.APIKeyDTO, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		items[i] = toAPIKeyDTO(apiKey)
+	}
+
+	writeAPIKeyJSON(w, dto.APIKeyListResponse{Items: items})
+}
+
+// Get handles GET /api-keys/{id}.
+//
+// Responses:
+//   - 200 OK with a dto.APIKeyDTO.
+//   - 404 Not Found when no key has the ID.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the query fails.
+//
+// @Security ApiKeyAuth
+// @Summary Get an API key by ID
+// @Description Retrieves an API key's name, scopes, expiry, last use and revocation. The key itself is not returned.
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 200 {object} dto.APIKeyDTO
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys/{id} [get]
+func (h *apiKeyServiceHandler) Get(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	apiKey, err := h.apiKeyRepo.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "API key not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to get API key: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeAPIKeyJSON(w, toAPIKeyDTO(*apiKey))
+}
+
+// Update handles PUT /api-keys/{id}, replacing the key's name, scopes and
+// expiry. An omitted expiry makes the key never expire. Scope changes apply
+// from the key's next request.
+//
+// Responses:
+//   - 200 OK with the updated dto.APIKeyDTO.
+//   - 400 Bad Request for malformed JSON, a missing name, unknown or duplicated scopes, or an expiry in the past.
+//   - 404 Not Found when no key has the ID.
+//   - 405 Method Not Allowed for non-PUT requests.
+//   - 500 Internal Server Error when the update fails.
+//
+// @Security ApiKeyAuth
+// @Summary Update an API key
+// @Description Replaces the name, scopes and expiry of an API key.
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Param apiKey body dto.UpdateAPIKeyRequest true "API key payload"
+// @Success 200 {object} dto.APIKeyDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys/{id} [put]
+func (h *apiKeyServiceHandler) Update(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed: only PUT is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var updateReq dto.UpdateAPIKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	apiKey := domain.APIKey{
+		ID:        id,
+		Name:      updateReq.Name,
+		Scopes:    toAPIKeyScopes(updateReq.Scopes),
+		ExpiresAt: updateReq.ExpiresAt,
+	}
+
+	if err := h.validatePayload(apiKey); err != nil {
+		http.Error(w, "Invalid API key payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.apiKeyRepo.Update(r.Context(), &apiKey)
+	if err != nil {
+		http.Error(w, "Failed to update API key: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if updated == nil {
+		http.Error(w, "API key not found", http.StatusNotFound)
+		return
+	}
+
+	writeAPIKeyJSON(w, toAPIKeyDTO(*updated))
+}
+
+// Revoke handles DELETE /api-keys/{id}. The key stops authenticating at once
+// but stays listed with its revocation time. Revoking a revoked key succeeds.
+//
+// Responses:
+//   - 204 No Content.
+//   - 404 Not Found when no key has the ID.
+//   - 405 Method Not Allowed for non-DELETE requests.
+//   - 500 Internal Server Error when the update fails.
+//
+// @Security ApiKeyAuth
+// @Summary Revoke an API key
+// @Description Revokes an API key. The key stays listed with its revocation time.
+// @Tags api-key
+// @Accept json
+// @Produce json
+// @Param id path string true "API key ID"
+// @Success 204 "No Content"
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /api-keys/{id} [delete]
+func (h *apiKeyServiceHandler) Revoke(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed: only DELETE is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.apiKeyRepo.Revoke(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "API key not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to revoke API key: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ResolveAPIKey looks up key by its hash and returns its scopes when it is
+// active, recording the use. Tokens without the API key prefix are not looked
+// up. Failing to record the use is logged rather than failing the request.
+func (h *apiKeyServiceHandler) ResolveAPIKey(ctx context.Context, key string) ([]string, bool, error) {
+	if !strings.HasPrefix(key, apiKeyPrefix) {
+		return nil, false, nil
+	}
+
+	apiKey, err := h.apiKeyRepo.GetByHash(ctx, hashSecret(key))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !apiKey.Active(h.timeProvider()) {
+		return nil, false, nil
+	}
+
+	if err := h.apiKeyRepo.Touch(ctx, apiKey.ID); err != nil {
+		log.Printf("api key %s: %v", apiKey.ID, err)
+	}
+
+	scopes := make([]string, len(apiKey.Scopes))
+	for i, scope := range apiKey.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return scopes, true, nil
+}
+
+// validatePayload checks apiKey with domain.APIKey.ValidatePayload and
+// rejects an expiry that has already passed.
+func (h *apiKeyServiceHandler) validatePayload(apiKey domain.APIKey) error {
+	if err := apiKey.ValidatePayload(); err != nil {
+		return err
+	}
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(h.timeProvider()) {
+		return errors.New("expiry must be in the future")
+	}
+
+	return nil
+}
+
+func toAPIKeyScopes(scopes []string) []domain.APIKeyScope {
+	apiKeyScopes := make([]domain.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		apiKeyScopes[i] = domain.APIKeyScope(scope)
+	}
+	return apiKeyScopes
+}
+
+func toAPIKeyDTO(apiKey domain.APIKey) dto.APIKeyDTO {
+	scopes := make([]string, len(apiKey.Scopes))
+	for i, scope := range apiKey.Scopes {
+		scopes[i] = string(scope)
+	}
+
+	return dto.APIKeyDTO{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Scopes:     scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+		UpdatedAt:  apiKey.UpdatedAt,
+	}
+}
+
+// writeAPIKeyJSON writes resp as a 200 OK JSON response.
+func writeAPIKeyJSON(w http.ResponseWriter, resp any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,590 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var apiKeyTestNow = time.Date(2026, 2, 9, 9, 0, 0, 0, time.UTC)
+
+type apiKeyHandlerTestFixture struct {
+	t              *testing.T
+	mockAPIKeyRepo *mockRepo.MockAPIKeyRepository
+	apiKeyHandler  APIKeyHandler
+}
+
+func newAPIKeyHandlerTestFixture(t *testing.T) *apiKeyHandlerTestFixture {
+	mockAPIKeyRepo := new(mockRepo.MockAPIKeyRepository)
+
+	apiKeyHandler := NewAPIKeyServiceHandler(
+		APIKeyServiceConfig{
+			apiKeyRepo:   mockAPIKeyRepo,
+			timeProvider: func() time.Time { return apiKeyTestNow },
+		},
+	)
+
+	return &apiKeyHandlerTestFixture{
+		t:              t,
+		mockAPIKeyRepo: mockAPIKeyRepo,
+		apiKeyHandler:  apiKeyHandler,
+	}
+}
+
+func TestAPIKeyServiceHandler_Create(t *testing.T) {
+	expiresAt := apiKeyTestNow.Add(24 * time.Hour)
+
+	type Given struct {
+		method   string
+		body     string
+		mockRepo func(m *mockRepo.MockAPIKeyRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"ci","scopes":["files:write"],"expires_at":"2026-02-10T09:00:00Z"}`,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.MatchedBy(func(key *domain.APIKey) bool {
+							return key.Name == "ci" &&
+								assert.ObjectsAreEqual([]domain.APIKeyScope{domain.ScopeFilesWrite}, key.Scopes) &&
+								key.ExpiresAt.Equal(expiresAt) &&
+								len(key.KeyHash) == 64
+						})).
+						RunAndReturn(func(ctx context.Context, key *domain.APIKey) error {
+							key.ID = "key-1"
+							key.CreatedAt = apiKeyTestNow
+							key.UpdatedAt = apiKeyTestNow
+							return nil
+						})
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+			},
+		},
+		"unknown scope": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"ci","scopes":["admin"]}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid API key payload: scope invalid = admin\n",
+			},
+		},
+		"missing scopes": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"ci"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid API key payload: scopes missing\n",
+			},
+		},
+		"expiry in the past": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"ci","scopes":["read"],"expires_at":"2026-02-09T09:00:00Z"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid API key payload: expiry must be in the future\n",
+			},
+		},
+		"invalid JSON": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"ci","scopes":["read"]}`,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to create API key: db error\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodGet,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+
+			var stored *domain.APIKey
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAPIKeyRepo)
+			}
+			for _, call := range f.mockAPIKeyRepo.ExpectedCalls {
+				call.Run(func(args mock.Arguments) {
+					stored = args.Get(1).(*domain.APIKey)
+				})
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/api-keys", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.apiKeyHandler.Create(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.code == http.StatusCreated {
+				assert.Equal(t, "no-store", res.Header.Get("Cache-Control"))
+
+				var got dto.CreateAPIKeyResponse
+				assert.NoError(t, json.Unmarshal(body, &got))
+				assert.Equal(t, "key-1", got.ID)
+				assert.Equal(t, []string{"files:write"}, got.Scopes)
+				assert.True(t, strings.HasPrefix(got.Key, "fk_"))
+				assert.Equal(t, hashSecret(got.Key), stored.KeyHash, "only the hash of the returned key is stored")
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyServiceHandler_List(t *testing.T) {
+	revokedAt := apiKeyTestNow.Add(-time.Hour)
+
+	tests := map[string]struct {
+		keys    []domain.APIKey
+		repoErr error
+		code    int
+		body    string
+	}{
+		"success": {
+			keys: []domain.APIKey{
+				{ID: "key-2", Name: "uploads", KeyHash: "hash-2", Scopes: []domain.APIKeyScope{domain.ScopeFilesWrite}, CreatedAt: apiKeyTestNow, UpdatedAt: apiKeyTestNow},
+				{ID: "key-1", Name: "old", KeyHash: "hash-1", Scopes: []domain.APIKeyScope{domain.ScopeRead}, RevokedAt: &revokedAt, CreatedAt: revokedAt, UpdatedAt: revokedAt},
+			},
+			code: http.StatusOK,
+			body: `{"items":[
+				{"id":"key-2","name":"uploads","scopes":["files:write"],"created_at":"2026-02-09T09:00:00Z","updated_at":"2026-02-09T09:00:00Z"},
+				{"id":"key-1","name":"old","scopes":["read"],"revoked_at":"2026-02-09T08:00:00Z","created_at":"2026-02-09T08:00:00Z","updated_at":"2026-02-09T08:00:00Z"}
+			]}`,
+		},
+		"no keys": {
+			keys: []domain.APIKey{},
+			code: http.StatusOK,
+			body: `{"items":[]}`,
+		},
+		"repository error": {
+			repoErr: errors.New("db error"),
+			code:    http.StatusInternalServerError,
+			body:    "Failed to list API keys: db error\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+			f.mockAPIKeyRepo.EXPECT().List(mock.Anything).Return(tt.keys, tt.repoErr)
+
+			w := httptest.NewRecorder()
+			f.apiKeyHandler.List(w, httptest.NewRequest(http.MethodGet, "/api-keys", nil))
+
+			assert.Equal(t, tt.code, w.Code)
+			if tt.code == http.StatusOK {
+				assert.JSONEq(t, tt.body, w.Body.String())
+				assert.NotContains(t, w.Body.String(), "hash", "key hashes are never returned")
+			} else {
+				assert.Equal(t, tt.body, w.Body.String())
+			}
+
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyServiceHandler_Get(t *testing.T) {
+	tests := map[string]struct {
+		key     *domain.APIKey
+		repoErr error
+		code    int
+		body    string
+	}{
+		"success": {
+			key:  &domain.APIKey{ID: "key-1", Name: "ci", Scopes: []domain.APIKeyScope{domain.ScopeRead}, CreatedAt: apiKeyTestNow, UpdatedAt: apiKeyTestNow},
+			code: http.StatusOK,
+			body: `{"id":"key-1","name":"ci","scopes":["read"],"created_at":"2026-02-09T09:00:00Z","updated_at":"2026-02-09T09:00:00Z"}`,
+		},
+		"not found": {
+			repoErr: errors.Join(errors.New("failed to get API key"), pgx.ErrNoRows),
+			code:    http.StatusNotFound,
+			body:    "API key not found\n",
+		},
+		"repository error": {
+			repoErr: errors.New("db error"),
+			code:    http.StatusInternalServerError,
+			body:    "Failed to get API key: db error\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+			f.mockAPIKeyRepo.EXPECT().Get(mock.Anything, "key-1").Return(tt.key, tt.repoErr)
+
+			w := httptest.NewRecorder()
+			f.apiKeyHandler.Get(w, httptest.NewRequest(http.MethodGet, "/api-keys/key-1", nil), "key-1")
+
+			assert.Equal(t, tt.code, w.Code)
+			if tt.code == http.StatusOK {
+				assert.JSONEq(t, tt.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.body, w.Body.String())
+			}
+
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyServiceHandler_Update(t *testing.T) {
+	updated := &domain.APIKey{
+		ID:        "key-1",
+		Name:      "uploads",
+		Scopes:    []domain.APIKeyScope{domain.ScopeFilesWrite, domain.ScopeRead},
+		CreatedAt: apiKeyTestNow.Add(-time.Hour),
+		UpdatedAt: apiKeyTestNow,
+	}
+
+	type Given struct {
+		body     string
+		mockRepo func(m *mockRepo.MockAPIKeyRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				body: `{"name":"uploads","scopes":["files:write","read"]}`,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().
+						Update(mock.Anything, &domain.APIKey{
+							ID:     "key-1",
+							Name:   "uploads",
+							Scopes: []domain.APIKeyScope{domain.ScopeFilesWrite, domain.ScopeRead},
+						}).
+						Return(updated, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"id":"key-1","name":"uploads","scopes":["files:write","read"],"created_at":"2026-02-09T08:00:00Z","updated_at":"2026-02-09T09:00:00Z"}`,
+			},
+		},
+		"not found": {
+			given: Given{
+				body: `{"name":"uploads","scopes":["files:write"]}`,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "API key not found\n",
+			},
+		},
+		"missing name": {
+			given: Given{
+				body: `{"scopes":["files:write"]}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid API key payload: name missing\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				body: `{"name":"uploads","scopes":["files:write"]}`,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to update API key: db error\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAPIKeyRepo)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/api-keys/key-1", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.apiKeyHandler.Update(w, req, "key-1")
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			if tt.expected.code == http.StatusOK {
+				assert.JSONEq(t, tt.expected.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyServiceHandler_Revoke(t *testing.T) {
+	tests := map[string]struct {
+		repoErr error
+		code    int
+		body    string
+	}{
+		"success": {
+			code: http.StatusNoContent,
+		},
+		"not found": {
+			repoErr: errors.Join(errors.New("failed to revoke API key"), pgx.ErrNoRows),
+			code:    http.StatusNotFound,
+			body:    "API key not found\n",
+		},
+		"repository error": {
+			repoErr: errors.New("db error"),
+			code:    http.StatusInternalServerError,
+			body:    "Failed to revoke API key: db error\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+			f.mockAPIKeyRepo.EXPECT().Revoke(mock.Anything, "key-1").Return(tt.repoErr)
+
+			w := httptest.NewRecorder()
+			f.apiKeyHandler.Revoke(w, httptest.NewRequest(http.MethodDelete, "/api-keys/key-1", nil), "key-1")
+
+			assert.Equal(t, tt.code, w.Code)
+			assert.Equal(t, tt.body, w.Body.String())
+
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyServiceHandler_ResolveAPIKey(t *testing.T) {
+	const key = "fk_secret"
+	expired := apiKeyTestNow
+	revokedAt := apiKeyTestNow.Add(-time.Minute)
+	future := apiKeyTestNow.Add(time.Hour)
+
+	active := domain.APIKey{
+		ID:        "key-1",
+		Scopes:    []domain.APIKeyScope{domain.ScopeRead, domain.ScopeFilesWrite},
+		ExpiresAt: &future,
+	}
+
+	type Given struct {
+		key      string
+		mockRepo func(m *mockRepo.MockAPIKeyRepository)
+	}
+	type Expected struct {
+		scopes []string
+		ok     bool
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"active key is touched": {
+			given: Given{
+				key: key,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().GetByHash(mock.Anything, hashSecret(key)).Return(&active, nil)
+					m.EXPECT().Touch(mock.Anything, "key-1").Return(nil)
+				},
+			},
+			expected: Expected{scopes: []string{"read", "files:write"}, ok: true},
+		},
+		"failing to record the use does not fail the request": {
+			given: Given{
+				key: key,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().GetByHash(mock.Anything, hashSecret(key)).Return(&active, nil)
+					m.EXPECT().Touch(mock.Anything, "key-1").Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{scopes: []string{"read", "files:write"}, ok: true},
+		},
+		"token without the prefix is not looked up": {
+			given: Given{key: "header.payload.signature"},
+		},
+		"unknown key": {
+			given: Given{
+				key: key,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().GetByHash(mock.Anything, hashSecret(key)).Return(nil, pgx.ErrNoRows)
+				},
+			},
+		},
+		"expired key": {
+			given: Given{
+				key: key,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().GetByHash(mock.Anything, hashSecret(key)).Return(&domain.APIKey{ID: "key-1", ExpiresAt: &expired}, nil)
+				},
+			},
+		},
+		"revoked key": {
+			given: Given{
+				key: key,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().GetByHash(mock.Anything, hashSecret(key)).Return(&domain.APIKey{ID: "key-1", RevokedAt: &revokedAt}, nil)
+				},
+			},
+		},
+		"lookup error": {
+			given: Given{
+				key: key,
+				mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+					m.EXPECT().GetByHash(mock.Anything, hashSecret(key)).Return(nil, errors.New("db error"))
+				},
+			},
+			expected: Expected{err: errors.New("db error")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAPIKeyRepo)
+			}
+
+			scopes, ok, err := f.apiKeyHandler.ResolveAPIKey(context.Background(), tt.given.key)
+
+			if tt.expected.err != nil {
+				assert.EqualError(t, err, tt.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected.ok, ok)
+			assert.Equal(t, tt.expected.scopes, scopes)
+
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyServiceHandler_ServeHTTP(t *testing.T) {
+	tests := map[string]struct {
+		method   string
+		path     string
+		mockRepo func(m *mockRepo.MockAPIKeyRepository)
+		code     int
+	}{
+		"list": {
+			method: http.MethodGet,
+			path:   "/api-keys/",
+			mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+				m.EXPECT().List(mock.Anything).Return([]domain.APIKey{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		"revoke": {
+			method: http.MethodDelete,
+			path:   "/api-keys/key-1",
+			mockRepo: func(m *mockRepo.MockAPIKeyRepository) {
+				m.EXPECT().Revoke(mock.Anything, "key-1").Return(nil)
+			},
+			code: http.StatusNoContent,
+		},
+		"collection method not allowed": {
+			method: http.MethodDelete,
+			path:   "/api-keys",
+			code:   http.StatusMethodNotAllowed,
+		},
+		"item method not allowed": {
+			method: http.MethodPost,
+			path:   "/api-keys/key-1",
+			code:   http.StatusMethodNotAllowed,
+		},
+		"nested path": {
+			method: http.MethodGet,
+			path:   "/api-keys/key-1/extra",
+			code:   http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyHandlerTestFixture(t)
+
+			if tt.mockRepo != nil {
+				tt.mockRepo(f.mockAPIKeyRepo)
+			}
+
+			w := httptest.NewRecorder()
+			f.apiKeyHandler.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, nil))
+
+			assert.Equal(t, tt.code, w.Code)
+			f.mockAPIKeyRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+type AuditHandler interface {
+	http.Handler
+	List(w http.ResponseWriter, r *http.Request)
+}
+
+type AuditServiceConfig struct {
+	DatabaseAPI database.DatabaseAPI
+
+	auditRepo v1.AuditRepository
+}
+
+type auditServiceHandler struct {
+	auditRepo v1.AuditRepository
+}
+
+// NewAuditServiceHandler creates and returns an AuditHandler configured using the provided
+// AuditServiceConfig. If cfg.auditRepo is nil, a default repository is constructed via
+// v1.NewAuditRepository using cfg.DatabaseAPI and the "audit_log" table.
+func NewAuditServiceHandler(cfg AuditServiceConfig) AuditHandler {
+	auditRepo := cfg.auditRepo
+	if auditRepo == nil {
+		auditRepo = newAuditRepository(cfg.DatabaseAPI)
+	}
+
+	return &auditServiceHandler{
+		auditRepo: auditRepo,
+	}
+}
+
+// newAuditRepository returns the audit repository the handlers write to.
+func newAuditRepository(databaseAPI database.DatabaseAPI) v1.AuditRepository {
+	return v1.NewAuditRepository(
+		v1.AuditRepositoryConfig{
+			DatabaseAPI: databaseAPI,
+			AuditTable:  "audit_log",
+		},
+	)
+}
+
+// newAuditedRepositoryConfig returns the config the default repositories of
+// the project, education, skill, file and trash handlers are wrapped with, so
+// their mutations land in the audit log.
+func newAuditedRepositoryConfig(databaseAPI database.DatabaseAPI) v1.AuditedRepositoryConfig {
+	return v1.AuditedRepositoryConfig{
+		DatabaseAPI: databaseAPI,
+		AuditRepo:   newAuditRepository(databaseAPI),
+	}
+}
+
+// ServeHTTP implements http.Handler for auditServiceHandler.
+//
+// Routes:
+//   - GET /audit -> h.List(w, r)
+//
+// A trailing slash is ignored. Unknown routes receive a 404 Not Found response.
+func (h *auditServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch path {
+	case "/audit":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.List(w, r)
+		return
+
+	default:
+		http.NotFound(w, r)
+		return
+	}
+}
+
+// List handles GET /audit and returns audit log entries, newest first.
+//
+// Query parameters:
+//   - entity: optional entity type (project, education, skill, file).
+//   - id: optional entity ID. Bulk file operations are logged under the parent ID.
+//   - from: optional RFC 3339 timestamp; entries at or after it are returned.
+//   - to: optional RFC 3339 timestamp; entries before it are returned.
+//   - limit: maximum number of entries (default 50, max 100).
+//
+// Responses:
+//   - 200 OK with a dto.AuditListResponse.
+//   - 400 Bad Request for an unknown entity, a malformed timestamp or from not before to.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the audit query fails.
+//
+// @Security ApiKeyAuth
+// @Summary List audit log
+// @Description Lists recorded creates, updates and deletes of projects, education, skills and files, newest first.
+// @Tags audit
+// @Accept json
+// @Produce json
+// @Param entity query string false "Entity type" Enums(project, education, skill, file)
+// @Param id query string false "Entity ID"
+// @Param from query string false "Inclusive lower bound (RFC 3339)"
+// @Param to query string false "Exclusive upper bound (RFC 3339)"
+// @Param limit query int false "Maximum number of entries (default 50, max 100)"
+// @Success 200 {object} dto.AuditListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /audit [get]
+func (h *auditServiceHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+
+	filter := domain.AuditFilter{
+		Limit: utils.GetQueryInt32(q, "limit", 50),
+	}
+
+	if entity := q.Get("entity"); entity != "" {
+		e := domain.AuditEntity(entity)
+		filter.Entity = &e
+	}
+
+	if id := q.Get("id"); id != "" {
+		filter.EntityID = &id
+	}
+
+	for _, bound := range []struct {
+		key  string
+		dest **time.Time
+	}{
+		{"from", &filter.From},
+		{"to", &filter.To},
+	} {
+		raw := q.Get(bound.key)
+		if raw == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			http.Error(w, "Invalid "+bound.key+" timestamp: must be RFC 3339", http.StatusBadRequest)
+			return
+		}
+		*bound.dest = &t
+	}
+
+	// Clamp limit to valid range
+	const maxLimit = 100
+	if filter.Limit < 1 {
+		filter.Limit = 50 // default
+	} else if filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+
+	if err := filter.Validate(); err != nil {
+		http.Error(w, "Invalid audit filter: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	entries, err := h.auditRepo.List(r.Context(), filter)
+	if err != nil {
+		http.Error(w, "Failed to list audit entries: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	entryDTOs := make([]dto.AuditEntryDTO, len(entries))
+	for i, entry := range entries {
+		diff := make(map[string]dto.AuditChangeDTO, len(entry.Diff))
+		for field, change := range entry.Diff {
+			diff[field] = dto.AuditChangeDTO{
+				Before: change.Before,
+				After:  change.After,
+			}
+		}
+
+		entryDTOs[i] = dto.AuditEntryDTO{
+			ID:        entry.ID,
+			Entity:    string(entry.Entity),
+			EntityID:  entry.EntityID,
+			Action:    string(entry.Action),
+			Diff:      diff,
+			RequestID: entry.RequestID,
+			CreatedAt: entry.CreatedAt,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(dto.AuditListResponse{Entries: entryDTOs}); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
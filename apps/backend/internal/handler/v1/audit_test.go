@@ -0,0 +1,259 @@
+package v1
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type auditHandlerTestFixture struct {
+	t             *testing.T
+	mockAuditRepo *mockRepo.MockAuditRepository
+	auditHandler  AuditHandler
+}
+
+func newAuditHandlerTestFixture(t *testing.T) *auditHandlerTestFixture {
+	mockAuditRepo := new(mockRepo.MockAuditRepository)
+
+	auditHandler := NewAuditServiceHandler(
+		AuditServiceConfig{
+			auditRepo: mockAuditRepo,
+		},
+	)
+
+	return &auditHandlerTestFixture{
+		t:             t,
+		mockAuditRepo: mockAuditRepo,
+		auditHandler:  auditHandler,
+	}
+}
+
+func TestAuditServiceHandler_List(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)
+	entity := domain.AuditProject
+	entityID := "p1"
+
+	entry := domain.AuditEntry{
+		ID:       "a1",
+		Entity:   domain.AuditProject,
+		EntityID: "p1",
+		Action:   domain.AuditUpdate,
+		Diff: map[string]domain.AuditChange{
+			"title": {Before: json.RawMessage(`"Old"`), After: json.RawMessage(`"New"`)},
+		},
+		RequestID: "req-1",
+		CreatedAt: createdAt,
+	}
+
+	type Given struct {
+		method   string
+		query    string
+		mockRepo func(m *mockRepo.MockAuditRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success with defaults": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockAuditRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.AuditFilter{Limit: 50}).
+						Return([]domain.AuditEntry{entry}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.AuditListResponse{
+					Entries: []dto.AuditEntryDTO{
+						{
+							ID:       "a1",
+							Entity:   "project",
+							EntityID: "p1",
+							Action:   "update",
+							Diff: map[string]dto.AuditChangeDTO{
+								"title": {Before: json.RawMessage(`"Old"`), After: json.RawMessage(`"New"`)},
+							},
+							RequestID: "req-1",
+							CreatedAt: createdAt,
+						},
+					},
+				}),
+			},
+		},
+		"every filter and clamped limit": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?entity=project&id=p1&from=2025-01-01T00:00:00Z&to=2025-01-02T00:00:00Z&limit=500",
+				mockRepo: func(m *mockRepo.MockAuditRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.AuditFilter{
+							Entity:   &entity,
+							EntityID: &entityID,
+							From:     &from,
+							To:       &to,
+							Limit:    100,
+						}).
+						Return([]domain.AuditEntry{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"entries":[]}`,
+			},
+		},
+		"invalid entity": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?entity=user",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid audit filter: entity invalid = user\n",
+			},
+		},
+		"malformed from": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=yesterday",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid from timestamp: must be RFC 3339\n",
+			},
+		},
+		"from after to": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?from=2025-01-02T00:00:00Z&to=2025-01-01T00:00:00Z",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid audit filter: from must be before to\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockAuditRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.Anything).
+						Return(nil, errors.New("db down"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list audit entries: db down\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodPost,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockAuditRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/audit"+tt.given.query, nil)
+			w := httptest.NewRecorder()
+
+			f.auditHandler.List(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.code == http.StatusOK {
+				assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockAuditRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuditServiceHandler_ServeHTTP(t *testing.T) {
+	type Given struct {
+		method string
+		path   string
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"list": {
+			given:    Given{method: http.MethodGet, path: "/audit"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"list with trailing slash": {
+			given:    Given{method: http.MethodGet, path: "/audit/"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"list method not allowed": {
+			given:    Given{method: http.MethodDelete, path: "/audit"},
+			expected: Expected{code: http.StatusMethodNotAllowed, body: "Method not allowed\n"},
+		},
+		"unknown route": {
+			given:    Given{method: http.MethodGet, path: "/audit/123"},
+			expected: Expected{code: http.StatusNotFound},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditHandlerTestFixture(t)
+			f.mockAuditRepo.EXPECT().
+				List(mock.Anything, mock.AnythingOfType("domain.AuditFilter")).
+				Return([]domain.AuditEntry{}, nil).
+				Maybe()
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.auditHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			if tt.expected.body != "" {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,418 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/token"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// defaultRefreshTokenTTL is used when AuthServiceConfig.RefreshTokenTTL is zero.
+const defaultRefreshTokenTTL = 7 * 24 * time.Hour
+
+// errInvalidRefreshToken is returned by rotate for a refresh token that is
+// unknown, expired, revoked or lost a rotation race. Whatever rotate did before
+// returning it, such as revoking the family of a replayed token, is committed.
+var errInvalidRefreshToken = errors.New("invalid refresh token")
+
+type AuthHandler interface {
+	http.Handler
+	Login(w http.ResponseWriter, r *http.Request)
+	Refresh(w http.ResponseWriter, r *http.Request)
+	Logout(w http.ResponseWriter, r *http.Request)
+}
+
+type AuthServiceConfig struct {
+	DatabaseAPI     database.DatabaseAPI
+	Username        string
+	PasswordHash    string
+	TokenAPI        token.TokenAPI
+	RefreshTokenTTL time.Duration
+
+	refreshTokenRepo v1.RefreshTokenRepository
+	timeProvider     domain.TimeProvider
+}
+
+type authServiceHandler struct {
+	databaseAPI      database.DatabaseAPI
+	username         string
+	passwordHash     []byte
+	tokenAPI         token.TokenAPI
+	refreshTokenTTL  time.Duration
+	refreshTokenRepo v1.RefreshTokenRepository
+	timeProvider     domain.TimeProvider
+}
+
+// NewAuthServiceHandler creates and returns an AuthHandler configured using the provided
+// AuthServiceConfig. Admins log in with cfg.Username and the password whose bcrypt hash is
+// cfg.PasswordHash; access tokens are issued by cfg.TokenAPI. If cfg.refreshTokenRepo is nil,
+// a default repository is constructed via v1.NewRefreshTokenRepository using cfg.DatabaseAPI
+// and the "refresh_token" table. A zero cfg.RefreshTokenTTL defaults to seven days.
+func NewAuthServiceHandler(cfg AuthServiceConfig) AuthHandler {
+	refreshTokenRepo := cfg.refreshTokenRepo
+	if refreshTokenRepo == nil {
+		refreshTokenRepo = v1.NewRefreshTokenRepository(
+			v1.RefreshTokenRepositoryConfig{
+				DatabaseAPI:       cfg.DatabaseAPI,
+				RefreshTokenTable: "refresh_token",
+			},
+		)
+	}
+
+	refreshTokenTTL := cfg.RefreshTokenTTL
+	if refreshTokenTTL == 0 {
+		refreshTokenTTL = defaultRefreshTokenTTL
+	}
+
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &authServiceHandler{
+		databaseAPI:      cfg.DatabaseAPI,
+		username:         cfg.Username,
+		passwordHash:     []byte(cfg.PasswordHash),
+		tokenAPI:         cfg.TokenAPI,
+		refreshTokenTTL:  refreshTokenTTL,
+		refreshTokenRepo: refreshTokenRepo,
+		timeProvider:     timeProvider,
+	}
+}
+
+// ServeHTTP implements http.Handler for authServiceHandler.
+//
+// Routes:
+//   - POST /auth/login   -> h.Login(w, r)
+//   - POST /auth/refresh -> h.Refresh(w, r)
+//   - POST /auth/logout  -> h.Logout(w, r)
+//
+// A trailing slash is ignored. Unknown routes receive a 404 Not Found response.
+func (h *authServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch path {
+	case "/auth/login":
+		h.Login(w, r)
+	case "/auth/refresh":
+		h.Refresh(w, r)
+	case "/auth/logout":
+		h.Logout(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Login handles POST /auth/login. It checks the username and password in the
+// JSON body against the configured admin credentials and, on success, starts
+// a new refresh token family.
+//
+// Responses:
+//   - 200 OK with a dto.TokenResponse.
+//   - 400 Bad Request for malformed JSON or a missing username or password.
+//   - 401 Unauthorized for wrong credentials; the message does not say which one was wrong.
+//   - 405 Method Not Allowed for non-POST requests.
+//   - 429 Too Many Requests, from the rate limiter in front of the handler, after too many attempts.
+//   - 500 Internal Server Error when issuing or storing the tokens fails.
+//
+// @Summary Log in as admin
+// @Description Exchanges the admin username and password for a short-lived access token and a refresh token.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param credentials body dto.LoginRequest true "Admin credentials"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/login [post]
+func (h *authServiceHandler) Login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var loginReq dto.LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&loginReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	if loginReq.Username == "" || loginReq.Password == "" {
+		http.Error(w, "Username and password are required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.validCredentials(loginReq.Username, loginReq.Password) {
+		http.Error(w, "Invalid username or password", http.StatusUnauthorized)
+		return
+	}
+
+	resp, err := h.issue(r.Context(), h.refreshTokenRepo, h.username, utils.GenerateKey())
+	if err != nil {
+		http.Error(w, "Failed to issue tokens: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeTokenResponse(w, resp)
+}
+
+// Refresh handles POST /auth/refresh. The refresh token in the JSON body is
+// revoked and replaced by a new one from the same family, together with a new
+// access token. Presenting a token that was already rotated away revokes its
+// whole family, since it means the token leaked.
+//
+// Responses:
+//   - 200 OK with a dto.TokenResponse.
+//   - 400 Bad Request for malformed JSON or a missing refresh token.
+//   - 401 Unauthorized for an unknown, expired or revoked refresh token.
+//   - 405 Method Not Allowed for non-POST requests.
+//   - 429 Too Many Requests, from the rate limiter in front of the handler, after too many attempts.
+//   - 500 Internal Server Error when reading, revoking or storing tokens fails.
+//
+// @Summary Refresh admin tokens
+// @Description Rotates a refresh token, returning a new access token and a new refresh token.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param payload body dto.RefreshRequest true "Refresh token"
+// @Success 200 {object} dto.TokenResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 401 {object} ErrorResponse
+// @Failure 429 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/refresh [post]
+func (h *authServiceHandler) Refresh(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	refreshToken, ok := decodeRefreshRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var (
+		resp    dto.TokenResponse
+		invalid bool
+	)
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		var err error
+		resp, err = h.rotate(r.Context(), h.refreshTokenRepo.WithTx(tx), refreshToken)
+		if errors.Is(err, errInvalidRefreshToken) {
+			// Commit rather than roll back, so the family revoked on a replay
+			// stays revoked.
+			invalid = true
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		http.Error(w, "Failed to refresh tokens: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if invalid {
+		http.Error(w, "Invalid refresh token", http.StatusUnauthorized)
+		return
+	}
+
+	writeTokenResponse(w, resp)
+}
+
+// Logout handles POST /auth/logout. It revokes the family of the refresh
+// token in the JSON body, ending the session on every device that shares it.
+// Unknown tokens are ignored, so logging out twice is not an error. Access
+// tokens already issued stay valid until they expire.
+//
+// Responses:
+//   - 204 No Content.
+//   - 400 Bad Request for malformed JSON or a missing refresh token.
+//   - 405 Method Not Allowed for non-POST requests.
+//   - 500 Internal Server Error when the lookup or revocation fails.
+//
+// @Summary Log out an admin session
+// @Description Revokes the refresh token and every token rotated from the same login.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param payload body dto.RefreshRequest true "Refresh token"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /auth/logout [post]
+func (h *authServiceHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	refreshToken, ok := decodeRefreshRequest(w, r)
+	if !ok {
+		return
+	}
+
+	stored, err := h.refreshTokenRepo.GetByHash(r.Context(), hashSecret(refreshToken))
+	if errors.Is(err, pgx.ErrNoRows) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if err != nil {
+		http.Error(w, "Failed to log out: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := h.refreshTokenRepo.RevokeFamily(r.Context(), stored.FamilyID); err != nil {
+		http.Error(w, "Failed to log out: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// validCredentials compares username and password with the configured admin
+// credentials. The bcrypt comparison runs even for a wrong username, so the
+// response time does not reveal which one was wrong.
+func (h *authServiceHandler) validCredentials(username, password string) bool {
+	usernameOK := h.username != "" && subtle.ConstantTimeCompare([]byte(username), []byte(h.username)) == 1
+	passwordOK := bcrypt.CompareHashAndPassword(h.passwordHash, []byte(password)) == nil
+	return usernameOK && passwordOK
+}
+
+// rotate revokes the stored refresh token matching refreshToken and issues
+// its replacement in the same family.
+func (h *authServiceHandler) rotate(ctx context.Context, repo v1.RefreshTokenRepository, refreshToken string) (dto.TokenResponse, error) {
+	stored, err := repo.GetByHash(ctx, hashSecret(refreshToken))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return dto.TokenResponse{}, errInvalidRefreshToken
+	}
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	if stored.RevokedAt != nil {
+		// A rotated token was replayed: whoever holds the family is suspect.
+		if err := repo.RevokeFamily(ctx, stored.FamilyID); err != nil {
+			return dto.TokenResponse{}, err
+		}
+		return dto.TokenResponse{}, errInvalidRefreshToken
+	}
+
+	if !h.timeProvider().Before(stored.ExpiresAt) {
+		return dto.TokenResponse{}, errInvalidRefreshToken
+	}
+
+	revoked, err := repo.Revoke(ctx, stored.ID)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+	if !revoked {
+		// Another request rotated the token first.
+		return dto.TokenResponse{}, errInvalidRefreshToken
+	}
+
+	return h.issue(ctx, repo, stored.Subject, stored.FamilyID)
+}
+
+// issue signs an access token for subject and stores a new refresh token in
+// familyID.
+func (h *authServiceHandler) issue(ctx context.Context, repo v1.RefreshTokenRepository, subject, familyID string) (dto.TokenResponse, error) {
+	accessToken, accessExpiresAt, err := h.tokenAPI.Issue(subject)
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	refreshToken, err := newSecret()
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	now := h.timeProvider()
+	err = repo.Create(ctx, &domain.RefreshToken{
+		FamilyID:  familyID,
+		Subject:   subject,
+		TokenHash: hashSecret(refreshToken),
+		ExpiresAt: now.Add(h.refreshTokenTTL),
+	})
+	if err != nil {
+		return dto.TokenResponse{}, err
+	}
+
+	return dto.TokenResponse{
+		AccessToken:      accessToken,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(accessExpiresAt.Sub(now) / time.Second),
+		RefreshToken:     refreshToken,
+		RefreshExpiresIn: int64(h.refreshTokenTTL / time.Second),
+	}, nil
+}
+
+// newSecret returns 32 random bytes, base64url encoded, for use as a refresh
+// token or the body of an API key.
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecret returns the hex SHA-256 of secret, the form refresh tokens and
+// API keys are stored and looked up in.
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// decodeRefreshRequest reads the refresh token from a dto.RefreshRequest
+// body, writing a 400 response and returning false when it is missing.
+func decodeRefreshRequest(w http.ResponseWriter, r *http.Request) (string, bool) {
+	defer r.Body.Close()
+
+	var refreshReq dto.RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&refreshReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return "", false
+	}
+
+	if refreshReq.RefreshToken == "" {
+		http.Error(w, "Refresh token is required", http.StatusBadRequest)
+		return "", false
+	}
+
+	return refreshReq.RefreshToken, true
+}
+
+// writeTokenResponse writes resp as JSON. Token responses must not be cached.
+func writeTokenResponse(w http.ResponseWriter, resp dto.TokenResponse) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
@@ -0,0 +1,604 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	mockDatabase "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	mockToken "github.com/fingertips18/fingertips18.github.io/backend/pkg/token/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var authTestNow = time.Date(2026, 2, 8, 9, 0, 0, 0, time.UTC)
+
+type authHandlerTestFixture struct {
+	t                    *testing.T
+	mockDatabaseAPI      *mockDatabase.MockDatabaseAPI
+	mockRefreshTokenRepo *mockRepo.MockRefreshTokenRepository
+	mockTokenAPI         *mockToken.MockTokenAPI
+	authHandler          AuthHandler
+}
+
+func newAuthHandlerTestFixture(t *testing.T) *authHandlerTestFixture {
+	mockDatabaseAPI := new(mockDatabase.MockDatabaseAPI)
+	mockTx := new(mockDatabase.MockTx)
+	mockRefreshTokenRepo := new(mockRepo.MockRefreshTokenRepository)
+	mockTokenAPI := new(mockToken.MockTokenAPI)
+
+	// Run transactional callbacks directly against the repository mock
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+	mockRefreshTokenRepo.EXPECT().WithTx(mockTx).Return(mockRefreshTokenRepo).Maybe()
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authHandler := NewAuthServiceHandler(
+		AuthServiceConfig{
+			DatabaseAPI:      mockDatabaseAPI,
+			Username:         "admin",
+			PasswordHash:     string(passwordHash),
+			TokenAPI:         mockTokenAPI,
+			RefreshTokenTTL:  time.Hour,
+			refreshTokenRepo: mockRefreshTokenRepo,
+			timeProvider:     func() time.Time { return authTestNow },
+		},
+	)
+
+	return &authHandlerTestFixture{
+		t:                    t,
+		mockDatabaseAPI:      mockDatabaseAPI,
+		mockRefreshTokenRepo: mockRefreshTokenRepo,
+		mockTokenAPI:         mockTokenAPI,
+		authHandler:          authHandler,
+	}
+}
+
+// newRefreshTokenMatcher matches a stored refresh token created for subject,
+// in familyID when it is non-empty.
+func newRefreshTokenMatcher(subject, familyID string) any {
+	return mock.MatchedBy(func(token *domain.RefreshToken) bool {
+		return token.Subject == subject &&
+			(familyID == "" || token.FamilyID == familyID) &&
+			token.FamilyID != "" &&
+			len(token.TokenHash) == 64 &&
+			token.ExpiresAt.Equal(authTestNow.Add(time.Hour))
+	})
+}
+
+// assertTokenResponse checks a successful token response. The refresh token
+// is random, so only its presence is checked.
+func assertTokenResponse(t *testing.T, res *http.Response, body []byte) {
+	t.Helper()
+
+	assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+	assert.Equal(t, "no-store", res.Header.Get("Cache-Control"))
+
+	var got dto.TokenResponse
+	assert.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, "access-token", got.AccessToken)
+	assert.Equal(t, "Bearer", got.TokenType)
+	assert.Equal(t, int64(900), got.ExpiresIn)
+	assert.NotEmpty(t, got.RefreshToken)
+	assert.Equal(t, int64(3600), got.RefreshExpiresIn)
+}
+
+func TestAuthServiceHandler_Login(t *testing.T) {
+	type Given struct {
+		method    string
+		body      string
+		mockToken func(m *mockToken.MockTokenAPI)
+		mockRepo  func(m *mockRepo.MockRefreshTokenRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"username":"admin","password":"secret"}`,
+				mockToken: func(m *mockToken.MockTokenAPI) {
+					m.EXPECT().Issue("admin").Return("access-token", authTestNow.Add(15*time.Minute), nil)
+				},
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().Create(mock.Anything, newRefreshTokenMatcher("admin", "")).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+			},
+		},
+		"wrong password": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"username":"admin","password":"guess"}`,
+			},
+			expected: Expected{
+				code: http.StatusUnauthorized,
+				body: "Invalid username or password\n",
+			},
+		},
+		"wrong username": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"username":"root","password":"secret"}`,
+			},
+			expected: Expected{
+				code: http.StatusUnauthorized,
+				body: "Invalid username or password\n",
+			},
+		},
+		"missing password": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"username":"admin"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Username and password are required\n",
+			},
+		},
+		"invalid JSON": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"issue error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"username":"admin","password":"secret"}`,
+				mockToken: func(m *mockToken.MockTokenAPI) {
+					m.EXPECT().Issue("admin").Return("", time.Time{}, errors.New("signing secret is empty"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to issue tokens: signing secret is empty\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"username":"admin","password":"secret"}`,
+				mockToken: func(m *mockToken.MockTokenAPI) {
+					m.EXPECT().Issue("admin").Return("access-token", authTestNow.Add(15*time.Minute), nil)
+				},
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().Create(mock.Anything, mock.Anything).Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to issue tokens: db error\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodGet,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuthHandlerTestFixture(t)
+
+			if tt.given.mockToken != nil {
+				tt.given.mockToken(f.mockTokenAPI)
+			}
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockRefreshTokenRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/auth/login", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.authHandler.Login(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.code == http.StatusOK {
+				assertTokenResponse(t, res, body)
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockTokenAPI.AssertExpectations(t)
+			f.mockRefreshTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthServiceHandler_Refresh(t *testing.T) {
+	revokedAt := authTestNow.Add(-time.Minute)
+	tokenHash := hashSecret("refresh-token")
+
+	stored := &domain.RefreshToken{
+		ID:        "rt1",
+		FamilyID:  "fam1",
+		Subject:   "admin",
+		TokenHash: tokenHash,
+		ExpiresAt: authTestNow.Add(time.Hour),
+	}
+
+	type Given struct {
+		method    string
+		body      string
+		mockToken func(m *mockToken.MockTokenAPI)
+		mockRepo  func(m *mockRepo.MockRefreshTokenRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success rotates within the family": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockToken: func(m *mockToken.MockTokenAPI) {
+					m.EXPECT().Issue("admin").Return("access-token", authTestNow.Add(15*time.Minute), nil)
+				},
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(stored, nil)
+					m.EXPECT().Revoke(mock.Anything, "rt1").Return(true, nil)
+					m.EXPECT().Create(mock.Anything, newRefreshTokenMatcher("admin", "fam1")).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+			},
+		},
+		"unknown token": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(nil, pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusUnauthorized,
+				body: "Invalid refresh token\n",
+			},
+		},
+		"expired token": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					expired := *stored
+					expired.ExpiresAt = authTestNow
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(&expired, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusUnauthorized,
+				body: "Invalid refresh token\n",
+			},
+		},
+		"reused token revokes the family": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					reused := *stored
+					reused.RevokedAt = &revokedAt
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(&reused, nil)
+					m.EXPECT().RevokeFamily(mock.Anything, "fam1").Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusUnauthorized,
+				body: "Invalid refresh token\n",
+			},
+		},
+		"lost rotation race": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(stored, nil)
+					m.EXPECT().Revoke(mock.Anything, "rt1").Return(false, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusUnauthorized,
+				body: "Invalid refresh token\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(nil, errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to refresh tokens: db error\n",
+			},
+		},
+		"missing refresh token": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Refresh token is required\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodGet,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuthHandlerTestFixture(t)
+
+			if tt.given.mockToken != nil {
+				tt.given.mockToken(f.mockTokenAPI)
+			}
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockRefreshTokenRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/auth/refresh", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.authHandler.Refresh(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.code == http.StatusOK {
+				assertTokenResponse(t, res, body)
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockTokenAPI.AssertExpectations(t)
+			f.mockRefreshTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// TestAuthServiceHandler_Refresh_ReplayRevocationCommitted runs Refresh
+// against a WithTx that, like a real transaction, throws away the work of a
+// callback returning an error, and checks that the family revoked on a
+// replay is kept.
+func TestAuthServiceHandler_Refresh_ReplayRevocationCommitted(t *testing.T) {
+	mockDatabaseAPI := new(mockDatabase.MockDatabaseAPI)
+	mockTx := new(mockDatabase.MockTx)
+	mockRefreshTokenRepo := new(mockRepo.MockRefreshTokenRepository)
+
+	var pending, committed []string
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			pending = nil
+			if err := fn(mockTx); err != nil {
+				return err
+			}
+			committed = append(committed, pending...)
+			return nil
+		})
+	mockRefreshTokenRepo.EXPECT().WithTx(mockTx).Return(mockRefreshTokenRepo)
+
+	revokedAt := authTestNow.Add(-time.Minute)
+	mockRefreshTokenRepo.EXPECT().
+		GetByHash(mock.Anything, hashSecret("refresh-token")).
+		Return(&domain.RefreshToken{
+			ID:        "rt1",
+			FamilyID:  "fam1",
+			Subject:   "admin",
+			ExpiresAt: authTestNow.Add(time.Hour),
+			RevokedAt: &revokedAt,
+		}, nil)
+	mockRefreshTokenRepo.EXPECT().
+		RevokeFamily(mock.Anything, "fam1").
+		RunAndReturn(func(ctx context.Context, familyID string) error {
+			pending = append(pending, familyID)
+			return nil
+		})
+
+	authHandler := NewAuthServiceHandler(
+		AuthServiceConfig{
+			DatabaseAPI:      mockDatabaseAPI,
+			RefreshTokenTTL:  time.Hour,
+			refreshTokenRepo: mockRefreshTokenRepo,
+			timeProvider:     func() time.Time { return authTestNow },
+		},
+	)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/refresh", strings.NewReader(`{"refresh_token":"refresh-token"}`))
+	w := httptest.NewRecorder()
+
+	authHandler.Refresh(w, req)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, "Invalid refresh token\n", w.Body.String())
+	assert.Equal(t, []string{"fam1"}, committed)
+	mockRefreshTokenRepo.AssertExpectations(t)
+}
+
+func TestAuthServiceHandler_Logout(t *testing.T) {
+	tokenHash := hashSecret("refresh-token")
+
+	type Given struct {
+		method   string
+		body     string
+		mockRepo func(m *mockRepo.MockRefreshTokenRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success revokes the family": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(&domain.RefreshToken{ID: "rt1", FamilyID: "fam1"}, nil)
+					m.EXPECT().RevokeFamily(mock.Anything, "fam1").Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+			},
+		},
+		"unknown token": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(nil, pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+			},
+		},
+		"revoke error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"refresh_token":"refresh-token"}`,
+				mockRepo: func(m *mockRepo.MockRefreshTokenRepository) {
+					m.EXPECT().GetByHash(mock.Anything, tokenHash).Return(&domain.RefreshToken{ID: "rt1", FamilyID: "fam1"}, nil)
+					m.EXPECT().RevokeFamily(mock.Anything, "fam1").Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to log out: db error\n",
+			},
+		},
+		"invalid JSON": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `not json`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodDelete,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuthHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockRefreshTokenRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/auth/logout", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.authHandler.Logout(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockRefreshTokenRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthServiceHandler_ServeHTTP(t *testing.T) {
+	tests := map[string]struct {
+		path string
+		code int
+	}{
+		"login":                {path: "/auth/login", code: http.StatusBadRequest},
+		"refresh with slash":   {path: "/auth/refresh/", code: http.StatusBadRequest},
+		"logout":               {path: "/auth/logout", code: http.StatusBadRequest},
+		"unknown route":        {path: "/auth/register", code: http.StatusNotFound},
+		"auth root not routed": {path: "/auth", code: http.StatusNotFound},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuthHandlerTestFixture(t)
+
+			req := httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(`{}`))
+			w := httptest.NewRecorder()
+
+			f.authHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.code, w.Code)
+		})
+	}
+}
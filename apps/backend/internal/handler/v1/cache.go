@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/cache"
+)
+
+type CacheHandler interface {
+	http.Handler
+	Stats(w http.ResponseWriter, r *http.Request)
+}
+
+type CacheServiceConfig struct {
+	RepositoryCache *v1.RepositoryCache
+}
+
+type cacheServiceHandler struct {
+	repositoryCache *v1.RepositoryCache
+}
+
+// NewCacheServiceHandler creates and returns a CacheHandler that reports on
+// cfg.RepositoryCache. A nil cache, as configured when caching is disabled, is
+// reported as disabled rather than treated as an error.
+func NewCacheServiceHandler(cfg CacheServiceConfig) CacheHandler {
+	return &cacheServiceHandler{
+		repositoryCache: cfg.RepositoryCache,
+	}
+}
+
+// ServeHTTP implements http.Handler for cacheServiceHandler.
+//
+// Routes:
+//   - GET /cache/stats -> h.Stats(w, r)
+//
+// A trailing slash is ignored. Unknown routes receive a 404 Not Found response.
+func (h *cacheServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch path {
+	case "/cache/stats":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.Stats(w, r)
+		return
+
+	default:
+		http.NotFound(w, r)
+		return
+	}
+}
+
+// Stats handles GET /cache/stats and returns the hit and miss counters of the
+// shared repository cache, per entity, since the server started.
+//
+// @Security ApiKeyAuth
+// @Summary Get repository cache stats
+// @Description Returns the hit and miss counters of the project, skill and education caches since the server started. Enabled is false when caching is turned off.
+// @Tags cache
+// @Produce json
+// @Success 200 {object} dto.CacheStatsResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /cache/stats [get]
+func (h *cacheServiceHandler) Stats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var resp dto.CacheStatsResponse
+	if h.repositoryCache != nil {
+		stats := h.repositoryCache.Stats()
+		resp = dto.CacheStatsResponse{
+			Enabled:    true,
+			Projects:   toCacheCountersDTO(stats.Projects),
+			Skills:     toCacheCountersDTO(stats.Skills),
+			Educations: toCacheCountersDTO(stats.Educations),
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+func toCacheCountersDTO(stats cache.Stats) dto.CacheCountersDTO {
+	return dto.CacheCountersDTO{Hits: stats.Hits, Misses: stats.Misses}
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCacheServiceHandler_Stats(t *testing.T) {
+	// newWarmCache returns a cache with one skill miss followed by two hits.
+	newWarmCache := func() *v1.RepositoryCache {
+		c := v1.NewRepositoryCache(v1.RepositoryCacheConfig{TTL: time.Minute})
+
+		mockSkillRepo := new(mockRepo.MockSkillRepository)
+		mockSkillRepo.EXPECT().Get(mock.Anything, "s1").Return(&domain.Skill{Id: "s1"}, nil).Once()
+
+		skills := v1.NewCachedSkillRepository(mockSkillRepo, c)
+		for range 3 {
+			_, _ = skills.Get(context.Background(), "s1")
+		}
+		return c
+	}
+
+	type Given struct {
+		method string
+		path   string
+		cache  *v1.RepositoryCache
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"reports counters": {
+			given: Given{method: http.MethodGet, path: "/cache/stats", cache: newWarmCache()},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.CacheStatsResponse{
+					Enabled: true,
+					Skills:  dto.CacheCountersDTO{Hits: 2, Misses: 1},
+				}),
+			},
+		},
+		"caching disabled": {
+			given: Given{method: http.MethodGet, path: "/cache/stats/"},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.CacheStatsResponse{}),
+			},
+		},
+		"invalid method": {
+			given: Given{method: http.MethodPost, path: "/cache/stats"},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"unknown route": {
+			given: Given{method: http.MethodGet, path: "/cache"},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "404 page not found\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			handler := NewCacheServiceHandler(CacheServiceConfig{RepositoryCache: tt.given.cache})
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(tt.given.method, tt.given.path, nil))
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			if w.Code == http.StatusOK {
+				assert.JSONEq(t, tt.expected.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+		})
+	}
+}
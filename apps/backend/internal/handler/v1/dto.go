@@ -1,6 +1,10 @@
 package v1
 
-import "time"
+import (
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+)
 
 type CreateSkillRequest struct {
 	Icon     string `json:"icon"`
@@ -27,22 +31,18 @@ type UpdateSkillResponse struct {
 	UpdatedAt time.Time `json:"updated_at"`
 }
 
-type SkillDTO struct {
-	Id        string    `json:"id"`
-	Icon      string    `json:"icon"`
-	HexColor  string    `json:"hex_color"`
-	Label     string    `json:"label"`
-	Category  string    `json:"category"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
-}
-
 type SkillFilterRequest struct {
 	Page          int32  `json:"page"`
 	PageSize      int32  `json:"page_size"`
 	SortBy        string `json:"sort_by"`
 	SortAscending bool   `json:"sort_ascending"`
 	Category      string `json:"category"`
+	Cursor        string `json:"cursor"`
+}
+
+type SkillListResponse struct {
+	Items []dto.SkillDTO `json:"items"`
+	dto.PageMetaDTO
 }
 
 type FileDTO struct {
@@ -0,0 +1,38 @@
+package dto
+
+type AnalyticsCountDTO struct {
+	Key      string `json:"key"`
+	Views    int    `json:"views"`
+	Visitors int    `json:"visitors"`
+}
+
+// AnalyticsEventCountDTO counts events by key: their name, or the project
+// ID of project clicks.
+type AnalyticsEventCountDTO struct {
+	Key      string `json:"key"`
+	Count    int    `json:"count"`
+	Visitors int    `json:"visitors"`
+}
+
+type AnalyticsSummaryResponse struct {
+	From         string                   `json:"from"`
+	To           string                   `json:"to"`
+	PageViews    int                      `json:"page_views"`
+	Visitors     int                      `json:"visitors"`
+	TopPages     []AnalyticsCountDTO      `json:"top_pages"`
+	TopReferrers []AnalyticsCountDTO      `json:"top_referrers"`
+	Events       []AnalyticsEventCountDTO `json:"events"`
+	TopProjects  []AnalyticsEventCountDTO `json:"top_projects"`
+}
+
+type AnalyticsDayDTO struct {
+	Date      string `json:"date"`
+	PageViews int    `json:"page_views"`
+	Visitors  int    `json:"visitors"`
+}
+
+type AnalyticsTimeseriesResponse struct {
+	From string            `json:"from"`
+	To   string            `json:"to"`
+	Days []AnalyticsDayDTO `json:"days"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import "time"
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name" example:"ci-uploads"`
+	Scopes    []string   `json:"scopes" example:"files:write"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2026-12-31T00:00:00Z"`
+}
+
+type UpdateAPIKeyRequest struct {
+	Name      string     `json:"name" example:"ci-uploads"`
+	Scopes    []string   `json:"scopes" example:"files:write"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" example:"2026-12-31T00:00:00Z"`
+}
+
+// APIKeyDTO describes a stored API key. The key itself is never returned
+// after creation.
+type APIKeyDTO struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+}
+
+// CreateAPIKeyResponse is the only response that carries the key; it can't
+// be recovered later.
+type CreateAPIKeyResponse struct {
+	APIKeyDTO
+	Key string `json:"key"`
+}
+
+type APIKeyListResponse struct {
+	Items []APIKeyDTO `json:"items"`
+}
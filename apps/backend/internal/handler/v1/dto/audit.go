@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AuditChangeDTO struct {
+	Before json.RawMessage `json:"before,omitempty" swaggertype:"object"`
+	After  json.RawMessage `json:"after,omitempty" swaggertype:"object"`
+}
+
+type AuditEntryDTO struct {
+	ID        string                    `json:"id"`
+	Entity    string                    `json:"entity"`
+	EntityID  string                    `json:"entity_id"`
+	Action    string                    `json:"action"`
+	Diff      map[string]AuditChangeDTO `json:"diff"`
+	RequestID string                    `json:"request_id"`
+	CreatedAt time.Time                 `json:"created_at"`
+}
+
+type AuditListResponse struct {
+	Entries []AuditEntryDTO `json:"entries"`
+}
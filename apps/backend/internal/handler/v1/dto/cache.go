@@ -0,0 +1,13 @@
+package dto
+
+type CacheCountersDTO struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+}
+
+type CacheStatsResponse struct {
+	Enabled    bool             `json:"enabled"`
+	Projects   CacheCountersDTO `json:"projects"`
+	Skills     CacheCountersDTO `json:"skills"`
+	Educations CacheCountersDTO `json:"educations"`
+}
@@ -50,4 +50,10 @@ type EducationFilterRequest struct {
 	PageSize      int32  `json:"page_size"`
 	SortBy        string `json:"sort_by"`
 	SortAscending bool   `json:"sort_ascending"`
+	Cursor        string `json:"cursor"`
+}
+
+type EducationListResponse struct {
+	Items []EducationDTO `json:"items"`
+	PageMetaDTO
 }
@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+type CreateExperienceRequest struct {
+	Logo       *CreateFileRequest `json:"logo,omitempty"`
+	Position   string             `json:"position"`
+	Company    string             `json:"company"`
+	Link       string             `json:"link,omitempty"`
+	Setup      string             `json:"setup" example:"remote"`
+	StartDate  time.Time          `json:"start_date" example:"2023-05-01T00:00:00Z"`
+	EndDate    *time.Time         `json:"end_date,omitempty" example:"2024-04-30T00:00:00Z"`
+	Highlights []string           `json:"highlights"`
+	Skills     []string           `json:"skills"`
+	SkillIDs   []string           `json:"skill_ids,omitempty"`
+}
+
+type ExperienceDTO struct {
+	ID           string     `json:"id"`
+	Logo         *FileDTO   `json:"logo,omitempty"`
+	Position     string     `json:"position"`
+	Company      string     `json:"company"`
+	Link         string     `json:"link,omitempty"`
+	Setup        string     `json:"setup"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      *time.Time `json:"end_date,omitempty"`
+	Highlights   []string   `json:"highlights"`
+	Skills       []string   `json:"skills"`
+	SkillIDs     []string   `json:"skill_ids,omitempty"`
+	LinkedSkills []SkillDTO `json:"linked_skills,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+type ExperienceFilterRequest struct {
+	Page          int32  `json:"page"`
+	PageSize      int32  `json:"page_size"`
+	SortBy        string `json:"sort_by"`
+	SortAscending bool   `json:"sort_ascending"`
+	Setup         string `json:"setup"`
+	Cursor        string `json:"cursor"`
+}
+
+type ExperienceListResponse struct {
+	Items []ExperienceDTO `json:"items"`
+	PageMetaDTO
+}
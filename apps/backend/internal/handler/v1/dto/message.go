@@ -0,0 +1,32 @@
+package dto
+
+import "time"
+
+// UpdateMessageRequest marks a message read or unread and archives or
+// unarchives it. Omitted fields are left unchanged.
+type UpdateMessageRequest struct {
+	Read     *bool `json:"read,omitempty" example:"true"`
+	Archived *bool `json:"archived,omitempty" example:"false"`
+}
+
+// MessageDTO is a stored contact form submission. ProviderResponse is what
+// the email provider answered on the last delivery attempt.
+type MessageDTO struct {
+	ID               string     `json:"id"`
+	Name             string     `json:"name"`
+	Email            string     `json:"email"`
+	Subject          string     `json:"subject"`
+	Message          string     `json:"message"`
+	Status           string     `json:"status"`
+	Attempts         int        `json:"attempts"`
+	ProviderResponse string     `json:"provider_response,omitempty"`
+	ReadAt           *time.Time `json:"read_at,omitempty"`
+	ArchivedAt       *time.Time `json:"archived_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+type MessageListResponse struct {
+	Items []MessageDTO `json:"items"`
+	PageMetaDTO
+}
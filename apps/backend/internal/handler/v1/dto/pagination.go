@@ -0,0 +1,12 @@
+package dto
+
+// PageMetaDTO carries the pagination fields shared by every list response envelope.
+// NextCursor is only set when more rows follow and the list is ordered by created_at;
+// pass it back as the cursor query parameter to fetch the next page by keyset.
+type PageMetaDTO struct {
+	Total      int64  `json:"total"`
+	Page       int32  `json:"page"`
+	PageSize   int32  `json:"page_size"`
+	HasNext    bool   `json:"has_next"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
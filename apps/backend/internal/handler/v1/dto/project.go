@@ -3,18 +3,20 @@ package dto
 import "time"
 
 type ProjectDTO struct {
-	ID          string    `json:"id"`
-	BlurHash    string    `json:"blurhash"`
-	Title       string    `json:"title"`
-	Subtitle    string    `json:"sub_title"`
-	Description string    `json:"description"`
-	Tags        []string  `json:"tags"`
-	Type        string    `json:"type"`
-	Link        string    `json:"link"`
-	EducationID string    `json:"education_id,omitempty"`
-	Previews    []FileDTO `json:"previews"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string     `json:"id"`
+	BlurHash    string     `json:"blurhash"`
+	Title       string     `json:"title"`
+	Subtitle    string     `json:"sub_title"`
+	Description string     `json:"description"`
+	Tags        []string   `json:"tags"`
+	Type        string     `json:"type"`
+	Link        string     `json:"link"`
+	EducationID string     `json:"education_id,omitempty"`
+	SkillIDs    []string   `json:"skill_ids,omitempty"`
+	Skills      []SkillDTO `json:"skills,omitempty"`
+	Previews    []FileDTO  `json:"previews"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
 }
 
 type CreateProjectRequest struct {
@@ -27,12 +29,27 @@ type CreateProjectRequest struct {
 	Type        string              `json:"type"`
 	Link        string              `json:"link"`
 	EducationID string              `json:"education_id,omitempty"`
+	SkillIDs    []string            `json:"skill_ids,omitempty"`
 }
 
 type ProjectFilterRequest struct {
-	Page          int32  `json:"page"`
-	PageSize      int32  `json:"page_size"`
-	SortBy        string `json:"sort_by"`
-	SortAscending bool   `json:"sort_ascending"`
-	Type          string `json:"type"`
+	Page          int32    `json:"page"`
+	PageSize      int32    `json:"page_size"`
+	SortBy        string   `json:"sort_by"`
+	SortAscending bool     `json:"sort_ascending"`
+	Type          string   `json:"type"`
+	EducationID   string   `json:"education_id"`
+	Tags          []string `json:"tags"`
+	TagMatch      string   `json:"tag_match"`
+	Cursor        string   `json:"cursor"`
+}
+
+type ProjectListResponse struct {
+	Items []ProjectDTO `json:"items"`
+	PageMetaDTO
+}
+
+type ProjectTagDTO struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
 }
@@ -0,0 +1,14 @@
+package dto
+
+type SearchHitDTO struct {
+	Type    string  `json:"type"`
+	ID      string  `json:"id"`
+	Title   string  `json:"title"`
+	Snippet string  `json:"snippet"`
+	Rank    float64 `json:"rank"`
+}
+
+type SearchResponse struct {
+	Query string         `json:"query"`
+	Hits  []SearchHitDTO `json:"hits"`
+}
@@ -0,0 +1,13 @@
+package dto
+
+import "time"
+
+type SkillDTO struct {
+	Id        string    `json:"id"`
+	Icon      string    `json:"icon"`
+	HexColor  string    `json:"hex_color"`
+	Label     string    `json:"label"`
+	Category  string    `json:"category"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
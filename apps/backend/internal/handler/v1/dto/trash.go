@@ -0,0 +1,14 @@
+package dto
+
+import "time"
+
+type TrashItemDTO struct {
+	Entity    string    `json:"entity"`
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	DeletedAt time.Time `json:"deleted_at"`
+}
+
+type TrashListResponse struct {
+	Items []TrashItemDTO `json:"items"`
+}
@@ -22,49 +22,85 @@ type EducationHandler interface {
 	Get(w http.ResponseWriter, r *http.Request, id string)
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request, id string)
+	Restore(w http.ResponseWriter, r *http.Request, id string)
 	List(w http.ResponseWriter, r *http.Request)
 }
 
 type EducationServiceConfig struct {
-	DatabaseAPI database.DatabaseAPI
-	ProjectRepo v1.ProjectRepository
+	DatabaseAPI     database.DatabaseAPI
+	RepositoryCache *v1.RepositoryCache
+	ProjectRepo     v1.ProjectRepository
 
 	educationRepo v1.EducationRepository
+	fileRepo      v1.FileRepository
 }
 
 type educationServiceHandler struct {
+	databaseAPI   database.DatabaseAPI
 	educationRepo v1.EducationRepository
 	projectRepo   v1.ProjectRepository
+	fileRepo      v1.FileRepository
 }
 
 // NewEducationServiceHandler creates and returns an EducationHandler configured using the provided
 // EducationServiceConfig. If cfg.educationRepo is nil, a default repository is constructed via
-// v1.NewEducationRepository using cfg.DatabaseAPI and the "Education" table. The returned handler
+// v1.NewEducationRepository using cfg.DatabaseAPI and the "Education" table, and wrapped with
+// v1.NewAuditedEducationRepository so its writes reach the audit log; when cfg.RepositoryCache
+// is set, default repositories also serve reads from it. The returned handler
 // wraps the chosen repository and is ready to serve education-related operations.
 func NewEducationServiceHandler(cfg EducationServiceConfig) EducationHandler {
+	audited := newAuditedRepositoryConfig(cfg.DatabaseAPI)
+
 	educationRepo := cfg.educationRepo
 	if educationRepo == nil {
-		educationRepo = v1.NewEducationRepository(
-			v1.EducationRepositoryConfig{
-				DatabaseAPI:    cfg.DatabaseAPI,
-				EducationTable: "Education",
-			},
+		educationRepo = v1.NewCachedEducationRepository(
+			v1.NewAuditedEducationRepository(
+				v1.NewEducationRepository(
+					v1.EducationRepositoryConfig{
+						DatabaseAPI:    cfg.DatabaseAPI,
+						EducationTable: "Education",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
 		)
 	}
 
 	projectRepo := cfg.ProjectRepo
 	if projectRepo == nil {
-		projectRepo = v1.NewProjectRepository(
-			v1.ProjectRepositoryConfig{
-				DatabaseAPI:  cfg.DatabaseAPI,
-				ProjectTable: "Project",
-			},
+		projectRepo = v1.NewCachedProjectRepository(
+			v1.NewAuditedProjectRepository(
+				v1.NewProjectRepository(
+					v1.ProjectRepositoryConfig{
+						DatabaseAPI:  cfg.DatabaseAPI,
+						ProjectTable: "Project",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	fileRepo := cfg.fileRepo
+	if fileRepo == nil {
+		fileRepo = v1.NewAuditedFileRepository(
+			v1.NewFileRepository(
+				v1.FileRepositoryConfig{
+					DatabaseAPI: cfg.DatabaseAPI,
+					FileTable:   "File",
+				},
+			),
+			audited,
 		)
 	}
 
 	return &educationServiceHandler{
+		databaseAPI:   cfg.DatabaseAPI,
 		educationRepo: educationRepo,
 		projectRepo:   projectRepo,
+		fileRepo:      fileRepo,
 	}
 }
 
@@ -84,13 +120,14 @@ func NewEducationServiceHandler(cfg EducationServiceConfig) EducationHandler {
 //   - DELETE /education/{id}    -> h.Delete(w, r, id)
 //     If the {id} segment is empty, responds with 400 Bad Request.
 //     Responds with 405 Method Not Allowed for unsupported methods.
+//   - POST /education/{id}/restore -> h.Restore(w, r, id)
 //
 // Unknown routes receive a 404 Not Found response.
 //
 // Note: path matching uses exact matches for "/educations" and "/education",
 // and prefix matching for "/education/". Request body parsing, validation, and
 // response serialization are handled by the delegated methods (Create, Update,
-// List, Get, Delete).
+// List, Get, Delete, Restore).
 func (h *educationServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Normalize path by trimming trailing slash
 	path := strings.TrimSuffix(r.URL.Path, "/")
@@ -117,6 +154,23 @@ func (h *educationServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Reque
 		}
 		return
 
+	// POST /education/{id}/restore
+	case strings.HasPrefix(path, "/education/") && strings.HasSuffix(strings.TrimPrefix(path, "/education/"), "/restore"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/education/"), "/restore")
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "Education ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.Restore(w, r, id)
+		return
+
 	// GET / DELETE /education/{id}
 	case strings.HasPrefix(path, "/education/"):
 		id := strings.TrimPrefix(path, "/education/")
@@ -244,7 +298,6 @@ func (h *educationServiceHandler) Create(w http.ResponseWriter, r *http.Request)
 // On success it encodes the education entity as JSON, sets Content-Type to application/json,
 // and writes the payload with StatusOK. Any encoding or write error results in an internal server error response.
 //
-// @Security ApiKeyAuth
 // @Summary Get an education by ID
 // @Description Retrieves the details of a specific education using its unique ID.
 // @Tags education
@@ -342,6 +395,7 @@ func (h *educationServiceHandler) Get(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	w.Header().Set("ETag", etag(educationRes.UpdatedAt))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
@@ -364,6 +418,7 @@ func (h *educationServiceHandler) Get(w http.ResponseWriter, r *http.Request, id
 // Notes:
 // - This handler performs input validation before invoking the repository to avoid persisting invalid data.
 // - All error responses include concise diagnostic messages and appropriate HTTP status codes.
+// - If-Match must carry the ETag from GET /education/{id} (or "*"); a stale version yields 412.
 //
 // @Security ApiKeyAuth
 // @Summary Update an education
@@ -371,10 +426,13 @@ func (h *educationServiceHandler) Get(w http.ResponseWriter, r *http.Request, id
 // @Tags education
 // @Accept json
 // @Produce json
+// @Param If-Match header string true "ETag of the version being edited, or *"
 // @Param education body dto.UpdateEducationRequest true "Education payload with ID"
 // @Success 200 {object} dto.UpdateEducationResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /education [put]
 func (h *educationServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -383,6 +441,11 @@ func (h *educationServiceHandler) Update(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
+
 	defer r.Body.Close()
 
 	var updateReq dto.UpdateEducationRequest
@@ -420,6 +483,7 @@ func (h *educationServiceHandler) Update(w http.ResponseWriter, r *http.Request)
 		},
 		SchoolPeriods: schoolPeriods,
 		Level:         domain.EducationLevel(updateReq.Level),
+		UpdatedAt:     version,
 	}
 
 	if err := education.ValidatePayload(); err != nil {
@@ -429,6 +493,12 @@ func (h *educationServiceHandler) Update(w http.ResponseWriter, r *http.Request)
 
 	updatedEducationRes, err := h.educationRepo.Update(r.Context(), &education)
 	if err != nil {
+		var conflict *domain.ConflictError
+		if errors.As(err, &conflict) {
+			w.Header().Set("ETag", etag(conflict.Current))
+			http.Error(w, "Education was modified by another request: "+err.Error(), http.StatusPreconditionFailed)
+			return
+		}
 		http.Error(w, "Failed to update education: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -476,21 +546,25 @@ func (h *educationServiceHandler) Update(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	w.Header().Set("ETag", etag(updatedEducationRes.UpdatedAt))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
 }
 
-// Delete handles HTTP DELETE requests to remove an education resource identified by id.
+// Delete handles HTTP DELETE requests to move an education resource identified by id
+// to the trash.
 // It enforces the DELETE method (returns 405 Method Not Allowed for other methods).
-// The handler delegates deletion to the education repository using the request context.
-// If the repository reports no matching row, Delete responds with 404 Not Found.
+// The education is soft-deleted together with its file records in one transaction;
+// it stays hidden until restored through POST /education/{id}/restore or purged
+// through the trash. Linked projects are left untouched.
+// If the repository reports no live matching row, Delete responds with 404 Not Found.
 // For other repository errors it responds with 500 Internal Server Error and an error message.
-// On successful deletion it writes a 204 No Content response with no body.
+// On success it writes a 204 No Content response with no body.
 //
 // @Security ApiKeyAuth
 // @Summary Delete an education
-// @Description Deletes an existing education by its unique ID provided in the path.
+// @Description Moves an existing education, identified by the ID in the path, to the trash. Linked projects are kept.
 // @Tags education
 // @Param id path string true "Education ID"
 // @Success 204 "No Content"
@@ -504,14 +578,22 @@ func (h *educationServiceHandler) Delete(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	err := h.educationRepo.Delete(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			http.Error(w, "Education not found", http.StatusNotFound)
-			return
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.fileRepo.WithTx(tx).TrashByParent(r.Context(), string(domain.EducationTable), id); err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to delete education files: ", err: err}
+		}
+
+		if err := h.educationRepo.WithTx(tx).Delete(r.Context(), id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Education not found"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to delete education: ", err: err}
 		}
 
-		http.Error(w, "Failed to delete education: "+err.Error(), http.StatusInternalServerError)
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
 		return
 	}
 
@@ -519,21 +601,66 @@ func (h *educationServiceHandler) Delete(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Restore handles HTTP POST requests to take an education record out of the
+// trash. The education and the file records trashed with it are restored in a
+// single transaction. It responds with 204 No Content on success and 404 Not
+// Found when the education is not in the trash.
+//
+// @Security ApiKeyAuth
+// @Summary Restore an education
+// @Description Restores a trashed education, identified by the ID in the path, together with its files.
+// @Tags education
+// @Param id path string true "Education ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /education/{id}/restore [post]
+func (h *educationServiceHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.educationRepo.WithTx(tx).Restore(r.Context(), id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Education not found in trash"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to restore education: ", err: err}
+		}
+
+		if err := h.fileRepo.WithTx(tx).RestoreByParent(r.Context(), string(domain.EducationTable), id); err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to restore education files: ", err: err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // List handles HTTP GET requests to list education records.
 // It accepts query parameters:
 //   - "page" (int, default 1)
 //   - "page_size" (int, default 10)
 //   - "sort_by" (validated by utils.GetQuerySortBy)
 //   - "sort_ascending" (bool, default false)
+//   - "cursor" (string, optional next_cursor from a previous page; requires sort_by=created_at)
 //
 // If the request method is not GET the handler responds with 405 Method Not Allowed.
-// If "sort_by" is invalid the handler responds with 400 Bad Request.
+// If "sort_by" or "cursor" is invalid the handler responds with 400 Bad Request.
 // The handler constructs a EducationFilterRequest from the parsed parameters, calls
-// h.educationRepo.List with the request context, and returns the result as JSON with
-// Content-Type "application/json" and HTTP 200 on success. Repository or encoding errors
+// h.educationRepo.List with the request context, and returns an EducationListResponse
+// envelope as JSON with Content-Type "application/json" and HTTP 200 on success. Repository or encoding errors
 // result in a 500 Internal Server Error response.
+// Responses carry an ETag and Last-Modified covering educations and the projects embedded in them;
+// a request whose If-None-Match or If-Modified-Since is still current gets 304 Not Modified.
 //
-// @Security ApiKeyAuth
 // @Summary List educations
 // @Description Retrieves a paginated list of educations with optional filtering and sorting.
 // @Tags education
@@ -543,7 +670,11 @@ func (h *educationServiceHandler) Delete(w http.ResponseWriter, r *http.Request,
 // @Param page_size query int false "Number of items per page (default 10)"
 // @Param sort_by query string false "Field to sort by" Enums(created_at, updated_at)
 // @Param sort_ascending query bool false "Sort ascending order"
-// @Success 200 {array} dto.EducationDTO
+// @Param cursor query string false "Keyset cursor from a previous next_cursor; requires sort_by=created_at"
+// @Param If-None-Match header string false "ETag from a previous response; answered with 304 while it is current"
+// @Param If-Modified-Since header string false "Last-Modified from a previous response; ignored when If-None-Match is sent"
+// @Success 200 {object} dto.EducationListResponse
+// @Success 304 "Not Modified"
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /educations [get]
@@ -566,6 +697,7 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		PageSize:      utils.GetQueryInt32(q, "page_size", 10),
 		SortBy:        sortBy,
 		SortAscending: utils.GetQueryBool(q, "sort_ascending", false),
+		Cursor:        q.Get("cursor"),
 	}
 
 	// Clamp page to minimum of 1
@@ -581,6 +713,12 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		filter.PageSize = maxPageSize
 	}
 
+	cursor, err := parseCursor(filter.Cursor, filter.SortBy)
+	if err != nil {
+		http.Error(w, "invalid cursor: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	var sortByPtr *domain.SortBy
 	if filter.SortBy != "" {
 		sb := domain.SortBy(filter.SortBy)
@@ -591,9 +729,21 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		PageSize:      filter.PageSize,
 		SortBy:        sortByPtr,
 		SortAscending: filter.SortAscending,
+		Cursor:        cursor,
+	}
+
+	// Educations embed their projects and those projects' previews, so any of
+	// the three tables changing makes a new version of the list.
+	version, err := collectionVersion(r.Context(), h.educationRepo.Version, h.projectRepo.Version, fileVersion(h.fileRepo, "project"))
+	if err != nil {
+		http.Error(w, "Failed to check educations version: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if notModified(w, r, version) {
+		return
 	}
 
-	educationsRes, err := h.educationRepo.List(r.Context(), domainFilter)
+	educationsRes, pageInfo, err := h.educationRepo.List(r.Context(), domainFilter)
 	if err != nil {
 		http.Error(w, "Failed to list educations: "+err.Error(), http.StatusInternalServerError)
 		return
@@ -614,6 +764,23 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Batch fetch previews for all linked projects
+	var projectIDs []string
+	for _, e := range educationsRes {
+		for _, p := range projectsByEducation[e.Id] {
+			projectIDs = append(projectIDs, p.Id)
+		}
+	}
+
+	previewsByProject := make(map[string][]domain.File)
+	if len(projectIDs) > 0 {
+		previewsByProject, err = h.fileRepo.FindByParents(r.Context(), "project", projectIDs, domain.Image)
+		if err != nil {
+			log.Printf("Failed to batch list project previews: %v", err)
+			previewsByProject = make(map[string][]domain.File)
+		}
+	}
+
 	educations := make([]dto.EducationDTO, len(educationsRes))
 	for i, e := range educationsRes {
 
@@ -623,6 +790,23 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		// Convert to DTOs
 		projectDTOs := make([]dto.ProjectDTO, len(projects))
 		for i, p := range projects {
+			previews := previewsByProject[p.Id]
+			previewDTOs := make([]dto.FileDTO, len(previews))
+			for j, preview := range previews {
+				previewDTOs[j] = dto.FileDTO{
+					ID:          preview.ID,
+					ParentTable: string(preview.ParentTable),
+					ParentID:    preview.ParentID,
+					Role:        string(preview.Role),
+					Name:        preview.Name,
+					URL:         preview.URL,
+					Type:        preview.Type,
+					Size:        preview.Size,
+					CreatedAt:   preview.CreatedAt,
+					UpdatedAt:   preview.UpdatedAt,
+				}
+			}
+
 			projectDTOs[i] = dto.ProjectDTO{
 				ID:          p.Id,
 				BlurHash:    p.BlurHash,
@@ -632,6 +816,7 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 				Tags:        p.Tags,
 				Type:        string(p.Type),
 				Link:        p.Link,
+				Previews:    previewDTOs,
 				EducationID: p.EducationID,
 				CreatedAt:   p.CreatedAt,
 				UpdatedAt:   p.UpdatedAt,
@@ -673,8 +858,13 @@ func (h *educationServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	resp := dto.EducationListResponse{
+		Items:       educations,
+		PageMetaDTO: toPageMetaDTO(pageInfo),
+	}
+
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(educations); err != nil {
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
 		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -10,6 +12,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	mockDatabase "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
 	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
@@ -20,26 +24,54 @@ import (
 
 type educationHandlerTestFixture struct {
 	t                 *testing.T
+	mockDatabaseAPI   *mockDatabase.MockDatabaseAPI
+	mockTx            *mockDatabase.MockTx
 	mockEducationRepo *mockRepo.MockEducationRepository
 	mockProjectRepo   *mockRepo.MockProjectRepository
+	mockFileRepo      *mockRepo.MockFileRepository
 	educationHandler  EducationHandler
 }
 
 func newEducationHandlerTestFixture(t *testing.T) *educationHandlerTestFixture {
+	mockDatabaseAPI := new(mockDatabase.MockDatabaseAPI)
+	mockTx := new(mockDatabase.MockTx)
 	mockEducationRepo := new(mockRepo.MockEducationRepository)
 	mockProjectRepo := new(mockRepo.MockProjectRepository)
+	mockFileRepo := new(mockRepo.MockFileRepository)
+
+	// Run transactional callbacks directly against the repository mocks
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+	mockEducationRepo.EXPECT().WithTx(mockTx).Return(mockEducationRepo).Maybe()
+	mockProjectRepo.EXPECT().WithTx(mockTx).Return(mockProjectRepo).Maybe()
+	mockFileRepo.EXPECT().WithTx(mockTx).Return(mockFileRepo).Maybe()
+
+	// An empty collection version carries no validators, so lists are served
+	// unconditionally unless a test builds its own repositories.
+	mockEducationRepo.EXPECT().Version(mock.Anything).Return(domain.CollectionVersion{}, nil).Maybe()
+	mockProjectRepo.EXPECT().Version(mock.Anything).Return(domain.CollectionVersion{}, nil).Maybe()
+	mockFileRepo.EXPECT().Version(mock.Anything, "project").Return(domain.CollectionVersion{}, nil).Maybe()
 
 	educationHandler := NewEducationServiceHandler(
 		EducationServiceConfig{
+			DatabaseAPI:   mockDatabaseAPI,
 			educationRepo: mockEducationRepo,
 			ProjectRepo:   mockProjectRepo,
+			fileRepo:      mockFileRepo,
 		},
 	)
 
 	return &educationHandlerTestFixture{
 		t:                 t,
+		mockDatabaseAPI:   mockDatabaseAPI,
+		mockTx:            mockTx,
 		mockEducationRepo: mockEducationRepo,
 		mockProjectRepo:   mockProjectRepo,
+		mockFileRepo:      mockFileRepo,
 		educationHandler:  educationHandler,
 	}
 }
@@ -708,14 +740,19 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 	validBody, _ := json.Marshal(requestDTO)
 	validResp, _ := json.Marshal(responseDTO)
 
+	version := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ifMatch := etag(version)
+
 	type Given struct {
 		method   string
+		ifMatch  string
 		body     string
 		mockRepo func(m *mockRepo.MockEducationRepository)
 	}
 	type Expected struct {
 		code int
 		body string
+		etag string
 	}
 
 	tests := map[string]struct {
@@ -724,12 +761,32 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 	}{
 		"success": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().
+						Update(mock.Anything, mock.MatchedBy(func(edu *domain.Education) bool {
+							return edu.Id == fixedID && edu.MainSchool.Name == "Harvard University" && edu.UpdatedAt.Equal(version)
+						})).
+						Return(existingEducation, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: string(validResp),
+				etag: etag(existingEducation.UpdatedAt),
+			},
+		},
+		"wildcard If-Match skips the version check": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: "*",
+				body:    string(validBody),
 				mockRepo: func(m *mockRepo.MockEducationRepository) {
 					m.EXPECT().
 						Update(mock.Anything, mock.MatchedBy(func(edu *domain.Education) bool {
-							return edu.Id == fixedID && edu.MainSchool.Name == "Harvard University"
+							return edu.UpdatedAt.IsZero()
 						})).
 						Return(existingEducation, nil)
 				},
@@ -737,6 +794,57 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 			expected: Expected{
 				code: http.StatusOK,
 				body: string(validResp),
+				etag: etag(existingEducation.UpdatedAt),
+			},
+		},
+		"missing If-Match": {
+			given: Given{
+				method: http.MethodPut,
+				body:   string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusPreconditionRequired,
+				body: "If-Match header is required\n",
+			},
+		},
+		"weak If-Match": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: "W/" + ifMatch,
+				body:    string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusPreconditionFailed,
+				body: "If-Match must name a single strong ETag\n",
+			},
+		},
+		"malformed If-Match": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: "yesterday",
+				body:    string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid If-Match header\n",
+			},
+		},
+		"stale version": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().
+						Update(mock.Anything, mock.AnythingOfType("*domain.Education")).
+						Return(nil, &domain.ConflictError{Entity: "education", ID: fixedID, Current: existingEducation.UpdatedAt})
+				},
+			},
+			expected: Expected{
+				code: http.StatusPreconditionFailed,
+				body: "Education was modified by another request: education " + fixedID + " was modified at " +
+					existingEducation.UpdatedAt.UTC().Format(time.RFC3339Nano) + "\n",
+				etag: etag(existingEducation.UpdatedAt),
 			},
 		},
 		"method not allowed": {
@@ -753,6 +861,7 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 		"invalid JSON": {
 			given: Given{
 				method:   http.MethodPut,
+				ifMatch:  ifMatch,
 				body:     `{"invalid":}`,
 				mockRepo: nil,
 			},
@@ -763,7 +872,8 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 		},
 		"invalid payload (validation failure)": {
 			given: Given{
-				method: http.MethodPut,
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
 				body: func() string {
 					bad := requestDTO
 					bad.MainSchool = dto.SchoolPeriodDTO{}
@@ -779,8 +889,9 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 		},
 		"repository error": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockRepo: func(m *mockRepo.MockEducationRepository) {
 					m.EXPECT().
 						Update(mock.Anything, mock.AnythingOfType("*domain.Education")).
@@ -794,8 +905,9 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 		},
 		"education not found": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockRepo: func(m *mockRepo.MockEducationRepository) {
 					m.EXPECT().
 						Update(mock.Anything, mock.AnythingOfType("*domain.Education")).
@@ -809,7 +921,8 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 		},
 		"large payload": {
 			given: Given{
-				method: http.MethodPut,
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
 				body: func() string {
 					large := requestDTO
 					large.MainSchool.Description = strings.Repeat("A", 10_000)
@@ -829,7 +942,8 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 		},
 		"unicode fields": {
 			given: Given{
-				method: http.MethodPut,
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
 				body: func() string {
 					unicode := requestDTO
 					unicode.MainSchool.Name = "東京大学 🏫"
@@ -861,6 +975,9 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/education", strings.NewReader(tt.given.body))
+			if tt.given.ifMatch != "" {
+				req.Header.Set("If-Match", tt.given.ifMatch)
+			}
 			w := httptest.NewRecorder()
 
 			f.educationHandler.Update(w, req)
@@ -870,6 +987,9 @@ func TestEducationServiceHandler_Update(t *testing.T) {
 
 			body, _ := io.ReadAll(res.Body)
 			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.etag != "" {
+				assert.Equal(t, tt.expected.etag, res.Header.Get("ETag"))
+			}
 
 			if strings.HasPrefix(tt.expected.body, "{") {
 				assert.JSONEq(t, tt.expected.body, string(body))
@@ -983,6 +1103,7 @@ func TestEducationServiceHandler_Update_Routing(t *testing.T) {
 
 	// Create request and recorder
 	req := httptest.NewRequest(http.MethodPut, "/education", strings.NewReader(string(validBody)))
+	req.Header.Set("If-Match", etag(existingEducation.UpdatedAt))
 	w := httptest.NewRecorder()
 
 	// Cast handler to http.Handler and call ServeHTTP
@@ -1005,9 +1126,11 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 	fixedID := "edu-123"
 
 	type Given struct {
-		method   string
-		id       string
-		mockRepo func(m *mockRepo.MockEducationRepository)
+		method       string
+		id           string
+		mockRepo     func(m *mockRepo.MockEducationRepository)
+		mockFileRepo func(m *mockRepo.MockFileRepository)
+		txErr        error
 	}
 	type Expected struct {
 		code int
@@ -1018,7 +1141,7 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 		given    Given
 		expected Expected
 	}{
-		"success": {
+		"success trashes education and its files but keeps linked projects": {
 			given: Given{
 				method: http.MethodDelete,
 				id:     fixedID,
@@ -1027,6 +1150,9 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 						Delete(mock.Anything, fixedID).
 						Return(nil)
 				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, string(domain.EducationTable), fixedID).Return(nil)
+				},
 			},
 			expected: Expected{
 				code: http.StatusNoContent,
@@ -1055,6 +1181,9 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 						Delete(mock.Anything, "missing-id").
 						Return(pgx.ErrNoRows)
 				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, string(domain.EducationTable), "missing-id").Return(nil)
+				},
 			},
 			expected: Expected{
 				code: http.StatusNotFound,
@@ -1070,25 +1199,39 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 						Delete(mock.Anything, fixedID).
 						Return(errors.New("database failure"))
 				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, string(domain.EducationTable), fixedID).Return(nil)
+				},
 			},
 			expected: Expected{
 				code: http.StatusInternalServerError,
 				body: "Failed to delete education: database failure\n",
 			},
 		},
-		"empty id": {
+		"education files trash error aborts before education delete": {
 			given: Given{
 				method: http.MethodDelete,
-				id:     "",
-				mockRepo: func(m *mockRepo.MockEducationRepository) {
+				id:     fixedID,
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
 					m.EXPECT().
-						Delete(mock.Anything, "").
-						Return(pgx.ErrNoRows)
+						TrashByParent(mock.Anything, string(domain.EducationTable), fixedID).
+						Return(errors.New("update failed"))
 				},
 			},
 			expected: Expected{
-				code: http.StatusNotFound,
-				body: "Education not found\n",
+				code: http.StatusInternalServerError,
+				body: "Failed to delete education files: update failed\n",
+			},
+		},
+		"transaction error": {
+			given: Given{
+				method: http.MethodDelete,
+				id:     fixedID,
+				txErr:  errors.New("failed to begin transaction: connection refused"),
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Transaction failed: failed to begin transaction: connection refused\n",
 			},
 		},
 	}
@@ -1097,9 +1240,20 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			f := newEducationHandlerTestFixture(t)
 
+			if tt.given.txErr != nil {
+				// Replace the pass-through transaction with one that fails to begin
+				f.mockDatabaseAPI.ExpectedCalls = nil
+				f.mockDatabaseAPI.EXPECT().
+					WithTx(mock.Anything, mock.Anything).
+					Return(tt.given.txErr)
+			}
+
 			if tt.given.mockRepo != nil {
 				tt.given.mockRepo(f.mockEducationRepo)
 			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
 
 			req := httptest.NewRequest(tt.given.method, "/education/"+tt.given.id, nil)
 			w := httptest.NewRecorder()
@@ -1114,6 +1268,8 @@ func TestEducationServiceHandler_Delete(t *testing.T) {
 			assert.Equal(t, tt.expected.body, string(body))
 
 			f.mockEducationRepo.AssertExpectations(t)
+			f.mockProjectRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -1124,6 +1280,9 @@ func TestEducationServiceHandler_Delete_Routing(t *testing.T) {
 	f := newEducationHandlerTestFixture(t)
 
 	// Setup mock expectation
+	f.mockFileRepo.EXPECT().
+		TrashByParent(mock.Anything, string(domain.EducationTable), fixedID).
+		Return(nil)
 	f.mockEducationRepo.EXPECT().
 		Delete(mock.Anything, fixedID).
 		Return(nil)
@@ -1148,6 +1307,135 @@ func TestEducationServiceHandler_Delete_Routing(t *testing.T) {
 	f.mockEducationRepo.AssertExpectations(t)
 }
 
+func TestEducationServiceHandler_Restore(t *testing.T) {
+	fixedID := "edu-123"
+
+	type Given struct {
+		method       string
+		path         string
+		mockRepo     func(m *mockRepo.MockEducationRepository)
+		mockFileRepo func(m *mockRepo.MockFileRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success restores education and its files": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/education/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().RestoreByParent(mock.Anything, string(domain.EducationTable), fixedID).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+				body: "",
+			},
+		},
+		"not in trash": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/education/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Education not found in trash\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/education/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(errors.New("database failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore education: database failure\n",
+			},
+		},
+		"files restore error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/education/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						RestoreByParent(mock.Anything, string(domain.EducationTable), fixedID).
+						Return(errors.New("update failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore education files: update failed\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/education/" + fixedID + "/restore",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"missing id": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/education//restore",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Education ID is required\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newEducationHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockEducationRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.educationHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockEducationRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEducationServiceHandler_List(t *testing.T) {
 	sampleEducation := domain.Education{
 		Id: "edu-123",
@@ -1204,13 +1492,84 @@ func TestEducationServiceHandler_List(t *testing.T) {
 		}
 	}
 
-	validJSON, _ := json.Marshal(educations)
+	listPageInfo := domain.PageInfo{Total: 1, Page: 1, PageSize: 10}
+	validJSON, _ := json.Marshal(dto.EducationListResponse{
+		Items:       educations,
+		PageMetaDTO: dto.PageMetaDTO{Total: 1, Page: 1, PageSize: 10},
+	})
+
+	nextCursor := domain.Cursor{CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ID: "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b"}
+	cursorJSON, _ := json.Marshal(dto.EducationListResponse{
+		Items:       educations,
+		PageMetaDTO: dto.PageMetaDTO{Total: 3, Page: 1, PageSize: 1, HasNext: true, NextCursor: nextCursor.Encode()},
+	})
+
+	linkedProject := domain.Project{
+		Id:          "p1",
+		Title:       "title1",
+		Type:        domain.Web,
+		EducationID: "edu-123",
+		CreatedAt:   sampleEducation.CreatedAt,
+		UpdatedAt:   sampleEducation.UpdatedAt,
+	}
+	preview := domain.File{
+		ID:          "f1",
+		ParentTable: "project",
+		ParentID:    "p1",
+		Role:        domain.Image,
+		Name:        "preview.png",
+		URL:         "http://example.com/preview.png",
+		Type:        "image/png",
+		Size:        1024,
+		CreatedAt:   sampleEducation.CreatedAt,
+		UpdatedAt:   sampleEducation.UpdatedAt,
+	}
+	linkedProjectDTO := dto.ProjectDTO{
+		ID:          linkedProject.Id,
+		Title:       linkedProject.Title,
+		Type:        string(linkedProject.Type),
+		EducationID: linkedProject.EducationID,
+		CreatedAt:   linkedProject.CreatedAt,
+		UpdatedAt:   linkedProject.UpdatedAt,
+	}
+
+	withPreviews := educations[0]
+	withPreviewsProject := linkedProjectDTO
+	withPreviewsProject.Previews = []dto.FileDTO{
+		{
+			ID:          preview.ID,
+			ParentTable: string(preview.ParentTable),
+			ParentID:    preview.ParentID,
+			Role:        string(preview.Role),
+			Name:        preview.Name,
+			URL:         preview.URL,
+			Type:        preview.Type,
+			Size:        preview.Size,
+			CreatedAt:   preview.CreatedAt,
+			UpdatedAt:   preview.UpdatedAt,
+		},
+	}
+	withPreviews.Projects = []dto.ProjectDTO{withPreviewsProject}
+	withPreviewsJSON, _ := json.Marshal(dto.EducationListResponse{
+		Items:       []dto.EducationDTO{withPreviews},
+		PageMetaDTO: dto.PageMetaDTO{Total: 1, Page: 1, PageSize: 10},
+	})
+
+	withoutPreviews := educations[0]
+	withoutPreviewsProject := linkedProjectDTO
+	withoutPreviewsProject.Previews = []dto.FileDTO{}
+	withoutPreviews.Projects = []dto.ProjectDTO{withoutPreviewsProject}
+	withoutPreviewsJSON, _ := json.Marshal(dto.EducationListResponse{
+		Items:       []dto.EducationDTO{withoutPreviews},
+		PageMetaDTO: dto.PageMetaDTO{Total: 1, Page: 1, PageSize: 10},
+	})
 
 	type Given struct {
 		method       string
 		query        string
 		mockEducRepo func(m *mockRepo.MockEducationRepository)
 		mockProjRepo func(m *mockRepo.MockProjectRepository)
+		mockFileRepo func(m *mockRepo.MockFileRepository)
 	}
 	type Expected struct {
 		code int
@@ -1234,7 +1593,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1249,6 +1608,50 @@ func TestEducationServiceHandler_List(t *testing.T) {
 				body: string(validJSON),
 			},
 		},
+		"success with cursor": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?page_size=1&cursor=" + nextCursor.Encode(),
+				mockEducRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(f domain.EducationFilter) bool {
+							return f.Cursor != nil && f.Cursor.ID == nextCursor.ID && f.Cursor.CreatedAt.Equal(nextCursor.CreatedAt)
+						})).
+						Return(listResp, domain.PageInfo{Total: 3, Page: 1, PageSize: 1, HasNext: true, NextCursor: nextCursor.Encode()}, nil)
+				},
+				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						ListByEducationIDs(mock.Anything, []string{"edu-123"}).
+						Return(map[string][]domain.Project{
+							"edu-123": {},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: string(cursorJSON),
+			},
+		},
+		"invalid cursor": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?cursor=not-base64!",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid cursor: cursor encoding invalid\n",
+			},
+		},
+		"cursor with non created_at sort": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?sort_by=updated_at&cursor=" + nextCursor.Encode(),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid cursor: cursor requires sort_by=created_at\n",
+			},
+		},
 		"method not allowed": {
 			given: Given{
 				method:       http.MethodPost,
@@ -1287,7 +1690,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(nil, errors.New("database failure"))
+						Return(nil, domain.PageInfo{}, errors.New("database failure"))
 				},
 				mockProjRepo: nil,
 			},
@@ -1310,7 +1713,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1339,13 +1742,13 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return([]domain.Education{}, nil)
+						Return([]domain.Education{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
 				},
 				mockProjRepo: nil,
 			},
 			expected: Expected{
 				code: http.StatusOK,
-				body: "[]",
+				body: `{"items":[],"total":0,"page":1,"page_size":10,"has_next":false}`,
 			},
 		},
 		"page zero defaults to one": {
@@ -1361,7 +1764,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1389,7 +1792,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1417,7 +1820,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1445,7 +1848,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1473,7 +1876,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 					}
 					m.EXPECT().
 						List(mock.Anything, expectedFilter).
-						Return(listResp, nil)
+						Return(listResp, listPageInfo, nil)
 				},
 				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
@@ -1488,6 +1891,58 @@ func TestEducationServiceHandler_List(t *testing.T) {
 				body: string(validJSON),
 			},
 		},
+		"success with project previews": {
+			given: Given{
+				method: http.MethodGet,
+				mockEducRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.EducationFilter")).
+						Return(listResp, listPageInfo, nil)
+				},
+				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						ListByEducationIDs(mock.Anything, []string{"edu-123"}).
+						Return(map[string][]domain.Project{
+							"edu-123": {linkedProject},
+						}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1"}, domain.Image).
+						Return(map[string][]domain.File{"p1": {preview}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: string(withPreviewsJSON),
+			},
+		},
+		"preview lookup failure still lists projects": {
+			given: Given{
+				method: http.MethodGet,
+				mockEducRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.EducationFilter")).
+						Return(listResp, listPageInfo, nil)
+				},
+				mockProjRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						ListByEducationIDs(mock.Anything, []string{"edu-123"}).
+						Return(map[string][]domain.Project{
+							"edu-123": {linkedProject},
+						}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1"}, domain.Image).
+						Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: string(withoutPreviewsJSON),
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -1499,6 +1954,9 @@ func TestEducationServiceHandler_List(t *testing.T) {
 			if tt.given.mockProjRepo != nil {
 				tt.given.mockProjRepo(f.mockProjectRepo)
 			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
 
 			req := httptest.NewRequest(tt.given.method, "/educations"+tt.given.query, nil)
 			w := httptest.NewRecorder()
@@ -1511,7 +1969,7 @@ func TestEducationServiceHandler_List(t *testing.T) {
 			body, _ := io.ReadAll(res.Body)
 			assert.Equal(t, tt.expected.code, res.StatusCode)
 
-			if strings.HasPrefix(tt.expected.body, "[") {
+			if strings.HasPrefix(tt.expected.body, "{") {
 				assert.JSONEq(t, tt.expected.body, string(body))
 			} else {
 				assert.Equal(t, tt.expected.body, string(body))
@@ -1519,6 +1977,97 @@ func TestEducationServiceHandler_List(t *testing.T) {
 
 			f.mockEducationRepo.AssertExpectations(t)
 			f.mockProjectRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEducationServiceHandler_List_Conditional(t *testing.T) {
+	educationsModified := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	projectsModified := educationsModified.Add(30 * time.Minute)
+	currentTag := `"` + fmt.Sprint(projectsModified.UnixMicro()) + `-7"`
+
+	type Given struct {
+		headers  map[string]string
+		mockRepo func(m *mockRepo.MockEducationRepository)
+	}
+
+	type Expected struct {
+		code int
+		etag string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"current If-None-Match is not modified": {
+			given: Given{
+				headers: map[string]string{"If-None-Match": currentTag},
+			},
+			expected: Expected{
+				code: http.StatusNotModified,
+				etag: currentTag,
+			},
+		},
+		"edited project rebuilds the list": {
+			given: Given{
+				headers: map[string]string{"If-Modified-Since": "Wed, 01 Jan 2025 12:00:00 GMT"},
+				mockRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.EducationFilter")).
+						Return([]domain.Education{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				etag: currentTag,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockEducationRepo := new(mockRepo.MockEducationRepository)
+			mockProjectRepo := new(mockRepo.MockProjectRepository)
+			mockFileRepo := new(mockRepo.MockFileRepository)
+
+			mockEducationRepo.EXPECT().
+				Version(mock.Anything).
+				Return(domain.CollectionVersion{LastModified: educationsModified, Count: 2}, nil)
+			mockProjectRepo.EXPECT().
+				Version(mock.Anything).
+				Return(domain.CollectionVersion{LastModified: projectsModified, Count: 5}, nil)
+			mockFileRepo.EXPECT().
+				Version(mock.Anything, "project").
+				Return(domain.CollectionVersion{}, nil)
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(mockEducationRepo)
+			}
+
+			handler := NewEducationServiceHandler(
+				EducationServiceConfig{
+					educationRepo: mockEducationRepo,
+					ProjectRepo:   mockProjectRepo,
+					fileRepo:      mockFileRepo,
+				},
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/educations", nil)
+			for k, v := range tt.given.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			handler.List(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			assert.Equal(t, tt.expected.etag, w.Header().Get("ETag"))
+			assert.Equal(t, "Wed, 01 Jan 2025 12:30:00 GMT", w.Header().Get("Last-Modified"))
+
+			mockEducationRepo.AssertExpectations(t)
+			mockProjectRepo.AssertExpectations(t)
+			mockFileRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -1579,7 +2128,10 @@ func TestEducationServiceHandler_List_Routing(t *testing.T) {
 		}
 	}
 
-	validJSON, _ := json.Marshal(educations)
+	validJSON, _ := json.Marshal(dto.EducationListResponse{
+		Items:       educations,
+		PageMetaDTO: dto.PageMetaDTO{Total: 1, Page: 1, PageSize: 10},
+	})
 
 	f := newEducationHandlerTestFixture(t)
 
@@ -1592,7 +2144,7 @@ func TestEducationServiceHandler_List_Routing(t *testing.T) {
 	}
 	f.mockEducationRepo.EXPECT().
 		List(mock.Anything, expectedFilter).
-		Return(listResp, nil)
+		Return(listResp, domain.PageInfo{Total: 1, Page: 1, PageSize: 10}, nil)
 
 		// Setup mock expectation for project repository (batch)
 	f.mockProjectRepo.EXPECT().
@@ -1621,3 +2173,52 @@ func TestEducationServiceHandler_List_Routing(t *testing.T) {
 	f.mockEducationRepo.AssertExpectations(t)
 	f.mockProjectRepo.AssertExpectations(t)
 }
+
+// countEducationListQueries serves one GET /educations for a page of size rows
+// and returns how many queries it issued.
+func countEducationListQueries(tb testing.TB, handler EducationHandler, db *queryCountDatabase, size int) int {
+	tb.Helper()
+
+	before := db.queries
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/educations?page_size=%d", size), nil)
+	w := httptest.NewRecorder()
+	handler.List(w, req)
+
+	if w.Code != http.StatusOK {
+		tb.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	return db.queries - before
+}
+
+func TestEducationServiceHandler_List_QueryCount(t *testing.T) {
+	// The education, project and file versions, COUNT(*), the page itself,
+	// one batched project lookup and one batched preview lookup
+	const expectedQueries = 7
+
+	for _, size := range listQueryCounts {
+		t.Run(fmt.Sprintf("educations=%d", size), func(t *testing.T) {
+			db := &queryCountDatabase{rows: size}
+			handler := NewEducationServiceHandler(EducationServiceConfig{DatabaseAPI: db})
+
+			assert.Equal(t, expectedQueries, countEducationListQueries(t, handler, db, size))
+		})
+	}
+}
+
+func BenchmarkEducationServiceHandler_List(b *testing.B) {
+	for _, size := range listQueryCounts {
+		b.Run(fmt.Sprintf("educations=%d", size), func(b *testing.B) {
+			db := &queryCountDatabase{rows: size}
+			handler := NewEducationServiceHandler(EducationServiceConfig{DatabaseAPI: db})
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				countEducationListQueries(b, handler, db, size)
+			}
+
+			b.ReportMetric(float64(db.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
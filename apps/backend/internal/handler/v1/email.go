@@ -3,66 +3,147 @@ package v1
 import (
 	"bytes"
 	"encoding/json"
+	"log"
+	"net"
 	"net/http"
 	"strings"
+	"time"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/templates"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/middleware"
 )
 
 type EmailHandler interface {
 	http.Handler
 	Send(w http.ResponseWriter, r *http.Request)
+	PreviewTemplate(w http.ResponseWriter, r *http.Request, name domain.EmailTemplate)
 }
 
 type EmailServiceConfig struct {
-	ServiceID      string
-	TemplateID     string
-	UserID         string
-	AccessToken    string
-	TemplateParams map[string]string
+	DatabaseAPI database.DatabaseAPI
+	// CaptchaProvider and CaptchaSecret enable captcha verification of the
+	// contact form; it is skipped when CaptchaSecret is empty.
+	CaptchaProvider string
+	CaptchaSecret   string
+	// SpamRules score every message; those reaching SpamThreshold
+	// (domain.DefaultSpamThreshold when zero) are dropped.
+	SpamRules     domain.SpamRules
+	SpamThreshold int
+	// AutoReply queues a confirmation to the sender along with the owner
+	// notification of every message.
+	AutoReply bool
+	// EmailTemplates renders the template previews; the embedded templates
+	// are used when nil.
+	EmailTemplates templates.EmailRenderer
 
-	emailRepo v1.EmailRepository
+	outboxRepo      v1.OutboxRepository
+	captchaVerifier client.CaptchaVerifier
 }
 
 type emailServiceHandler struct {
-	emailRepo v1.EmailRepository
+	outboxRepo      v1.OutboxRepository
+	captchaVerifier client.CaptchaVerifier
+	emailTemplates  templates.EmailRenderer
+	queued          []domain.EmailTemplate
+	spamRules       domain.SpamRules
+	spamThreshold   int
+}
+
+// previewEmailTemplateData is the sample message email templates are
+// previewed with.
+var previewEmailTemplateData = domain.EmailTemplateData{
+	Name:    "Jane Doe",
+	Email:   "jane.doe@example.com",
+	Subject: "Let's work together",
+	Message: "Hi!\n\nI came across your portfolio and would love to talk about a project.\n\nBest,\nJane",
+	Date:    time.Date(2026, time.January, 2, 15, 4, 5, 0, time.UTC),
 }
 
 // NewEmailServiceHandler creates and returns a new instance of EmailService.
-// It initializes the email repository using the provided EmailServiceConfig.
-// If an email repository is not provided in the config, it creates a new one
-// using the configuration parameters. The returned EmailService can be used
-// to interact with email-related functionality.
+// If an outbox repository is not provided in the config, it creates one on
+// cfg.DatabaseAPI; the emails queued there are sent by the outbox worker.
+// The returned EmailService can be used to interact with email-related
+// functionality. A captcha verifier is created when a captcha secret is
+// configured; it panics if the provider is unknown or the embedded email
+// templates do not parse.
 func NewEmailServiceHandler(cfg EmailServiceConfig) EmailHandler {
-	emailRepo := cfg.emailRepo
-	if emailRepo == nil {
-		emailRepo = v1.NewEmailRepository(
-			v1.EmailRepositoryConfig{
-				ServiceID:      cfg.ServiceID,
-				TemplateID:     cfg.TemplateID,
-				UserID:         cfg.UserID,
-				AccessToken:    cfg.AccessToken,
-				TemplateParams: cfg.TemplateParams,
+	outboxRepo := cfg.outboxRepo
+	if outboxRepo == nil {
+		outboxRepo = v1.NewOutboxRepository(
+			v1.OutboxRepositoryConfig{
+				DatabaseAPI:  cfg.DatabaseAPI,
+				OutboxTable:  "email_outbox",
+				MessageTable: "message",
+				MessageRepo:  newMessageRepository(cfg.DatabaseAPI),
 			},
 		)
 	}
 
+	captchaVerifier := cfg.captchaVerifier
+	if captchaVerifier == nil && cfg.CaptchaSecret != "" {
+		verifier, err := client.NewCaptchaVerifier(
+			client.CaptchaConfig{
+				Provider: cfg.CaptchaProvider,
+				Secret:   cfg.CaptchaSecret,
+			},
+		)
+		if err != nil {
+			panic(err)
+		}
+		captchaVerifier = verifier
+	}
+
+	emailTemplates := cfg.EmailTemplates
+	if emailTemplates == nil {
+		renderer, err := templates.NewEmailRenderer(templates.EmailRendererConfig{})
+		if err != nil {
+			panic(err)
+		}
+		emailTemplates = renderer
+	}
+
+	queued := []domain.EmailTemplate{domain.EmailOwnerNotification}
+	if cfg.AutoReply {
+		queued = append(queued, domain.EmailAutoReply)
+	}
+
+	spamThreshold := cfg.SpamThreshold
+	if spamThreshold <= 0 {
+		spamThreshold = domain.DefaultSpamThreshold
+	}
+
 	return &emailServiceHandler{
-		emailRepo: emailRepo,
+		outboxRepo:      outboxRepo,
+		captchaVerifier: captchaVerifier,
+		emailTemplates:  emailTemplates,
+		queued:          queued,
+		spamRules:       cfg.SpamRules,
+		spamThreshold:   spamThreshold,
 	}
 }
 
 // ServeHTTP handles HTTP requests routed to the email service handler.
 // It inspects the request path after trimming the "/email" prefix and dispatches
 // the request to the appropriate handler method. If the path is "/send", it calls
-// the Send method to process the request; otherwise, it responds with a 404 Not Found.
+// the Send method to process the request; "/templates/{name}/preview" is served by
+// PreviewTemplate. Otherwise, it responds with a 404 Not Found.
 func (h *emailServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	path := strings.TrimPrefix(r.URL.Path, "/email")
 
-	switch path {
-	case "/send":
+	switch {
+	case path == "/send":
 		h.Send(w, r)
+	case strings.HasPrefix(path, "/templates/") && strings.HasSuffix(path, "/preview"):
+		name := strings.TrimSuffix(strings.TrimPrefix(path, "/templates/"), "/preview")
+		if name == "" || strings.Contains(name, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		h.PreviewTemplate(w, r, domain.EmailTemplate(name))
 	default:
 		http.NotFound(w, r)
 	}
@@ -71,18 +152,27 @@ func (h *emailServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 // Send handles HTTP POST requests to send an email.
 // It expects a JSON payload in the request body containing the sender's name, email, and message.
 // If the request method is not POST, it responds with "Method not allowed".
-// On successful email sending, it responds with a JSON object {"status": "ok"} and HTTP 200 status.
-// If there is an error decoding the request or sending the email, it responds with an appropriate HTTP error.
+// The message is stored and its emails queued in the outbox: the owner
+// notification, and the auto reply to the sender when enabled. The handler
+// responds with HTTP 202 right away; the outbox worker sends them in the
+// background, retrying failed sends, and records the outcome of the owner
+// notification on the message.
+// If there is an error decoding or validating the request, or queueing the email, it responds with an appropriate HTTP error.
+//
+// Spam is filtered before anything is queued. When a captcha verifier is
+// configured, a missing or rejected captcha token is a 400. Messages that fill
+// the honeypot field or reach the spam score threshold are logged and dropped,
+// but answered as if they were accepted so bots learn nothing.
 //
-// @Security ApiKeyAuth
 // @Summary Send an email
-// @Description Sends an email with the provided details and returns a confirmation message.
+// @Description Queues an email with the provided details for delivery and returns a confirmation message.
 // @Tags email
 // @Accept json
 // @Produce json
 // @Param email body domain.SendEmail true "Email payload"
 // @Success 202 {object} map[string]string "Confirmation message"
 // @Failure 400 {object} domain.ErrorResponse
+// @Failure 429 {object} domain.ErrorResponse
 // @Failure 500 {object} domain.ErrorResponse
 // @Router /email/send [post]
 func (h *emailServiceHandler) Send(w http.ResponseWriter, r *http.Request) {
@@ -99,13 +189,105 @@ func (h *emailServiceHandler) Send(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := h.emailRepo.Send(req); err != nil {
-		http.Error(w, "Failed to send email: "+err.Error(), http.StatusInternalServerError)
+	if err := req.Validate(); err != nil {
+		http.Error(w, "Invalid email: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if req.IsHoneypotFilled() {
+		log.Printf("Dropping contact form message from %s: honeypot filled", req.Email)
+		writeEmailSent(w, req)
+		return
+	}
+
+	if h.captchaVerifier != nil {
+		ok, err := h.captchaVerifier.Verify(r.Context(), req.CaptchaToken, clientIP(r))
+		if err != nil {
+			http.Error(w, "Failed to verify captcha: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !ok {
+			http.Error(w, "Invalid captcha: verification failed", http.StatusBadRequest)
+			return
+		}
+	}
+
+	spam := h.spamRules.Score(req)
+	if spam.Score >= h.spamThreshold {
+		log.Printf("Dropping contact form message from %s: spam score = %d (%s)", req.Email, spam.Score, strings.Join(spam.Reasons, ", "))
+		writeEmailSent(w, req)
+		return
+	}
+	log.Printf("Contact form message from %s: spam score = %d", req.Email, spam.Score)
+
+	message := domain.NewMessage(req)
+	if err := h.outboxRepo.Enqueue(r.Context(), &message, h.queued...); err != nil {
+		http.Error(w, "Failed to queue email: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeEmailSent(w, req)
+}
+
+// PreviewTemplate handles HTTP GET requests to preview an email template.
+// It renders the template name with a sample message and responds with its
+// HTML body, or with its subject and text body when the "format" query
+// parameter is "text". Overridden templates are read again for every preview.
+// If the request method is not GET, it responds with "Method not allowed".
+// An unknown template is a 404 and an unknown format a 400.
+//
+// @Summary Preview an email template
+// @Description Renders an email template with a sample contact form message.
+// @Tags email
+// @Produce html
+// @Produce plain
+// @Param name path string true "Template name" Enums(owner_notification, auto_reply)
+// @Param format query string false "Body to preview" Enums(html, text) default(html)
+// @Security ApiKeyAuth
+// @Success 200 {string} string "Rendered email"
+// @Failure 400 {object} domain.ErrorResponse
+// @Failure 404 {object} domain.ErrorResponse
+// @Failure 500 {object} domain.ErrorResponse
+// @Router /email/templates/{name}/preview [get]
+func (h *emailServiceHandler) PreviewTemplate(w http.ResponseWriter, r *http.Request, name domain.EmailTemplate) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !name.IsValid() {
+		http.Error(w, "Template not found: "+string(name), http.StatusNotFound)
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "html" && format != "text" {
+		http.Error(w, "Invalid format: must be html or text", http.StatusBadRequest)
 		return
 	}
 
+	email, err := h.emailTemplates.Render(name, previewEmailTemplateData)
+	if err != nil {
+		http.Error(w, "Failed to render template: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if format == "text" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("Subject: " + email.Subject + "\n\n" + email.Text))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(email.HTML))
+}
+
+// writeEmailSent writes the 202 confirming req was accepted for delivery.
+func writeEmailSent(w http.ResponseWriter, req domain.SendEmail) {
 	resp := map[string]string{
-		"message": "Email sent successfully",
+		"message": "Email queued for delivery",
 		"email":   req.Email,
 	}
 
@@ -119,3 +301,17 @@ func (h *emailServiceHandler) Send(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusAccepted)
 	w.Write(buf.Bytes())
 }
+
+// clientIP returns the IP of the visitor who sent r: the one resolved by the
+// rate limiter when it ran, the peer address otherwise.
+func clientIP(r *http.Request) string {
+	if ip := middleware.ClientIPFromContext(r.Context()); ip != "" {
+		return ip
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -10,37 +10,53 @@ import (
 	"strings"
 	"testing"
 
+	mockClient "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/templates"
+	mockTemplates "github.com/fingertips18/fingertips18.github.io/backend/internal/templates/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 type emailHandlerTestFixture struct {
-	t             *testing.T
-	mockEmailRepo *mockRepo.MockEmailRepository
-	emailHandler  EmailHandler
+	t              *testing.T
+	mockOutboxRepo *mockRepo.MockOutboxRepository
+	emailHandler   EmailHandler
 }
 
 func newEmailHandlerTestFixture(t *testing.T) *emailHandlerTestFixture {
-	mockEmailRepo := new(mockRepo.MockEmailRepository)
+	mockOutboxRepo := new(mockRepo.MockOutboxRepository)
 
 	emailHandler := NewEmailServiceHandler(
 		EmailServiceConfig{
-			emailRepo: mockEmailRepo,
+			outboxRepo: mockOutboxRepo,
 		},
 	)
 
 	return &emailHandlerTestFixture{
-		t:             t,
-		mockEmailRepo: mockEmailRepo,
-		emailHandler:  emailHandler,
+		t:              t,
+		mockOutboxRepo: mockOutboxRepo,
+		emailHandler:   emailHandler,
 	}
 }
 
+// expectEmailQueued expects req to be stored as a pending message and its
+// owner notification queued in the outbox.
+func expectEmailQueued(m *mockRepo.MockOutboxRepository, req domain.SendEmail) {
+	m.EXPECT().
+		Enqueue(mock.Anything, mock.MatchedBy(func(message *domain.Message) bool {
+			return message.SendEmail() == domain.SendEmail{Name: req.Name, Email: req.Email, Subject: req.Subject, Message: req.Message} &&
+				message.Status == domain.MessagePending
+		}), []domain.EmailTemplate{domain.EmailOwnerNotification}).
+		Return(nil)
+}
+
 func TestEmailServiceHandler_Send(t *testing.T) {
 	validReq := domain.SendEmail{
 		Name:    "John Doe",
 		Email:   "john@example.com",
+		Subject: "Hello",
 		Message: "Hello, world!",
 	}
 	validBody, _ := json.Marshal(validReq)
@@ -48,7 +64,7 @@ func TestEmailServiceHandler_Send(t *testing.T) {
 	type Given struct {
 		method   string
 		body     string
-		mockRepo func(m *mockRepo.MockEmailRepository)
+		mockRepo func(m *mockRepo.MockOutboxRepository)
 	}
 	type Expected struct {
 		code int
@@ -63,16 +79,14 @@ func TestEmailServiceHandler_Send(t *testing.T) {
 			given: Given{
 				method: http.MethodPost,
 				body:   string(validBody),
-				mockRepo: func(m *mockRepo.MockEmailRepository) {
-					m.EXPECT().
-						Send(validReq).
-						Return(nil)
+				mockRepo: func(m *mockRepo.MockOutboxRepository) {
+					expectEmailQueued(m, validReq)
 				},
 			},
 			expected: Expected{
 				code: http.StatusAccepted,
 				body: toJSON(map[string]string{
-					"message": "Email sent successfully",
+					"message": "Email queued for delivery",
 					"email":   validReq.Email,
 				}),
 			},
@@ -97,19 +111,29 @@ func TestEmailServiceHandler_Send(t *testing.T) {
 				body: "Invalid JSON in request body\n",
 			},
 		},
+		"missing subject": {
+			given: Given{
+				method: http.MethodPost,
+				body:   `{"name":"Jane Doe","email":"jane@example.com","message":"Hello"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid email: subject missing\n",
+			},
+		},
 		"repo error": {
 			given: Given{
 				method: http.MethodPost,
 				body:   string(validBody),
-				mockRepo: func(m *mockRepo.MockEmailRepository) {
+				mockRepo: func(m *mockRepo.MockOutboxRepository) {
 					m.EXPECT().
-						Send(validReq).
-						Return(errors.New("smtp error"))
+						Enqueue(mock.Anything, mock.Anything, mock.Anything).
+						Return(errors.New("db down"))
 				},
 			},
 			expected: Expected{
 				code: http.StatusInternalServerError,
-				body: "Failed to send email: smtp error\n",
+				body: "Failed to queue email: db down\n",
 			},
 		},
 	}
@@ -119,7 +143,7 @@ func TestEmailServiceHandler_Send(t *testing.T) {
 			f := newEmailHandlerTestFixture(t)
 
 			if tt.given.mockRepo != nil {
-				tt.given.mockRepo(f.mockEmailRepo)
+				tt.given.mockRepo(f.mockOutboxRepo)
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/email/send", strings.NewReader(tt.given.body))
@@ -139,7 +163,7 @@ func TestEmailServiceHandler_Send(t *testing.T) {
 				assert.Equal(t, tt.expected.body, string(body))
 			}
 
-			f.mockEmailRepo.AssertExpectations(t)
+			f.mockOutboxRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -148,21 +172,20 @@ func TestEmailServiceHandler_Send_Routing(t *testing.T) {
 	validReq := domain.SendEmail{
 		Name:    "John Doe",
 		Email:   "john@example.com",
+		Subject: "Hello",
 		Message: "Hello, world!",
 	}
 	validBody, _ := json.Marshal(validReq)
 
 	expectedResp, _ := json.Marshal(map[string]string{
-		"message": "Email sent successfully",
+		"message": "Email queued for delivery",
 		"email":   validReq.Email,
 	})
 
 	f := newEmailHandlerTestFixture(t)
 
 	// Mock repo expectation
-	f.mockEmailRepo.EXPECT().
-		Send(validReq).
-		Return(nil)
+	expectEmailQueued(f.mockOutboxRepo, validReq)
 
 	// Create POST request
 	req := httptest.NewRequest(http.MethodPost, "/email/send", bytes.NewReader(validBody))
@@ -183,5 +206,400 @@ func TestEmailServiceHandler_Send_Routing(t *testing.T) {
 	assert.Equal(t, http.StatusAccepted, res.StatusCode)
 	assert.JSONEq(t, string(expectedResp), string(body))
 
-	f.mockEmailRepo.AssertExpectations(t)
+	f.mockOutboxRepo.AssertExpectations(t)
+}
+
+func TestEmailServiceHandler_Send_AutoReply(t *testing.T) {
+	mockOutboxRepo := new(mockRepo.MockOutboxRepository)
+	mockOutboxRepo.EXPECT().
+		Enqueue(mock.Anything, mock.Anything, []domain.EmailTemplate{domain.EmailOwnerNotification, domain.EmailAutoReply}).
+		Return(nil)
+
+	emailHandler := NewEmailServiceHandler(
+		EmailServiceConfig{
+			AutoReply:  true,
+			outboxRepo: mockOutboxRepo,
+		},
+	)
+
+	body, _ := json.Marshal(domain.SendEmail{Name: "John Doe", Email: "john@example.com", Subject: "Hi"})
+	req := httptest.NewRequest(http.MethodPost, "/email/send", bytes.NewReader(body))
+	w := httptest.NewRecorder()
+
+	emailHandler.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	mockOutboxRepo.AssertExpectations(t)
+}
+
+func TestEmailServiceHandler_PreviewTemplate(t *testing.T) {
+	type Given struct {
+		method     string
+		path       string
+		mockRender func(m *mockTemplates.MockEmailRenderer)
+	}
+	type Expected struct {
+		code        int
+		contentType string
+		body        string
+	}
+
+	rendered := templates.Email{
+		Subject: "New message from Jane Doe: Let's work together",
+		Text:    "Jane Doe sent a message.\n",
+		HTML:    "<p>Jane Doe sent a message.</p>",
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"html": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/email/templates/owner_notification/preview",
+				mockRender: func(m *mockTemplates.MockEmailRenderer) {
+					m.EXPECT().Render(domain.EmailOwnerNotification, previewEmailTemplateData).Return(rendered, nil)
+				},
+			},
+			expected: Expected{
+				code:        http.StatusOK,
+				contentType: "text/html; charset=utf-8",
+				body:        "<p>Jane Doe sent a message.</p>",
+			},
+		},
+		"text": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/email/templates/auto_reply/preview?format=text",
+				mockRender: func(m *mockTemplates.MockEmailRenderer) {
+					m.EXPECT().Render(domain.EmailAutoReply, previewEmailTemplateData).Return(rendered, nil)
+				},
+			},
+			expected: Expected{
+				code:        http.StatusOK,
+				contentType: "text/plain; charset=utf-8",
+				body:        "Subject: New message from Jane Doe: Let's work together\n\nJane Doe sent a message.\n",
+			},
+		},
+		"unknown template": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/email/templates/newsletter/preview",
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Template not found: newsletter\n",
+			},
+		},
+		"nested path": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/email/templates/auto_reply/extra/preview",
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "404 page not found\n",
+			},
+		},
+		"invalid format": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/email/templates/auto_reply/preview?format=pdf",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid format: must be html or text\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/email/templates/auto_reply/preview",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+		"render error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/email/templates/auto_reply/preview",
+				mockRender: func(m *mockTemplates.MockEmailRenderer) {
+					m.EXPECT().Render(mock.Anything, mock.Anything).Return(templates.Email{}, errors.New("failed to parse email template: bad"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to render template: failed to parse email template: bad\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockRenderer := new(mockTemplates.MockEmailRenderer)
+			if tt.given.mockRender != nil {
+				tt.given.mockRender(mockRenderer)
+			}
+
+			emailHandler := NewEmailServiceHandler(
+				EmailServiceConfig{
+					EmailTemplates: mockRenderer,
+					outboxRepo:     new(mockRepo.MockOutboxRepository),
+				},
+			)
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			emailHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			assert.Equal(t, tt.expected.body, w.Body.String())
+			if tt.expected.contentType != "" {
+				assert.Equal(t, tt.expected.contentType, w.Header().Get("Content-Type"))
+			}
+
+			mockRenderer.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEmailServiceHandler_PreviewTemplate_Embedded(t *testing.T) {
+	emailHandler := NewEmailServiceHandler(EmailServiceConfig{})
+
+	for _, name := range domain.EmailTemplates {
+		req := httptest.NewRequest(http.MethodGet, "/email/templates/"+string(name)+"/preview", nil)
+		w := httptest.NewRecorder()
+
+		emailHandler.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code, name)
+		assert.Contains(t, w.Body.String(), "Jane Doe", name)
+	}
+}
+
+func TestEmailServiceHandler_Send_SpamProtection(t *testing.T) {
+	validReq := domain.SendEmail{
+		Name:         "John Doe",
+		Email:        "john@example.com",
+		Subject:      "Hello",
+		Message:      "Hello, world!",
+		CaptchaToken: "captcha-token",
+	}
+	sentResp := toJSON(map[string]string{
+		"message": "Email queued for delivery",
+		"email":   validReq.Email,
+	})
+
+	withReq := func(edit func(req *domain.SendEmail)) domain.SendEmail {
+		req := validReq
+		edit(&req)
+		return req
+	}
+
+	type Given struct {
+		req         domain.SendEmail
+		mockCaptcha func(m *mockClient.MockCaptchaVerifier)
+		queued      bool
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"clean message is queued": {
+			given: Given{
+				req: validReq,
+				mockCaptcha: func(m *mockClient.MockCaptchaVerifier) {
+					m.EXPECT().
+						Verify(mock.Anything, "captcha-token", "203.0.113.7").
+						Return(true, nil)
+				},
+				queued: true,
+			},
+			expected: Expected{
+				code: http.StatusAccepted,
+				body: sentResp,
+			},
+		},
+		"honeypot filled is dropped silently": {
+			given: Given{
+				req: withReq(func(req *domain.SendEmail) {
+					req.Website = "https://spam.example"
+				}),
+			},
+			expected: Expected{
+				code: http.StatusAccepted,
+				body: sentResp,
+			},
+		},
+		"captcha rejected": {
+			given: Given{
+				req: validReq,
+				mockCaptcha: func(m *mockClient.MockCaptchaVerifier) {
+					m.EXPECT().
+						Verify(mock.Anything, "captcha-token", "203.0.113.7").
+						Return(false, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid captcha: verification failed\n",
+			},
+		},
+		"captcha provider error": {
+			given: Given{
+				req: validReq,
+				mockCaptcha: func(m *mockClient.MockCaptchaVerifier) {
+					m.EXPECT().
+						Verify(mock.Anything, "captcha-token", "203.0.113.7").
+						Return(false, errors.New("timeout"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to verify captcha: timeout\n",
+			},
+		},
+		"blocked terms are dropped silently": {
+			given: Given{
+				req: withReq(func(req *domain.SendEmail) {
+					req.Message = "Cheap CASINO bonus, buy viagra now"
+				}),
+				mockCaptcha: func(m *mockClient.MockCaptchaVerifier) {
+					m.EXPECT().
+						Verify(mock.Anything, "captcha-token", "203.0.113.7").
+						Return(true, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusAccepted,
+				body: sentResp,
+			},
+		},
+		"too many links are dropped silently": {
+			given: Given{
+				req: withReq(func(req *domain.SendEmail) {
+					req.Message = "https://a.example http://b.example www.c.example https://d.example https://e.example"
+				}),
+				mockCaptcha: func(m *mockClient.MockCaptchaVerifier) {
+					m.EXPECT().
+						Verify(mock.Anything, "captcha-token", "203.0.113.7").
+						Return(true, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusAccepted,
+				body: sentResp,
+			},
+		},
+		"score below the threshold is queued": {
+			given: Given{
+				req: withReq(func(req *domain.SendEmail) {
+					req.Message = "Saw your casino project at https://a.example"
+				}),
+				mockCaptcha: func(m *mockClient.MockCaptchaVerifier) {
+					m.EXPECT().
+						Verify(mock.Anything, "captcha-token", "203.0.113.7").
+						Return(true, nil)
+				},
+				queued: true,
+			},
+			expected: Expected{
+				code: http.StatusAccepted,
+				body: sentResp,
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockOutboxRepo := new(mockRepo.MockOutboxRepository)
+			mockCaptcha := new(mockClient.MockCaptchaVerifier)
+
+			if tt.given.mockCaptcha != nil {
+				tt.given.mockCaptcha(mockCaptcha)
+			}
+			if tt.given.queued {
+				expectEmailQueued(mockOutboxRepo, tt.given.req)
+			}
+
+			emailHandler := NewEmailServiceHandler(
+				EmailServiceConfig{
+					SpamRules: domain.SpamRules{
+						MaxLinks:  domain.DefaultSpamMaxLinks,
+						Blocklist: []string{"casino", "viagra"},
+					},
+					outboxRepo:      mockOutboxRepo,
+					captchaVerifier: mockCaptcha,
+				},
+			)
+
+			body, _ := json.Marshal(tt.given.req)
+			req := httptest.NewRequest(http.MethodPost, "/email/send", bytes.NewReader(body))
+			req.RemoteAddr = "203.0.113.7:5000"
+			w := httptest.NewRecorder()
+
+			emailHandler.Send(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			resBody, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(resBody))
+			} else {
+				assert.Equal(t, tt.expected.body, string(resBody))
+			}
+
+			mockOutboxRepo.AssertExpectations(t)
+			mockCaptcha.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSpamRules_Score(t *testing.T) {
+	rules := domain.SpamRules{
+		MaxLinks:  1,
+		Blocklist: []string{"Casino", " crypto ", ""},
+	}
+
+	tests := map[string]struct {
+		given    domain.SendEmail
+		expected domain.SpamScore
+	}{
+		"clean": {
+			given:    domain.SendEmail{Name: "Jane", Email: "jane@example.com", Subject: "Hi", Message: "See https://jane.example"},
+			expected: domain.SpamScore{},
+		},
+		"extra links": {
+			given: domain.SendEmail{Message: "https://a.example [url=b] www.c.example"},
+			expected: domain.SpamScore{
+				Score:   2 * domain.SpamScorePerExtraLink,
+				Reasons: []string{"links = 3"},
+			},
+		},
+		"blocked terms in any field": {
+			given: domain.SendEmail{Email: "win@casino.example", Subject: "CRYPTO"},
+			expected: domain.SpamScore{
+				Score:   2 * domain.SpamScorePerBlockedTerm,
+				Reasons: []string{"blocked term = casino", "blocked term = crypto"},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, rules.Score(tt.given))
+		})
+	}
 }
@@ -0,0 +1,130 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+)
+
+// etag returns the strong entity tag of a row last written at updatedAt. The
+// tag is the microsecond Unix time, the precision Postgres stores timestamps
+// at, so a value read back from the database always round-trips.
+func etag(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 10) + `"`
+}
+
+// ifMatchVersion reads the If-Match header of an update and returns the
+// updated_at version it names. "*" matches whatever version is current and
+// yields the zero time, which makes the repositories skip the version check.
+//
+// A missing header is answered with 428 Precondition Required, a weak or list
+// tag with 412 Precondition Failed (If-Match uses strong comparison against a
+// single version) and an unparsable tag with 400 Bad Request. In those cases
+// the response has been written and ok is false.
+func ifMatchVersion(w http.ResponseWriter, r *http.Request) (version time.Time, ok bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" {
+		http.Error(w, "If-Match header is required", http.StatusPreconditionRequired)
+		return time.Time{}, false
+	}
+	if header == "*" {
+		return time.Time{}, true
+	}
+	if strings.HasPrefix(header, "W/") || strings.Contains(header, ",") {
+		http.Error(w, "If-Match must name a single strong ETag", http.StatusPreconditionFailed)
+		return time.Time{}, false
+	}
+
+	raw, found := strings.CutPrefix(header, `"`)
+	if found {
+		raw, found = strings.CutSuffix(raw, `"`)
+	}
+	micros, err := strconv.ParseInt(raw, 10, 64)
+	if !found || err != nil {
+		http.Error(w, "Invalid If-Match header", http.StatusBadRequest)
+		return time.Time{}, false
+	}
+
+	return time.UnixMicro(micros).UTC(), true
+}
+
+// collectionETag returns the strong entity tag of a list response built from
+// a collection at version.
+func collectionETag(version domain.CollectionVersion) string {
+	return `"` + strconv.FormatInt(version.LastModified.UnixMicro(), 10) + "-" + strconv.FormatInt(version.Count, 10) + `"`
+}
+
+// versionSource reports the version of one table a list response is built from.
+type versionSource func(ctx context.Context) (domain.CollectionVersion, error)
+
+// collectionVersion merges the versions reported by sources into the version
+// of the response they make up.
+func collectionVersion(ctx context.Context, sources ...versionSource) (domain.CollectionVersion, error) {
+	var version domain.CollectionVersion
+	for _, source := range sources {
+		v, err := source(ctx)
+		if err != nil {
+			return domain.CollectionVersion{}, err
+		}
+		version = version.Merge(v)
+	}
+
+	return version, nil
+}
+
+// notModified handles a conditional GET of a collection at version. It sets the
+// ETag and Last-Modified validators and, when If-None-Match (or, without it,
+// If-Modified-Since) shows the client already holds this version, answers 304
+// Not Modified and returns true. An empty collection carries no validators.
+func notModified(w http.ResponseWriter, r *http.Request, version domain.CollectionVersion) bool {
+	if version.LastModified.IsZero() {
+		return false
+	}
+
+	tag := collectionETag(version)
+	w.Header().Set("ETag", tag)
+	w.Header().Set("Last-Modified", version.LastModified.UTC().Format(http.TimeFormat))
+
+	if header := r.Header.Get("If-None-Match"); header != "" {
+		if !etagListContains(header, tag) {
+			return false
+		}
+	} else if header := r.Header.Get("If-Modified-Since"); header != "" {
+		since, err := http.ParseTime(header)
+		if err != nil || version.LastModified.Truncate(time.Second).After(since) {
+			return false
+		}
+	} else {
+		return false
+	}
+
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
+
+// etagListContains reports whether an If-None-Match header lists tag. The
+// comparison is weak, as RFC 9110 prescribes for If-None-Match, so a W/ prefix
+// added by a proxy still matches.
+func etagListContains(header, tag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fileVersion returns the versionSource of the files attached to rows of
+// parentTable.
+func fileVersion(fileRepo v1.FileRepository, parentTable string) versionSource {
+	return func(ctx context.Context) (domain.CollectionVersion, error) {
+		return fileRepo.Version(ctx, parentTable)
+	}
+}
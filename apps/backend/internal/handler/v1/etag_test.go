@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatchVersion(t *testing.T) {
+	version := time.Date(2025, 1, 1, 12, 0, 0, 123456000, time.UTC)
+
+	type Given struct {
+		header string
+	}
+
+	type Expected struct {
+		version time.Time
+		ok      bool
+		code    int
+		body    string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"strong tag": {
+			given:    Given{header: etag(version)},
+			expected: Expected{version: version, ok: true},
+		},
+		"wildcard": {
+			given:    Given{header: "*"},
+			expected: Expected{ok: true},
+		},
+		"missing": {
+			expected: Expected{code: http.StatusPreconditionRequired, body: "If-Match header is required\n"},
+		},
+		"weak tag": {
+			given:    Given{header: "W/" + etag(version)},
+			expected: Expected{code: http.StatusPreconditionFailed, body: "If-Match must name a single strong ETag\n"},
+		},
+		"list of tags": {
+			given:    Given{header: etag(version) + ", " + etag(version.Add(time.Second))},
+			expected: Expected{code: http.StatusPreconditionFailed, body: "If-Match must name a single strong ETag\n"},
+		},
+		"unquoted tag": {
+			given:    Given{header: "1735732800123456"},
+			expected: Expected{code: http.StatusBadRequest, body: "Invalid If-Match header\n"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/project", nil)
+			if tt.given.header != "" {
+				req.Header.Set("If-Match", tt.given.header)
+			}
+			w := httptest.NewRecorder()
+
+			got, ok := ifMatchVersion(w, req)
+
+			assert.Equal(t, tt.expected.ok, ok)
+			assert.True(t, tt.expected.version.Equal(got))
+			if !tt.expected.ok {
+				assert.Equal(t, tt.expected.code, w.Code)
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestNotModified(t *testing.T) {
+	lastModified := time.Date(2025, 1, 1, 12, 0, 0, 500000000, time.UTC)
+	version := domain.CollectionVersion{LastModified: lastModified, Count: 3}
+	tag := `"1735732800500000-3"`
+
+	type Given struct {
+		version domain.CollectionVersion
+		headers map[string]string
+	}
+
+	type Expected struct {
+		notModified  bool
+		etag         string
+		lastModified string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"no validators sent": {
+			given:    Given{version: version},
+			expected: Expected{etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"matching If-None-Match": {
+			given:    Given{version: version, headers: map[string]string{"If-None-Match": tag}},
+			expected: Expected{notModified: true, etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"weak tag in a list matches": {
+			given:    Given{version: version, headers: map[string]string{"If-None-Match": `"other", W/` + tag}},
+			expected: Expected{notModified: true, etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"stale If-None-Match": {
+			given:    Given{version: version, headers: map[string]string{"If-None-Match": `"1735732800500000-2"`}},
+			expected: Expected{etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"If-None-Match wins over If-Modified-Since": {
+			given: Given{version: version, headers: map[string]string{
+				"If-None-Match":     `"stale"`,
+				"If-Modified-Since": "Wed, 01 Jan 2025 12:00:00 GMT",
+			}},
+			expected: Expected{etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"If-Modified-Since at the last write": {
+			given:    Given{version: version, headers: map[string]string{"If-Modified-Since": "Wed, 01 Jan 2025 12:00:00 GMT"}},
+			expected: Expected{notModified: true, etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"If-Modified-Since before the last write": {
+			given:    Given{version: version, headers: map[string]string{"If-Modified-Since": "Wed, 01 Jan 2025 11:59:59 GMT"}},
+			expected: Expected{etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"malformed If-Modified-Since": {
+			given:    Given{version: version, headers: map[string]string{"If-Modified-Since": "yesterday"}},
+			expected: Expected{etag: tag, lastModified: "Wed, 01 Jan 2025 12:00:00 GMT"},
+		},
+		"empty collection": {
+			given: Given{headers: map[string]string{"If-None-Match": "*"}},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/projects", nil)
+			for k, v := range tt.given.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			got := notModified(w, req, tt.given.version)
+
+			assert.Equal(t, tt.expected.notModified, got)
+			assert.Equal(t, tt.expected.etag, w.Header().Get("ETag"))
+			assert.Equal(t, tt.expected.lastModified, w.Header().Get("Last-Modified"))
+			if tt.expected.notModified {
+				assert.Equal(t, http.StatusNotModified, w.Code)
+				assert.Empty(t, w.Body.String())
+			}
+		})
+	}
+}
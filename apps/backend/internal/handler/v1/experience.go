@@ -0,0 +1,766 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type ExperienceHandler interface {
+	http.Handler
+	Create(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request, id string)
+	Update(w http.ResponseWriter, r *http.Request)
+	Delete(w http.ResponseWriter, r *http.Request, id string)
+	Restore(w http.ResponseWriter, r *http.Request, id string)
+	List(w http.ResponseWriter, r *http.Request)
+}
+
+type ExperienceServiceConfig struct {
+	DatabaseAPI database.DatabaseAPI
+
+	experienceRepo v1.ExperienceRepository
+	skillRepo      v1.SkillRepository
+	fileRepo       v1.FileRepository
+}
+
+type experienceServiceHandler struct {
+	databaseAPI    database.DatabaseAPI
+	experienceRepo v1.ExperienceRepository
+	skillRepo      v1.SkillRepository
+	fileRepo       v1.FileRepository
+}
+
+// NewExperienceServiceHandler creates and returns an ExperienceHandler configured using the provided
+// ExperienceServiceConfig. If cfg.experienceRepo, cfg.skillRepo or cfg.fileRepo is nil, a default
+// repository is constructed using cfg.DatabaseAPI and the "Experience", "Skill" or "File" table
+// respectively; experiences and skills are linked through the "experience_skill" table.
+func NewExperienceServiceHandler(cfg ExperienceServiceConfig) ExperienceHandler {
+	experienceRepo := cfg.experienceRepo
+	if experienceRepo == nil {
+		experienceRepo = v1.NewExperienceRepository(
+			v1.ExperienceRepositoryConfig{
+				DatabaseAPI:          cfg.DatabaseAPI,
+				ExperienceTable:      "Experience",
+				ExperienceSkillTable: "experience_skill",
+			},
+		)
+	}
+
+	skillRepo := cfg.skillRepo
+	if skillRepo == nil {
+		skillRepo = v1.NewSkillRepository(
+			v1.SkillRepositoryConfig{
+				DatabaseAPI:          cfg.DatabaseAPI,
+				SkillTable:           "Skill",
+				ProjectSkillTable:    "project_skill",
+				ExperienceSkillTable: "experience_skill",
+			},
+		)
+	}
+
+	fileRepo := cfg.fileRepo
+	if fileRepo == nil {
+		fileRepo = v1.NewFileRepository(
+			v1.FileRepositoryConfig{
+				DatabaseAPI: cfg.DatabaseAPI,
+				FileTable:   "File",
+			},
+		)
+	}
+
+	return &experienceServiceHandler{
+		databaseAPI:    cfg.DatabaseAPI,
+		experienceRepo: experienceRepo,
+		skillRepo:      skillRepo,
+		fileRepo:       fileRepo,
+	}
+}
+
+// ServeHTTP implements http.Handler for experienceServiceHandler.
+//
+// Routes:
+//   - GET    /experiences       -> h.List(w, r)
+//   - POST   /experience        -> h.Create(w, r)
+//   - PUT    /experience        -> h.Update(w, r)
+//   - GET    /experience/{id}   -> h.Get(w, r, id)
+//   - DELETE /experience/{id}   -> h.Delete(w, r, id)
+//   - POST   /experience/{id}/restore -> h.Restore(w, r, id)
+//
+// A trailing slash is ignored. Unsupported methods receive 405 Method Not Allowed,
+// an empty {id} segment receives 400 Bad Request and unknown routes receive 404 Not Found.
+func (h *experienceServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Normalize path by trimming trailing slash
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	// GET /experiences
+	case path == "/experiences":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.List(w, r)
+		return
+
+	// POST / PUT /experience
+	case path == "/experience":
+		switch r.Method {
+		case http.MethodPost:
+			h.Create(w, r)
+		case http.MethodPut:
+			h.Update(w, r)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+
+	// POST /experience/{id}/restore
+	case strings.HasPrefix(path, "/experience/") && strings.HasSuffix(strings.TrimPrefix(path, "/experience/"), "/restore"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/experience/"), "/restore")
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "Experience ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.Restore(w, r, id)
+		return
+
+	// GET / DELETE /experience/{id}
+	case strings.HasPrefix(path, "/experience/"):
+		id := strings.TrimPrefix(path, "/experience/")
+
+		if id == "" {
+			http.Error(w, "Experience ID is required", http.StatusBadRequest)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			h.Get(w, r, id)
+		case http.MethodDelete:
+			h.Delete(w, r, id)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+
+	// Unknown route
+	default:
+		http.NotFound(w, r)
+		return
+	}
+}
+
+// Create handles HTTP POST requests to create a new experience.
+// It decodes a CreateExperienceRequest, validates it and inserts the experience, its
+// skill links and, when given, its company logo file record in a single transaction. On success it
+// responds with 201 Created and the new ID. Invalid JSON or payloads return 400 Bad
+// Request; repository or encoding failures return 500 Internal Server Error.
+//
+// @Security ApiKeyAuth
+// @Summary Create an experience
+// @Description Creates a new work experience, with an optional company logo, from the provided JSON payload. Returns the assigned ID.
+// @Tags experience
+// @Accept json
+// @Produce json
+// @Param experience body dto.CreateExperienceRequest true "Experience payload"
+// @Success 201 {object} IDResponse "Experience ID"
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /experience [post]
+func (h *experienceServiceHandler) Create(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var createReq dto.CreateExperienceRequest
+	if err := json.NewDecoder(r.Body).Decode(&createReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	experience := domain.Experience{
+		Position:   createReq.Position,
+		Company:    createReq.Company,
+		Link:       createReq.Link,
+		Setup:      domain.WorkSetup(createReq.Setup),
+		StartDate:  createReq.StartDate,
+		EndDate:    createReq.EndDate,
+		Highlights: createReq.Highlights,
+		Skills:     createReq.Skills,
+	}
+
+	// Validate before calling repository
+	if err := experience.ValidatePayload(); err != nil {
+		http.Error(w, "Invalid experience payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Insert the experience and its logo together so a failed file insert never
+	// leaves an experience without the logo it was created with.
+	var id string
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.validateSkillLinks(r.Context(), tx, createReq.SkillIDs); err != nil {
+			return err
+		}
+
+		experienceRepo := h.experienceRepo.WithTx(tx)
+		experienceID, err := experienceRepo.Create(r.Context(), &experience)
+		if err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to create experience: ", err: err}
+		}
+
+		if len(createReq.SkillIDs) > 0 {
+			if err := experienceRepo.SetSkills(r.Context(), experienceID, createReq.SkillIDs); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to link experience skills: ", err: err}
+			}
+		}
+
+		if createReq.Logo != nil {
+			logo := domain.File{
+				ParentTable: domain.ExperienceTable,
+				ParentID:    experienceID,
+				Role:        domain.Image,
+				Name:        createReq.Logo.Name,
+				URL:         createReq.Logo.URL,
+				Type:        createReq.Logo.Type,
+				Size:        createReq.Logo.Size,
+			}
+
+			if _, err := h.fileRepo.WithTx(tx).Create(r.Context(), logo); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to create file record: ", err: err}
+			}
+		}
+
+		id = experienceID
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
+	}
+
+	resp := IDResponse{Id: id}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	w.Write(buf.Bytes())
+}
+
+// Get handles HTTP GET requests to retrieve an experience by its ID, including its
+// company logo when one is stored and its linked skills. It responds with 404 Not Found when no experience
+// matches and 500 Internal Server Error on repository or encoding failures.
+//
+// @Summary Get an experience by ID
+// @Description Retrieves the details of a specific work experience, including its company logo, using its unique ID.
+// @Tags experience
+// @Accept json
+// @Produce json
+// @Param id path string true "Experience ID"
+// @Success 200 {object} dto.ExperienceDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /experience/{id} [get]
+func (h *experienceServiceHandler) Get(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	experience, err := h.experienceRepo.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "Experience not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "GET error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if experience == nil {
+		http.Error(w, "Experience not found", http.StatusNotFound)
+		return
+	}
+
+	logos, err := h.fileRepo.FindByParent(r.Context(), string(domain.ExperienceTable), id, domain.Image)
+	if err != nil {
+		http.Error(w, "Failed to fetch experience logo: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	skillsByExperience, err := h.skillRepo.ListByExperienceIDs(r.Context(), []string{id})
+	if err != nil {
+		http.Error(w, "Failed to fetch experience skills: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := toExperienceDTO(*experience, logos, skillsByExperience[id])
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Update handles HTTP PUT requests to update an existing experience.
+// It expects a dto.ExperienceDTO with the experience ID. The experience row, its
+// logo and, when skill_ids is present, its skill links are written in a single
+// transaction: a logo with an ID updates that file record, a logo without an ID
+// replaces any stored logo, and an omitted logo or skill_ids leaves the stored one
+// untouched. It responds with the updated experience, 400 Bad Request
+// for invalid input, 404 Not Found for an unknown ID and 500 on other failures.
+//
+// @Security ApiKeyAuth
+// @Summary Update an experience
+// @Description Updates an existing work experience using the ID provided in the request body. Returns the updated experience.
+// @Tags experience
+// @Accept json
+// @Produce json
+// @Param experience body dto.ExperienceDTO true "Experience payload with ID"
+// @Success 200 {object} dto.ExperienceDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /experience [put]
+func (h *experienceServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		http.Error(w, "Method not allowed: only PUT is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var updateReq dto.ExperienceDTO
+	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	experience := domain.Experience{
+		Id:         updateReq.ID,
+		Position:   updateReq.Position,
+		Company:    updateReq.Company,
+		Link:       updateReq.Link,
+		Setup:      domain.WorkSetup(updateReq.Setup),
+		StartDate:  updateReq.StartDate,
+		EndDate:    updateReq.EndDate,
+		Highlights: updateReq.Highlights,
+		Skills:     updateReq.Skills,
+	}
+
+	if err := experience.ValidatePayload(); err != nil {
+		http.Error(w, "Invalid experience payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Update the experience and its logo atomically.
+	var updatedExperience *domain.Experience
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.validateSkillLinks(r.Context(), tx, updateReq.SkillIDs); err != nil {
+			return err
+		}
+
+		experienceRepo := h.experienceRepo.WithTx(tx)
+		updated, err := experienceRepo.Update(r.Context(), &experience)
+		if err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to update experience: ", err: err}
+		}
+		if updated == nil {
+			return &txError{status: http.StatusNotFound, msg: "Experience not found"}
+		}
+
+		if updateReq.SkillIDs != nil {
+			if err := experienceRepo.SetSkills(r.Context(), updated.Id, updateReq.SkillIDs); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to link experience skills: ", err: err}
+			}
+		}
+
+		if updateReq.Logo != nil {
+			fileRepo := h.fileRepo.WithTx(tx)
+			logo := domain.File{
+				ID:          updateReq.Logo.ID,
+				ParentTable: domain.ExperienceTable,
+				ParentID:    updated.Id,
+				Role:        domain.Image,
+				Name:        updateReq.Logo.Name,
+				URL:         updateReq.Logo.URL,
+				Type:        updateReq.Logo.Type,
+				Size:        updateReq.Logo.Size,
+			}
+
+			if logo.ID != "" {
+				if _, err := fileRepo.Update(r.Context(), logo); err != nil {
+					return &txError{status: http.StatusInternalServerError, msg: "Failed to update file record: ", err: err}
+				}
+			} else {
+				if err := fileRepo.DeleteByParent(r.Context(), string(domain.ExperienceTable), updated.Id); err != nil {
+					return &txError{status: http.StatusInternalServerError, msg: "Failed to replace experience logo: ", err: err}
+				}
+				if _, err := fileRepo.Create(r.Context(), logo); err != nil {
+					return &txError{status: http.StatusInternalServerError, msg: "Failed to create file record: ", err: err}
+				}
+			}
+		}
+
+		updatedExperience = updated
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
+	}
+
+	// Reload the logo from the database to get fresh timestamps
+	logos, err := h.fileRepo.FindByParent(r.Context(), string(domain.ExperienceTable), updatedExperience.Id, domain.Image)
+	if err != nil {
+		http.Error(w, "Failed to reload experience logo: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	skillsByExperience, err := h.skillRepo.ListByExperienceIDs(r.Context(), []string{updatedExperience.Id})
+	if err != nil {
+		http.Error(w, "Failed to reload experience skills: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	resp := toExperienceDTO(*updatedExperience, logos, skillsByExperience[updatedExperience.Id])
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Delete handles HTTP DELETE requests to move an experience to the trash by its ID.
+// The experience's logo file records and the experience itself are soft-deleted in a
+// single transaction; they stay hidden until restored through
+// POST /experience/{id}/restore or purged through the trash. It responds with 204 No
+// Content on success, 404 Not Found for an unknown or already trashed ID and 500
+// Internal Server Error on other failures.
+//
+// @Security ApiKeyAuth
+// @Summary Delete an experience
+// @Description Moves an existing work experience and its company logo, identified by the ID in the path, to the trash.
+// @Tags experience
+// @Param id path string true "Experience ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /experience/{id} [delete]
+func (h *experienceServiceHandler) Delete(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed: only DELETE is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Trash the experience together with its logo so neither can outlive the other
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.fileRepo.WithTx(tx).TrashByParent(r.Context(), string(domain.ExperienceTable), id); err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to delete experience files: ", err: err}
+		}
+
+		if err := h.experienceRepo.WithTx(tx).Delete(r.Context(), id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Experience not found"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to delete experience: ", err: err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
+	}
+
+	// Successful delete → 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Restore handles HTTP POST requests to take an experience out of the trash.
+// The experience and the logo file records trashed with it are restored in a
+// single transaction. It responds with 204 No Content on success and 404 Not
+// Found when the experience is not in the trash.
+//
+// @Security ApiKeyAuth
+// @Summary Restore an experience
+// @Description Restores a trashed work experience, identified by the ID in the path, together with its company logo.
+// @Tags experience
+// @Param id path string true "Experience ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /experience/{id}/restore [post]
+func (h *experienceServiceHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.experienceRepo.WithTx(tx).Restore(r.Context(), id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Experience not found in trash"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to restore experience: ", err: err}
+		}
+
+		if err := h.fileRepo.WithTx(tx).RestoreByParent(r.Context(), string(domain.ExperienceTable), id); err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to restore experience files: ", err: err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// List handles HTTP GET requests to return a paginated list of experiences.
+//
+// Query parameters:
+//   - page (int, default: 1) and page_size (int, default: 10, max: 100).
+//   - sort_by (created_at or updated_at) and sort_ascending (bool, default: false).
+//   - setup (string): optional work setup filter; one of remote, on-site or hybrid.
+//   - cursor (string): optional next_cursor from a previous page; requires sort_by=created_at.
+//
+// Logos and linked skills for every experience on the page are each fetched with a
+// single batched query.
+// It responds with an ExperienceListResponse envelope, 400 Bad Request for invalid
+// query parameters and 500 Internal Server Error on repository or encoding failures.
+//
+// @Summary List experiences
+// @Description Retrieves a paginated list of work experiences with optional filtering and sorting.
+// @Tags experience
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Number of items per page (default 10)"
+// @Param sort_by query string false "Field to sort by" Enums(created_at, updated_at)
+// @Param sort_ascending query bool false "Sort ascending order"
+// @Param setup query string false "Filter by work setup" Enums(remote, on-site, hybrid)
+// @Param cursor query string false "Keyset cursor from a previous next_cursor; requires sort_by=created_at"
+// @Success 200 {object} dto.ExperienceListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /experiences [get]
+func (h *experienceServiceHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+
+	sortBy, err := utils.GetQuerySortBy(q, "sort_by")
+	if err != nil {
+		http.Error(w, "invalid sort by", http.StatusBadRequest)
+		return
+	}
+
+	filter := dto.ExperienceFilterRequest{
+		Page:          utils.GetQueryInt32(q, "page", 1),
+		PageSize:      utils.GetQueryInt32(q, "page_size", 10),
+		SortBy:        sortBy,
+		SortAscending: utils.GetQueryBool(q, "sort_ascending", false),
+		Setup:         q.Get("setup"),
+		Cursor:        q.Get("cursor"),
+	}
+
+	// Clamp page to minimum of 1
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+
+	// Clamp page_size to valid range
+	const maxPageSize = 100
+	if filter.PageSize < 1 {
+		filter.PageSize = 10 // default
+	} else if filter.PageSize > maxPageSize {
+		filter.PageSize = maxPageSize
+	}
+
+	var setup *domain.WorkSetup
+	if filter.Setup != "" {
+		s := domain.WorkSetup(filter.Setup)
+		switch s {
+		case domain.Remote, domain.OnSite, domain.Hybrid:
+			setup = &s
+		default:
+			http.Error(w, "invalid setup", http.StatusBadRequest)
+			return
+		}
+	}
+
+	cursor, err := parseCursor(filter.Cursor, filter.SortBy)
+	if err != nil {
+		http.Error(w, "invalid cursor: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var sortByPtr *domain.SortBy
+	if filter.SortBy != "" {
+		sb := domain.SortBy(filter.SortBy)
+		sortByPtr = &sb
+	}
+	domainFilter := domain.ExperienceFilter{
+		Page:          filter.Page,
+		PageSize:      filter.PageSize,
+		SortBy:        sortByPtr,
+		SortAscending: filter.SortAscending,
+		Setup:         setup,
+		Cursor:        cursor,
+	}
+
+	experiences, pageInfo, err := h.experienceRepo.List(r.Context(), domainFilter)
+	if err != nil {
+		http.Error(w, "Failed to list experiences: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Batch fetch logos for all experiences
+	experienceIDs := make([]string, len(experiences))
+	for i, e := range experiences {
+		experienceIDs[i] = e.Id
+	}
+
+	logosByExperience := make(map[string][]domain.File)
+	skillsByExperience := make(map[string][]domain.Skill)
+	if len(experienceIDs) > 0 {
+		logosByExperience, err = h.fileRepo.FindByParents(r.Context(), string(domain.ExperienceTable), experienceIDs, domain.Image)
+		if err != nil {
+			http.Error(w, "Failed to retrieve experience logos: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		skillsByExperience, err = h.skillRepo.ListByExperienceIDs(r.Context(), experienceIDs)
+		if err != nil {
+			http.Error(w, "Failed to retrieve experience skills: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	items := make([]dto.ExperienceDTO, 0, len(experiences))
+	for _, experience := range experiences {
+		items = append(items, toExperienceDTO(experience, logosByExperience[experience.Id], skillsByExperience[experience.Id]))
+	}
+
+	resp := dto.ExperienceListResponse{
+		Items:       items,
+		PageMetaDTO: toPageMetaDTO(pageInfo),
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// validateSkillLinks checks, within tx, that every skill an experience is being
+// linked to exists. Malformed or unknown IDs are reported as a 400 naming the
+// offending IDs; an empty list is always valid.
+func (h *experienceServiceHandler) validateSkillLinks(ctx context.Context, tx database.Tx, skillIDs []string) error {
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	for _, id := range skillIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return &txError{status: http.StatusBadRequest, msg: "Invalid experience payload: invalid skill id " + id}
+		}
+	}
+
+	missing, err := h.skillRepo.WithTx(tx).FindMissing(ctx, skillIDs)
+	if err != nil {
+		return &txError{status: http.StatusInternalServerError, msg: "Failed to validate skills: ", err: err}
+	}
+	if len(missing) > 0 {
+		return &txError{status: http.StatusBadRequest, msg: "Invalid experience payload: skill not found: " + strings.Join(missing, ", ")}
+	}
+
+	return nil
+}
+
+// toExperienceDTO maps an experience, its logo files and its linked skills to the
+// response DTO. Files come newest first, so the first one is the current logo.
+func toExperienceDTO(experience domain.Experience, logos []domain.File, skills []domain.Skill) dto.ExperienceDTO {
+	var logo *dto.FileDTO
+	if len(logos) > 0 {
+		l := logos[0]
+		logo = &dto.FileDTO{
+			ID:          l.ID,
+			ParentTable: string(l.ParentTable),
+			ParentID:    l.ParentID,
+			Role:        string(l.Role),
+			Name:        l.Name,
+			URL:         l.URL,
+			Type:        l.Type,
+			Size:        l.Size,
+			CreatedAt:   l.CreatedAt,
+			UpdatedAt:   l.UpdatedAt,
+		}
+	}
+
+	return dto.ExperienceDTO{
+		ID:           experience.Id,
+		Logo:         logo,
+		Position:     experience.Position,
+		Company:      experience.Company,
+		Link:         experience.Link,
+		Setup:        string(experience.Setup),
+		StartDate:    experience.StartDate,
+		EndDate:      experience.EndDate,
+		Highlights:   experience.Highlights,
+		Skills:       experience.Skills,
+		LinkedSkills: toSkillDTOs(skills),
+		CreatedAt:    experience.CreatedAt,
+		UpdatedAt:    experience.UpdatedAt,
+	}
+}
@@ -0,0 +1,1381 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	mockDatabase "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type experienceHandlerTestFixture struct {
+	t                  *testing.T
+	mockDatabaseAPI    *mockDatabase.MockDatabaseAPI
+	mockTx             *mockDatabase.MockTx
+	mockExperienceRepo *mockRepo.MockExperienceRepository
+	mockSkillRepo      *mockRepo.MockSkillRepository
+	mockFileRepo       *mockRepo.MockFileRepository
+	experienceHandler  ExperienceHandler
+}
+
+func newExperienceHandlerTestFixture(t *testing.T) *experienceHandlerTestFixture {
+	mockDatabaseAPI := new(mockDatabase.MockDatabaseAPI)
+	mockTx := new(mockDatabase.MockTx)
+	mockExperienceRepo := new(mockRepo.MockExperienceRepository)
+	mockSkillRepo := new(mockRepo.MockSkillRepository)
+	mockFileRepo := new(mockRepo.MockFileRepository)
+
+	// Run transactional callbacks directly against the repository mocks
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+	mockExperienceRepo.EXPECT().WithTx(mockTx).Return(mockExperienceRepo).Maybe()
+	mockSkillRepo.EXPECT().WithTx(mockTx).Return(mockSkillRepo).Maybe()
+	mockFileRepo.EXPECT().WithTx(mockTx).Return(mockFileRepo).Maybe()
+
+	experienceHandler := NewExperienceServiceHandler(
+		ExperienceServiceConfig{
+			DatabaseAPI:    mockDatabaseAPI,
+			experienceRepo: mockExperienceRepo,
+			skillRepo:      mockSkillRepo,
+			fileRepo:       mockFileRepo,
+		},
+	)
+
+	return &experienceHandlerTestFixture{
+		t:                  t,
+		mockDatabaseAPI:    mockDatabaseAPI,
+		mockTx:             mockTx,
+		mockExperienceRepo: mockExperienceRepo,
+		mockSkillRepo:      mockSkillRepo,
+		mockFileRepo:       mockFileRepo,
+		experienceHandler:  experienceHandler,
+	}
+}
+
+var (
+	testExperienceStart = time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	testExperienceEnd   = time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	testExperienceTime  = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func newTestExperience(id string) domain.Experience {
+	end := testExperienceEnd
+	return domain.Experience{
+		Id:         id,
+		Position:   "Mobile Application Developer",
+		Company:    "GotWork Digital",
+		Link:       "https://gotwork.digital",
+		Setup:      domain.Remote,
+		StartDate:  testExperienceStart,
+		EndDate:    &end,
+		Highlights: []string{"Rewrote the app in Flutter"},
+		Skills:     []string{"Flutter"},
+		CreatedAt:  testExperienceTime,
+		UpdatedAt:  testExperienceTime,
+	}
+}
+
+func newTestExperienceSkill() domain.Skill {
+	return domain.Skill{
+		Id:        "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b",
+		Icon:      "flutter.svg",
+		HexColor:  "#02569B",
+		Label:     "Flutter",
+		Category:  domain.Frontend,
+		CreatedAt: testExperienceTime,
+		UpdatedAt: testExperienceTime,
+	}
+}
+
+func newTestExperienceLogo(id, experienceID string) domain.File {
+	return domain.File{
+		ID:          id,
+		ParentTable: domain.ExperienceTable,
+		ParentID:    experienceID,
+		Role:        domain.Image,
+		Name:        "logo.png",
+		URL:         "https://example.com/logo.png",
+		Type:        "image/png",
+		Size:        1024,
+		CreatedAt:   testExperienceTime,
+		UpdatedAt:   testExperienceTime,
+	}
+}
+
+func TestExperienceServiceHandler_Create(t *testing.T) {
+	fixedID := "3f9e0c4a-8a52-4d2b-9a77-0f1c2d3e4f5a"
+	end := testExperienceEnd
+
+	validCreateReq := dto.CreateExperienceRequest{
+		Logo: &dto.CreateFileRequest{
+			Role: "ignored",
+			Name: "logo.png",
+			URL:  "https://example.com/logo.png",
+			Type: "image/png",
+			Size: 1024,
+		},
+		Position:   "Mobile Application Developer",
+		Company:    "GotWork Digital",
+		Setup:      "remote",
+		StartDate:  testExperienceStart,
+		EndDate:    &end,
+		Highlights: []string{"Rewrote the app in Flutter"},
+		Skills:     []string{"Flutter"},
+	}
+	validBody, _ := json.Marshal(validCreateReq)
+
+	noLogoReq := validCreateReq
+	noLogoReq.Logo = nil
+	noLogoBody, _ := json.Marshal(noLogoReq)
+
+	invalidReq := validCreateReq
+	invalidReq.Setup = "office"
+	invalidBody, _ := json.Marshal(invalidReq)
+
+	skillID := newTestExperienceSkill().Id
+	withSkillsReq := noLogoReq
+	withSkillsReq.SkillIDs = []string{skillID}
+	withSkillsBody, _ := json.Marshal(withSkillsReq)
+
+	badSkillReq := noLogoReq
+	badSkillReq.SkillIDs = []string{"not-a-uuid"}
+	badSkillBody, _ := json.Marshal(badSkillReq)
+
+	type Given struct {
+		method        string
+		body          string
+		mockRepo      func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo  func(m *mockRepo.MockFileRepository)
+		mockSkillRepo func(m *mockRepo.MockSkillRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success with logo": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.MatchedBy(func(e *domain.Experience) bool {
+							return e.Company == "GotWork Digital" && e.Setup == domain.Remote && e.EndDate.Equal(end)
+						})).
+						Return(fixedID, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.MatchedBy(func(f domain.File) bool {
+							return f.ParentTable == domain.ExperienceTable &&
+								f.ParentID == fixedID &&
+								f.Role == domain.Image &&
+								f.Name == "logo.png"
+						})).
+						Return("logo-1", nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: toJSON(IDResponse{Id: fixedID}),
+			},
+		},
+		"success without logo": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(noLogoBody),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.AnythingOfType("*domain.Experience")).
+						Return(fixedID, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: toJSON(IDResponse{Id: fixedID}),
+			},
+		},
+		"success with skills": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(withSkillsBody),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.AnythingOfType("*domain.Experience")).
+						Return(fixedID, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, []string{skillID}).Return(nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID}).Return([]string{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: toJSON(IDResponse{Id: fixedID}),
+			},
+		},
+		"invalid skill id": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(badSkillBody),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid experience payload: invalid skill id not-a-uuid\n",
+			},
+		},
+		"skill not found": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(withSkillsBody),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID}).Return([]string{skillID}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid experience payload: skill not found: " + skillID + "\n",
+			},
+		},
+		"skill link error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(withSkillsBody),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Create(mock.Anything, mock.Anything).Return(fixedID, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, mock.Anything).Return(errors.New("link failure"))
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, mock.Anything).Return([]string{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to link experience skills: link failure\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodGet,
+				body:   string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only POST is supported\n",
+			},
+		},
+		"invalid JSON": {
+			given: Given{
+				method: http.MethodPost,
+				body:   "{invalid-json}",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"invalid payload": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(invalidBody),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid experience payload: setup invalid = office\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.Anything).
+						Return("", errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to create experience: db failure\n",
+			},
+		},
+		"logo error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(validBody),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.Anything).
+						Return(fixedID, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.Anything).
+						Return("", errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to create file record: file failure\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/experience", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.Create(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceServiceHandler_Get(t *testing.T) {
+	fixedID := "exp-123"
+	experience := newTestExperience(fixedID)
+	logo := newTestExperienceLogo("logo-1", fixedID)
+	skill := newTestExperienceSkill()
+
+	type Given struct {
+		method        string
+		mockRepo      func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo  func(m *mockRepo.MockFileRepository)
+		mockSkillRepo func(m *mockRepo.MockSkillRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParent(mock.Anything, string(domain.ExperienceTable), fixedID, domain.Image).
+						Return([]domain.File{logo}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {skill}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(toExperienceDTO(experience, []domain.File{logo}, []domain.Skill{skill})),
+			},
+		},
+		"success without logo": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParent(mock.Anything, string(domain.ExperienceTable), fixedID, domain.Image).
+						Return([]domain.File{}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(toExperienceDTO(experience, nil, nil)),
+			},
+		},
+		"not found": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(nil, pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Experience not found\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "GET error: db failure\n",
+			},
+		},
+		"logo error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParent(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to fetch experience logo: file failure\n",
+			},
+		},
+		"skills error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParent(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return([]domain.File{}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, mock.Anything).
+						Return(nil, errors.New("skill failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to fetch experience skills: skill failure\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/experience/"+fixedID, nil)
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.Get(w, req, fixedID)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceServiceHandler_Update(t *testing.T) {
+	fixedID := "3f9e0c4a-8a52-4d2b-9a77-0f1c2d3e4f5a"
+	experience := newTestExperience(fixedID)
+	logo := newTestExperienceLogo("logo-1", fixedID)
+
+	withLogo := func(id string) string {
+		req := toExperienceDTO(experience, nil, nil)
+		req.Logo = &dto.FileDTO{ID: id, Name: "logo.png", URL: "https://example.com/logo.png", Type: "image/png", Size: 1024}
+		b, _ := json.Marshal(req)
+		return string(b)
+	}
+	noLogoBody := toJSON(toExperienceDTO(experience, nil, nil))
+
+	skill := newTestExperienceSkill()
+	withSkills := func(skillIDs []string) string {
+		req := toExperienceDTO(experience, nil, nil)
+		req.SkillIDs = skillIDs
+		b, _ := json.Marshal(req)
+		return string(b)
+	}
+
+	type Given struct {
+		method        string
+		body          string
+		mockRepo      func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo  func(m *mockRepo.MockFileRepository)
+		mockSkillRepo func(m *mockRepo.MockSkillRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success - keeps logo when omitted": {
+			given: Given{
+				method: http.MethodPut,
+				body:   noLogoBody,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Experience")).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParent(mock.Anything, string(domain.ExperienceTable), fixedID, domain.Image).
+						Return([]domain.File{logo}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(toExperienceDTO(experience, []domain.File{logo}, nil)),
+			},
+		},
+		"success - updates existing logo": {
+			given: Given{
+				method: http.MethodPut,
+				body:   withLogo("logo-1"),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						Update(mock.Anything, mock.MatchedBy(func(f domain.File) bool {
+							return f.ID == "logo-1" && f.ParentTable == domain.ExperienceTable && f.ParentID == fixedID
+						})).
+						Return(&logo, nil)
+					m.EXPECT().
+						FindByParent(mock.Anything, string(domain.ExperienceTable), fixedID, domain.Image).
+						Return([]domain.File{logo}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(toExperienceDTO(experience, []domain.File{logo}, nil)),
+			},
+		},
+		"success - replaces logo without ID": {
+			given: Given{
+				method: http.MethodPut,
+				body:   withLogo(""),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						DeleteByParent(mock.Anything, string(domain.ExperienceTable), fixedID).
+						Return(nil)
+					m.EXPECT().
+						Create(mock.Anything, mock.MatchedBy(func(f domain.File) bool {
+							return f.ID == "" && f.Role == domain.Image && f.ParentID == fixedID
+						})).
+						Return("logo-2", nil)
+					m.EXPECT().
+						FindByParent(mock.Anything, string(domain.ExperienceTable), fixedID, domain.Image).
+						Return([]domain.File{newTestExperienceLogo("logo-2", fixedID)}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(toExperienceDTO(experience, []domain.File{newTestExperienceLogo("logo-2", fixedID)}, nil)),
+			},
+		},
+		"success - replaces skill links": {
+			given: Given{
+				method: http.MethodPut,
+				body:   withSkills([]string{skill.Id}),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(&experience, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, []string{skill.Id}).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParent(mock.Anything, string(domain.ExperienceTable), fixedID, domain.Image).
+						Return([]domain.File{}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skill.Id}).Return([]string{}, nil)
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {skill}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(toExperienceDTO(experience, nil, []domain.Skill{skill})),
+			},
+		},
+		"skill not found": {
+			given: Given{
+				method: http.MethodPut,
+				body:   withSkills([]string{skill.Id}),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skill.Id}).Return([]string{skill.Id}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid experience payload: skill not found: " + skill.Id + "\n",
+			},
+		},
+		"invalid JSON": {
+			given: Given{
+				method: http.MethodPut,
+				body:   "{invalid-json}",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"invalid payload": {
+			given: Given{
+				method: http.MethodPut,
+				body:   `{"id":"` + fixedID + `","company":"GotWork Digital","setup":"remote"}`,
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid experience payload: position missing\n",
+			},
+		},
+		"not found": {
+			given: Given{
+				method: http.MethodPut,
+				body:   noLogoBody,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Experience not found\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodPut,
+				body:   noLogoBody,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to update experience: db failure\n",
+			},
+		},
+		"logo update error": {
+			given: Given{
+				method: http.MethodPut,
+				body:   withLogo("logo-1"),
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(&experience, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().Update(mock.Anything, mock.Anything).Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to update file record: file failure\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+				body:   noLogoBody,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only PUT is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/experience", strings.NewReader(tt.given.body))
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.Update(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceServiceHandler_Delete(t *testing.T) {
+	fixedID := "exp-123"
+
+	type Given struct {
+		method       string
+		mockRepo     func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo func(m *mockRepo.MockFileRepository)
+		txErr        error
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodDelete,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Delete(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, string(domain.ExperienceTable), fixedID).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+				body: "",
+			},
+		},
+		"not found": {
+			given: Given{
+				method: http.MethodDelete,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Delete(mock.Anything, fixedID).Return(pgx.ErrNoRows)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, string(domain.ExperienceTable), fixedID).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Experience not found\n",
+			},
+		},
+		"file delete error": {
+			given: Given{
+				method: http.MethodDelete,
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to delete experience files: file failure\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodDelete,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Delete(mock.Anything, fixedID).Return(errors.New("db failure"))
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().TrashByParent(mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to delete experience: db failure\n",
+			},
+		},
+		"transaction fails to begin": {
+			given: Given{
+				method: http.MethodDelete,
+				txErr:  errors.New("failed to begin transaction: connection refused"),
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Transaction failed: failed to begin transaction: connection refused\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodGet,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only DELETE is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+
+			if tt.given.txErr != nil {
+				// Replace the pass-through transaction with one that fails to begin
+				f.mockDatabaseAPI.ExpectedCalls = nil
+				f.mockDatabaseAPI.EXPECT().
+					WithTx(mock.Anything, mock.Anything).
+					Return(tt.given.txErr)
+			}
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/experience/"+fixedID, nil)
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.Delete(w, req, fixedID)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceServiceHandler_Restore(t *testing.T) {
+	fixedID := "exp-123"
+
+	type Given struct {
+		method       string
+		path         string
+		mockRepo     func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo func(m *mockRepo.MockFileRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success restores experience and its logo": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/experience/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().RestoreByParent(mock.Anything, string(domain.ExperienceTable), fixedID).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+				body: "",
+			},
+		},
+		"not in trash": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/experience/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Experience not found in trash\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/experience/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(errors.New("database failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore experience: database failure\n",
+			},
+		},
+		"files restore error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/experience/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						RestoreByParent(mock.Anything, string(domain.ExperienceTable), fixedID).
+						Return(errors.New("update failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore experience files: update failed\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/experience/" + fixedID + "/restore",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"missing id": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/experience//restore",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Experience ID is required\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceServiceHandler_List(t *testing.T) {
+	first := newTestExperience("exp-1")
+	second := newTestExperience("exp-2")
+	second.Setup = domain.OnSite
+	second.EndDate = nil
+	logo := newTestExperienceLogo("logo-1", "exp-1")
+	skill := newTestExperienceSkill()
+
+	type Given struct {
+		method        string
+		query         string
+		mockRepo      func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo  func(m *mockRepo.MockFileRepository)
+		mockSkillRepo func(m *mockRepo.MockSkillRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.ExperienceFilter{Page: 1, PageSize: 10}).
+						Return([]domain.Experience{first, second}, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, string(domain.ExperienceTable), []string{"exp-1", "exp-2"}, domain.Image).
+						Return(map[string][]domain.File{"exp-1": {logo}, "exp-2": {}}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, []string{"exp-1", "exp-2"}).
+						Return(map[string][]domain.Skill{"exp-1": {}, "exp-2": {skill}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.ExperienceListResponse{
+					Items: []dto.ExperienceDTO{
+						toExperienceDTO(first, []domain.File{logo}, nil),
+						toExperienceDTO(second, nil, []domain.Skill{skill}),
+					},
+					PageMetaDTO: dto.PageMetaDTO{Total: 2, Page: 1, PageSize: 10},
+				}),
+			},
+		},
+		"success - with setup filter": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?setup=on-site",
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(f domain.ExperienceFilter) bool {
+							return f.Setup != nil && *f.Setup == domain.OnSite
+						})).
+						Return([]domain.Experience{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"items":[],"total":0,"page":1,"page_size":10,"has_next":false}`,
+			},
+		},
+		"invalid setup": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?setup=office",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid setup\n",
+			},
+		},
+		"invalid sort by": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?sort_by=title",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid sort by\n",
+			},
+		},
+		"invalid cursor": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?cursor=not-base64!",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid cursor: cursor encoding invalid\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.Anything).
+						Return(nil, domain.PageInfo{}, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list experiences: db failure\n",
+			},
+		},
+		"logos error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.Anything).
+						Return([]domain.Experience{first}, domain.PageInfo{Total: 1, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to retrieve experience logos: file failure\n",
+			},
+		},
+		"skills error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.Anything).
+						Return([]domain.Experience{first}, domain.PageInfo{Total: 1, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(map[string][]domain.File{}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByExperienceIDs(mock.Anything, mock.Anything).
+						Return(nil, errors.New("skill failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to retrieve experience skills: skill failure\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/experiences"+tt.given.query, nil)
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.List(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceServiceHandler_ServeHTTP(t *testing.T) {
+	fixedID := "exp-123"
+	experience := newTestExperience(fixedID)
+
+	type Given struct {
+		method string
+		path   string
+	}
+	type Expected struct {
+		code int
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"list": {
+			given:    Given{method: http.MethodGet, path: "/experiences"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"list with trailing slash": {
+			given:    Given{method: http.MethodGet, path: "/experiences/"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"list invalid method": {
+			given:    Given{method: http.MethodPost, path: "/experiences"},
+			expected: Expected{code: http.StatusMethodNotAllowed},
+		},
+		"get": {
+			given:    Given{method: http.MethodGet, path: "/experience/" + fixedID},
+			expected: Expected{code: http.StatusOK},
+		},
+		"delete": {
+			given:    Given{method: http.MethodDelete, path: "/experience/" + fixedID},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"restore": {
+			given:    Given{method: http.MethodPost, path: "/experience/" + fixedID + "/restore"},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"id route invalid method": {
+			given:    Given{method: http.MethodPatch, path: "/experience/" + fixedID},
+			expected: Expected{code: http.StatusMethodNotAllowed},
+		},
+		"collection route invalid method": {
+			given:    Given{method: http.MethodDelete, path: "/experience"},
+			expected: Expected{code: http.StatusMethodNotAllowed},
+		},
+		"unknown route": {
+			given:    Given{method: http.MethodGet, path: "/experiencez"},
+			expected: Expected{code: http.StatusNotFound},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceHandlerTestFixture(t)
+			f.mockExperienceRepo.EXPECT().
+				List(mock.Anything, mock.Anything).
+				Return([]domain.Experience{}, domain.PageInfo{Page: 1, PageSize: 10}, nil).
+				Maybe()
+			f.mockExperienceRepo.EXPECT().Get(mock.Anything, fixedID).Return(&experience, nil).Maybe()
+			f.mockExperienceRepo.EXPECT().Delete(mock.Anything, fixedID).Return(nil).Maybe()
+			f.mockExperienceRepo.EXPECT().Restore(mock.Anything, fixedID).Return(nil).Maybe()
+			f.mockFileRepo.EXPECT().RestoreByParent(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+			f.mockFileRepo.EXPECT().
+				FindByParent(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return([]domain.File{}, nil).
+				Maybe()
+			f.mockSkillRepo.EXPECT().
+				ListByExperienceIDs(mock.Anything, mock.Anything).
+				Return(map[string][]domain.Skill{}, nil).
+				Maybe()
+			f.mockFileRepo.EXPECT().TrashByParent(mock.Anything, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.experienceHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+		})
+	}
+}
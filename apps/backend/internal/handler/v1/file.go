@@ -37,16 +37,22 @@ type fileServiceHandler struct {
 // NewFileServiceHandler creates and returns a new instance of FileHandler.
 // It accepts a FileServiceConfig, which may include a custom file repository.
 // If no repository is provided in the config, it initializes a default FileRepository
-// using the provided DatabaseAPI and a default table name.
+// using the provided DatabaseAPI and a default table name, audited via
+// v1.NewAuditedFileRepository.
 // Returns a FileHandler implementation.
 func NewFileServiceHandler(cfg FileServiceConfig) FileHandler {
+	audited := newAuditedRepositoryConfig(cfg.DatabaseAPI)
+
 	fileRepo := cfg.fileRepo
 	if fileRepo == nil {
-		fileRepo = v1.NewFileRepository(
-			v1.FileRepositoryConfig{
-				DatabaseAPI: cfg.DatabaseAPI,
-				FileTable:   "File",
-			},
+		fileRepo = v1.NewAuditedFileRepository(
+			v1.NewFileRepository(
+				v1.FileRepositoryConfig{
+					DatabaseAPI: cfg.DatabaseAPI,
+					FileTable:   "File",
+				},
+			),
+			audited,
 		)
 	}
 
@@ -187,7 +193,6 @@ func (h *fileServiceHandler) Create(w http.ResponseWriter, r *http.Request) {
 // On success, it responds with a JSON representation of the file.
 // If the ID is invalid, the file is not found, or retrieval fails, it responds with an appropriate HTTP error.
 //
-// @Security ApiKeyAuth
 // @Summary Get a file by ID
 // @Description Retrieves a file record by its unique identifier.
 // @Tags file
@@ -395,7 +400,6 @@ func (h *fileServiceHandler) DeleteByParent(w http.ResponseWriter, r *http.Reque
 // On success, it responds with a JSON array of files matching the criteria.
 // If required parameters are missing or invalid, it responds with an appropriate HTTP error.
 //
-// @Security ApiKeyAuth
 // @Summary List files by parent
 // @Description Retrieves all files for a specific parent entity and role.
 // @Tags file
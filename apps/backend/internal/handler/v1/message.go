@@ -0,0 +1,335 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type MessageHandler interface {
+	http.Handler
+	List(w http.ResponseWriter, r *http.Request)
+	Get(w http.ResponseWriter, r *http.Request, id string)
+	Update(w http.ResponseWriter, r *http.Request, id string)
+}
+
+type MessageServiceConfig struct {
+	DatabaseAPI database.DatabaseAPI
+
+	messageRepo v1.MessageRepository
+}
+
+type messageServiceHandler struct {
+	messageRepo v1.MessageRepository
+}
+
+// NewMessageServiceHandler creates and returns a MessageHandler configured using the provided
+// MessageServiceConfig. If cfg.messageRepo is nil, a default repository is constructed via
+// newMessageRepository using cfg.DatabaseAPI.
+func NewMessageServiceHandler(cfg MessageServiceConfig) MessageHandler {
+	messageRepo := cfg.messageRepo
+	if messageRepo == nil {
+		messageRepo = newMessageRepository(cfg.DatabaseAPI)
+	}
+
+	return &messageServiceHandler{
+		messageRepo: messageRepo,
+	}
+}
+
+// newMessageRepository returns the repository contact form messages are
+// stored in, shared by the email and message handlers.
+func newMessageRepository(databaseAPI database.DatabaseAPI) v1.MessageRepository {
+	return v1.NewMessageRepository(
+		v1.MessageRepositoryConfig{
+			DatabaseAPI:  databaseAPI,
+			MessageTable: "message",
+		},
+	)
+}
+
+// ServeHTTP implements http.Handler for messageServiceHandler.
+//
+// Routes:
+//   - GET   /messages      -> h.List(w, r)
+//   - GET   /message/{id}  -> h.Get(w, r, id)
+//   - PATCH /message/{id}  -> h.Update(w, r, id)
+//
+// A trailing slash is ignored. Unknown routes receive a 404 Not Found response.
+func (h *messageServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	// GET /messages
+	case path == "/messages":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.List(w, r)
+		return
+
+	// GET / PATCH /message/{id}
+	case strings.HasPrefix(path, "/message/"):
+		id := strings.TrimPrefix(path, "/message/")
+
+		if id == "" || strings.Contains(id, "/") {
+			http.NotFound(w, r)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			h.Get(w, r, id)
+		case http.MethodPatch:
+			h.Update(w, r, id)
+		default:
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		}
+		return
+
+	default:
+		http.NotFound(w, r)
+		return
+	}
+}
+
+// List handles GET /messages and returns the inbox, newest first.
+//
+// Query parameters:
+//   - page (int, default 1) and page_size (int, default 20, max 100).
+//   - cursor: optional next_cursor from a previous page.
+//   - status: optional delivery status (pending, sent, failed).
+//   - read: optional bool; true lists read messages only, false unread ones only.
+//   - archived: bool, default false; true lists archived messages instead of the inbox.
+//
+// Responses:
+//   - 200 OK with a dto.MessageListResponse.
+//   - 400 Bad Request for an unknown status, a malformed read flag or cursor.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the query fails.
+//
+// @Security ApiKeyAuth
+// @Summary List messages
+// @Description Lists contact form messages, newest first. Archived messages are only listed with archived=true.
+// @Tags message
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number (default 1)"
+// @Param page_size query int false "Number of items per page (default 20, max 100)"
+// @Param cursor query string false "Keyset cursor from a previous next_cursor"
+// @Param status query string false "Delivery status" Enums(pending, sent, failed)
+// @Param read query bool false "Only read (true) or unread (false) messages"
+// @Param archived query bool false "List archived messages instead of the inbox"
+// @Success 200 {object} dto.MessageListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /messages [get]
+func (h *messageServiceHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+
+	filter := domain.MessageFilter{
+		Page:     utils.GetQueryInt32(q, "page", 1),
+		PageSize: utils.GetQueryInt32(q, "page_size", 20),
+		Archived: utils.GetQueryBool(q, "archived", false),
+	}
+
+	// Clamp page to minimum of 1
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+
+	// Clamp page_size to valid range
+	const maxPageSize = 100
+	if filter.PageSize < 1 {
+		filter.PageSize = 20 // default
+	} else if filter.PageSize > maxPageSize {
+		filter.PageSize = maxPageSize
+	}
+
+	if status := q.Get("status"); status != "" {
+		s := domain.MessageStatus(status)
+		filter.Status = &s
+	}
+
+	if raw := q.Get("read"); raw != "" {
+		read, err := strconv.ParseBool(raw)
+		if err != nil {
+			http.Error(w, "Invalid read flag: must be true or false", http.StatusBadRequest)
+			return
+		}
+		filter.Read = &read
+	}
+
+	cursor, err := parseCursor(q.Get("cursor"), "")
+	if err != nil {
+		http.Error(w, "invalid cursor: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	filter.Cursor = cursor
+
+	if err := filter.Validate(); err != nil {
+		http.Error(w, "Invalid message filter: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	messages, pageInfo, err := h.messageRepo.List(r.Context(), filter)
+	if err != nil {
+		http.Error(w, "Failed to list messages: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]dto.MessageDTO, len(messages))
+	for i, message := range messages {
+		items[i] = toMessageDTO(message)
+	}
+
+	writeMessageJSON(w, dto.MessageListResponse{
+		Items:       items,
+		PageMetaDTO: toPageMetaDTO(pageInfo),
+	})
+}
+
+// Get handles GET /message/{id}.
+//
+// Responses:
+//   - 200 OK with a dto.MessageDTO.
+//   - 404 Not Found when no message has the ID.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the query fails.
+//
+// @Security ApiKeyAuth
+// @Summary Get a message
+// @Description Retrieves a contact form message, archived or not.
+// @Tags message
+// @Accept json
+// @Produce json
+// @Param id path string true "Message ID"
+// @Success 200 {object} dto.MessageDTO
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /message/{id} [get]
+func (h *messageServiceHandler) Get(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	message, err := h.messageRepo.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "Message not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to get message: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeMessageJSON(w, toMessageDTO(*message))
+}
+
+// Update handles PATCH /message/{id}, marking the message read or unread and
+// archiving or unarchiving it. Omitted fields are left unchanged.
+//
+// Responses:
+//   - 200 OK with the updated dto.MessageDTO.
+//   - 400 Bad Request for malformed JSON or when neither read nor archived is set.
+//   - 404 Not Found when no message has the ID.
+//   - 405 Method Not Allowed for non-PATCH requests.
+//   - 500 Internal Server Error when the update fails.
+//
+// @Security ApiKeyAuth
+// @Summary Update a message
+// @Description Marks a contact form message read or unread and archives or unarchives it.
+// @Tags message
+// @Accept json
+// @Produce json
+// @Param id path string true "Message ID"
+// @Param message body dto.UpdateMessageRequest true "Fields to change"
+// @Success 200 {object} dto.MessageDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /message/{id} [patch]
+func (h *messageServiceHandler) Update(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPatch {
+		http.Error(w, "Method not allowed: only PATCH is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	defer r.Body.Close()
+
+	var updateReq dto.UpdateMessageRequest
+	if err := json.NewDecoder(r.Body).Decode(&updateReq); err != nil {
+		http.Error(w, "Invalid JSON in request body", http.StatusBadRequest)
+		return
+	}
+
+	update := domain.MessageUpdate{
+		Read:     updateReq.Read,
+		Archived: updateReq.Archived,
+	}
+
+	if err := update.Validate(); err != nil {
+		http.Error(w, "Invalid message payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	updated, err := h.messageRepo.Update(r.Context(), id, update)
+	if err != nil {
+		http.Error(w, "Failed to update message: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if updated == nil {
+		http.Error(w, "Message not found", http.StatusNotFound)
+		return
+	}
+
+	writeMessageJSON(w, toMessageDTO(*updated))
+}
+
+// toMessageDTO maps a stored message to its response form.
+func toMessageDTO(message domain.Message) dto.MessageDTO {
+	return dto.MessageDTO{
+		ID:               message.ID,
+		Name:             message.Name,
+		Email:            message.Email,
+		Subject:          message.Subject,
+		Message:          message.Message,
+		Status:           string(message.Status),
+		Attempts:         message.Attempts,
+		ProviderResponse: message.ProviderResponse,
+		ReadAt:           message.ReadAt,
+		ArchivedAt:       message.ArchivedAt,
+		CreatedAt:        message.CreatedAt,
+		UpdatedAt:        message.UpdatedAt,
+	}
+}
+
+// writeMessageJSON writes resp as a 200 JSON response.
+func writeMessageJSON(w http.ResponseWriter, resp any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
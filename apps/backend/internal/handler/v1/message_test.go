@@ -0,0 +1,424 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var messageTestNow = time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)
+
+type messageHandlerTestFixture struct {
+	t               *testing.T
+	mockMessageRepo *mockRepo.MockMessageRepository
+	messageHandler  MessageHandler
+}
+
+func newMessageHandlerTestFixture(t *testing.T) *messageHandlerTestFixture {
+	mockMessageRepo := new(mockRepo.MockMessageRepository)
+
+	messageHandler := NewMessageServiceHandler(
+		MessageServiceConfig{
+			messageRepo: mockMessageRepo,
+		},
+	)
+
+	return &messageHandlerTestFixture{
+		t:               t,
+		mockMessageRepo: mockMessageRepo,
+		messageHandler:  messageHandler,
+	}
+}
+
+func newTestMessage() domain.Message {
+	return domain.Message{
+		ID:        "message-1",
+		Name:      "Jane Doe",
+		Email:     "jane@example.com",
+		Subject:   "Hello",
+		Message:   "Nice portfolio!",
+		Status:    domain.MessageSent,
+		Attempts:  1,
+		CreatedAt: messageTestNow,
+		UpdatedAt: messageTestNow,
+	}
+}
+
+const testMessageJSON = `{"id":"message-1","name":"Jane Doe","email":"jane@example.com","subject":"Hello","message":"Nice portfolio!","status":"sent","attempts":1,"created_at":"2026-02-10T09:00:00Z","updated_at":"2026-02-10T09:00:00Z"}`
+
+func TestMessageServiceHandler_List(t *testing.T) {
+	failed := newTestMessage()
+	failed.Status = domain.MessageFailed
+	failed.ProviderResponse = "bad gateway"
+
+	failedStatus := domain.MessageFailed
+	unread := false
+
+	type Given struct {
+		query    string
+		mockRepo func(m *mockRepo.MockMessageRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"inbox with defaults": {
+			given: Given{
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.MessageFilter{Page: 1, PageSize: 20}).
+						Return([]domain.Message{newTestMessage()}, domain.PageInfo{Total: 1, Page: 1, PageSize: 20}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"items":[` + testMessageJSON + `],"total":1,"page":1,"page_size":20,"has_next":false}`,
+			},
+		},
+		"filtered": {
+			given: Given{
+				query: "?status=failed&read=false&archived=true&page=2&page_size=500",
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.MessageFilter{
+							Page:     2,
+							PageSize: 100,
+							Status:   &failedStatus,
+							Read:     &unread,
+							Archived: true,
+						}).
+						Return([]domain.Message{failed}, domain.PageInfo{Total: 101, Page: 2, PageSize: 100}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"items":[` + strings.Replace(
+					strings.Replace(testMessageJSON, `"status":"sent"`, `"status":"failed"`, 1),
+					`"attempts":1`, `"attempts":1,"provider_response":"bad gateway"`, 1,
+				) + `],"total":101,"page":2,"page_size":100,"has_next":false}`,
+			},
+		},
+		"cursor": {
+			given: Given{
+				query: "?cursor=" + domain.Cursor{CreatedAt: messageTestNow, ID: "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6d"}.Encode(),
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(filter domain.MessageFilter) bool {
+							return filter.Cursor != nil && filter.Cursor.ID == "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6d"
+						})).
+						Return([]domain.Message{}, domain.PageInfo{Page: 1, PageSize: 20}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"items":[],"total":0,"page":1,"page_size":20,"has_next":false}`,
+			},
+		},
+		"unknown status": {
+			given: Given{query: "?status=bounced"},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid message filter: status invalid = bounced\n",
+			},
+		},
+		"invalid read flag": {
+			given: Given{query: "?read=maybe"},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid read flag: must be true or false\n",
+			},
+		},
+		"invalid cursor": {
+			given: Given{query: "?cursor=!!!"},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid cursor: cursor encoding invalid\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.Anything).
+						Return(nil, domain.PageInfo{}, errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list messages: db error\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockMessageRepo)
+			}
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/messages", nil)
+			req.URL.RawQuery = strings.TrimPrefix(tt.given.query, "?")
+			f.messageHandler.List(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			if tt.expected.code == http.StatusOK {
+				assert.JSONEq(t, tt.expected.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+
+			f.mockMessageRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageServiceHandler_Get(t *testing.T) {
+	message := newTestMessage()
+
+	tests := map[string]struct {
+		message *domain.Message
+		repoErr error
+		code    int
+		body    string
+	}{
+		"success": {
+			message: &message,
+			code:    http.StatusOK,
+			body:    testMessageJSON,
+		},
+		"not found": {
+			repoErr: errors.Join(errors.New("failed to get message"), pgx.ErrNoRows),
+			code:    http.StatusNotFound,
+			body:    "Message not found\n",
+		},
+		"repository error": {
+			repoErr: errors.New("db error"),
+			code:    http.StatusInternalServerError,
+			body:    "Failed to get message: db error\n",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageHandlerTestFixture(t)
+			f.mockMessageRepo.EXPECT().Get(mock.Anything, "message-1").Return(tt.message, tt.repoErr)
+
+			w := httptest.NewRecorder()
+			f.messageHandler.Get(w, httptest.NewRequest(http.MethodGet, "/message/message-1", nil), "message-1")
+
+			assert.Equal(t, tt.code, w.Code)
+			if tt.code == http.StatusOK {
+				assert.JSONEq(t, tt.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.body, w.Body.String())
+			}
+
+			f.mockMessageRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageServiceHandler_Update(t *testing.T) {
+	read := newTestMessage()
+	read.ReadAt = &messageTestNow
+	yes, no := true, false
+
+	type Given struct {
+		body     string
+		mockRepo func(m *mockRepo.MockMessageRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"mark read": {
+			given: Given{
+				body: `{"read":true}`,
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						Update(mock.Anything, "message-1", domain.MessageUpdate{Read: &yes}).
+						Return(&read, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: strings.Replace(testMessageJSON, `"created_at"`, `"read_at":"2026-02-10T09:00:00Z","created_at"`, 1),
+			},
+		},
+		"unarchive": {
+			given: Given{
+				body: `{"archived":false}`,
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						Update(mock.Anything, "message-1", domain.MessageUpdate{Archived: &no}).
+						Return(&read, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: strings.Replace(testMessageJSON, `"created_at"`, `"read_at":"2026-02-10T09:00:00Z","created_at"`, 1),
+			},
+		},
+		"nothing to change": {
+			given: Given{body: `{}`},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid message payload: read or archived missing\n",
+			},
+		},
+		"invalid json": {
+			given: Given{body: `{"read":`},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid JSON in request body\n",
+			},
+		},
+		"not found": {
+			given: Given{
+				body: `{"read":true}`,
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						Update(mock.Anything, "message-1", mock.Anything).
+						Return(nil, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Message not found\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				body: `{"read":true}`,
+				mockRepo: func(m *mockRepo.MockMessageRepository) {
+					m.EXPECT().
+						Update(mock.Anything, "message-1", mock.Anything).
+						Return(nil, errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to update message: db error\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockMessageRepo)
+			}
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPatch, "/message/message-1", strings.NewReader(tt.given.body))
+			f.messageHandler.Update(w, req, "message-1")
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			if tt.expected.code == http.StatusOK {
+				assert.JSONEq(t, tt.expected.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+
+			f.mockMessageRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageServiceHandler_ServeHTTP(t *testing.T) {
+	message := newTestMessage()
+
+	tests := map[string]struct {
+		method   string
+		path     string
+		body     string
+		mockRepo func(m *mockRepo.MockMessageRepository)
+		code     int
+	}{
+		"list": {
+			method: http.MethodGet,
+			path:   "/messages/",
+			mockRepo: func(m *mockRepo.MockMessageRepository) {
+				m.EXPECT().List(mock.Anything, mock.Anything).Return([]domain.Message{}, domain.PageInfo{}, nil)
+			},
+			code: http.StatusOK,
+		},
+		"get": {
+			method: http.MethodGet,
+			path:   "/message/message-1",
+			mockRepo: func(m *mockRepo.MockMessageRepository) {
+				m.EXPECT().Get(mock.Anything, "message-1").Return(&message, nil)
+			},
+			code: http.StatusOK,
+		},
+		"update": {
+			method: http.MethodPatch,
+			path:   "/message/message-1",
+			body:   `{"archived":true}`,
+			mockRepo: func(m *mockRepo.MockMessageRepository) {
+				m.EXPECT().Update(mock.Anything, "message-1", mock.Anything).Return(&message, nil)
+			},
+			code: http.StatusOK,
+		},
+		"collection method not allowed": {
+			method: http.MethodPost,
+			path:   "/messages",
+			code:   http.StatusMethodNotAllowed,
+		},
+		"item method not allowed": {
+			method: http.MethodDelete,
+			path:   "/message/message-1",
+			code:   http.StatusMethodNotAllowed,
+		},
+		"missing id": {
+			method: http.MethodGet,
+			path:   "/message/",
+			code:   http.StatusNotFound,
+		},
+		"nested path": {
+			method: http.MethodGet,
+			path:   "/message/message-1/extra",
+			code:   http.StatusNotFound,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageHandlerTestFixture(t)
+
+			if tt.mockRepo != nil {
+				tt.mockRepo(f.mockMessageRepo)
+			}
+
+			w := httptest.NewRecorder()
+			f.messageHandler.ServeHTTP(w, httptest.NewRequest(tt.method, tt.path, strings.NewReader(tt.body)))
+
+			assert.Equal(t, tt.code, w.Code)
+			f.mockMessageRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -37,6 +37,98 @@ func (_m *MockAnalyticsHandler) EXPECT() *MockAnalyticsHandler_Expecter {
 	return &MockAnalyticsHandler_Expecter{mock: &_m.Mock}
 }
 
+// Event provides a mock function for the type MockAnalyticsHandler
+func (_mock *MockAnalyticsHandler) Event(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockAnalyticsHandler_Event_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Event'
+type MockAnalyticsHandler_Event_Call struct {
+	*mock.Call
+}
+
+// Event is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockAnalyticsHandler_Expecter) Event(w interface{}, r interface{}) *MockAnalyticsHandler_Event_Call {
+	return &MockAnalyticsHandler_Event_Call{Call: _e.mock.On("Event", w, r)}
+}
+
+func (_c *MockAnalyticsHandler_Event_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Event_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Event_Call) Return() *MockAnalyticsHandler_Event_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Event_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Event_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Events provides a mock function for the type MockAnalyticsHandler
+func (_mock *MockAnalyticsHandler) Events(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockAnalyticsHandler_Events_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Events'
+type MockAnalyticsHandler_Events_Call struct {
+	*mock.Call
+}
+
+// Events is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockAnalyticsHandler_Expecter) Events(w interface{}, r interface{}) *MockAnalyticsHandler_Events_Call {
+	return &MockAnalyticsHandler_Events_Call{Call: _e.mock.On("Events", w, r)}
+}
+
+func (_c *MockAnalyticsHandler_Events_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Events_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Events_Call) Return() *MockAnalyticsHandler_Events_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Events_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Events_Call {
+	_c.Run(run)
+	return _c
+}
+
 // PageView provides a mock function for the type MockAnalyticsHandler
 func (_mock *MockAnalyticsHandler) PageView(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
@@ -128,3 +220,95 @@ func (_c *MockAnalyticsHandler_ServeHTTP_Call) RunAndReturn(run func(responseWri
 	_c.Run(run)
 	return _c
 }
+
+// Summary provides a mock function for the type MockAnalyticsHandler
+func (_mock *MockAnalyticsHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockAnalyticsHandler_Summary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summary'
+type MockAnalyticsHandler_Summary_Call struct {
+	*mock.Call
+}
+
+// Summary is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockAnalyticsHandler_Expecter) Summary(w interface{}, r interface{}) *MockAnalyticsHandler_Summary_Call {
+	return &MockAnalyticsHandler_Summary_Call{Call: _e.mock.On("Summary", w, r)}
+}
+
+func (_c *MockAnalyticsHandler_Summary_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Summary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Summary_Call) Return() *MockAnalyticsHandler_Summary_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Summary_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Summary_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Timeseries provides a mock function for the type MockAnalyticsHandler
+func (_mock *MockAnalyticsHandler) Timeseries(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockAnalyticsHandler_Timeseries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeseries'
+type MockAnalyticsHandler_Timeseries_Call struct {
+	*mock.Call
+}
+
+// Timeseries is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockAnalyticsHandler_Expecter) Timeseries(w interface{}, r interface{}) *MockAnalyticsHandler_Timeseries_Call {
+	return &MockAnalyticsHandler_Timeseries_Call{Call: _e.mock.On("Timeseries", w, r)}
+}
+
+func (_c *MockAnalyticsHandler_Timeseries_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Timeseries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Timeseries_Call) Return() *MockAnalyticsHandler_Timeseries_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockAnalyticsHandler_Timeseries_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockAnalyticsHandler_Timeseries_Call {
+	_c.Run(run)
+	return _c
+}
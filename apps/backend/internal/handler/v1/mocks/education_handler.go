@@ -233,6 +233,58 @@ func (_c *MockEducationHandler_List_Call) RunAndReturn(run func(w http.ResponseW
 	return _c
 }
 
+// Restore provides a mock function for the type MockEducationHandler
+func (_mock *MockEducationHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	_mock.Called(w, r, id)
+	return
+}
+
+// MockEducationHandler_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockEducationHandler_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+//   - id string
+func (_e *MockEducationHandler_Expecter) Restore(w interface{}, r interface{}, id interface{}) *MockEducationHandler_Restore_Call {
+	return &MockEducationHandler_Restore_Call{Call: _e.mock.On("Restore", w, r, id)}
+}
+
+func (_c *MockEducationHandler_Restore_Call) Run(run func(w http.ResponseWriter, r *http.Request, id string)) *MockEducationHandler_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEducationHandler_Restore_Call) Return() *MockEducationHandler_Restore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEducationHandler_Restore_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, id string)) *MockEducationHandler_Restore_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ServeHTTP provides a mock function for the type MockEducationHandler
 func (_mock *MockEducationHandler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
 	_mock.Called(responseWriter, request)
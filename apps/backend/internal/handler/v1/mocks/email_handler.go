@@ -7,6 +7,7 @@ package v1
 import (
 	"net/http"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -37,6 +38,58 @@ func (_m *MockEmailHandler) EXPECT() *MockEmailHandler_Expecter {
 	return &MockEmailHandler_Expecter{mock: &_m.Mock}
 }
 
+// PreviewTemplate provides a mock function for the type MockEmailHandler
+func (_mock *MockEmailHandler) PreviewTemplate(w http.ResponseWriter, r *http.Request, name domain.EmailTemplate) {
+	_mock.Called(w, r, name)
+	return
+}
+
+// MockEmailHandler_PreviewTemplate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PreviewTemplate'
+type MockEmailHandler_PreviewTemplate_Call struct {
+	*mock.Call
+}
+
+// PreviewTemplate is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+//   - name domain.EmailTemplate
+func (_e *MockEmailHandler_Expecter) PreviewTemplate(w interface{}, r interface{}, name interface{}) *MockEmailHandler_PreviewTemplate_Call {
+	return &MockEmailHandler_PreviewTemplate_Call{Call: _e.mock.On("PreviewTemplate", w, r, name)}
+}
+
+func (_c *MockEmailHandler_PreviewTemplate_Call) Run(run func(w http.ResponseWriter, r *http.Request, name domain.EmailTemplate)) *MockEmailHandler_PreviewTemplate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		var arg2 domain.EmailTemplate
+		if args[2] != nil {
+			arg2 = args[2].(domain.EmailTemplate)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEmailHandler_PreviewTemplate_Call) Return() *MockEmailHandler_PreviewTemplate_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockEmailHandler_PreviewTemplate_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, name domain.EmailTemplate)) *MockEmailHandler_PreviewTemplate_Call {
+	_c.Run(run)
+	return _c
+}
+
 // Send provides a mock function for the type MockEmailHandler
 func (_mock *MockEmailHandler) Send(w http.ResponseWriter, r *http.Request) {
 	_mock.Called(w, r)
@@ -233,6 +233,104 @@ func (_c *MockProjectHandler_List_Call) RunAndReturn(run func(w http.ResponseWri
 	return _c
 }
 
+// ListTags provides a mock function for the type MockProjectHandler
+func (_mock *MockProjectHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	_mock.Called(w, r)
+	return
+}
+
+// MockProjectHandler_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockProjectHandler_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+func (_e *MockProjectHandler_Expecter) ListTags(w interface{}, r interface{}) *MockProjectHandler_ListTags_Call {
+	return &MockProjectHandler_ListTags_Call{Call: _e.mock.On("ListTags", w, r)}
+}
+
+func (_c *MockProjectHandler_ListTags_Call) Run(run func(w http.ResponseWriter, r *http.Request)) *MockProjectHandler_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_ListTags_Call) Return() *MockProjectHandler_ListTags_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProjectHandler_ListTags_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request)) *MockProjectHandler_ListTags_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockProjectHandler
+func (_mock *MockProjectHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	_mock.Called(w, r, id)
+	return
+}
+
+// MockProjectHandler_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockProjectHandler_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+//   - id string
+func (_e *MockProjectHandler_Expecter) Restore(w interface{}, r interface{}, id interface{}) *MockProjectHandler_Restore_Call {
+	return &MockProjectHandler_Restore_Call{Call: _e.mock.On("Restore", w, r, id)}
+}
+
+func (_c *MockProjectHandler_Restore_Call) Run(run func(w http.ResponseWriter, r *http.Request, id string)) *MockProjectHandler_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectHandler_Restore_Call) Return() *MockProjectHandler_Restore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockProjectHandler_Restore_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, id string)) *MockProjectHandler_Restore_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ServeHTTP provides a mock function for the type MockProjectHandler
 func (_mock *MockProjectHandler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
 	_mock.Called(responseWriter, request)
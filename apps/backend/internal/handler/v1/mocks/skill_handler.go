@@ -233,6 +233,162 @@ func (_c *MockSkillHandler_List_Call) RunAndReturn(run func(w http.ResponseWrite
 	return _c
 }
 
+// ListExperiences provides a mock function for the type MockSkillHandler
+func (_mock *MockSkillHandler) ListExperiences(w http.ResponseWriter, r *http.Request, id string) {
+	_mock.Called(w, r, id)
+	return
+}
+
+// MockSkillHandler_ListExperiences_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExperiences'
+type MockSkillHandler_ListExperiences_Call struct {
+	*mock.Call
+}
+
+// ListExperiences is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+//   - id string
+func (_e *MockSkillHandler_Expecter) ListExperiences(w interface{}, r interface{}, id interface{}) *MockSkillHandler_ListExperiences_Call {
+	return &MockSkillHandler_ListExperiences_Call{Call: _e.mock.On("ListExperiences", w, r, id)}
+}
+
+func (_c *MockSkillHandler_ListExperiences_Call) Run(run func(w http.ResponseWriter, r *http.Request, id string)) *MockSkillHandler_ListExperiences_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillHandler_ListExperiences_Call) Return() *MockSkillHandler_ListExperiences_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSkillHandler_ListExperiences_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, id string)) *MockSkillHandler_ListExperiences_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListProjects provides a mock function for the type MockSkillHandler
+func (_mock *MockSkillHandler) ListProjects(w http.ResponseWriter, r *http.Request, id string) {
+	_mock.Called(w, r, id)
+	return
+}
+
+// MockSkillHandler_ListProjects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProjects'
+type MockSkillHandler_ListProjects_Call struct {
+	*mock.Call
+}
+
+// ListProjects is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+//   - id string
+func (_e *MockSkillHandler_Expecter) ListProjects(w interface{}, r interface{}, id interface{}) *MockSkillHandler_ListProjects_Call {
+	return &MockSkillHandler_ListProjects_Call{Call: _e.mock.On("ListProjects", w, r, id)}
+}
+
+func (_c *MockSkillHandler_ListProjects_Call) Run(run func(w http.ResponseWriter, r *http.Request, id string)) *MockSkillHandler_ListProjects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillHandler_ListProjects_Call) Return() *MockSkillHandler_ListProjects_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSkillHandler_ListProjects_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, id string)) *MockSkillHandler_ListProjects_Call {
+	_c.Run(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockSkillHandler
+func (_mock *MockSkillHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	_mock.Called(w, r, id)
+	return
+}
+
+// MockSkillHandler_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockSkillHandler_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - w http.ResponseWriter
+//   - r *http.Request
+//   - id string
+func (_e *MockSkillHandler_Expecter) Restore(w interface{}, r interface{}, id interface{}) *MockSkillHandler_Restore_Call {
+	return &MockSkillHandler_Restore_Call{Call: _e.mock.On("Restore", w, r, id)}
+}
+
+func (_c *MockSkillHandler_Restore_Call) Run(run func(w http.ResponseWriter, r *http.Request, id string)) *MockSkillHandler_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 http.ResponseWriter
+		if args[0] != nil {
+			arg0 = args[0].(http.ResponseWriter)
+		}
+		var arg1 *http.Request
+		if args[1] != nil {
+			arg1 = args[1].(*http.Request)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillHandler_Restore_Call) Return() *MockSkillHandler_Restore_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockSkillHandler_Restore_Call) RunAndReturn(run func(w http.ResponseWriter, r *http.Request, id string)) *MockSkillHandler_Restore_Call {
+	_c.Run(run)
+	return _c
+}
+
 // ServeHTTP provides a mock function for the type MockSkillHandler
 func (_mock *MockSkillHandler) ServeHTTP(responseWriter http.ResponseWriter, request *http.Request) {
 	_mock.Called(responseWriter, request)
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+)
+
+// parseCursor decodes the optional cursor query parameter of a list request.
+// An empty cursor returns nil. Keyset pagination walks (created_at, id), so a
+// cursor combined with any sort other than created_at is rejected.
+func parseCursor(cursor, sortBy string) (*domain.Cursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	if sortBy != "" && domain.SortBy(sortBy) != domain.CreatedAt {
+		return nil, errors.New("cursor requires sort_by=created_at")
+	}
+
+	return domain.DecodeCursor(cursor)
+}
+
+// toPageMetaDTO maps repository pagination metadata to its response form.
+func toPageMetaDTO(info domain.PageInfo) dto.PageMetaDTO {
+	return dto.PageMetaDTO{
+		Total:      info.Total,
+		Page:       info.Page,
+		PageSize:   info.PageSize,
+		HasNext:    info.HasNext,
+		NextCursor: info.NextCursor,
+	}
+}
@@ -2,8 +2,10 @@ package v1
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -13,6 +15,7 @@ import (
 	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
 	"github.com/fingertips18/fingertips18.github.io/backend/pkg/metadata"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -22,29 +25,41 @@ type ProjectHandler interface {
 	Get(w http.ResponseWriter, r *http.Request, id string)
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request, id string)
+	Restore(w http.ResponseWriter, r *http.Request, id string)
 	List(w http.ResponseWriter, r *http.Request)
+	ListTags(w http.ResponseWriter, r *http.Request)
 }
 
 type ProjectServiceConfig struct {
-	DatabaseAPI database.DatabaseAPI
-	BlurHashAPI metadata.BlurHashAPI
-
-	projectRepo v1.ProjectRepository
-	fileRepo    v1.FileRepository
+	DatabaseAPI     database.DatabaseAPI
+	RepositoryCache *v1.RepositoryCache
+	BlurHashAPI     metadata.BlurHashAPI
+
+	projectRepo   v1.ProjectRepository
+	fileRepo      v1.FileRepository
+	educationRepo v1.EducationRepository
+	skillRepo     v1.SkillRepository
 }
 
 type projectServiceHandler struct {
-	blurHashAPI metadata.BlurHashAPI
-	projectRepo v1.ProjectRepository
-	fileRepo    v1.FileRepository
+	databaseAPI   database.DatabaseAPI
+	blurHashAPI   metadata.BlurHashAPI
+	projectRepo   v1.ProjectRepository
+	fileRepo      v1.FileRepository
+	educationRepo v1.EducationRepository
+	skillRepo     v1.SkillRepository
 }
 
 // NewProjectServiceHandler creates and returns a new instance of ProjectService.
 // It accepts a ProjectServiceConfig, which may include a custom project repository.
 // If no repository is provided in the config, it initializes a default ProjectRepository
-// using the provided connection string and a default table name.
+// using the provided connection string and a default table name. Default repositories
+// are wrapped so that their mutations are recorded in the audit log, and the project,
+// education and skill ones read through cfg.RepositoryCache when it is set.
 // Returns a ProjectService implementation.
 func NewProjectServiceHandler(cfg ProjectServiceConfig) ProjectHandler {
+	audited := newAuditedRepositoryConfig(cfg.DatabaseAPI)
+
 	blurHashAPI := cfg.BlurHashAPI
 	if blurHashAPI == nil {
 		blurHashAPI = metadata.NewBlurHashAPI()
@@ -52,29 +67,76 @@ func NewProjectServiceHandler(cfg ProjectServiceConfig) ProjectHandler {
 
 	projectRepo := cfg.projectRepo
 	if projectRepo == nil {
-		projectRepo = v1.NewProjectRepository(
-			v1.ProjectRepositoryConfig{
-				DatabaseAPI:  cfg.DatabaseAPI,
-				BlurHashAPI:  blurHashAPI,
-				ProjectTable: "Project",
-			},
+		projectRepo = v1.NewCachedProjectRepository(
+			v1.NewAuditedProjectRepository(
+				v1.NewProjectRepository(
+					v1.ProjectRepositoryConfig{
+						DatabaseAPI:       cfg.DatabaseAPI,
+						BlurHashAPI:       blurHashAPI,
+						ProjectTable:      "Project",
+						ProjectSkillTable: "project_skill",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
 		)
 	}
 
 	fileRepo := cfg.fileRepo
 	if fileRepo == nil {
-		fileRepo = v1.NewFileRepository(
-			v1.FileRepositoryConfig{
-				DatabaseAPI: cfg.DatabaseAPI,
-				FileTable:   "File",
-			},
+		fileRepo = v1.NewAuditedFileRepository(
+			v1.NewFileRepository(
+				v1.FileRepositoryConfig{
+					DatabaseAPI: cfg.DatabaseAPI,
+					FileTable:   "File",
+				},
+			),
+			audited,
+		)
+	}
+
+	educationRepo := cfg.educationRepo
+	if educationRepo == nil {
+		educationRepo = v1.NewCachedEducationRepository(
+			v1.NewAuditedEducationRepository(
+				v1.NewEducationRepository(
+					v1.EducationRepositoryConfig{
+						DatabaseAPI:    cfg.DatabaseAPI,
+						EducationTable: "Education",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	skillRepo := cfg.skillRepo
+	if skillRepo == nil {
+		skillRepo = v1.NewCachedSkillRepository(
+			v1.NewAuditedSkillRepository(
+				v1.NewSkillRepository(
+					v1.SkillRepositoryConfig{
+						DatabaseAPI:          cfg.DatabaseAPI,
+						SkillTable:           "Skill",
+						ProjectSkillTable:    "project_skill",
+						ExperienceSkillTable: "experience_skill",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
 		)
 	}
 
 	return &projectServiceHandler{
-		blurHashAPI: blurHashAPI,
-		projectRepo: projectRepo,
-		fileRepo:    fileRepo,
+		databaseAPI:   cfg.DatabaseAPI,
+		blurHashAPI:   blurHashAPI,
+		projectRepo:   projectRepo,
+		fileRepo:      fileRepo,
+		educationRepo: educationRepo,
+		skillRepo:     skillRepo,
 	}
 }
 
@@ -82,10 +144,12 @@ func NewProjectServiceHandler(cfg ProjectServiceConfig) ProjectHandler {
 //
 // It supports the following routes:
 //   - GET    /projects         : List all projects.
+//   - GET    /projects/tags    : List distinct project tags with usage counts.
 //   - POST   /project          : Create a new project.
 //   - PUT    /project          : Update an existing project.
 //   - GET    /project/{id}     : Retrieve a project by its ID.
-//   - DELETE /project/{id}     : Delete a project by its ID.
+//   - DELETE /project/{id}     : Move a project to the trash by its ID.
+//   - POST   /project/{id}/restore : Restore a trashed project.
 //
 // For unsupported methods or unknown routes, it responds with appropriate HTTP error codes.
 func (h *projectServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -102,6 +166,15 @@ func (h *projectServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		h.List(w, r)
 		return
 
+	// GET /projects/tags
+	case path == "/projects/tags":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.ListTags(w, r)
+		return
+
 	// POST / PUT /project
 	case path == "/project":
 		switch r.Method {
@@ -114,6 +187,23 @@ func (h *projectServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		}
 		return
 
+	// POST /project/{id}/restore
+	case strings.HasPrefix(path, "/project/") && strings.HasSuffix(strings.TrimPrefix(path, "/project/"), "/restore"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/project/"), "/restore")
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "Project ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.Restore(w, r, id)
+		return
+
 	// GET / DELETE /project/{id}
 	case strings.HasPrefix(path, "/project/"):
 		id := strings.TrimPrefix(path, "/project/")
@@ -149,6 +239,7 @@ func (h *projectServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 // It expects a JSON payload in the request body representing a project.
 // On success, it responds with a JSON object containing the new project's ID and a status message.
 // If the request method is not POST, the JSON is invalid, or project creation fails, it responds with an appropriate HTTP error.
+// The project, its preview file records and its skill links are inserted in a single transaction, so a failure leaves nothing behind.
 //
 // @Security ApiKeyAuth
 // @Summary Create a project
@@ -192,34 +283,52 @@ func (h *projectServiceHandler) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id, err := h.projectRepo.Create(r.Context(), &project)
-	if err != nil {
-		http.Error(w, "Failed to create project: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-
-	createdAny := false
-	for _, preview := range createReq.Previews {
-		preview := &domain.File{
-			ParentTable: "project",
-			ParentID:    id,
-			Role:        domain.FileRole(preview.Role),
-			Name:        preview.Name,
-			URL:         preview.URL,
-			Type:        preview.Type,
-			Size:        preview.Size,
+	// Insert the project and its preview files in one transaction so a failed
+	// file insert never leaves an orphaned project row behind.
+	var id string
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.validateEducationLink(r.Context(), tx, project.EducationID); err != nil {
+			return err
+		}
+		if err := h.validateSkillLinks(r.Context(), tx, createReq.SkillIDs); err != nil {
+			return err
 		}
 
-		_, err := h.fileRepo.Create(r.Context(), *preview)
+		projectRepo := h.projectRepo.WithTx(tx)
+		projectID, err := projectRepo.Create(r.Context(), &project)
 		if err != nil {
-			if createdAny {
-				_ = h.fileRepo.DeleteByParent(r.Context(), "project", id)
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to create project: ", err: err}
+		}
+
+		if len(createReq.SkillIDs) > 0 {
+			if err := projectRepo.SetSkills(r.Context(), projectID, createReq.SkillIDs); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to link project skills: ", err: err}
 			}
-			_ = h.projectRepo.Delete(r.Context(), id)
-			http.Error(w, "Failed to create file record: "+err.Error(), http.StatusInternalServerError)
-			return
 		}
-		createdAny = true
+
+		fileRepo := h.fileRepo.WithTx(tx)
+		for _, preview := range createReq.Previews {
+			preview := &domain.File{
+				ParentTable: "project",
+				ParentID:    projectID,
+				Role:        domain.FileRole(preview.Role),
+				Name:        preview.Name,
+				URL:         preview.URL,
+				Type:        preview.Type,
+				Size:        preview.Size,
+			}
+
+			if _, err := fileRepo.Create(r.Context(), *preview); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to create file record: ", err: err}
+			}
+		}
+
+		id = projectID
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
 	}
 
 	resp := IDResponse{Id: id}
@@ -241,7 +350,6 @@ func (h *projectServiceHandler) Create(w http.ResponseWriter, r *http.Request) {
 // If there is an error decoding the request, retrieving the project, or encoding the response,
 // it responds with the appropriate HTTP error status and message.
 //
-// @Security ApiKeyAuth
 // @Summary Get a project by ID
 // @Description Retrieves the details of a specific project using its unique ID.
 // @Tags project
@@ -280,6 +388,13 @@ func (h *projectServiceHandler) Get(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	// Get linked skills
+	skillsByProject, err := h.skillRepo.ListByProjectIDs(r.Context(), []string{id})
+	if err != nil {
+		http.Error(w, "Failed to fetch project skills: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	// Convert to response DTO
 	previewResponses := make([]dto.FileDTO, 0, len(previews))
 	for _, file := range previews {
@@ -307,6 +422,7 @@ func (h *projectServiceHandler) Get(w http.ResponseWriter, r *http.Request, id s
 		Type:        string(project.Type),
 		Link:        project.Link,
 		EducationID: project.EducationID,
+		Skills:      toSkillDTOs(skillsByProject[id]),
 		Previews:    previewResponses,
 		CreatedAt:   project.CreatedAt,
 		UpdatedAt:   project.UpdatedAt,
@@ -318,6 +434,7 @@ func (h *projectServiceHandler) Get(w http.ResponseWriter, r *http.Request, id s
 		return
 	}
 
+	w.Header().Set("ETag", etag(project.UpdatedAt))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
@@ -328,7 +445,11 @@ func (h *projectServiceHandler) Get(w http.ResponseWriter, r *http.Request, id s
 // and attempts to update the project in the repository. If successful,
 // it responds with the updated project and a status message in JSON format.
 // Returns appropriate HTTP error responses for invalid methods, bad JSON,
-// update failures, or response encoding errors.
+// update failures, or response encoding errors. The project row, its preview
+// file records and, when skill_ids is present, its skill links are updated in a
+// single transaction; omitting skill_ids leaves the existing links untouched.
+// The If-Match header must carry the ETag returned by GET /project/{id} (or "*");
+// an edit based on a stale version is rejected with 412 Precondition Failed.
 //
 // @Security ApiKeyAuth
 // @Summary Update a project
@@ -336,10 +457,13 @@ func (h *projectServiceHandler) Get(w http.ResponseWriter, r *http.Request, id s
 // @Tags project
 // @Accept json
 // @Produce json
+// @Param If-Match header string true "ETag of the version being edited, or *"
 // @Param project body dto.ProjectDTO true "Project payload with ID"
 // @Success 200 {object} dto.ProjectDTO
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /project [put]
 func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -348,6 +472,11 @@ func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
+
 	defer r.Body.Close()
 
 	var updateReq dto.ProjectDTO
@@ -367,7 +496,7 @@ func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Link:        updateReq.Link,
 		EducationID: updateReq.EducationID,
 		CreatedAt:   updateReq.CreatedAt,
-		UpdatedAt:   updateReq.UpdatedAt,
+		UpdatedAt:   version,
 	}
 
 	if err := project.ValidatePayload(h.blurHashAPI); err != nil {
@@ -375,33 +504,60 @@ func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	updatedProject, err := h.projectRepo.Update(r.Context(), &project)
-	if err != nil {
-		http.Error(w, "Failed to update project: "+err.Error(), http.StatusInternalServerError)
-		return
-	}
-	if updatedProject == nil {
-		http.Error(w, "Project not found", http.StatusNotFound)
-		return
-	}
-
-	for _, preview := range updateReq.Previews {
-		prevUpdate := &domain.File{
-			ID:          preview.ID,
-			ParentTable: "project",
-			ParentID:    updatedProject.Id,
-			Role:        domain.FileRole(preview.Role),
-			Name:        preview.Name,
-			URL:         preview.URL,
-			Type:        preview.Type,
-			Size:        preview.Size,
+	// Update the project and its preview files atomically.
+	var updatedProject *domain.Project
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.validateEducationLink(r.Context(), tx, project.EducationID); err != nil {
+			return err
+		}
+		if err := h.validateSkillLinks(r.Context(), tx, updateReq.SkillIDs); err != nil {
+			return err
 		}
 
-		_, err := h.fileRepo.Update(r.Context(), *prevUpdate)
+		projectRepo := h.projectRepo.WithTx(tx)
+		updated, err := projectRepo.Update(r.Context(), &project)
 		if err != nil {
-			http.Error(w, "Failed to update file record: "+err.Error(), http.StatusInternalServerError)
-			return
+			var conflict *domain.ConflictError
+			if errors.As(err, &conflict) {
+				w.Header().Set("ETag", etag(conflict.Current))
+				return &txError{status: http.StatusPreconditionFailed, msg: "Project was modified by another request: ", err: err}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to update project: ", err: err}
 		}
+		if updated == nil {
+			return &txError{status: http.StatusNotFound, msg: "Project not found"}
+		}
+
+		if updateReq.SkillIDs != nil {
+			if err := projectRepo.SetSkills(r.Context(), updated.Id, updateReq.SkillIDs); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to link project skills: ", err: err}
+			}
+		}
+
+		fileRepo := h.fileRepo.WithTx(tx)
+		for _, preview := range updateReq.Previews {
+			prevUpdate := &domain.File{
+				ID:          preview.ID,
+				ParentTable: "project",
+				ParentID:    updated.Id,
+				Role:        domain.FileRole(preview.Role),
+				Name:        preview.Name,
+				URL:         preview.URL,
+				Type:        preview.Type,
+				Size:        preview.Size,
+			}
+
+			if _, err := fileRepo.Update(r.Context(), *prevUpdate); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to update file record: ", err: err}
+			}
+		}
+
+		updatedProject = updated
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
 	}
 
 	// Reload previews from database to get fresh timestamps
@@ -411,6 +567,12 @@ func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	skillsByProject, err := h.skillRepo.ListByProjectIDs(r.Context(), []string{updatedProject.Id})
+	if err != nil {
+		http.Error(w, "Failed to reload project skills: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
 	// Transform previews to DTOs
 	previewDTOs := make([]dto.FileDTO, 0, len(previews))
 	for _, preview := range previews {
@@ -438,6 +600,7 @@ func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		Type:        string(updatedProject.Type),
 		Link:        updatedProject.Link,
 		EducationID: updatedProject.EducationID,
+		Skills:      toSkillDTOs(skillsByProject[updatedProject.Id]),
 		Previews:    previewDTOs,
 		CreatedAt:   updatedProject.CreatedAt,
 		UpdatedAt:   updatedProject.UpdatedAt,
@@ -449,20 +612,23 @@ func (h *projectServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("ETag", etag(updatedProject.UpdatedAt))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
 }
 
-// Delete handles HTTP DELETE requests to remove a project by its ID.
-// It expects a JSON body containing the "id" field. If the request method is not DELETE,
-// it responds with "405 Method Not Allowed". On successful deletion, it returns a JSON
-// response with status "ok". If an error occurs during decoding, deletion, or response
-// encoding, it responds with the appropriate HTTP error status and message.
+// Delete handles HTTP DELETE requests to move a project to the trash by its ID.
+// If the request method is not DELETE, it responds with "405 Method Not Allowed".
+// The project is soft-deleted rather than removed: it disappears from every read
+// until it is restored through POST /project/{id}/restore or purged through the
+// trash. Its file records are trashed in the same transaction so they follow the
+// project. On success it responds with 204 No Content; an unknown or already
+// trashed project yields 404 Not Found.
 //
 // @Security ApiKeyAuth
 // @Summary Delete a project
-// @Description Deletes an existing project by its unique ID provided in the path.
+// @Description Moves an existing project, identified by the ID in the path, to the trash.
 // @Tags project
 // @Param id path string true "Project ID"
 // @Success 204 "No Content"
@@ -476,34 +642,83 @@ func (h *projectServiceHandler) Delete(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
-	// Delete associated files FIRST to prevent orphans
-	err := h.fileRepo.DeleteByParent(r.Context(), "project", id)
+	// Trash the project together with its files so neither outlives the other
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.fileRepo.WithTx(tx).TrashByParent(r.Context(), "project", id); err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to delete project files: ", err: err}
+		}
+
+		if err := h.projectRepo.WithTx(tx).Delete(r.Context(), id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Project not found"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to delete project: ", err: err}
+		}
+
+		return nil
+	})
 	if err != nil {
-		http.Error(w, "Failed to delete project files: "+err.Error(), http.StatusInternalServerError)
+		writeTxError(w, err)
 		return
 	}
 
-	// Then delete the project
-	err = h.projectRepo.Delete(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			http.Error(w, "Project not found", http.StatusNotFound)
-			return
+	// Successful delete → 204 No Content
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Restore handles HTTP POST requests to take a project out of the trash.
+// The project and the file records trashed with it are restored in a single
+// transaction. On success it responds with 204 No Content; if the project is not
+// in the trash it responds with 404 Not Found.
+//
+// @Security ApiKeyAuth
+// @Summary Restore a project
+// @Description Restores a trashed project, identified by the ID in the path, together with its files.
+// @Tags project
+// @Param id path string true "Project ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /project/{id}/restore [post]
+func (h *projectServiceHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		if err := h.projectRepo.WithTx(tx).Restore(r.Context(), id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Project not found in trash"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to restore project: ", err: err}
 		}
-		http.Error(w, "Failed to delete project: "+err.Error(), http.StatusInternalServerError)
+
+		if err := h.fileRepo.WithTx(tx).RestoreByParent(r.Context(), "project", id); err != nil {
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to restore project files: ", err: err}
+		}
+
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
 		return
 	}
 
-	// Successful delete → 204 No Content
 	w.WriteHeader(http.StatusNoContent)
 }
 
 // List handles HTTP GET requests to retrieve a list of projects based on the provided filter criteria.
-// It expects a JSON-encoded ProjectFilterRequest in the request body, decodes it, and queries the project repository.
-// On success, it responds with a JSON object containing the list of projects and a status message.
+// It reads a ProjectFilterRequest from the query string and queries the project repository.
+// Tags may be given comma-separated or as repeated tags parameters; tag_match selects whether a
+// project needs any (default) or all of them.
+// On success, it responds with a ProjectListResponse envelope holding the page of projects together with
+// the total count, page, page size, has_next flag and, when available, a next_cursor for keyset pagination.
 // If the request method is not GET, the JSON is invalid, or an error occurs during processing, it returns an appropriate HTTP error response.
+// Responses carry an ETag and Last-Modified derived from the newest write to projects, skills and
+// project previews; a request whose If-None-Match or If-Modified-Since is still current gets 304 Not Modified.
 //
-// @Security ApiKeyAuth
 // @Summary List projects
 // @Description Retrieves a paginated list of projects with optional filtering and sorting.
 // @Tags project
@@ -514,7 +729,14 @@ func (h *projectServiceHandler) Delete(w http.ResponseWriter, r *http.Request, i
 // @Param sort_by query string false "Field to sort by" Enums(created_at, updated_at)
 // @Param sort_ascending query bool false "Sort ascending order"
 // @Param type query string false "Filter by project type" Enums(web, mobile, game)
-// @Success 200 {array} dto.ProjectDTO
+// @Param education_id query string false "Filter by linked education ID"
+// @Param tags query string false "Comma-separated tags to filter by (at most 10)"
+// @Param tag_match query string false "Whether a project must have any or all of the tags (default any)" Enums(any, all)
+// @Param cursor query string false "Keyset cursor from a previous next_cursor; requires sort_by=created_at"
+// @Param If-None-Match header string false "ETag from a previous response; answered with 304 while it is current"
+// @Param If-Modified-Since header string false "Last-Modified from a previous response; ignored when If-None-Match is sent"
+// @Success 200 {object} dto.ProjectListResponse
+// @Success 304 "Not Modified"
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /projects [get]
@@ -538,6 +760,10 @@ func (h *projectServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		SortBy:        sortBy,
 		SortAscending: utils.GetQueryBool(q, "sort_ascending", false),
 		Type:          q.Get("type"),
+		EducationID:   q.Get("education_id"),
+		Tags:          parseTags(q["tags"]),
+		TagMatch:      q.Get("tag_match"),
+		Cursor:        q.Get("cursor"),
 	}
 
 	// Clamp page to minimum of 1
@@ -565,6 +791,37 @@ func (h *projectServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var educationID *string
+	if filter.EducationID != "" {
+		if _, err := uuid.Parse(filter.EducationID); err != nil {
+			http.Error(w, "invalid education id", http.StatusBadRequest)
+			return
+		}
+		educationID = &filter.EducationID
+	}
+
+	if len(filter.Tags) > maxTagFilters {
+		http.Error(w, fmt.Sprintf("too many tags: at most %d allowed", maxTagFilters), http.StatusBadRequest)
+		return
+	}
+
+	tagMatch := domain.TagMatchAny
+	if filter.TagMatch != "" {
+		tagMatch = domain.TagMatch(filter.TagMatch)
+		switch tagMatch {
+		case domain.TagMatchAny, domain.TagMatchAll:
+		default:
+			http.Error(w, "invalid tag match", http.StatusBadRequest)
+			return
+		}
+	}
+
+	cursor, err := parseCursor(filter.Cursor, filter.SortBy)
+	if err != nil {
+		http.Error(w, "invalid cursor: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	var sortByPtr *domain.SortBy
 	if filter.SortBy != "" {
 		sb := domain.SortBy(filter.SortBy)
@@ -576,22 +833,55 @@ func (h *projectServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		SortBy:        sortByPtr,
 		SortAscending: filter.SortAscending,
 		Type:          projectType,
+		EducationID:   educationID,
+		Tags:          filter.Tags,
+		TagMatch:      tagMatch,
+		Cursor:        cursor,
+	}
+
+	// Projects embed their previews and skills, so any of the three tables
+	// changing makes a new version of the list.
+	version, err := collectionVersion(r.Context(), h.projectRepo.Version, h.skillRepo.Version, fileVersion(h.fileRepo, "project"))
+	if err != nil {
+		http.Error(w, "Failed to check projects version: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if notModified(w, r, version) {
+		return
 	}
 
-	projects, err := h.projectRepo.List(r.Context(), domainFilter)
+	projects, pageInfo, err := h.projectRepo.List(r.Context(), domainFilter)
 	if err != nil {
 		http.Error(w, "Failed to list project: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
-	projectDTOs := make([]dto.ProjectDTO, 0, len(projects))
-	for _, project := range projects {
-		previews, err := h.fileRepo.FindByParent(r.Context(), "project", project.Id, domain.Image)
+	// Batch fetch previews for all projects
+	projectIDs := make([]string, len(projects))
+	for i, p := range projects {
+		projectIDs[i] = p.Id
+	}
+
+	previewsByProject := make(map[string][]domain.File)
+	skillsByProject := make(map[string][]domain.Skill)
+	if len(projectIDs) > 0 {
+		previewsByProject, err = h.fileRepo.FindByParents(r.Context(), "project", projectIDs, domain.Image)
 		if err != nil {
 			http.Error(w, "Failed to retrieve previews: "+err.Error(), http.StatusInternalServerError)
 			return
 		}
 
+		skillsByProject, err = h.skillRepo.ListByProjectIDs(r.Context(), projectIDs)
+		if err != nil {
+			http.Error(w, "Failed to retrieve project skills: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	projectDTOs := make([]dto.ProjectDTO, 0, len(projects))
+	for _, project := range projects {
+		previews := previewsByProject[project.Id]
+
 		previewDTOs := make([]dto.FileDTO, 0, len(previews))
 		for _, preview := range previews {
 			previewDTOs = append(previewDTOs, dto.FileDTO{
@@ -619,13 +909,19 @@ func (h *projectServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 			Link:        project.Link,
 			Previews:    previewDTOs,
 			EducationID: project.EducationID,
+			Skills:      toSkillDTOs(skillsByProject[project.Id]),
 			CreatedAt:   project.CreatedAt,
 			UpdatedAt:   project.UpdatedAt,
 		})
 	}
 
+	resp := dto.ProjectListResponse{
+		Items:       projectDTOs,
+		PageMetaDTO: toPageMetaDTO(pageInfo),
+	}
+
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(projectDTOs); err != nil {
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
 		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -634,3 +930,111 @@ func (h *projectServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
 }
+
+// ListTags handles HTTP GET requests for the tag catalog: every distinct tag used by a
+// project together with the number of projects carrying it, most used first.
+// If the request method is not GET or the query fails, it returns an appropriate HTTP error response.
+//
+// @Summary List project tags
+// @Description Retrieves every distinct project tag with its usage count, ordered by count descending.
+// @Tags project
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.ProjectTagDTO
+// @Failure 500 {object} ErrorResponse
+// @Router /projects/tags [get]
+func (h *projectServiceHandler) ListTags(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	tags, err := h.projectRepo.ListTags(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to list project tags: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	tagDTOs := make([]dto.ProjectTagDTO, len(tags))
+	for i, tag := range tags {
+		tagDTOs[i] = dto.ProjectTagDTO{
+			Tag:   tag.Tag,
+			Count: tag.Count,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(tagDTOs); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// maxTagFilters caps how many tags a single list request may filter by.
+const maxTagFilters = 10
+
+// parseTags flattens the tags query values, which may be repeated and/or
+// comma-separated, into a trimmed list without empty or duplicate entries.
+func parseTags(values []string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, value := range values {
+		for _, tag := range strings.Split(value, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "" || seen[tag] {
+				continue
+			}
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// validateEducationLink checks, within tx, that the education a project refers to
+// exists. An empty educationID means the project is not linked and is always valid.
+// A missing education is reported as a 400 so the caller can fix the payload.
+func (h *projectServiceHandler) validateEducationLink(ctx context.Context, tx database.Tx, educationID string) error {
+	if educationID == "" {
+		return nil
+	}
+
+	exists, err := h.educationRepo.WithTx(tx).Exists(ctx, educationID)
+	if err != nil {
+		return &txError{status: http.StatusInternalServerError, msg: "Failed to validate education: ", err: err}
+	}
+	if !exists {
+		return &txError{status: http.StatusBadRequest, msg: "Invalid project payload: education not found"}
+	}
+
+	return nil
+}
+
+// validateSkillLinks checks, within tx, that every skill a project is being linked
+// to exists. Malformed or unknown IDs are reported as a 400 naming the offending
+// IDs so the caller can fix the payload; an empty list is always valid.
+func (h *projectServiceHandler) validateSkillLinks(ctx context.Context, tx database.Tx, skillIDs []string) error {
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	for _, id := range skillIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return &txError{status: http.StatusBadRequest, msg: "Invalid project payload: invalid skill id " + id}
+		}
+	}
+
+	missing, err := h.skillRepo.WithTx(tx).FindMissing(ctx, skillIDs)
+	if err != nil {
+		return &txError{status: http.StatusInternalServerError, msg: "Failed to validate skills: ", err: err}
+	}
+	if len(missing) > 0 {
+		return &txError{status: http.StatusBadRequest, msg: "Invalid project payload: skill not found: " + strings.Join(missing, ", ")}
+	}
+
+	return nil
+}
@@ -2,8 +2,11 @@ package v1
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +14,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	mockDatabase "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
 	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
@@ -21,7 +26,8 @@ import (
 )
 
 const (
-	validBlurHash = "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
+	validBlurHash   = "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
+	testEducationID = "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b"
 )
 
 // Helper to marshal JSON safely
@@ -31,31 +37,65 @@ func toJSON(v any) string {
 }
 
 type projectHandlerTestFixture struct {
-	t               *testing.T
-	mockBlurHashAPI *metadata.MockBlurHashAPI
-	mockProjectRepo *mockRepo.MockProjectRepository
-	mockFileRepo    *mockRepo.MockFileRepository
-	projectHandler  ProjectHandler
+	t                 *testing.T
+	mockDatabaseAPI   *mockDatabase.MockDatabaseAPI
+	mockTx            *mockDatabase.MockTx
+	mockBlurHashAPI   *metadata.MockBlurHashAPI
+	mockProjectRepo   *mockRepo.MockProjectRepository
+	mockFileRepo      *mockRepo.MockFileRepository
+	mockEducationRepo *mockRepo.MockEducationRepository
+	mockSkillRepo     *mockRepo.MockSkillRepository
+	projectHandler    ProjectHandler
 }
 
 func newProjectHandlerTestFixture(t *testing.T) *projectHandlerTestFixture {
+	mockDatabaseAPI := new(mockDatabase.MockDatabaseAPI)
+	mockTx := new(mockDatabase.MockTx)
 	mockBlurHashAPI := new(metadata.MockBlurHashAPI)
 	mockProjectRepo := new(mockRepo.MockProjectRepository)
 	mockFileRepo := new(mockRepo.MockFileRepository)
+	mockEducationRepo := new(mockRepo.MockEducationRepository)
+	mockSkillRepo := new(mockRepo.MockSkillRepository)
+
+	// Run transactional callbacks directly against the repository mocks
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+	mockProjectRepo.EXPECT().WithTx(mockTx).Return(mockProjectRepo).Maybe()
+	mockFileRepo.EXPECT().WithTx(mockTx).Return(mockFileRepo).Maybe()
+	mockEducationRepo.EXPECT().WithTx(mockTx).Return(mockEducationRepo).Maybe()
+	mockSkillRepo.EXPECT().WithTx(mockTx).Return(mockSkillRepo).Maybe()
+
+	// An empty collection version carries no validators, so lists are served
+	// unconditionally unless a test builds its own repositories.
+	mockProjectRepo.EXPECT().Version(mock.Anything).Return(domain.CollectionVersion{}, nil).Maybe()
+	mockSkillRepo.EXPECT().Version(mock.Anything).Return(domain.CollectionVersion{}, nil).Maybe()
+	mockFileRepo.EXPECT().Version(mock.Anything, "project").Return(domain.CollectionVersion{}, nil).Maybe()
+
 	projectHandler := NewProjectServiceHandler(
 		ProjectServiceConfig{
-			BlurHashAPI: mockBlurHashAPI,
-			projectRepo: mockProjectRepo,
-			fileRepo:    mockFileRepo,
+			DatabaseAPI:   mockDatabaseAPI,
+			BlurHashAPI:   mockBlurHashAPI,
+			projectRepo:   mockProjectRepo,
+			fileRepo:      mockFileRepo,
+			educationRepo: mockEducationRepo,
+			skillRepo:     mockSkillRepo,
 		},
 	)
 
 	return &projectHandlerTestFixture{
-		t:               t,
-		mockBlurHashAPI: mockBlurHashAPI,
-		mockProjectRepo: mockProjectRepo,
-		mockFileRepo:    mockFileRepo,
-		projectHandler:  projectHandler,
+		t:                 t,
+		mockDatabaseAPI:   mockDatabaseAPI,
+		mockTx:            mockTx,
+		mockBlurHashAPI:   mockBlurHashAPI,
+		mockProjectRepo:   mockProjectRepo,
+		mockFileRepo:      mockFileRepo,
+		mockEducationRepo: mockEducationRepo,
+		mockSkillRepo:     mockSkillRepo,
+		projectHandler:    projectHandler,
 	}
 }
 
@@ -86,12 +126,23 @@ func TestProjectServiceHandler_Create(t *testing.T) {
 	}
 	invalidBlurHashBody, _ := json.Marshal(invalidBlurHashReq)
 
+	withPreviewReq := createReq
+	withPreviewReq.Previews = []dto.CreateFileRequest{
+		{Role: "image", Name: "preview.png", URL: "https://example.com/preview.png", Type: "image/png", Size: 1024},
+	}
+	withPreviewBody, _ := json.Marshal(withPreviewReq)
+
+	linkedReq := createReq
+	linkedReq.EducationID = testEducationID
+	linkedBody, _ := json.Marshal(linkedReq)
+
 	type Given struct {
-		method       string
-		body         string
-		mockBlurHash func(m *metadata.MockBlurHashAPI)
-		mockRepo     func(m *mockRepo.MockProjectRepository)
-		mockFileRepo func(m *mockRepo.MockFileRepository)
+		method            string
+		body              string
+		mockBlurHash      func(m *metadata.MockBlurHashAPI)
+		mockRepo          func(m *mockRepo.MockProjectRepository)
+		mockFileRepo      func(m *mockRepo.MockFileRepository)
+		mockEducationRepo func(m *mockRepo.MockEducationRepository)
 	}
 	type Expected struct {
 		code int
@@ -102,6 +153,61 @@ func TestProjectServiceHandler_Create(t *testing.T) {
 		given    Given
 		expected Expected
 	}{
+		"success linked to education": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(linkedBody),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockEducationRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Exists(mock.Anything, testEducationID).Return(true, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.MatchedBy(func(p *domain.Project) bool {
+							return p.EducationID == testEducationID
+						})).
+						Return(fixedID, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: string(validResp),
+			},
+		},
+		"education not found": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(linkedBody),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockEducationRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Exists(mock.Anything, testEducationID).Return(false, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid project payload: education not found\n",
+			},
+		},
+		"education lookup error": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(linkedBody),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockEducationRepo: func(m *mockRepo.MockEducationRepository) {
+					m.EXPECT().Exists(mock.Anything, testEducationID).Return(false, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to validate education: db failure\n",
+			},
+		},
 		"success": {
 			given: Given{
 				method: http.MethodPost,
@@ -174,6 +280,54 @@ func TestProjectServiceHandler_Create(t *testing.T) {
 				body: "Failed to create project: db failure\n",
 			},
 		},
+		"success with previews": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(withPreviewBody),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.AnythingOfType("*domain.Project")).
+						Return(fixedID, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.MatchedBy(func(f domain.File) bool {
+							return f.ParentID == fixedID && f.Name == "preview.png"
+						})).
+						Return("file-1", nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: string(validResp),
+			},
+		},
+		"file record error rolls back without manual cleanup": {
+			given: Given{
+				method: http.MethodPost,
+				body:   string(withPreviewBody),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.AnythingOfType("*domain.Project")).
+						Return(fixedID, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						Create(mock.Anything, mock.AnythingOfType("domain.File")).
+						Return("", errors.New("insert failed"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to create file record: insert failed\n",
+			},
+		},
 	}
 
 	for name, tt := range tests {
@@ -186,6 +340,12 @@ func TestProjectServiceHandler_Create(t *testing.T) {
 			if tt.given.mockRepo != nil {
 				tt.given.mockRepo(f.mockProjectRepo)
 			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+			if tt.given.mockEducationRepo != nil {
+				tt.given.mockEducationRepo(f.mockEducationRepo)
+			}
 
 			req := httptest.NewRequest(tt.given.method, "/project", strings.NewReader(tt.given.body))
 			w := httptest.NewRecorder()
@@ -205,6 +365,8 @@ func TestProjectServiceHandler_Create(t *testing.T) {
 			}
 
 			f.mockProjectRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+			f.mockEducationRepo.AssertExpectations(t)
 			f.mockBlurHashAPI.AssertExpectations(t)
 		})
 	}
@@ -377,6 +539,9 @@ func TestProjectServiceHandler_Get(t *testing.T) {
 				f.mockFileRepo.EXPECT().
 					FindByParent(mock.Anything, "project", tt.given.id, domain.Image).
 					Return([]domain.File{}, nil)
+				f.mockSkillRepo.EXPECT().
+					ListByProjectIDs(mock.Anything, []string{tt.given.id}).
+					Return(map[string][]domain.Skill{tt.given.id: {}}, nil)
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/project/"+tt.given.id, nil)
@@ -446,6 +611,10 @@ func TestProjectServiceHandler_Get_Routing(t *testing.T) {
 		FindByParent(mock.Anything, "project", fixedID, domain.Image).
 		Return([]domain.File{}, nil)
 
+	f.mockSkillRepo.EXPECT().
+		ListByProjectIDs(mock.Anything, []string{fixedID}).
+		Return(map[string][]domain.Skill{fixedID: {}}, nil)
+
 	// Create HTTP request and response recorder
 	req := httptest.NewRequest(http.MethodGet, "/project/"+fixedID, nil)
 	w := httptest.NewRecorder()
@@ -484,6 +653,13 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 
 	validBody, _ := json.Marshal(validProject)
 
+	version := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ifMatch := etag(version)
+
+	// The If-Match version replaces whatever updated_at the body carries.
+	versionedProject := *validProject
+	versionedProject.UpdatedAt = version
+
 	invalidBlurHashReq := dto.CreateProjectRequest{
 		BlurHash:    "invalid-hash",
 		Title:       "title",
@@ -497,6 +673,7 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 
 	type Given struct {
 		method       string
+		ifMatch      string
 		body         string
 		mockBlurHash func(m *metadata.MockBlurHashAPI)
 		mockRepo     func(m *mockRepo.MockProjectRepository)
@@ -506,6 +683,7 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 	type Expected struct {
 		code int
 		body string
+		etag string
 	}
 
 	tests := map[string]struct {
@@ -514,14 +692,15 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 	}{
 		"success": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
 					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
 				},
 				mockRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
-						Update(mock.Anything, validProject).
+						Update(mock.Anything, &versionedProject).
 						Return(validProject, nil)
 				},
 			},
@@ -540,6 +719,37 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 					CreatedAt:   validProject.CreatedAt,
 					UpdatedAt:   validProject.UpdatedAt,
 				}),
+				etag: etag(validProject.UpdatedAt),
+			},
+		},
+		"missing If-Match": {
+			given: Given{
+				method: http.MethodPut,
+				body:   string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusPreconditionRequired,
+				body: "If-Match header is required\n",
+			},
+		},
+		"stale version": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						Update(mock.Anything, &versionedProject).
+						Return(nil, &domain.ConflictError{Entity: "project", ID: fixedID, Current: version.Add(time.Second)})
+				},
+			},
+			expected: Expected{
+				code: http.StatusPreconditionFailed,
+				body: "Project was modified by another request: project " + fixedID + " was modified at 2025-01-01T12:00:01Z\n",
+				etag: etag(version.Add(time.Second)),
 			},
 		},
 		"invalid method": {
@@ -554,8 +764,9 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 		},
 		"invalid json": {
 			given: Given{
-				method: http.MethodPut,
-				body:   `{"invalid",}`,
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    `{"invalid",}`,
 			},
 			expected: Expected{
 				code: http.StatusBadRequest,
@@ -564,14 +775,15 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 		},
 		"repo error": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
 					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
 				},
 				mockRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
-						Update(mock.Anything, validProject).
+						Update(mock.Anything, &versionedProject).
 						Return(nil, errors.New("db failure"))
 				},
 			},
@@ -582,14 +794,15 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 		},
 		"not found": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
 					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
 				},
 				mockRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
-						Update(mock.Anything, validProject).
+						Update(mock.Anything, &versionedProject).
 						Return(nil, nil)
 				},
 			},
@@ -600,8 +813,9 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 		},
 		"invalid blurhash": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(invalidBlurHashBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(invalidBlurHashBody),
 				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
 					m.EXPECT().IsValid("invalid-hash").Return(false).Once()
 				},
@@ -630,9 +844,15 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 				f.mockFileRepo.EXPECT().
 					FindByParent(mock.Anything, "project", fixedID, domain.Image).
 					Return([]domain.File{}, nil)
+				f.mockSkillRepo.EXPECT().
+					ListByProjectIDs(mock.Anything, []string{fixedID}).
+					Return(map[string][]domain.Skill{fixedID: {}}, nil)
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/project", strings.NewReader(tt.given.body))
+			if tt.given.ifMatch != "" {
+				req.Header.Set("If-Match", tt.given.ifMatch)
+			}
 			w := httptest.NewRecorder()
 
 			f.projectHandler.(*projectServiceHandler).Update(w, req)
@@ -642,6 +862,9 @@ func TestProjectServiceHandler_Update(t *testing.T) {
 
 			body, _ := io.ReadAll(res.Body)
 			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.etag != "" {
+				assert.Equal(t, tt.expected.etag, res.Header.Get("ETag"))
+			}
 
 			if strings.HasPrefix(tt.expected.body, "{") {
 				assert.JSONEq(t, tt.expected.body, string(body))
@@ -707,8 +930,14 @@ func TestProjectServiceHandler_Update_Routing(t *testing.T) {
 		FindByParent(mock.Anything, "project", fixedID, domain.Image).
 		Return([]domain.File{}, nil)
 
+	// Mock skillRepo.ListByProjectIDs call to reload linked skills
+	f.mockSkillRepo.EXPECT().
+		ListByProjectIDs(mock.Anything, []string{fixedID}).
+		Return(map[string][]domain.Skill{fixedID: {}}, nil)
+
 	// Create PUT request
 	req := httptest.NewRequest(http.MethodPut, "/project", bytes.NewReader(reqBody))
+	req.Header.Set("If-Match", etag(fixedTime))
 	w := httptest.NewRecorder()
 
 	// Ensure handler implements http.Handler
@@ -724,6 +953,7 @@ func TestProjectServiceHandler_Update_Routing(t *testing.T) {
 
 	body, _ := io.ReadAll(res.Body)
 	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, etag(fixedTime), res.Header.Get("ETag"))
 	assert.JSONEq(t, string(expectedResp), string(body))
 
 	f.mockProjectRepo.AssertExpectations(t)
@@ -815,10 +1045,10 @@ func TestProjectServiceHandler_Delete(t *testing.T) {
 				tt.given.mockRepo(f.mockProjectRepo)
 			}
 
-			// Mock fileRepo.DeleteByParent for ALL cases except method_not_allowed
+			// Mock fileRepo.TrashByParent for ALL cases except method_not_allowed
 			if name != "method_not_allowed" {
 				f.mockFileRepo.EXPECT().
-					DeleteByParent(mock.Anything, "project", tt.given.id).
+					TrashByParent(mock.Anything, "project", tt.given.id).
 					Return(nil)
 			}
 
@@ -855,7 +1085,7 @@ func TestProjectServiceHandler_Delete_Routing(t *testing.T) {
 	f := newProjectHandlerTestFixture(t)
 
 	f.mockFileRepo.EXPECT().
-		DeleteByParent(mock.Anything, "project", fixedID).
+		TrashByParent(mock.Anything, "project", fixedID).
 		Return(nil)
 
 	// Setup mock expectation
@@ -887,6 +1117,133 @@ func TestProjectServiceHandler_Delete_Routing(t *testing.T) {
 	f.mockProjectRepo.AssertExpectations(t)
 }
 
+func TestProjectServiceHandler_Restore(t *testing.T) {
+	fixedID := "123-abc"
+
+	type Given struct {
+		method       string
+		path         string
+		mockRepo     func(*mockRepo.MockProjectRepository)
+		mockFileRepo func(*mockRepo.MockFileRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().RestoreByParent(mock.Anything, "project", fixedID).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+				body: "",
+			},
+		},
+		"not_in_trash": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Project not found in trash\n",
+			},
+		},
+		"repo_error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore project: db error\n",
+			},
+		},
+		"file_restore_error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().RestoreByParent(mock.Anything, "project", fixedID).Return(errors.New("file error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore project files: file error\n",
+			},
+		},
+		"method_not_allowed": {
+			given: Given{
+				method: http.MethodDelete,
+				path:   "/project/" + fixedID + "/restore",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"missing_id": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project//restore",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Project ID is required\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockProjectRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.projectHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockProjectRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestProjectServiceHandler_List(t *testing.T) {
 	fixedTime := time.Now()
 	validProjects := []domain.Project{
@@ -916,10 +1273,28 @@ func TestProjectServiceHandler_List(t *testing.T) {
 		},
 	}
 
+	listPageMeta := dto.PageMetaDTO{Total: 2, Page: 1, PageSize: 10}
+	nextCursor := domain.Cursor{CreatedAt: fixedTime, ID: "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6c"}
+
+	preview := domain.File{
+		ID:          "f1",
+		ParentTable: "project",
+		ParentID:    "p1",
+		Role:        domain.Image,
+		Name:        "preview.png",
+		URL:         "http://example.com/preview.png",
+		Type:        "image/png",
+		Size:        1024,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
 	type Given struct {
-		method   string
-		query    string
-		mockRepo func(m *mockRepo.MockProjectRepository)
+		method        string
+		query         string
+		mockRepo      func(m *mockRepo.MockProjectRepository)
+		mockFileRepo  func(m *mockRepo.MockFileRepository)
+		mockSkillRepo func(m *mockRepo.MockSkillRepository)
 	}
 	type Expected struct {
 		code int
@@ -937,12 +1312,25 @@ func TestProjectServiceHandler_List(t *testing.T) {
 				mockRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
 						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
-						Return(validProjects, nil)
+						Return(validProjects, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1", "p2"}, domain.Image).
+						Return(map[string][]domain.File{
+							"p1": {preview},
+							"p2": {},
+						}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{"p1", "p2"}).
+						Return(map[string][]domain.Skill{"p1": {}, "p2": {}}, nil)
 				},
 			},
 			expected: Expected{
 				code: http.StatusOK,
-				body: toJSON([]dto.ProjectDTO{
+				body: projectListJSON(listPageMeta, []dto.ProjectDTO{
 					{
 						ID:          "p1",
 						BlurHash:    "hash1",
@@ -952,9 +1340,22 @@ func TestProjectServiceHandler_List(t *testing.T) {
 						Tags:        []string{"go"},
 						Type:        string(domain.Web),
 						Link:        "http://example.com/1",
-						Previews:    []dto.FileDTO{},
-						CreatedAt:   fixedTime,
-						UpdatedAt:   fixedTime,
+						Previews: []dto.FileDTO{
+							{
+								ID:          preview.ID,
+								ParentTable: string(preview.ParentTable),
+								ParentID:    preview.ParentID,
+								Role:        string(preview.Role),
+								Name:        preview.Name,
+								URL:         preview.URL,
+								Type:        preview.Type,
+								Size:        preview.Size,
+								CreatedAt:   preview.CreatedAt,
+								UpdatedAt:   preview.UpdatedAt,
+							},
+						},
+						CreatedAt: fixedTime,
+						UpdatedAt: fixedTime,
 					},
 					{
 						ID:          "p2",
@@ -979,12 +1380,22 @@ func TestProjectServiceHandler_List(t *testing.T) {
 				mockRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
 						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
-						Return([]domain.Project{validProjects[0]}, nil)
+						Return([]domain.Project{validProjects[0]}, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1"}, domain.Image).
+						Return(map[string][]domain.File{"p1": {}}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{"p1"}).
+						Return(map[string][]domain.Skill{"p1": {}}, nil)
 				},
 			},
 			expected: Expected{
 				code: http.StatusOK,
-				body: toJSON([]dto.ProjectDTO{
+				body: projectListJSON(listPageMeta, []dto.ProjectDTO{
 					{
 						ID:          "p1",
 						BlurHash:    "hash1",
@@ -1001,49 +1412,204 @@ func TestProjectServiceHandler_List(t *testing.T) {
 				}),
 			},
 		},
-		"invalid method": {
+		"success - with education filter": {
 			given: Given{
-				method: http.MethodPost,
-				query:  "",
+				method: http.MethodGet,
+				query:  "?education_id=" + testEducationID,
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(f domain.ProjectFilter) bool {
+							return f.EducationID != nil && *f.EducationID == testEducationID
+						})).
+						Return([]domain.Project{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
+				},
 			},
 			expected: Expected{
-				code: http.StatusMethodNotAllowed,
-				body: "Method not allowed: only GET is supported\n",
+				code: http.StatusOK,
+				body: `{"items":[],"total":0,"page":1,"page_size":10,"has_next":false}`,
 			},
 		},
-		"invalid project type": {
+		"invalid education id": {
 			given: Given{
 				method: http.MethodGet,
-				query:  "?type=desktop",
+				query:  "?education_id=not-a-uuid",
 			},
 			expected: Expected{
 				code: http.StatusBadRequest,
-				body: "invalid project type\n",
+				body: "invalid education id\n",
 			},
 		},
-		"invalid sort by": {
+		"success - with tags filter": {
 			given: Given{
 				method: http.MethodGet,
-				query:  "?sort_by=invalid_field",
+				query:  "?tags=go,+react&tags=go&tags=&tag_match=all",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(f domain.ProjectFilter) bool {
+							return assert.ObjectsAreEqual([]string{"go", "react"}, f.Tags) &&
+								f.TagMatch == domain.TagMatchAll
+						})).
+						Return([]domain.Project{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
+				},
 			},
 			expected: Expected{
-				code: http.StatusBadRequest,
-				body: "invalid sort by\n",
+				code: http.StatusOK,
+				body: `{"items":[],"total":0,"page":1,"page_size":10,"has_next":false}`,
 			},
 		},
-		"repo error": {
+		"success - tag match defaults to any": {
 			given: Given{
 				method: http.MethodGet,
-				query:  "",
+				query:  "?tags=go",
 				mockRepo: func(m *mockRepo.MockProjectRepository) {
 					m.EXPECT().
-						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
-						Return(nil, errors.New("db failure"))
+						List(mock.Anything, mock.MatchedBy(func(f domain.ProjectFilter) bool {
+							return assert.ObjectsAreEqual([]string{"go"}, f.Tags) &&
+								f.TagMatch == domain.TagMatchAny
+						})).
+						Return([]domain.Project{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
 				},
 			},
 			expected: Expected{
-				code: http.StatusInternalServerError,
-				body: "Failed to list project: db failure\n",
+				code: http.StatusOK,
+				body: `{"items":[],"total":0,"page":1,"page_size":10,"has_next":false}`,
+			},
+		},
+		"invalid tag match": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?tags=go&tag_match=some",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid tag match\n",
+			},
+		},
+		"too many tags": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?tags=a,b,c,d,e,f,g,h,i,j,k",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "too many tags: at most 10 allowed\n",
+			},
+		},
+		"success - with cursor": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?page_size=1&sort_by=created_at&cursor=" + nextCursor.Encode(),
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(f domain.ProjectFilter) bool {
+							return f.Cursor != nil && f.Cursor.ID == nextCursor.ID && f.Cursor.CreatedAt.Equal(fixedTime)
+						})).
+						Return([]domain.Project{}, domain.PageInfo{Total: 2, Page: 1, PageSize: 1}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"items":[],"total":2,"page":1,"page_size":1,"has_next":false}`,
+			},
+		},
+		"invalid cursor": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?cursor=not-base64!",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid cursor: cursor encoding invalid\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+				query:  "",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+		"invalid project type": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?type=desktop",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid project type\n",
+			},
+		},
+		"invalid sort by": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?sort_by=invalid_field",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid sort by\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
+						Return(nil, domain.PageInfo{}, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list project: db failure\n",
+			},
+		},
+		"previews error": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
+						Return(validProjects, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1", "p2"}, domain.Image).
+						Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to retrieve previews: file failure\n",
+			},
+		},
+		"skills error": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "",
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
+						Return(validProjects, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1", "p2"}, domain.Image).
+						Return(map[string][]domain.File{"p1": {}, "p2": {}}, nil)
+				},
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{"p1", "p2"}).
+						Return(nil, errors.New("skill failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to retrieve project skills: skill failure\n",
 			},
 		},
 	}
@@ -1056,18 +1622,12 @@ func TestProjectServiceHandler_List(t *testing.T) {
 				tt.given.mockRepo(f.mockProjectRepo)
 			}
 
-			switch name {
-			case "success - no filters":
-				f.mockFileRepo.EXPECT().
-					FindByParent(mock.Anything, "project", "p1", domain.Image).
-					Return([]domain.File{}, nil)
-				f.mockFileRepo.EXPECT().
-					FindByParent(mock.Anything, "project", "p2", domain.Image).
-					Return([]domain.File{}, nil)
-			case "success - with type filter":
-				f.mockFileRepo.EXPECT().
-					FindByParent(mock.Anything, "project", "p1", domain.Image).
-					Return([]domain.File{}, nil)
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/projects"+tt.given.query, nil)
@@ -1081,16 +1641,15 @@ func TestProjectServiceHandler_List(t *testing.T) {
 			body, _ := io.ReadAll(res.Body)
 			assert.Equal(t, tt.expected.code, res.StatusCode)
 
-			if strings.HasPrefix(tt.expected.body, "[") {
+			if strings.HasPrefix(tt.expected.body, "{") {
 				assert.JSONEq(t, tt.expected.body, string(body))
 			} else {
 				assert.Equal(t, tt.expected.body, string(body))
 			}
 
 			f.mockProjectRepo.AssertExpectations(t)
-			if name == "success - no filters" || name == "success - with type filter" {
-				f.mockFileRepo.AssertExpectations(t)
-			}
+			f.mockFileRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
 		})
 	}
 }
@@ -1125,8 +1684,8 @@ func TestProjectServiceHandler_List_Routing(t *testing.T) {
 		},
 	}
 
-	// Expected response is dto.ProjectDTO array
-	expectedBody, _ := json.Marshal([]dto.ProjectDTO{
+	// Expected response is a dto.ProjectListResponse envelope
+	expectedBody := projectListJSON(dto.PageMetaDTO{Total: 2, Page: 1, PageSize: 10}, []dto.ProjectDTO{
 		{
 			ID:          "p1",
 			BlurHash:    "hash1",
@@ -1160,15 +1719,17 @@ func TestProjectServiceHandler_List_Routing(t *testing.T) {
 	// Mock the repository call
 	f.mockProjectRepo.EXPECT().
 		List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
-		Return(validProjects, nil)
+		Return(validProjects, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
 
-	// Mock fileRepo.FindByParent for each project
-	f.mockFileRepo.EXPECT().
-		FindByParent(mock.Anything, "project", "p1", domain.Image).
-		Return([]domain.File{}, nil)
+	// Mock a single batched preview lookup for all projects
 	f.mockFileRepo.EXPECT().
-		FindByParent(mock.Anything, "project", "p2", domain.Image).
-		Return([]domain.File{}, nil)
+		FindByParents(mock.Anything, "project", []string{"p1", "p2"}, domain.Image).
+		Return(map[string][]domain.File{"p1": {}, "p2": {}}, nil)
+
+	// Mock a single batched skill lookup for all projects
+	f.mockSkillRepo.EXPECT().
+		ListByProjectIDs(mock.Anything, []string{"p1", "p2"}).
+		Return(map[string][]domain.Skill{"p1": {}, "p2": {}}, nil)
 
 	// Create GET request to /projects
 	req := httptest.NewRequest(http.MethodGet, "/projects", nil)
@@ -1192,3 +1753,742 @@ func TestProjectServiceHandler_List_Routing(t *testing.T) {
 	f.mockFileRepo.AssertExpectations(t)
 	f.mockProjectRepo.AssertExpectations(t)
 }
+
+func TestProjectServiceHandler_SkillLinks(t *testing.T) {
+	fixedID := "123-abc"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	skillID := "5b1f7c2e-3d4a-4b6c-8e9f-0a1b2c3d4e5f"
+	otherSkillID := "6c2a8d3f-4e5b-4c7d-9f0a-1b2c3d4e5f6a"
+
+	skill := domain.Skill{
+		Id:        skillID,
+		Icon:      "go.svg",
+		HexColor:  "#00ADD8",
+		Label:     "Go",
+		Category:  domain.Backend,
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	project := &domain.Project{
+		Id:          fixedID,
+		BlurHash:    validBlurHash,
+		Title:       "title",
+		Subtitle:    "subtitle",
+		Description: "desc",
+		Tags:        []string{"go"},
+		Type:        domain.Web,
+		Link:        "http://example.com",
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	projectDTO := dto.ProjectDTO{
+		ID:          fixedID,
+		BlurHash:    validBlurHash,
+		Title:       "title",
+		Subtitle:    "subtitle",
+		Description: "desc",
+		Tags:        []string{"go"},
+		Type:        string(domain.Web),
+		Link:        "http://example.com",
+		Previews:    []dto.FileDTO{},
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	linkedDTO := projectDTO
+	linkedDTO.Skills = toSkillDTOs([]domain.Skill{skill})
+
+	createBody := func(skillIDs ...string) string {
+		return toJSON(dto.CreateProjectRequest{
+			BlurHash:    validBlurHash,
+			Title:       "title",
+			Subtitle:    "subtitle",
+			Description: "desc",
+			Tags:        []string{"go"},
+			Type:        "web",
+			Link:        "http://example.com",
+			SkillIDs:    skillIDs,
+		})
+	}
+	updateBody := func(skillIDs []string) string {
+		req := projectDTO
+		req.SkillIDs = skillIDs
+		return toJSON(req)
+	}
+
+	type Given struct {
+		method        string
+		path          string
+		body          string
+		mockRepo      func(m *mockRepo.MockProjectRepository)
+		mockSkillRepo func(m *mockRepo.MockSkillRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"create links skills": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project",
+				body:   createBody(skillID, otherSkillID),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID, otherSkillID}).Return([]string{}, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Project")).Return(fixedID, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, []string{skillID, otherSkillID}).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: toJSON(IDResponse{Id: fixedID}),
+			},
+		},
+		"create without skills skips linking": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project",
+				body:   createBody(),
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Create(mock.Anything, mock.AnythingOfType("*domain.Project")).Return(fixedID, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusCreated,
+				body: toJSON(IDResponse{Id: fixedID}),
+			},
+		},
+		"create with unknown skill": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project",
+				body:   createBody(skillID, otherSkillID),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID, otherSkillID}).Return([]string{otherSkillID}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid project payload: skill not found: " + otherSkillID + "\n",
+			},
+		},
+		"create with malformed skill id": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project",
+				body:   createBody("not-a-uuid"),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid project payload: invalid skill id not-a-uuid\n",
+			},
+		},
+		"create with skill lookup error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project",
+				body:   createBody(skillID),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID}).Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to validate skills: db failure\n",
+			},
+		},
+		"create with link error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/project",
+				body:   createBody(skillID),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID}).Return([]string{}, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Create(mock.Anything, mock.Anything).Return(fixedID, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, []string{skillID}).Return(errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to link project skills: db failure\n",
+			},
+		},
+		"update replaces skills": {
+			given: Given{
+				method: http.MethodPut,
+				path:   "/project",
+				body:   updateBody([]string{skillID}),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().FindMissing(mock.Anything, []string{skillID}).Return([]string{}, nil)
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {skill}}, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Project")).Return(project, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, []string{skillID}).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(linkedDTO),
+			},
+		},
+		"update with empty skills clears links": {
+			given: Given{
+				method: http.MethodPut,
+				path:   "/project",
+				body:   `{"id":"` + fixedID + `","blurhash":"` + validBlurHash + `","title":"title","sub_title":"subtitle","description":"desc","tags":["go"],"type":"web","link":"http://example.com","skill_ids":[]}`,
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {}}, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Project")).Return(project, nil)
+					m.EXPECT().SetSkills(mock.Anything, fixedID, []string{}).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(projectDTO),
+			},
+		},
+		"update without skill_ids keeps links": {
+			given: Given{
+				method: http.MethodPut,
+				path:   "/project",
+				body:   updateBody(nil),
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {skill}}, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Update(mock.Anything, mock.AnythingOfType("*domain.Project")).Return(project, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(linkedDTO),
+			},
+		},
+		"get embeds skills": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/project/" + fixedID,
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{fixedID}).
+						Return(map[string][]domain.Skill{fixedID: {skill}}, nil)
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(project, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(linkedDTO),
+			},
+		},
+		"get with skill lookup error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/project/" + fixedID,
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						ListByProjectIDs(mock.Anything, []string{fixedID}).
+						Return(nil, errors.New("db failure"))
+				},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().Get(mock.Anything, fixedID).Return(project, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to fetch project skills: db failure\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectHandlerTestFixture(t)
+
+			f.mockBlurHashAPI.EXPECT().IsValid(validBlurHash).Return(true).Maybe()
+			f.mockFileRepo.EXPECT().
+				FindByParent(mock.Anything, "project", fixedID, domain.Image).
+				Return([]domain.File{}, nil).
+				Maybe()
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockProjectRepo)
+			}
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, strings.NewReader(tt.given.body))
+			if tt.given.method == http.MethodPut {
+				req.Header.Set("If-Match", "*")
+			}
+			w := httptest.NewRecorder()
+
+			f.projectHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockProjectRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectServiceHandler_List_Conditional(t *testing.T) {
+	projectsModified := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	previewsModified := projectsModified.Add(time.Hour)
+	// The newest write across projects, skills and previews, and their live rows
+	currentTag := `"` + fmt.Sprint(previewsModified.UnixMicro()) + `-6"`
+
+	type Given struct {
+		headers    map[string]string
+		versionErr error
+		mockRepo   func(m *mockRepo.MockProjectRepository)
+	}
+
+	type Expected struct {
+		code         int
+		etag         string
+		lastModified string
+		body         string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"current If-None-Match is not modified": {
+			given: Given{
+				headers: map[string]string{"If-None-Match": currentTag},
+			},
+			expected: Expected{
+				code:         http.StatusNotModified,
+				etag:         currentTag,
+				lastModified: "Wed, 01 Jan 2025 13:00:00 GMT",
+			},
+		},
+		"current If-Modified-Since is not modified": {
+			given: Given{
+				headers: map[string]string{"If-Modified-Since": "Wed, 01 Jan 2025 13:00:00 GMT"},
+			},
+			expected: Expected{
+				code:         http.StatusNotModified,
+				etag:         currentTag,
+				lastModified: "Wed, 01 Jan 2025 13:00:00 GMT",
+			},
+		},
+		"stale If-None-Match rebuilds the list": {
+			given: Given{
+				headers: map[string]string{"If-None-Match": `"1-1"`},
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.ProjectFilter")).
+						Return([]domain.Project{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
+				},
+			},
+			expected: Expected{
+				code:         http.StatusOK,
+				etag:         currentTag,
+				lastModified: "Wed, 01 Jan 2025 13:00:00 GMT",
+				body:         projectListJSON(dto.PageMetaDTO{Page: 1, PageSize: 10}, []dto.ProjectDTO{}),
+			},
+		},
+		"version lookup fails": {
+			given: Given{
+				versionErr: errors.New("db down"),
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to check projects version: db down\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockProjectRepo := new(mockRepo.MockProjectRepository)
+			mockSkillRepo := new(mockRepo.MockSkillRepository)
+			mockFileRepo := new(mockRepo.MockFileRepository)
+
+			mockProjectRepo.EXPECT().
+				Version(mock.Anything).
+				Return(domain.CollectionVersion{LastModified: projectsModified, Count: 2}, tt.given.versionErr)
+			if tt.given.versionErr == nil {
+				mockSkillRepo.EXPECT().
+					Version(mock.Anything).
+					Return(domain.CollectionVersion{LastModified: projectsModified.Add(-time.Hour), Count: 3}, nil)
+				mockFileRepo.EXPECT().
+					Version(mock.Anything, "project").
+					Return(domain.CollectionVersion{LastModified: previewsModified, Count: 1}, nil)
+			}
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(mockProjectRepo)
+			}
+
+			handler := NewProjectServiceHandler(
+				ProjectServiceConfig{
+					projectRepo: mockProjectRepo,
+					skillRepo:   mockSkillRepo,
+					fileRepo:    mockFileRepo,
+				},
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/projects", nil)
+			for k, v := range tt.given.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			handler.List(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			assert.Equal(t, tt.expected.etag, w.Header().Get("ETag"))
+			assert.Equal(t, tt.expected.lastModified, w.Header().Get("Last-Modified"))
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+
+			mockProjectRepo.AssertExpectations(t)
+			mockSkillRepo.AssertExpectations(t)
+			mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectServiceHandler_ListTags(t *testing.T) {
+	type Given struct {
+		method   string
+		mockRepo func(m *mockRepo.MockProjectRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						ListTags(mock.Anything).
+						Return([]domain.TagCount{{Tag: "go", Count: 3}, {Tag: "react", Count: 1}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON([]dto.ProjectTagDTO{{Tag: "go", Count: 3}, {Tag: "react", Count: 1}}),
+			},
+		},
+		"success - no tags": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						ListTags(mock.Anything).
+						Return([]domain.TagCount{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `[]`,
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().
+						ListTags(mock.Anything).
+						Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list project tags: db failure\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockProjectRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/projects/tags", nil)
+			w := httptest.NewRecorder()
+
+			f.projectHandler.ListTags(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "[") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockProjectRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectServiceHandler_ListTags_Routing(t *testing.T) {
+	type Given struct {
+		method string
+		path   string
+	}
+	type Expected struct {
+		code int
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"tags": {
+			given:    Given{method: http.MethodGet, path: "/projects/tags"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"tags with trailing slash": {
+			given:    Given{method: http.MethodGet, path: "/projects/tags/"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"invalid method": {
+			given:    Given{method: http.MethodDelete, path: "/projects/tags"},
+			expected: Expected{code: http.StatusMethodNotAllowed},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectHandlerTestFixture(t)
+			f.mockProjectRepo.EXPECT().
+				ListTags(mock.Anything).
+				Return([]domain.TagCount{}, nil).
+				Maybe()
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.projectHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+		})
+	}
+}
+
+// projectListJSON renders the list envelope the project List handler is expected to write.
+func projectListJSON(meta dto.PageMetaDTO, items []dto.ProjectDTO) string {
+	return toJSON(dto.ProjectListResponse{Items: items, PageMetaDTO: meta})
+}
+
+// queryCountDatabase is a database.DatabaseAPI that answers list queries with
+// generated rows and counts every round trip, so tests can assert how many
+// queries a request issues regardless of how many rows come back.
+type queryCountDatabase struct {
+	rows    int
+	queries int
+}
+
+func (d *queryCountDatabase) QueryRow(ctx context.Context, query string, args ...any) database.Row {
+	d.queries++
+	return queryCountRow{count: int64(d.rows)}
+}
+
+func (d *queryCountDatabase) Exec(ctx context.Context, query string, args ...any) (database.CommandTag, error) {
+	d.queries++
+	return nil, errors.New("exec not supported")
+}
+
+func (d *queryCountDatabase) Query(ctx context.Context, query string, args ...any) (database.Rows, error) {
+	d.queries++
+
+	rows := &queryCountRows{query: query, args: args, n: d.rows}
+	switch {
+	case strings.Contains(query, "education_id IN"):
+		// One project per requested education
+		rows.n = len(args)
+	case strings.Contains(query, "FROM File"):
+		// One file per requested parent; args start with parent_table and role
+		rows.n = len(args) - 2
+	case strings.Contains(query, "project_skill"):
+		// One linked skill per requested project
+		rows.n = len(args)
+	}
+
+	return rows, nil
+}
+
+func (d *queryCountDatabase) Begin(ctx context.Context) (database.Tx, error) {
+	return nil, errors.New("transactions not supported")
+}
+
+func (d *queryCountDatabase) WithTx(ctx context.Context, fn func(tx database.Tx) error) error {
+	return errors.New("transactions not supported")
+}
+
+func (d *queryCountDatabase) Close() {}
+
+type queryCountRow struct {
+	count int64
+}
+
+func (r queryCountRow) Scan(dest ...any) error {
+	if len(dest) == 2 {
+		// A collection version: an empty table, which leaves the list unconditional
+		*dest[0].(*sql.NullTime) = sql.NullTime{}
+		*dest[1].(*int64) = 0
+		return nil
+	}
+
+	*dest[0].(*int64) = r.count
+	return nil
+}
+
+// queryCountRows generates n rows, filling only the columns the list
+// handlers need to link parents and children together.
+type queryCountRows struct {
+	query string
+	args  []any
+	n     int
+	index int
+}
+
+func (r *queryCountRows) Next() bool {
+	r.index++
+	return r.index <= r.n
+}
+
+func (r *queryCountRows) Scan(dest ...any) error {
+	i := r.index - 1
+
+	switch {
+	case strings.Contains(r.query, "FROM Education"):
+		*dest[0].(*string) = fmt.Sprintf("edu-%d", i)
+		*dest[1].(*[]byte) = []byte("{}")
+	case strings.Contains(r.query, "education_id IN"):
+		*dest[0].(*string) = fmt.Sprintf("p-%d", i)
+		*dest[8].(*sql.NullString) = sql.NullString{String: r.args[i].(string), Valid: true}
+	case strings.Contains(r.query, "FROM Project"):
+		*dest[0].(*string) = fmt.Sprintf("p-%d", i)
+	case strings.Contains(r.query, "FROM File"):
+		*dest[0].(*string) = fmt.Sprintf("f-%d", i)
+		*dest[2].(*string) = r.args[i+2].(string)
+	case strings.Contains(r.query, "project_skill"):
+		*dest[0].(*string) = r.args[i].(string)
+		*dest[1].(*string) = fmt.Sprintf("s-%d", i)
+	default:
+		return fmt.Errorf("unexpected query: %s", r.query)
+	}
+
+	return nil
+}
+
+func (r *queryCountRows) Err() error { return nil }
+
+func (r *queryCountRows) Close() {}
+
+// listQueryCounts are the page sizes the query count checks run against. The
+// repositories cap a page at 20 rows.
+var listQueryCounts = []int{1, 5, 20}
+
+// countProjectListQueries serves one GET /projects for a page of size rows and
+// returns how many queries it issued.
+func countProjectListQueries(tb testing.TB, handler ProjectHandler, db *queryCountDatabase, size int) int {
+	tb.Helper()
+
+	before := db.queries
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/projects?page_size=%d", size), nil)
+	w := httptest.NewRecorder()
+	handler.List(w, req)
+
+	if w.Code != http.StatusOK {
+		tb.Fatalf("expected status 200, got %d: %s", w.Code, w.Body.String())
+	}
+
+	return db.queries - before
+}
+
+func TestProjectServiceHandler_List_QueryCount(t *testing.T) {
+	// The project, skill and file versions, COUNT(*), the page itself, one
+	// batched preview lookup and one batched skill lookup
+	const expectedQueries = 7
+
+	for _, size := range listQueryCounts {
+		t.Run(fmt.Sprintf("projects=%d", size), func(t *testing.T) {
+			db := &queryCountDatabase{rows: size}
+			handler := NewProjectServiceHandler(ProjectServiceConfig{DatabaseAPI: db})
+
+			assert.Equal(t, expectedQueries, countProjectListQueries(t, handler, db, size))
+		})
+	}
+}
+
+func BenchmarkProjectServiceHandler_List(b *testing.B) {
+	for _, size := range listQueryCounts {
+		b.Run(fmt.Sprintf("projects=%d", size), func(b *testing.B) {
+			db := &queryCountDatabase{rows: size}
+			handler := NewProjectServiceHandler(ProjectServiceConfig{DatabaseAPI: db})
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				countProjectListQueries(b, handler, db, size)
+			}
+
+			b.ReportMetric(float64(db.queries)/float64(b.N), "queries/op")
+		})
+	}
+}
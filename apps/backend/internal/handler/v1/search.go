@@ -0,0 +1,162 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+type SearchHandler interface {
+	http.Handler
+	Search(w http.ResponseWriter, r *http.Request)
+}
+
+type SearchServiceConfig struct {
+	DatabaseAPI database.DatabaseAPI
+
+	searchRepo v1.SearchRepository
+}
+
+type searchServiceHandler struct {
+	searchRepo v1.SearchRepository
+}
+
+// NewSearchServiceHandler creates and returns a SearchHandler configured using the provided
+// SearchServiceConfig. If cfg.searchRepo is nil, a default repository is constructed via
+// v1.NewSearchRepository using cfg.DatabaseAPI and the "Project", "Skill" and "Education" tables.
+func NewSearchServiceHandler(cfg SearchServiceConfig) SearchHandler {
+	searchRepo := cfg.searchRepo
+	if searchRepo == nil {
+		searchRepo = v1.NewSearchRepository(
+			v1.SearchRepositoryConfig{
+				DatabaseAPI:    cfg.DatabaseAPI,
+				ProjectTable:   "Project",
+				SkillTable:     "Skill",
+				EducationTable: "Education",
+			},
+		)
+	}
+
+	return &searchServiceHandler{
+		searchRepo: searchRepo,
+	}
+}
+
+// ServeHTTP implements http.Handler for searchServiceHandler.
+//
+// Routes:
+//   - GET /search -> h.Search(w, r)
+//
+// A trailing slash is ignored. Unknown routes receive a 404 Not Found response.
+func (h *searchServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch path {
+	case "/search":
+		h.Search(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// Search handles GET /search and returns ranked full-text matches across
+// projects, skills and education.
+//
+// Query parameters:
+//   - q: the search text (required, at most 200 characters). Quoted phrases,
+//     "or" and a leading "-" work as in a web search box.
+//   - type: optional comma-separated list of project, skill and education.
+//   - limit: maximum number of hits (default 20, max 50).
+//
+// Responses:
+//   - 200 OK with a dto.SearchResponse; hits are ordered by rank and snippets
+//     wrap matched terms in <mark></mark>.
+//   - 400 Bad Request when q is missing or too long, or a type is unknown.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the search query fails.
+//
+// @Summary Search content
+// @Description Full-text search across projects, skills and education. Returns ranked, typed hits with highlighted snippets.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search text"
+// @Param type query string false "Comma-separated hit types to include (project, skill, education)"
+// @Param limit query int false "Maximum number of hits (default 20, max 50)"
+// @Success 200 {object} dto.SearchResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /search [get]
+func (h *searchServiceHandler) Search(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+
+	filter := domain.SearchFilter{
+		Query: strings.TrimSpace(q.Get("q")),
+		Limit: utils.GetQueryInt32(q, "limit", 20),
+	}
+
+	if rawTypes := q.Get("type"); rawTypes != "" {
+		for _, t := range strings.Split(rawTypes, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				filter.Types = append(filter.Types, domain.SearchHitType(t))
+			}
+		}
+	}
+
+	// Clamp limit to valid range
+	const maxLimit = 50
+	if filter.Limit < 1 {
+		filter.Limit = 20 // default
+	} else if filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+
+	if err := filter.Validate(); err != nil {
+		http.Error(w, "Invalid search: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	hits, err := h.searchRepo.Search(r.Context(), filter)
+	if err != nil {
+		http.Error(w, "Failed to search: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	hitDTOs := make([]dto.SearchHitDTO, len(hits))
+	for i, hit := range hits {
+		hitDTOs[i] = dto.SearchHitDTO{
+			Type:    string(hit.Type),
+			ID:      hit.ID,
+			Title:   hit.Title,
+			Snippet: hit.Snippet,
+			Rank:    hit.Rank,
+		}
+	}
+
+	resp := dto.SearchResponse{
+		Query: filter.Query,
+		Hits:  hitDTOs,
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
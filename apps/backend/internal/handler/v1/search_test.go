@@ -0,0 +1,241 @@
+package v1
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type searchHandlerTestFixture struct {
+	t              *testing.T
+	mockSearchRepo *mockRepo.MockSearchRepository
+	searchHandler  SearchHandler
+}
+
+func newSearchHandlerTestFixture(t *testing.T) *searchHandlerTestFixture {
+	mockSearchRepo := new(mockRepo.MockSearchRepository)
+
+	searchHandler := NewSearchServiceHandler(
+		SearchServiceConfig{
+			searchRepo: mockSearchRepo,
+		},
+	)
+
+	return &searchHandlerTestFixture{
+		t:              t,
+		mockSearchRepo: mockSearchRepo,
+		searchHandler:  searchHandler,
+	}
+}
+
+func TestSearchServiceHandler_Search(t *testing.T) {
+	hits := []domain.SearchHit{
+		{
+			Type:    domain.ProjectHit,
+			ID:      "p1",
+			Title:   "Portfolio",
+			Snippet: "My <mark>portfolio</mark> site",
+			Rank:    0.6,
+		},
+		{
+			Type:    domain.SkillHit,
+			ID:      "s1",
+			Title:   "Go",
+			Snippet: "<mark>Go</mark>",
+			Rank:    0.1,
+		},
+	}
+
+	type Given struct {
+		method   string
+		query    string
+		mockRepo func(m *mockRepo.MockSearchRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?q=+portfolio+",
+				mockRepo: func(m *mockRepo.MockSearchRepository) {
+					m.EXPECT().
+						Search(mock.Anything, domain.SearchFilter{Query: "portfolio", Limit: 20}).
+						Return(hits, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.SearchResponse{
+					Query: "portfolio",
+					Hits: []dto.SearchHitDTO{
+						{Type: "project", ID: "p1", Title: "Portfolio", Snippet: "My <mark>portfolio</mark> site", Rank: 0.6},
+						{Type: "skill", ID: "s1", Title: "Go", Snippet: "<mark>Go</mark>", Rank: 0.1},
+					},
+				}),
+			},
+		},
+		"success - with types and limit": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?q=go&type=skill,+project&limit=999",
+				mockRepo: func(m *mockRepo.MockSearchRepository) {
+					m.EXPECT().
+						Search(mock.Anything, domain.SearchFilter{
+							Query: "go",
+							Types: []domain.SearchHitType{domain.SkillHit, domain.ProjectHit},
+							Limit: 50,
+						}).
+						Return([]domain.SearchHit{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"query":"go","hits":[]}`,
+			},
+		},
+		"missing query": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?q=+",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid search: query missing\n",
+			},
+		},
+		"query too long": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?q=" + strings.Repeat("a", 201),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid search: query longer than 200 characters\n",
+			},
+		},
+		"invalid type": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?q=go&type=user",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid search: type invalid = user\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+				query:  "?q=go",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+		"repo error": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?q=go",
+				mockRepo: func(m *mockRepo.MockSearchRepository) {
+					m.EXPECT().
+						Search(mock.Anything, mock.AnythingOfType("domain.SearchFilter")).
+						Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to search: db failure\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSearchHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockSearchRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/search"+tt.given.query, nil)
+			w := httptest.NewRecorder()
+
+			f.searchHandler.Search(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockSearchRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSearchServiceHandler_ServeHTTP(t *testing.T) {
+	type Given struct {
+		path string
+	}
+	type Expected struct {
+		code int
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"search": {
+			given:    Given{path: "/search?q=go"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"search with trailing slash": {
+			given:    Given{path: "/search/?q=go"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"unknown route": {
+			given:    Given{path: "/search/extra"},
+			expected: Expected{code: http.StatusNotFound},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSearchHandlerTestFixture(t)
+			f.mockSearchRepo.EXPECT().
+				Search(mock.Anything, mock.AnythingOfType("domain.SearchFilter")).
+				Return([]domain.SearchHit{}, nil).
+				Maybe()
+
+			req := httptest.NewRequest(http.MethodGet, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.searchHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
 	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
 	"github.com/jackc/pgx/v5"
@@ -20,36 +21,105 @@ type SkillHandler interface {
 	Get(w http.ResponseWriter, r *http.Request, id string)
 	Update(w http.ResponseWriter, r *http.Request)
 	Delete(w http.ResponseWriter, r *http.Request, id string)
+	Restore(w http.ResponseWriter, r *http.Request, id string)
 	List(w http.ResponseWriter, r *http.Request)
+	ListProjects(w http.ResponseWriter, r *http.Request, id string)
+	ListExperiences(w http.ResponseWriter, r *http.Request, id string)
 }
 
 type SkillServiceConfig struct {
-	DatabaseAPI database.DatabaseAPI
+	DatabaseAPI     database.DatabaseAPI
+	RepositoryCache *v1.RepositoryCache
 
-	skillRepo v1.SkillRepository
+	skillRepo      v1.SkillRepository
+	projectRepo    v1.ProjectRepository
+	experienceRepo v1.ExperienceRepository
+	fileRepo       v1.FileRepository
 }
 
 type skillServiceHandler struct {
-	skillRepo v1.SkillRepository
+	skillRepo      v1.SkillRepository
+	projectRepo    v1.ProjectRepository
+	experienceRepo v1.ExperienceRepository
+	fileRepo       v1.FileRepository
 }
 
 // NewSkillServiceHandler returns a SkillHandler wired according to the provided
 // SkillServiceConfig. If cfg.skillRepo is nil, a default v1.SkillRepository is
-// created using cfg.DatabaseAPI and the "Skill" table name. The resulting handler
-// uses the supplied or default repository to satisfy skill-related operations.
+// created using cfg.DatabaseAPI and the "Skill" table name; the project, experience
+// and file repositories used to list a skill's projects and experiences are
+// defaulted the same way. Default
+// repositories are audited, so every write is also recorded in the audit log, and
+// read through cfg.RepositoryCache when it is set. The
+// resulting handler uses the supplied or default repositories to satisfy
+// skill-related operations.
 func NewSkillServiceHandler(cfg SkillServiceConfig) SkillHandler {
+	audited := newAuditedRepositoryConfig(cfg.DatabaseAPI)
+
 	skillRepo := cfg.skillRepo
 	if skillRepo == nil {
-		skillRepo = v1.NewSkillRepository(
-			v1.SkillRepositoryConfig{
-				DatabaseAPI: cfg.DatabaseAPI,
-				SkillTable:  "Skill",
+		skillRepo = v1.NewCachedSkillRepository(
+			v1.NewAuditedSkillRepository(
+				v1.NewSkillRepository(
+					v1.SkillRepositoryConfig{
+						DatabaseAPI:          cfg.DatabaseAPI,
+						SkillTable:           "Skill",
+						ProjectSkillTable:    "project_skill",
+						ExperienceSkillTable: "experience_skill",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	projectRepo := cfg.projectRepo
+	if projectRepo == nil {
+		projectRepo = v1.NewCachedProjectRepository(
+			v1.NewAuditedProjectRepository(
+				v1.NewProjectRepository(
+					v1.ProjectRepositoryConfig{
+						DatabaseAPI:       cfg.DatabaseAPI,
+						ProjectTable:      "Project",
+						ProjectSkillTable: "project_skill",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	experienceRepo := cfg.experienceRepo
+	if experienceRepo == nil {
+		experienceRepo = v1.NewExperienceRepository(
+			v1.ExperienceRepositoryConfig{
+				DatabaseAPI:          cfg.DatabaseAPI,
+				ExperienceTable:      "Experience",
+				ExperienceSkillTable: "experience_skill",
 			},
 		)
 	}
 
+	fileRepo := cfg.fileRepo
+	if fileRepo == nil {
+		fileRepo = v1.NewAuditedFileRepository(
+			v1.NewFileRepository(
+				v1.FileRepositoryConfig{
+					DatabaseAPI: cfg.DatabaseAPI,
+					FileTable:   "File",
+				},
+			),
+			audited,
+		)
+	}
+
 	return &skillServiceHandler{
-		skillRepo: skillRepo,
+		skillRepo:      skillRepo,
+		projectRepo:    projectRepo,
+		experienceRepo: experienceRepo,
+		fileRepo:       fileRepo,
 	}
 }
 
@@ -64,6 +134,9 @@ func NewSkillServiceHandler(cfg SkillServiceConfig) SkillHandler {
 //   - PUT  /skill          -> calls h.Update(w, r)
 //   - GET  /skill/{id}     -> calls h.Get(w, r, id)
 //   - DELETE /skill/{id}   -> calls h.Delete(w, r, id)
+//   - GET  /skill/{id}/projects -> calls h.ListProjects(w, r, id)
+//   - GET  /skill/{id}/experiences -> calls h.ListExperiences(w, r, id)
+//   - POST /skill/{id}/restore  -> calls h.Restore(w, r, id)
 //
 // If a method is not allowed for a matched route, ServeHTTP responds with
 // 405 Method Not Allowed. If a required skill ID segment is missing for a
@@ -99,6 +172,57 @@ func (h *skillServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request)
 		}
 		return
 
+	// GET /skill/{id}/projects
+	case strings.HasPrefix(path, "/skill/") && strings.HasSuffix(strings.TrimPrefix(path, "/skill/"), "/projects"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/skill/"), "/projects")
+
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "Skill ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.ListProjects(w, r, id)
+		return
+
+	// GET /skill/{id}/experiences
+	case strings.HasPrefix(path, "/skill/") && strings.HasSuffix(strings.TrimPrefix(path, "/skill/"), "/experiences"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/skill/"), "/experiences")
+
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "Skill ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.ListExperiences(w, r, id)
+		return
+
+	// POST /skill/{id}/restore
+	case strings.HasPrefix(path, "/skill/") && strings.HasSuffix(strings.TrimPrefix(path, "/skill/"), "/restore"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "/skill/"), "/restore")
+
+		if r.Method != http.MethodPost {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "Skill ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.Restore(w, r, id)
+		return
+
 	// GET / DELETE /skill/{id}
 	case strings.HasPrefix(path, "/skill/"):
 		id := strings.TrimPrefix(path, "/skill/")
@@ -218,14 +342,13 @@ func (h *skillServiceHandler) Create(w http.ResponseWriter, r *http.Request) {
 // If JSON encoding or response writing fails, Get responds with 500 Internal Server Error.
 // The id parameter is expected to be the skill identifier (typically extracted from the request URL).
 //
-// @Security ApiKeyAuth
 // @Summary Get a skill by ID
 // @Description Retrieves the details of a specific skill using its unique ID.
 // @Tags skill
 // @Accept json
 // @Produce json
 // @Param id path string true "Skill ID"
-// @Success 200 {object} SkillDTO
+// @Success 200 {object} dto.SkillDTO
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
@@ -250,7 +373,7 @@ func (h *skillServiceHandler) Get(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
-	skill := SkillDTO{
+	skill := dto.SkillDTO{
 		Id:        skillRes.Id,
 		Icon:      skillRes.Icon,
 		HexColor:  skillRes.HexColor,
@@ -266,6 +389,7 @@ func (h *skillServiceHandler) Get(w http.ResponseWriter, r *http.Request, id str
 		return
 	}
 
+	w.Header().Set("ETag", etag(skillRes.UpdatedAt))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
@@ -288,6 +412,7 @@ func (h *skillServiceHandler) Get(w http.ResponseWriter, r *http.Request, id str
 // Notes:
 // - Uses json.Decoder/Encoder for request/response processing.
 // - Errors include brief human-readable messages for client feedback.
+// - If-Match must carry the ETag from GET /skill/{id} (or "*"); a stale version yields 412.
 //
 // @Security ApiKeyAuth
 // @Summary Update a skill
@@ -295,10 +420,13 @@ func (h *skillServiceHandler) Get(w http.ResponseWriter, r *http.Request, id str
 // @Tags skill
 // @Accept json
 // @Produce json
+// @Param If-Match header string true "ETag of the version being edited, or *"
 // @Param skill body UpdateSkillRequest true "Skill payload with ID"
 // @Success 200 {object} UpdateSkillResponse
 // @Failure 400 {object} ErrorResponse
 // @Failure 404 {object} ErrorResponse
+// @Failure 412 {object} ErrorResponse
+// @Failure 428 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /skill [put]
 func (h *skillServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -307,6 +435,11 @@ func (h *skillServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	version, ok := ifMatchVersion(w, r)
+	if !ok {
+		return
+	}
+
 	defer r.Body.Close()
 
 	var updateReq UpdateSkillRequest
@@ -316,11 +449,12 @@ func (h *skillServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	skill := domain.Skill{
-		Id:       updateReq.Id,
-		Icon:     updateReq.Icon,
-		HexColor: updateReq.HexColor,
-		Label:    updateReq.Label,
-		Category: domain.SkillCategory(updateReq.Category),
+		Id:        updateReq.Id,
+		Icon:      updateReq.Icon,
+		HexColor:  updateReq.HexColor,
+		Label:     updateReq.Label,
+		Category:  domain.SkillCategory(updateReq.Category),
+		UpdatedAt: version,
 	}
 
 	if err := skill.ValidatePayload(); err != nil {
@@ -330,6 +464,12 @@ func (h *skillServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 
 	updatedSkillRes, err := h.skillRepo.Update(r.Context(), &skill)
 	if err != nil {
+		var conflict *domain.ConflictError
+		if errors.As(err, &conflict) {
+			w.Header().Set("ETag", etag(conflict.Current))
+			http.Error(w, "Skill was modified by another request: "+err.Error(), http.StatusPreconditionFailed)
+			return
+		}
 		http.Error(w, "Failed to update skill: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -354,15 +494,18 @@ func (h *skillServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	w.Header().Set("ETag", etag(updatedSkillRes.UpdatedAt))
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
 }
 
-// Delete handles HTTP DELETE requests to remove a skill identified by id.
+// Delete handles HTTP DELETE requests to move a skill identified by id to the trash.
 // It expects the request method to be DELETE; otherwise it responds with
 // 405 Method Not Allowed. The request context is forwarded to the repository
-// layer when attempting the delete.
+// layer when attempting the delete. A trashed skill is hidden from every read,
+// including the skills embedded in projects, until it is restored through
+// POST /skill/{id}/restore or purged through the trash.
 //
 // On success the handler responds with 204 No Content and no response body.
 // If the repository returns pgx.ErrNoRows the handler responds with 404 Not Found.
@@ -376,7 +519,7 @@ func (h *skillServiceHandler) Update(w http.ResponseWriter, r *http.Request) {
 //
 // @Security ApiKeyAuth
 // @Summary Delete a skill
-// @Description Deletes an existing skill by its unique ID provided in the path.
+// @Description Moves an existing skill, identified by the ID in the path, to the trash.
 // @Tags skill
 // @Param id path string true "Skill ID"
 // @Success 204 "No Content"
@@ -405,6 +548,40 @@ func (h *skillServiceHandler) Delete(w http.ResponseWriter, r *http.Request, id
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Restore handles HTTP POST requests to take a skill out of the trash. Its
+// project links were kept while it was trashed, so they come back with it.
+// It responds with 204 No Content on success and 404 Not Found when the skill
+// is not in the trash.
+//
+// @Security ApiKeyAuth
+// @Summary Restore a skill
+// @Description Restores a trashed skill identified by the ID in the path.
+// @Tags skill
+// @Param id path string true "Skill ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /skill/{id}/restore [post]
+func (h *skillServiceHandler) Restore(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed: only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.skillRepo.Restore(r.Context(), id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "Skill not found in trash", http.StatusNotFound)
+			return
+		}
+
+		http.Error(w, "Failed to restore skill: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // List handles HTTP GET requests to return a paginated list of skills.
 //
 // It only supports the GET method; other methods receive HTTP 405 Method Not Allowed.
@@ -414,18 +591,21 @@ func (h *skillServiceHandler) Delete(w http.ResponseWriter, r *http.Request, id
 //   - page_size (int, default: 10, max: 100): number of items per page. Values < 1 revert to the default; values > 100 are clamped to 100.
 //   - sort_by (string): validated via utils.GetQuerySortBy; if invalid, the handler returns HTTP 400.
 //   - sort_ascending (bool, default: false): whether to sort ascending.
+//   - cursor (string): optional next_cursor from a previous page for keyset pagination; requires sort_by=created_at, otherwise HTTP 400.
 //   - category (string): optional skill category. Supported categories are "Frontend", "Backend", "Tools", and "Others"; unknown categories result in HTTP 400.
 //
 // Behavior:
 //   - Builds a domain.SkillFilter from the validated query parameters and calls the repository to obtain the skill list.
 //   - On repository errors, responds with HTTP 500 and an error message.
-//   - On successful retrieval, encodes the skills as JSON, sets Content-Type: application/json, and responds with HTTP 200.
+//   - On successful retrieval, encodes a SkillListResponse envelope (items plus total, page, page_size, has_next
+//     and next_cursor) as JSON, sets Content-Type: application/json, and responds with HTTP 200.
 //   - On JSON encoding errors, responds with HTTP 500.
+//   - Sets ETag and Last-Modified from the newest skill write; a current If-None-Match or If-Modified-Since
+//     short-circuits with HTTP 304 before the list is queried.
 //
 // Notes:
 //   - The handler returns concise HTTP error responses for invalid input (400), unsupported method (405), and internal failures (500).
 //
-// @Security ApiKeyAuth
 // @Summary List skills
 // @Description Retrieves a paginated list of skills with optional filtering and sorting.
 // @Tags skill
@@ -436,7 +616,11 @@ func (h *skillServiceHandler) Delete(w http.ResponseWriter, r *http.Request, id
 // @Param sort_by query string false "Field to sort by" Enums(created_at, updated_at)
 // @Param sort_ascending query bool false "Sort ascending order"
 // @Param category query string false "Filter by skill category" Enums(frontend, backend, tools, others)
-// @Success 200 {array} SkillDTO
+// @Param cursor query string false "Keyset cursor from a previous next_cursor; requires sort_by=created_at"
+// @Param If-None-Match header string false "ETag from a previous response; answered with 304 while it is current"
+// @Param If-Modified-Since header string false "Last-Modified from a previous response; ignored when If-None-Match is sent"
+// @Success 200 {object} SkillListResponse
+// @Success 304 "Not Modified"
 // @Failure 400 {object} ErrorResponse
 // @Failure 500 {object} ErrorResponse
 // @Router /skills [get]
@@ -460,6 +644,7 @@ func (h *skillServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		SortBy:        sortBy,
 		SortAscending: utils.GetQueryBool(q, "sort_ascending", false),
 		Category:      q.Get("category"),
+		Cursor:        q.Get("cursor"),
 	}
 
 	// Clamp page to minimum of 1
@@ -487,6 +672,12 @@ func (h *skillServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	cursor, err := parseCursor(filter.Cursor, filter.SortBy)
+	if err != nil {
+		http.Error(w, "invalid cursor: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	var sortByPtr *domain.SortBy
 	if filter.SortBy != "" {
 		sb := domain.SortBy(filter.SortBy)
@@ -498,16 +689,148 @@ func (h *skillServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 		SortBy:        sortByPtr,
 		SortAscending: filter.SortAscending,
 		Category:      skillCategory,
+		Cursor:        cursor,
 	}
 
-	skills, err := h.skillRepo.List(r.Context(), domainFilter)
+	version, err := h.skillRepo.Version(r.Context())
+	if err != nil {
+		http.Error(w, "Failed to check skills version: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if notModified(w, r, version) {
+		return
+	}
+
+	skills, pageInfo, err := h.skillRepo.List(r.Context(), domainFilter)
 	if err != nil {
 		http.Error(w, "Failed to list skills: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	items := make([]dto.SkillDTO, 0, len(skills))
+	for _, skill := range skills {
+		items = append(items, dto.SkillDTO{
+			Id:        skill.Id,
+			Icon:      skill.Icon,
+			HexColor:  skill.HexColor,
+			Label:     skill.Label,
+			Category:  string(skill.Category),
+			CreatedAt: skill.CreatedAt,
+			UpdatedAt: skill.UpdatedAt,
+		})
+	}
+
+	resp := SkillListResponse{
+		Items:       items,
+		PageMetaDTO: toPageMetaDTO(pageInfo),
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(resp); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// ListProjects handles HTTP GET requests for the projects a skill is linked to,
+// newest first, so the skills page can show where each technology was used.
+// The skill must exist; each project carries its preview images, fetched in a
+// single batched query. It responds with 404 when the skill is unknown and with
+// the appropriate HTTP error for other failures.
+//
+// @Summary List a skill's projects
+// @Description Retrieves the projects linked to the skill with the given ID, newest first.
+// @Tags skill
+// @Accept json
+// @Produce json
+// @Param id path string true "Skill ID"
+// @Success 200 {array} dto.ProjectDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /skill/{id}/projects [get]
+func (h *skillServiceHandler) ListProjects(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	skill, err := h.skillRepo.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "Skill not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "GET error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if skill == nil {
+		http.Error(w, "Skill not found", http.StatusNotFound)
+		return
+	}
+
+	projects, err := h.projectRepo.ListBySkillID(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to list skill projects: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	projectIDs := make([]string, len(projects))
+	for i, p := range projects {
+		projectIDs[i] = p.Id
+	}
+
+	previewsByProject := make(map[string][]domain.File)
+	if len(projectIDs) > 0 {
+		previewsByProject, err = h.fileRepo.FindByParents(r.Context(), "project", projectIDs, domain.Image)
+		if err != nil {
+			http.Error(w, "Failed to retrieve previews: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	projectDTOs := make([]dto.ProjectDTO, 0, len(projects))
+	for _, project := range projects {
+		previews := previewsByProject[project.Id]
+
+		previewDTOs := make([]dto.FileDTO, 0, len(previews))
+		for _, preview := range previews {
+			previewDTOs = append(previewDTOs, dto.FileDTO{
+				ID:          preview.ID,
+				ParentTable: string(preview.ParentTable),
+				ParentID:    preview.ParentID,
+				Role:        string(preview.Role),
+				Name:        preview.Name,
+				URL:         preview.URL,
+				Type:        preview.Type,
+				Size:        preview.Size,
+				CreatedAt:   preview.CreatedAt,
+				UpdatedAt:   preview.UpdatedAt,
+			})
+		}
+
+		projectDTOs = append(projectDTOs, dto.ProjectDTO{
+			ID:          project.Id,
+			BlurHash:    project.BlurHash,
+			Title:       project.Title,
+			Subtitle:    project.Subtitle,
+			Description: project.Description,
+			Tags:        project.Tags,
+			Type:        string(project.Type),
+			Link:        project.Link,
+			EducationID: project.EducationID,
+			Previews:    previewDTOs,
+			CreatedAt:   project.CreatedAt,
+			UpdatedAt:   project.UpdatedAt,
+		})
+	}
+
 	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(skills); err != nil {
+	if err := json.NewEncoder(&buf).Encode(projectDTOs); err != nil {
 		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -516,3 +839,97 @@ func (h *skillServiceHandler) List(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 	w.Write(buf.Bytes())
 }
+
+// ListExperiences handles HTTP GET requests for the experiences a skill is linked
+// to, most recent first. The skill must exist; each experience carries its company
+// logo, fetched in a single batched query. It responds with 404 when the skill is
+// unknown and with the appropriate HTTP error for other failures.
+//
+// @Summary List a skill's experiences
+// @Description Retrieves the work experiences linked to the skill with the given ID, most recent first.
+// @Tags skill
+// @Accept json
+// @Produce json
+// @Param id path string true "Skill ID"
+// @Success 200 {array} dto.ExperienceDTO
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /skill/{id}/experiences [get]
+func (h *skillServiceHandler) ListExperiences(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	skill, err := h.skillRepo.Get(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			http.Error(w, "Skill not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "GET error: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if skill == nil {
+		http.Error(w, "Skill not found", http.StatusNotFound)
+		return
+	}
+
+	experiences, err := h.experienceRepo.ListBySkillID(r.Context(), id)
+	if err != nil {
+		http.Error(w, "Failed to list skill experiences: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	experienceIDs := make([]string, len(experiences))
+	for i, e := range experiences {
+		experienceIDs[i] = e.Id
+	}
+
+	logosByExperience := make(map[string][]domain.File)
+	if len(experienceIDs) > 0 {
+		logosByExperience, err = h.fileRepo.FindByParents(r.Context(), string(domain.ExperienceTable), experienceIDs, domain.Image)
+		if err != nil {
+			http.Error(w, "Failed to retrieve experience logos: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	experienceDTOs := make([]dto.ExperienceDTO, 0, len(experiences))
+	for _, experience := range experiences {
+		experienceDTOs = append(experienceDTOs, toExperienceDTO(experience, logosByExperience[experience.Id], nil))
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(experienceDTOs); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// toSkillDTOs maps domain skills to their response DTOs. It returns nil for no
+// skills so embedding DTOs can omit the field.
+func toSkillDTOs(skills []domain.Skill) []dto.SkillDTO {
+	if len(skills) == 0 {
+		return nil
+	}
+
+	skillDTOs := make([]dto.SkillDTO, len(skills))
+	for i, skill := range skills {
+		skillDTOs[i] = dto.SkillDTO{
+			Id:        skill.Id,
+			Icon:      skill.Icon,
+			HexColor:  skill.HexColor,
+			Label:     skill.Label,
+			Category:  string(skill.Category),
+			CreatedAt: skill.CreatedAt,
+			UpdatedAt: skill.UpdatedAt,
+		}
+	}
+	return skillDTOs
+}
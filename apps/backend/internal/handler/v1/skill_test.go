@@ -12,6 +12,7 @@ import (
 	"time"
 
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
 	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
 	"github.com/jackc/pgx/v5"
 	"github.com/stretchr/testify/assert"
@@ -19,23 +20,40 @@ import (
 )
 
 type skillHandlerTestFixture struct {
-	t             *testing.T
-	mockSkillRepo *mockRepo.MockSkillRepository
-	skillHandler  SkillHandler
+	t                  *testing.T
+	mockSkillRepo      *mockRepo.MockSkillRepository
+	mockProjectRepo    *mockRepo.MockProjectRepository
+	mockExperienceRepo *mockRepo.MockExperienceRepository
+	mockFileRepo       *mockRepo.MockFileRepository
+	skillHandler       SkillHandler
 }
 
 func newSkillHandlerTestFixture(t *testing.T) *skillHandlerTestFixture {
 	mockSkillRepo := new(mockRepo.MockSkillRepository)
+	mockProjectRepo := new(mockRepo.MockProjectRepository)
+	mockExperienceRepo := new(mockRepo.MockExperienceRepository)
+	mockFileRepo := new(mockRepo.MockFileRepository)
+
+	// An empty collection version carries no validators, so lists are served
+	// unconditionally unless a test builds its own repositories.
+	mockSkillRepo.EXPECT().Version(mock.Anything).Return(domain.CollectionVersion{}, nil).Maybe()
+
 	skillHandler := NewSkillServiceHandler(
 		SkillServiceConfig{
-			skillRepo: mockSkillRepo,
+			skillRepo:      mockSkillRepo,
+			projectRepo:    mockProjectRepo,
+			experienceRepo: mockExperienceRepo,
+			fileRepo:       mockFileRepo,
 		},
 	)
 
 	return &skillHandlerTestFixture{
-		t:             t,
-		mockSkillRepo: mockSkillRepo,
-		skillHandler:  skillHandler,
+		t:                  t,
+		mockSkillRepo:      mockSkillRepo,
+		mockProjectRepo:    mockProjectRepo,
+		mockExperienceRepo: mockExperienceRepo,
+		mockFileRepo:       mockFileRepo,
+		skillHandler:       skillHandler,
 	}
 }
 
@@ -251,7 +269,7 @@ func TestSkillServiceHandler_Get(t *testing.T) {
 	fixedID := "skill-123"
 	fixedTime := time.Date(2025, 10, 25, 21, 56, 4, 0, time.UTC)
 
-	sampleSkill := &SkillDTO{
+	sampleSkill := &dto.SkillDTO{
 		Id:        fixedID,
 		Icon:      "icon.png",
 		HexColor:  "#FFFFFF",
@@ -420,7 +438,7 @@ func TestSkillServiceHandler_Get_Routing(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 
-	dto := SkillDTO{
+	skillDTO := dto.SkillDTO{
 		Id:        sampleSkill.Id,
 		Icon:      sampleSkill.Icon,
 		HexColor:  sampleSkill.HexColor,
@@ -430,7 +448,7 @@ func TestSkillServiceHandler_Get_Routing(t *testing.T) {
 		UpdatedAt: sampleSkill.UpdatedAt,
 	}
 
-	expectedResp, _ := json.Marshal(dto)
+	expectedResp, _ := json.Marshal(skillDTO)
 
 	f := newSkillHandlerTestFixture(t)
 
@@ -492,14 +510,19 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 	validBody, _ := json.Marshal(requestDTO)
 	validResp, _ := json.Marshal(responseDTO)
 
+	version := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	ifMatch := etag(version)
+
 	type Given struct {
 		method   string
+		ifMatch  string
 		body     string
 		mockRepo func(m *mockRepo.MockSkillRepository)
 	}
 	type Expected struct {
 		code int
 		body string
+		etag string
 	}
 
 	tests := map[string]struct {
@@ -508,12 +531,13 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 	}{
 		"success": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockRepo: func(m *mockRepo.MockSkillRepository) {
 					m.EXPECT().
 						Update(mock.Anything, mock.MatchedBy(func(s *domain.Skill) bool {
-							return s.Id == fixedID && s.Label == "JavaScript"
+							return s.Id == fixedID && s.Label == "JavaScript" && s.UpdatedAt.Equal(version)
 						})).
 						Return(existingSkill, nil)
 				},
@@ -521,6 +545,45 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 			expected: Expected{
 				code: http.StatusOK,
 				body: string(validResp),
+				etag: etag(existingSkill.UpdatedAt),
+			},
+		},
+		"missing If-Match": {
+			given: Given{
+				method: http.MethodPut,
+				body:   string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusPreconditionRequired,
+				body: "If-Match header is required\n",
+			},
+		},
+		"If-Match list": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: ifMatch + ", " + etag(existingSkill.UpdatedAt),
+				body:    string(validBody),
+			},
+			expected: Expected{
+				code: http.StatusPreconditionFailed,
+				body: "If-Match must name a single strong ETag\n",
+			},
+		},
+		"stale version": {
+			given: Given{
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
+				mockRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						Update(mock.Anything, mock.AnythingOfType("*domain.Skill")).
+						Return(nil, &domain.ConflictError{Entity: "skill", ID: fixedID, Current: version.Add(time.Minute)})
+				},
+			},
+			expected: Expected{
+				code: http.StatusPreconditionFailed,
+				body: "Skill was modified by another request: skill " + fixedID + " was modified at 2025-01-01T12:01:00Z\n",
+				etag: etag(version.Add(time.Minute)),
 			},
 		},
 		"method not allowed": {
@@ -537,6 +600,7 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 		"invalid JSON": {
 			given: Given{
 				method:   http.MethodPut,
+				ifMatch:  ifMatch,
 				body:     `{"invalid":}`,
 				mockRepo: nil,
 			},
@@ -547,7 +611,8 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 		},
 		"invalid payload (validation failure)": {
 			given: Given{
-				method: http.MethodPut,
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
 				body: func() string {
 					bad := requestDTO
 					bad.Label = "" // assume ValidatePayload requires Label
@@ -563,8 +628,9 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 		},
 		"repository error": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockRepo: func(m *mockRepo.MockSkillRepository) {
 					m.EXPECT().
 						Update(mock.Anything, mock.AnythingOfType("*domain.Skill")).
@@ -578,8 +644,9 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 		},
 		"skill not found": {
 			given: Given{
-				method: http.MethodPut,
-				body:   string(validBody),
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
+				body:    string(validBody),
 				mockRepo: func(m *mockRepo.MockSkillRepository) {
 					m.EXPECT().
 						Update(mock.Anything, mock.AnythingOfType("*domain.Skill")).
@@ -593,7 +660,8 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 		},
 		"unicode fields": {
 			given: Given{
-				method: http.MethodPut,
+				method:  http.MethodPut,
+				ifMatch: ifMatch,
 				body: func() string {
 					unicode := requestDTO
 					unicode.Label = "東京大学 🏫"
@@ -624,6 +692,9 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 			}
 
 			req := httptest.NewRequest(tt.given.method, "/skill", strings.NewReader(tt.given.body))
+			if tt.given.ifMatch != "" {
+				req.Header.Set("If-Match", tt.given.ifMatch)
+			}
 			w := httptest.NewRecorder()
 
 			f.skillHandler.Update(w, req)
@@ -633,6 +704,9 @@ func TestSkillServiceHandler_Update(t *testing.T) {
 
 			body, _ := io.ReadAll(res.Body)
 			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.etag != "" {
+				assert.Equal(t, tt.expected.etag, res.Header.Get("ETag"))
+			}
 
 			if strings.HasPrefix(tt.expected.body, "{") {
 				assert.JSONEq(t, tt.expected.body, string(body))
@@ -792,6 +866,109 @@ func TestSkillServiceHandler_Delete_Routing(t *testing.T) {
 	f.mockSkillRepo.AssertExpectations(t)
 }
 
+func TestSkillServiceHandler_Restore(t *testing.T) {
+	fixedID := "skill-123"
+
+	type Given struct {
+		method   string
+		path     string
+		mockRepo func(m *mockRepo.MockSkillRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/skill/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNoContent,
+				body: "",
+			},
+		},
+		"not in trash": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/skill/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Skill not found in trash\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/skill/" + fixedID + "/restore",
+				mockRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Restore(mock.Anything, fixedID).Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to restore skill: db error\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + fixedID + "/restore",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"missing id": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/skill//restore",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Skill ID is required\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockSkillRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.skillHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
 func TestSkillServiceHandler_List(t *testing.T) {
 	fixedTime := time.Now()
 	validSkills := []domain.Skill{
@@ -836,12 +1013,12 @@ func TestSkillServiceHandler_List(t *testing.T) {
 				mockRepo: func(m *mockRepo.MockSkillRepository) {
 					m.EXPECT().
 						List(mock.Anything, mock.AnythingOfType("domain.SkillFilter")).
-						Return(validSkills, nil)
+						Return(validSkills, domain.PageInfo{Total: 2, Page: 1, PageSize: 10}, nil)
 				},
 			},
 			expected: Expected{
 				code: http.StatusOK,
-				body: toJSON(validSkills),
+				body: skillListJSON(dto.PageMetaDTO{Total: 2, Page: 1, PageSize: 10}, validSkills),
 			},
 		},
 		"success - with category filter": {
@@ -851,12 +1028,39 @@ func TestSkillServiceHandler_List(t *testing.T) {
 				mockRepo: func(m *mockRepo.MockSkillRepository) {
 					m.EXPECT().
 						List(mock.Anything, mock.AnythingOfType("domain.SkillFilter")).
-						Return([]domain.Skill{validSkills[0]}, nil)
+						Return([]domain.Skill{validSkills[0]}, domain.PageInfo{Total: 1, Page: 1, PageSize: 5}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: skillListJSON(dto.PageMetaDTO{Total: 1, Page: 1, PageSize: 5}, []domain.Skill{validSkills[0]}),
+			},
+		},
+		"success - with cursor returns next cursor": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?page_size=2&cursor=" + domain.Cursor{CreatedAt: fixedTime, ID: "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6e"}.Encode(),
+				mockRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.MatchedBy(func(f domain.SkillFilter) bool {
+							return f.Cursor != nil && f.Cursor.ID == "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6e"
+						})).
+						Return(validSkills, domain.PageInfo{Total: 5, Page: 1, PageSize: 2, HasNext: true, NextCursor: "next"}, nil)
 				},
 			},
 			expected: Expected{
 				code: http.StatusOK,
-				body: toJSON([]domain.Skill{validSkills[0]}),
+				body: skillListJSON(dto.PageMetaDTO{Total: 5, Page: 1, PageSize: 2, HasNext: true, NextCursor: "next"}, validSkills),
+			},
+		},
+		"cursor with updated_at sort": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?sort_by=updated_at&cursor=" + domain.Cursor{CreatedAt: fixedTime, ID: "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6e"}.Encode(),
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "invalid cursor: cursor requires sort_by=created_at\n",
 			},
 		},
 		"invalid method": {
@@ -896,7 +1100,7 @@ func TestSkillServiceHandler_List(t *testing.T) {
 				mockRepo: func(m *mockRepo.MockSkillRepository) {
 					m.EXPECT().
 						List(mock.Anything, mock.AnythingOfType("domain.SkillFilter")).
-						Return(nil, errors.New("db failure"))
+						Return(nil, domain.PageInfo{}, errors.New("db failure"))
 				},
 			},
 			expected: Expected{
@@ -925,7 +1129,7 @@ func TestSkillServiceHandler_List(t *testing.T) {
 			body, _ := io.ReadAll(res.Body)
 			assert.Equal(t, tt.expected.code, res.StatusCode)
 
-			if strings.HasPrefix(tt.expected.body, "[") {
+			if strings.HasPrefix(tt.expected.body, "{") {
 				assert.JSONEq(t, tt.expected.body, string(body))
 			} else {
 				assert.Equal(t, tt.expected.body, string(body))
@@ -954,7 +1158,7 @@ func TestSkillServiceHandler_List_Routing(t *testing.T) {
 
 	f.mockSkillRepo.EXPECT().
 		List(mock.Anything, mock.AnythingOfType("domain.SkillFilter")).
-		Return(validSkills, nil)
+		Return(validSkills, domain.PageInfo{Total: 1, Page: 1, PageSize: 10}, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/skills", nil)
 	w := httptest.NewRecorder()
@@ -968,7 +1172,591 @@ func TestSkillServiceHandler_List_Routing(t *testing.T) {
 
 	body, _ := io.ReadAll(res.Body)
 	assert.Equal(t, http.StatusOK, res.StatusCode)
-	assert.JSONEq(t, toJSON(validSkills), string(body))
+	assert.JSONEq(t, skillListJSON(dto.PageMetaDTO{Total: 1, Page: 1, PageSize: 10}, validSkills), string(body))
 
 	f.mockSkillRepo.AssertExpectations(t)
 }
+
+// skillListJSON renders the list envelope the skill List handler is expected to write.
+func skillListJSON(meta dto.PageMetaDTO, skills []domain.Skill) string {
+	items := make([]dto.SkillDTO, 0, len(skills))
+	for _, skill := range skills {
+		items = append(items, dto.SkillDTO{
+			Id:        skill.Id,
+			Icon:      skill.Icon,
+			HexColor:  skill.HexColor,
+			Label:     skill.Label,
+			Category:  string(skill.Category),
+			CreatedAt: skill.CreatedAt,
+			UpdatedAt: skill.UpdatedAt,
+		})
+	}
+	return toJSON(SkillListResponse{Items: items, PageMetaDTO: meta})
+}
+
+func TestSkillServiceHandler_List_Conditional(t *testing.T) {
+	lastModified := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	currentTag := etag(lastModified)[:len(etag(lastModified))-1] + `-4"`
+
+	type Given struct {
+		headers    map[string]string
+		versionErr error
+		mockRepo   func(m *mockRepo.MockSkillRepository)
+	}
+
+	type Expected struct {
+		code int
+		etag string
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"current If-None-Match is not modified": {
+			given: Given{
+				headers: map[string]string{"If-None-Match": currentTag},
+			},
+			expected: Expected{
+				code: http.StatusNotModified,
+				etag: currentTag,
+			},
+		},
+		"modified since the client's copy": {
+			given: Given{
+				headers: map[string]string{"If-Modified-Since": "Wed, 01 Jan 2025 11:00:00 GMT"},
+				mockRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.AnythingOfType("domain.SkillFilter")).
+						Return([]domain.Skill{}, domain.PageInfo{Page: 1, PageSize: 10}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				etag: currentTag,
+				body: `{"items":[],"total":0,"page":1,"page_size":10,"has_next":false}`,
+			},
+		},
+		"version lookup fails": {
+			given: Given{
+				versionErr: errors.New("db down"),
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to check skills version: db down\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockSkillRepo := new(mockRepo.MockSkillRepository)
+			mockSkillRepo.EXPECT().
+				Version(mock.Anything).
+				Return(domain.CollectionVersion{LastModified: lastModified, Count: 4}, tt.given.versionErr)
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(mockSkillRepo)
+			}
+
+			handler := NewSkillServiceHandler(
+				SkillServiceConfig{
+					skillRepo:      mockSkillRepo,
+					projectRepo:    new(mockRepo.MockProjectRepository),
+					experienceRepo: new(mockRepo.MockExperienceRepository),
+					fileRepo:       new(mockRepo.MockFileRepository),
+				},
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/skills", nil)
+			for k, v := range tt.given.headers {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+
+			handler.List(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			assert.Equal(t, tt.expected.etag, w.Header().Get("ETag"))
+			if strings.HasPrefix(tt.expected.body, "{") {
+				assert.JSONEq(t, tt.expected.body, w.Body.String())
+			} else {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+
+			mockSkillRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSkillServiceHandler_ListProjects(t *testing.T) {
+	skillID := "skill-123"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	skill := &domain.Skill{
+		Id:        skillID,
+		Icon:      "go.svg",
+		HexColor:  "#00ADD8",
+		Label:     "Go",
+		Category:  domain.Backend,
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	projects := []domain.Project{
+		{
+			Id:          "p1",
+			BlurHash:    "hash1",
+			Title:       "title1",
+			Subtitle:    "subtitle1",
+			Description: "desc1",
+			Tags:        []string{"go"},
+			Type:        domain.Web,
+			Link:        "http://example.com/1",
+			CreatedAt:   fixedTime,
+			UpdatedAt:   fixedTime,
+		},
+		{
+			Id:          "p2",
+			BlurHash:    "hash2",
+			Title:       "title2",
+			Subtitle:    "subtitle2",
+			Description: "desc2",
+			Tags:        []string{"go", "react"},
+			Type:        domain.Mobile,
+			Link:        "http://example.com/2",
+			CreatedAt:   fixedTime,
+			UpdatedAt:   fixedTime,
+		},
+	}
+
+	preview := domain.File{
+		ID:          "f1",
+		ParentTable: "project",
+		ParentID:    "p1",
+		Role:        domain.Image,
+		Name:        "preview.png",
+		URL:         "http://example.com/preview.png",
+		Type:        "image/png",
+		Size:        1024,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	type Given struct {
+		method          string
+		path            string
+		mockSkillRepo   func(m *mockRepo.MockSkillRepository)
+		mockProjectRepo func(m *mockRepo.MockProjectRepository)
+		mockFileRepo    func(m *mockRepo.MockFileRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/projects",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockProjectRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return(projects, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1", "p2"}, domain.Image).
+						Return(map[string][]domain.File{"p1": {preview}, "p2": {}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON([]dto.ProjectDTO{
+					{
+						ID:          "p1",
+						BlurHash:    "hash1",
+						Title:       "title1",
+						Subtitle:    "subtitle1",
+						Description: "desc1",
+						Tags:        []string{"go"},
+						Type:        string(domain.Web),
+						Link:        "http://example.com/1",
+						Previews: []dto.FileDTO{
+							{
+								ID:          "f1",
+								ParentTable: "project",
+								ParentID:    "p1",
+								Role:        "image",
+								Name:        "preview.png",
+								URL:         "http://example.com/preview.png",
+								Type:        "image/png",
+								Size:        1024,
+								CreatedAt:   fixedTime,
+								UpdatedAt:   fixedTime,
+							},
+						},
+						CreatedAt: fixedTime,
+						UpdatedAt: fixedTime,
+					},
+					{
+						ID:          "p2",
+						BlurHash:    "hash2",
+						Title:       "title2",
+						Subtitle:    "subtitle2",
+						Description: "desc2",
+						Tags:        []string{"go", "react"},
+						Type:        string(domain.Mobile),
+						Link:        "http://example.com/2",
+						Previews:    []dto.FileDTO{},
+						CreatedAt:   fixedTime,
+						UpdatedAt:   fixedTime,
+					},
+				}),
+			},
+		},
+		"success - no linked projects": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/projects/",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockProjectRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return([]domain.Project{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: "[]\n",
+			},
+		},
+		"skill not found": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/projects",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(nil, pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Skill not found\n",
+			},
+		},
+		"skill lookup error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/projects",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "GET error: db failure\n",
+			},
+		},
+		"projects error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/projects",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockProjectRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list skill projects: db failure\n",
+			},
+		},
+		"previews error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/projects",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockProjectRepo: func(m *mockRepo.MockProjectRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return(projects, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, "project", []string{"p1", "p2"}, domain.Image).
+						Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to retrieve previews: file failure\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/skill/" + skillID + "/projects",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"missing skill id": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill//projects",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Skill ID is required\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillHandlerTestFixture(t)
+
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+			if tt.given.mockProjectRepo != nil {
+				tt.given.mockProjectRepo(f.mockProjectRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.skillHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "[{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockSkillRepo.AssertExpectations(t)
+			f.mockProjectRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSkillServiceHandler_ListExperiences(t *testing.T) {
+	skillID := "skill-123"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	skill := &domain.Skill{
+		Id:        skillID,
+		Icon:      "flutter.svg",
+		HexColor:  "#02569B",
+		Label:     "Flutter",
+		Category:  domain.Frontend,
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	experience := domain.Experience{
+		Id:         "exp-1",
+		Position:   "Mobile Application Developer",
+		Company:    "GotWork Digital",
+		Setup:      domain.Remote,
+		StartDate:  time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		Highlights: []string{"Rewrote the app in Flutter"},
+		Skills:     []string{"Flutter"},
+		CreatedAt:  fixedTime,
+		UpdatedAt:  fixedTime,
+	}
+
+	logo := domain.File{
+		ID:          "f1",
+		ParentTable: domain.ExperienceTable,
+		ParentID:    "exp-1",
+		Role:        domain.Image,
+		Name:        "logo.png",
+		URL:         "http://example.com/logo.png",
+		Type:        "image/png",
+		Size:        1024,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	type Given struct {
+		method             string
+		path               string
+		mockSkillRepo      func(m *mockRepo.MockSkillRepository)
+		mockExperienceRepo func(m *mockRepo.MockExperienceRepository)
+		mockFileRepo       func(m *mockRepo.MockFileRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/experiences",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockExperienceRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return([]domain.Experience{experience}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, string(domain.ExperienceTable), []string{"exp-1"}, domain.Image).
+						Return(map[string][]domain.File{"exp-1": {logo}}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON([]dto.ExperienceDTO{toExperienceDTO(experience, []domain.File{logo}, nil)}),
+			},
+		},
+		"success - no linked experiences": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/experiences/",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockExperienceRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return([]domain.Experience{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: "[]\n",
+			},
+		},
+		"skill not found": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/experiences",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(nil, pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Skill not found\n",
+			},
+		},
+		"experiences error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/experiences",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockExperienceRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return(nil, errors.New("db failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list skill experiences: db failure\n",
+			},
+		},
+		"logos error": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill/" + skillID + "/experiences",
+				mockSkillRepo: func(m *mockRepo.MockSkillRepository) {
+					m.EXPECT().Get(mock.Anything, skillID).Return(skill, nil)
+				},
+				mockExperienceRepo: func(m *mockRepo.MockExperienceRepository) {
+					m.EXPECT().ListBySkillID(mock.Anything, skillID).Return([]domain.Experience{experience}, nil)
+				},
+				mockFileRepo: func(m *mockRepo.MockFileRepository) {
+					m.EXPECT().
+						FindByParents(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, errors.New("file failure"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to retrieve experience logos: file failure\n",
+			},
+		},
+		"invalid method": {
+			given: Given{
+				method: http.MethodPost,
+				path:   "/skill/" + skillID + "/experiences",
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed\n",
+			},
+		},
+		"missing skill id": {
+			given: Given{
+				method: http.MethodGet,
+				path:   "/skill//experiences",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Skill ID is required\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillHandlerTestFixture(t)
+
+			if tt.given.mockSkillRepo != nil {
+				tt.given.mockSkillRepo(f.mockSkillRepo)
+			}
+			if tt.given.mockExperienceRepo != nil {
+				tt.given.mockExperienceRepo(f.mockExperienceRepo)
+			}
+			if tt.given.mockFileRepo != nil {
+				tt.given.mockFileRepo(f.mockFileRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.skillHandler.ServeHTTP(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+
+			if strings.HasPrefix(tt.expected.body, "[{") {
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockSkillRepo.AssertExpectations(t)
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,352 @@
+package v1
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type TrashHandler interface {
+	http.Handler
+	List(w http.ResponseWriter, r *http.Request)
+	Purge(w http.ResponseWriter, r *http.Request, entity domain.TrashEntity, id string)
+}
+
+type TrashServiceConfig struct {
+	DatabaseAPI     database.DatabaseAPI
+	RepositoryCache *v1.RepositoryCache
+
+	trashRepo      v1.TrashRepository
+	projectRepo    v1.ProjectRepository
+	educationRepo  v1.EducationRepository
+	skillRepo      v1.SkillRepository
+	experienceRepo v1.ExperienceRepository
+	fileRepo       v1.FileRepository
+}
+
+type trashServiceHandler struct {
+	databaseAPI    database.DatabaseAPI
+	trashRepo      v1.TrashRepository
+	projectRepo    v1.ProjectRepository
+	educationRepo  v1.EducationRepository
+	skillRepo      v1.SkillRepository
+	experienceRepo v1.ExperienceRepository
+	fileRepo       v1.FileRepository
+}
+
+// NewTrashServiceHandler creates and returns a TrashHandler configured using the provided
+// TrashServiceConfig. Repositories left nil in cfg are constructed with cfg.DatabaseAPI and
+// the "Project", "Education", "Skill", "Experience", "File" and join tables; the project,
+// education, skill and file repositories are audited, so their purges are recorded in the
+// audit log. Restores and purges go through cfg.RepositoryCache, when set, so cached lists
+// never outlive them.
+func NewTrashServiceHandler(cfg TrashServiceConfig) TrashHandler {
+	audited := newAuditedRepositoryConfig(cfg.DatabaseAPI)
+
+	trashRepo := cfg.trashRepo
+	if trashRepo == nil {
+		trashRepo = v1.NewTrashRepository(
+			v1.TrashRepositoryConfig{
+				DatabaseAPI:     cfg.DatabaseAPI,
+				ProjectTable:    "Project",
+				SkillTable:      "Skill",
+				EducationTable:  "Education",
+				ExperienceTable: "Experience",
+			},
+		)
+	}
+
+	projectRepo := cfg.projectRepo
+	if projectRepo == nil {
+		projectRepo = v1.NewCachedProjectRepository(
+			v1.NewAuditedProjectRepository(
+				v1.NewProjectRepository(
+					v1.ProjectRepositoryConfig{
+						DatabaseAPI:       cfg.DatabaseAPI,
+						ProjectTable:      "Project",
+						ProjectSkillTable: "project_skill",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	educationRepo := cfg.educationRepo
+	if educationRepo == nil {
+		educationRepo = v1.NewCachedEducationRepository(
+			v1.NewAuditedEducationRepository(
+				v1.NewEducationRepository(
+					v1.EducationRepositoryConfig{
+						DatabaseAPI:    cfg.DatabaseAPI,
+						EducationTable: "Education",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	skillRepo := cfg.skillRepo
+	if skillRepo == nil {
+		skillRepo = v1.NewCachedSkillRepository(
+			v1.NewAuditedSkillRepository(
+				v1.NewSkillRepository(
+					v1.SkillRepositoryConfig{
+						DatabaseAPI:          cfg.DatabaseAPI,
+						SkillTable:           "Skill",
+						ProjectSkillTable:    "project_skill",
+						ExperienceSkillTable: "experience_skill",
+					},
+				),
+				audited,
+			),
+			cfg.RepositoryCache,
+		)
+	}
+
+	experienceRepo := cfg.experienceRepo
+	if experienceRepo == nil {
+		experienceRepo = v1.NewExperienceRepository(
+			v1.ExperienceRepositoryConfig{
+				DatabaseAPI:          cfg.DatabaseAPI,
+				ExperienceTable:      "Experience",
+				ExperienceSkillTable: "experience_skill",
+			},
+		)
+	}
+
+	fileRepo := cfg.fileRepo
+	if fileRepo == nil {
+		fileRepo = v1.NewAuditedFileRepository(
+			v1.NewFileRepository(
+				v1.FileRepositoryConfig{
+					DatabaseAPI: cfg.DatabaseAPI,
+					FileTable:   "File",
+				},
+			),
+			audited,
+		)
+	}
+
+	return &trashServiceHandler{
+		databaseAPI:    cfg.DatabaseAPI,
+		trashRepo:      trashRepo,
+		projectRepo:    projectRepo,
+		educationRepo:  educationRepo,
+		skillRepo:      skillRepo,
+		experienceRepo: experienceRepo,
+		fileRepo:       fileRepo,
+	}
+}
+
+// ServeHTTP implements http.Handler for trashServiceHandler.
+//
+// Routes:
+//   - GET    /trash                -> h.List(w, r)
+//   - DELETE /trash/{entity}/{id}  -> h.Purge(w, r, entity, id)
+//
+// A trailing slash is ignored. An unknown entity or a missing id yields 400 Bad
+// Request, an unsupported method 405 Method Not Allowed and any other path 404 Not Found.
+func (h *trashServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimSuffix(r.URL.Path, "/")
+
+	switch {
+	// GET /trash
+	case path == "/trash":
+		if r.Method != http.MethodGet {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		h.List(w, r)
+		return
+
+	// DELETE /trash/{entity}/{id}
+	case strings.HasPrefix(path, "/trash/"):
+		if r.Method != http.MethodDelete {
+			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		entity, id, _ := strings.Cut(strings.TrimPrefix(path, "/trash/"), "/")
+		if !domain.TrashEntity(entity).IsValid() {
+			http.Error(w, "Invalid trash entity: "+entity, http.StatusBadRequest)
+			return
+		}
+		if id == "" || strings.Contains(id, "/") {
+			http.Error(w, "ID is required", http.StatusBadRequest)
+			return
+		}
+
+		h.Purge(w, r, domain.TrashEntity(entity), id)
+		return
+
+	// Unknown route
+	default:
+		http.NotFound(w, r)
+		return
+	}
+}
+
+// List handles GET /trash and returns the soft-deleted projects, education
+// records, skills and experiences, most recently deleted first.
+//
+// Query parameters:
+//   - entity: optional comma-separated list of project, education, skill and experience.
+//   - limit: maximum number of items (default 50, max 100).
+//
+// Responses:
+//   - 200 OK with a dto.TrashListResponse.
+//   - 400 Bad Request when an entity is unknown.
+//   - 405 Method Not Allowed for non-GET requests.
+//   - 500 Internal Server Error when the trash query fails.
+//
+// @Security ApiKeyAuth
+// @Summary List trash
+// @Description Lists soft-deleted projects, education records, skills and experiences, most recently deleted first.
+// @Tags trash
+// @Accept json
+// @Produce json
+// @Param entity query string false "Comma-separated entity types to include (project, education, skill, experience)"
+// @Param limit query int false "Maximum number of items (default 50, max 100)"
+// @Success 200 {object} dto.TrashListResponse
+// @Failure 400 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /trash [get]
+func (h *trashServiceHandler) List(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed: only GET is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	q := r.URL.Query()
+
+	filter := domain.TrashFilter{
+		Limit: utils.GetQueryInt32(q, "limit", 50),
+	}
+
+	if rawEntities := q.Get("entity"); rawEntities != "" {
+		for _, e := range strings.Split(rawEntities, ",") {
+			if e = strings.TrimSpace(e); e != "" {
+				filter.Entities = append(filter.Entities, domain.TrashEntity(e))
+			}
+		}
+	}
+
+	// Clamp limit to valid range
+	const maxLimit = 100
+	if filter.Limit < 1 {
+		filter.Limit = 50 // default
+	} else if filter.Limit > maxLimit {
+		filter.Limit = maxLimit
+	}
+
+	if err := filter.Validate(); err != nil {
+		http.Error(w, "Invalid trash filter: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	items, err := h.trashRepo.List(r.Context(), filter)
+	if err != nil {
+		http.Error(w, "Failed to list trash: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	itemDTOs := make([]dto.TrashItemDTO, len(items))
+	for i, item := range items {
+		itemDTOs[i] = dto.TrashItemDTO{
+			Entity:    string(item.Entity),
+			ID:        item.ID,
+			Title:     item.Title,
+			DeletedAt: item.DeletedAt,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(dto.TrashListResponse{Items: itemDTOs}); err != nil {
+		http.Error(w, "Failed to write response: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buf.Bytes())
+}
+
+// Purge handles DELETE /trash/{entity}/{id} and permanently removes a trashed
+// project, education record, skill or experience. The row and its file records
+// are deleted in a single transaction. Purging an education keeps its projects,
+// which lose their education link; purging a skill drops its project and
+// experience links, and purging an experience its skill links. Live rows cannot
+// be purged and yield 404 Not Found, as do unknown IDs.
+//
+// @Security ApiKeyAuth
+// @Summary Purge a trashed item
+// @Description Permanently deletes a trashed project, education, skill or experience together with its files.
+// @Tags trash
+// @Param entity path string true "Entity type" Enums(project, education, skill, experience)
+// @Param id path string true "Entity ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} ErrorResponse
+// @Failure 404 {object} ErrorResponse
+// @Failure 500 {object} ErrorResponse
+// @Router /trash/{entity}/{id} [delete]
+func (h *trashServiceHandler) Purge(w http.ResponseWriter, r *http.Request, entity domain.TrashEntity, id string) {
+	if r.Method != http.MethodDelete {
+		http.Error(w, "Method not allowed: only DELETE is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	err := h.databaseAPI.WithTx(r.Context(), func(tx database.Tx) error {
+		var purgeErr error
+		var parentTable string
+
+		switch entity {
+		case domain.TrashedProject:
+			purgeErr = h.projectRepo.WithTx(tx).Purge(r.Context(), id)
+			parentTable = "project"
+		case domain.TrashedEducation:
+			purgeErr = h.educationRepo.WithTx(tx).Purge(r.Context(), id)
+			parentTable = string(domain.EducationTable)
+		case domain.TrashedSkill:
+			purgeErr = h.skillRepo.WithTx(tx).Purge(r.Context(), id)
+		case domain.TrashedExperience:
+			purgeErr = h.experienceRepo.WithTx(tx).Purge(r.Context(), id)
+			parentTable = string(domain.ExperienceTable)
+		default:
+			return &txError{status: http.StatusBadRequest, msg: "Invalid trash entity: " + string(entity)}
+		}
+
+		if purgeErr != nil {
+			if errors.Is(purgeErr, pgx.ErrNoRows) {
+				return &txError{status: http.StatusNotFound, msg: "Item not found in trash"}
+			}
+			return &txError{status: http.StatusInternalServerError, msg: "Failed to purge " + string(entity) + ": ", err: purgeErr}
+		}
+
+		if parentTable != "" {
+			if err := h.fileRepo.WithTx(tx).DeleteByParent(r.Context(), parentTable, id); err != nil {
+				return &txError{status: http.StatusInternalServerError, msg: "Failed to purge " + string(entity) + " files: ", err: err}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		writeTxError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
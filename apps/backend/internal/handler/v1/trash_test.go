@@ -0,0 +1,426 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	mockDatabase "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1/dto"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type trashHandlerTestFixture struct {
+	t                  *testing.T
+	mockDatabaseAPI    *mockDatabase.MockDatabaseAPI
+	mockTrashRepo      *mockRepo.MockTrashRepository
+	mockProjectRepo    *mockRepo.MockProjectRepository
+	mockEducationRepo  *mockRepo.MockEducationRepository
+	mockSkillRepo      *mockRepo.MockSkillRepository
+	mockExperienceRepo *mockRepo.MockExperienceRepository
+	mockFileRepo       *mockRepo.MockFileRepository
+	trashHandler       TrashHandler
+}
+
+func newTrashHandlerTestFixture(t *testing.T) *trashHandlerTestFixture {
+	mockDatabaseAPI := new(mockDatabase.MockDatabaseAPI)
+	mockTx := new(mockDatabase.MockTx)
+	mockTrashRepo := new(mockRepo.MockTrashRepository)
+	mockProjectRepo := new(mockRepo.MockProjectRepository)
+	mockEducationRepo := new(mockRepo.MockEducationRepository)
+	mockSkillRepo := new(mockRepo.MockSkillRepository)
+	mockExperienceRepo := new(mockRepo.MockExperienceRepository)
+	mockFileRepo := new(mockRepo.MockFileRepository)
+
+	// Run transactional callbacks directly against the repository mocks
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+	mockProjectRepo.EXPECT().WithTx(mockTx).Return(mockProjectRepo).Maybe()
+	mockEducationRepo.EXPECT().WithTx(mockTx).Return(mockEducationRepo).Maybe()
+	mockSkillRepo.EXPECT().WithTx(mockTx).Return(mockSkillRepo).Maybe()
+	mockExperienceRepo.EXPECT().WithTx(mockTx).Return(mockExperienceRepo).Maybe()
+	mockFileRepo.EXPECT().WithTx(mockTx).Return(mockFileRepo).Maybe()
+
+	trashHandler := NewTrashServiceHandler(
+		TrashServiceConfig{
+			DatabaseAPI:    mockDatabaseAPI,
+			trashRepo:      mockTrashRepo,
+			projectRepo:    mockProjectRepo,
+			educationRepo:  mockEducationRepo,
+			skillRepo:      mockSkillRepo,
+			experienceRepo: mockExperienceRepo,
+			fileRepo:       mockFileRepo,
+		},
+	)
+
+	return &trashHandlerTestFixture{
+		t:                  t,
+		mockDatabaseAPI:    mockDatabaseAPI,
+		mockTrashRepo:      mockTrashRepo,
+		mockProjectRepo:    mockProjectRepo,
+		mockEducationRepo:  mockEducationRepo,
+		mockSkillRepo:      mockSkillRepo,
+		mockExperienceRepo: mockExperienceRepo,
+		mockFileRepo:       mockFileRepo,
+		trashHandler:       trashHandler,
+	}
+}
+
+func TestTrashServiceHandler_List(t *testing.T) {
+	deletedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	projectItem := domain.TrashItem{
+		Entity:    domain.TrashedProject,
+		ID:        "p1",
+		Title:     "Portfolio",
+		DeletedAt: deletedAt,
+	}
+
+	type Given struct {
+		method   string
+		query    string
+		mockRepo func(m *mockRepo.MockTrashRepository)
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"success with defaults": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockTrashRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.TrashFilter{Limit: 50}).
+						Return([]domain.TrashItem{projectItem}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: toJSON(dto.TrashListResponse{
+					Items: []dto.TrashItemDTO{
+						{Entity: "project", ID: "p1", Title: "Portfolio", DeletedAt: deletedAt},
+					},
+				}),
+			},
+		},
+		"entity filter and clamped limit": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?entity=skill,%20education&limit=500",
+				mockRepo: func(m *mockRepo.MockTrashRepository) {
+					m.EXPECT().
+						List(mock.Anything, domain.TrashFilter{
+							Entities: []domain.TrashEntity{domain.TrashedSkill, domain.TrashedEducation},
+							Limit:    100,
+						}).
+						Return([]domain.TrashItem{}, nil)
+				},
+			},
+			expected: Expected{
+				code: http.StatusOK,
+				body: `{"items":[]}`,
+			},
+		},
+		"invalid entity": {
+			given: Given{
+				method: http.MethodGet,
+				query:  "?entity=user",
+			},
+			expected: Expected{
+				code: http.StatusBadRequest,
+				body: "Invalid trash filter: entity invalid = user\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				method: http.MethodGet,
+				mockRepo: func(m *mockRepo.MockTrashRepository) {
+					m.EXPECT().
+						List(mock.Anything, mock.Anything).
+						Return(nil, errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to list trash: db error\n",
+			},
+		},
+		"method not allowed": {
+			given: Given{
+				method: http.MethodPost,
+			},
+			expected: Expected{
+				code: http.StatusMethodNotAllowed,
+				body: "Method not allowed: only GET is supported\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newTrashHandlerTestFixture(t)
+
+			if tt.given.mockRepo != nil {
+				tt.given.mockRepo(f.mockTrashRepo)
+			}
+
+			req := httptest.NewRequest(tt.given.method, "/trash"+tt.given.query, nil)
+			w := httptest.NewRecorder()
+
+			f.trashHandler.List(w, req)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			if tt.expected.code == http.StatusOK {
+				assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+				assert.JSONEq(t, tt.expected.body, string(body))
+			} else {
+				assert.Equal(t, tt.expected.body, string(body))
+			}
+
+			f.mockTrashRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTrashServiceHandler_Purge(t *testing.T) {
+	fixedID := "123-abc"
+
+	type Given struct {
+		entity domain.TrashEntity
+		mock   func(f *trashHandlerTestFixture)
+		txErr  error
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"purges project and its files": {
+			given: Given{
+				entity: domain.TrashedProject,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockProjectRepo.EXPECT().Purge(mock.Anything, fixedID).Return(nil)
+					f.mockFileRepo.EXPECT().DeleteByParent(mock.Anything, "project", fixedID).Return(nil)
+				},
+			},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"purges education and its files": {
+			given: Given{
+				entity: domain.TrashedEducation,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockEducationRepo.EXPECT().Purge(mock.Anything, fixedID).Return(nil)
+					f.mockFileRepo.EXPECT().DeleteByParent(mock.Anything, string(domain.EducationTable), fixedID).Return(nil)
+				},
+			},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"purges skill without touching files": {
+			given: Given{
+				entity: domain.TrashedSkill,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockSkillRepo.EXPECT().Purge(mock.Anything, fixedID).Return(nil)
+				},
+			},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"purges experience and its logo": {
+			given: Given{
+				entity: domain.TrashedExperience,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockExperienceRepo.EXPECT().Purge(mock.Anything, fixedID).Return(nil)
+					f.mockFileRepo.EXPECT().DeleteByParent(mock.Anything, string(domain.ExperienceTable), fixedID).Return(nil)
+				},
+			},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"not in trash": {
+			given: Given{
+				entity: domain.TrashedProject,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockProjectRepo.EXPECT().Purge(mock.Anything, fixedID).Return(pgx.ErrNoRows)
+				},
+			},
+			expected: Expected{
+				code: http.StatusNotFound,
+				body: "Item not found in trash\n",
+			},
+		},
+		"repository error": {
+			given: Given{
+				entity: domain.TrashedSkill,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockSkillRepo.EXPECT().Purge(mock.Anything, fixedID).Return(errors.New("db error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to purge skill: db error\n",
+			},
+		},
+		"file delete error": {
+			given: Given{
+				entity: domain.TrashedEducation,
+				mock: func(f *trashHandlerTestFixture) {
+					f.mockEducationRepo.EXPECT().Purge(mock.Anything, fixedID).Return(nil)
+					f.mockFileRepo.EXPECT().
+						DeleteByParent(mock.Anything, string(domain.EducationTable), fixedID).
+						Return(errors.New("file error"))
+				},
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Failed to purge education files: file error\n",
+			},
+		},
+		"transaction error": {
+			given: Given{
+				entity: domain.TrashedProject,
+				txErr:  errors.New("failed to begin transaction: connection refused"),
+			},
+			expected: Expected{
+				code: http.StatusInternalServerError,
+				body: "Transaction failed: failed to begin transaction: connection refused\n",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newTrashHandlerTestFixture(t)
+
+			if tt.given.txErr != nil {
+				// Replace the pass-through transaction with one that fails to begin
+				f.mockDatabaseAPI.ExpectedCalls = nil
+				f.mockDatabaseAPI.EXPECT().
+					WithTx(mock.Anything, mock.Anything).
+					Return(tt.given.txErr)
+			}
+
+			if tt.given.mock != nil {
+				tt.given.mock(f)
+			}
+
+			req := httptest.NewRequest(http.MethodDelete, "/trash/"+string(tt.given.entity)+"/"+fixedID, nil)
+			w := httptest.NewRecorder()
+
+			f.trashHandler.Purge(w, req, tt.given.entity, fixedID)
+
+			res := w.Result()
+			defer res.Body.Close()
+
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, tt.expected.code, res.StatusCode)
+			assert.Equal(t, tt.expected.body, string(body))
+
+			f.mockProjectRepo.AssertExpectations(t)
+			f.mockEducationRepo.AssertExpectations(t)
+			f.mockSkillRepo.AssertExpectations(t)
+			f.mockExperienceRepo.AssertExpectations(t)
+			f.mockFileRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestTrashServiceHandler_ServeHTTP(t *testing.T) {
+	type Given struct {
+		method string
+		path   string
+	}
+	type Expected struct {
+		code int
+		body string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"list": {
+			given:    Given{method: http.MethodGet, path: "/trash"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"list with trailing slash": {
+			given:    Given{method: http.MethodGet, path: "/trash/"},
+			expected: Expected{code: http.StatusOK},
+		},
+		"list method not allowed": {
+			given:    Given{method: http.MethodDelete, path: "/trash"},
+			expected: Expected{code: http.StatusMethodNotAllowed, body: "Method not allowed\n"},
+		},
+		"purge": {
+			given:    Given{method: http.MethodDelete, path: "/trash/skill/123-abc"},
+			expected: Expected{code: http.StatusNoContent},
+		},
+		"purge method not allowed": {
+			given:    Given{method: http.MethodGet, path: "/trash/skill/123-abc"},
+			expected: Expected{code: http.StatusMethodNotAllowed, body: "Method not allowed\n"},
+		},
+		"purge unknown entity": {
+			given:    Given{method: http.MethodDelete, path: "/trash/user/123-abc"},
+			expected: Expected{code: http.StatusBadRequest, body: "Invalid trash entity: user\n"},
+		},
+		"purge missing id": {
+			given:    Given{method: http.MethodDelete, path: "/trash/skill"},
+			expected: Expected{code: http.StatusBadRequest, body: "ID is required\n"},
+		},
+		"purge extra segment": {
+			given:    Given{method: http.MethodDelete, path: "/trash/skill/123-abc/extra"},
+			expected: Expected{code: http.StatusBadRequest, body: "ID is required\n"},
+		},
+		"unknown route": {
+			given:    Given{method: http.MethodGet, path: "/trashcan"},
+			expected: Expected{code: http.StatusNotFound},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newTrashHandlerTestFixture(t)
+			f.mockTrashRepo.EXPECT().
+				List(mock.Anything, mock.AnythingOfType("domain.TrashFilter")).
+				Return([]domain.TrashItem{}, nil).
+				Maybe()
+			f.mockSkillRepo.EXPECT().
+				Purge(mock.Anything, "123-abc").
+				Return(nil).
+				Maybe()
+
+			req := httptest.NewRequest(tt.given.method, tt.given.path, nil)
+			w := httptest.NewRecorder()
+
+			f.trashHandler.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expected.code, w.Code)
+			if tt.expected.body != "" {
+				assert.Equal(t, tt.expected.body, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"errors"
+	"net/http"
+)
+
+// txError is returned from inside a DatabaseAPI.WithTx callback to abort the
+// transaction while carrying the HTTP status and message the handler should
+// respond with once the rollback has completed.
+type txError struct {
+	status int
+	msg    string
+	err    error
+}
+
+func (e *txError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+	return e.msg + e.err.Error()
+}
+
+func (e *txError) Unwrap() error {
+	return e.err
+}
+
+// writeTxError writes the response for an error returned by DatabaseAPI.WithTx.
+// A txError is written with its own status and message; anything else (for
+// example a failed BEGIN or COMMIT) is reported as a 500 Internal Server Error.
+func writeTxError(w http.ResponseWriter, err error) {
+	var txErr *txError
+	if errors.As(err, &txErr) {
+		http.Error(w, txErr.Error(), txErr.status)
+		return
+	}
+
+	http.Error(w, "Transaction failed: "+err.Error(), http.StatusInternalServerError)
+}
@@ -1,74 +1,306 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"time"
 
-	"github.com/blackmagiqq/ga4"
-	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
 )
 
 type AnalyticsRepository interface {
-	PageView(pageView domain.PageView) error
+	PageView(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error
+	Events(ctx context.Context, events []domain.AnalyticsEvent, visitor domain.Visitor) error
+	Summary(ctx context.Context, dateRange domain.AnalyticsRange) (*domain.AnalyticsSummary, error)
+	Timeseries(ctx context.Context, dateRange domain.AnalyticsRange) ([]domain.AnalyticsDay, error)
+}
+
+// AnalyticsSink receives every page view and event after it is stored, e.g.
+// to forward it to a third-party analytics service.
+type AnalyticsSink interface {
+	PageView(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error
+	Event(ctx context.Context, event domain.AnalyticsEvent, visitor domain.Visitor) error
 }
 
 type AnalyticsRepositoryConfig struct {
-	GoogleMeasurementID string
-	GoogleAPISecret     string
+	DatabaseAPI   database.DatabaseAPI
+	PageViewTable string
+	EventTable    string
+	// Sinks are forwarded every stored page view and event, in order. Their
+	// errors are logged, not returned: the page view or event is already recorded.
+	Sinks []AnalyticsSink
 
-	analyticsAPI client.GoogleAnalyticsAPI
+	timeProvider domain.TimeProvider
 }
 
 type analyticsRepository struct {
-	GoogleMeasurementID string
-	GoogleAPISecret     string
-
-	analyticsAPI client.GoogleAnalyticsAPI
+	pageViewTable string
+	eventTable    string
+	databaseAPI   database.DatabaseAPI
+	sinks         []AnalyticsSink
+	timeProvider  domain.TimeProvider
 }
 
+// NewAnalyticsRepository creates and returns an AnalyticsRepository that stores
+// page views in cfg.PageViewTable and events in cfg.EventTable using
+// cfg.DatabaseAPI, and forwards them to cfg.Sinks. If cfg.timeProvider is nil
+// the repository defaults to time.Now.
 func NewAnalyticsRepository(cfg AnalyticsRepositoryConfig) AnalyticsRepository {
-	analyticsAPI := cfg.analyticsAPI
-
-	if analyticsAPI == nil {
-		analyticsAPI = client.NewGoogleAnalyticsAPI(
-			cfg.GoogleMeasurementID,
-			cfg.GoogleAPISecret,
-		)
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
 	}
 
 	return &analyticsRepository{
-		analyticsAPI: analyticsAPI,
+		pageViewTable: cfg.PageViewTable,
+		eventTable:    cfg.EventTable,
+		databaseAPI:   cfg.DatabaseAPI,
+		sinks:         cfg.Sinks,
+		timeProvider:  timeProvider,
 	}
 }
 
-// PageView validates the provided domain.PageView and sends a "page_view" event to the configured analytics API.
-// The event includes "page_location" and "page_title" parameters and is sent using a generated client ID.
-// It logs the attempt and the successful send. Returns an error if validation or sending the event fails.
-func (r *analyticsRepository) PageView(pageView domain.PageView) error {
+// PageView validates the provided domain.PageView and stores it with the path
+// of its location, its referring host and the visitor, then forwards it to
+// the configured sinks. Returns an error if validation or storing fails.
+func (r *analyticsRepository) PageView(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error {
 	if err := pageView.Validate(); err != nil {
 		return fmt.Errorf("failed to validate page view: %w", err)
 	}
 
-	log.Printf("Sending page view event on page %s location %s\n", pageView.PageTitle, pageView.PageLocation)
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, path, title, referrer, ua_family, country, visitor_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		r.pageViewTable,
+	)
+
+	_, err := r.databaseAPI.Exec(
+		ctx,
+		query,
+		utils.GenerateKey(),
+		pageView.Path(),
+		pageView.PageTitle,
+		pageView.ReferrerHost(),
+		visitor.UAFamily,
+		visitor.Country,
+		visitor.ID,
+		r.timeProvider(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record page view: %w", err)
+	}
+
+	for _, sink := range r.sinks {
+		if err := sink.PageView(ctx, pageView, visitor); err != nil {
+			log.Printf("Failed to forward page view of %s: %v", pageView.PageLocation, err)
+		}
+	}
+
+	return nil
+}
+
+// Events validates the provided events and stores them, with the path of
+// the page they happened on and the visitor, in one transaction, then
+// forwards each of them to the configured sinks. Returns an error if any
+// event is invalid or storing fails, in which case none is stored.
+func (r *analyticsRepository) Events(ctx context.Context, events []domain.AnalyticsEvent, visitor domain.Visitor) error {
+	if err := (domain.AnalyticsEventBatch{Events: events}).Validate(); err != nil {
+		return fmt.Errorf("failed to validate events: %w", err)
+	}
 
-	err := r.analyticsAPI.SendEvent(
-		ga4.Event{
-			Name: "page_view",
-			Params: map[string]any{
-				"page_location": pageView.PageLocation,
-				"page_title":    pageView.PageTitle,
-			},
-		},
-		ga4.ClientID(utils.GenerateKey()),
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, name, path, params, ua_family, country, visitor_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		r.eventTable,
 	)
 
+	now := r.timeProvider()
+	err := r.databaseAPI.WithTx(ctx, func(tx database.Tx) error {
+		for _, event := range events {
+			params := event.Params
+			if params == nil {
+				params = map[string]string{}
+			}
+
+			_, err := tx.Exec(
+				ctx,
+				query,
+				utils.GenerateKey(),
+				event.Name,
+				event.Path(),
+				params,
+				visitor.UAFamily,
+				visitor.Country,
+				visitor.ID,
+				now,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("failed to send event: %w", err)
+		return fmt.Errorf("failed to record events: %w", err)
 	}
 
-	log.Printf("Successful page view: pageTitle=%s, pageLocation=%s", pageView.PageTitle, pageView.PageLocation)
+	for _, event := range events {
+		for _, sink := range r.sinks {
+			if err := sink.Event(ctx, event, visitor); err != nil {
+				log.Printf("Failed to forward %s event: %v", event.Name, err)
+			}
+		}
+	}
 
 	return nil
 }
+
+// Summary returns the page views and visitors of dateRange along with its
+// most viewed pages and referrers, the number of every event and the most
+// clicked projects, up to dateRange.Limit each (default 10, max 100). As
+// visitor IDs rotate, a visitor returning with a new client ID counts again.
+//
+// Returns:
+//   - (*domain.AnalyticsSummary, nil) on success; the top lists are empty when nothing was viewed.
+//   - (nil, error) on validation, query, scan, or row iteration failures.
+func (r *analyticsRepository) Summary(ctx context.Context, dateRange domain.AnalyticsRange) (*domain.AnalyticsSummary, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate analytics range: %w", err)
+	}
+
+	if dateRange.Limit <= 0 || dateRange.Limit > domain.MaxAnalyticsLimit {
+		dateRange.Limit = domain.DefaultAnalyticsLimit
+	}
+
+	query := fmt.Sprintf(
+		`SELECT COUNT(*), COUNT(DISTINCT visitor_id)
+		FROM %s
+		WHERE created_at >= $1 AND created_at < $2`,
+		r.pageViewTable,
+	)
+
+	var summary domain.AnalyticsSummary
+	err := r.databaseAPI.QueryRow(ctx, query, dateRange.From, dateRange.End()).Scan(&summary.PageViews, &summary.Visitors)
+	if err != nil {
+		return nil, fmt.Errorf("failed to summarize page views: %w", err)
+	}
+
+	tops := []struct {
+		dest  *[]domain.AnalyticsCount
+		name  string
+		table string
+		key   string
+		where string
+	}{
+		{&summary.TopPages, "path", r.pageViewTable, "path", "path <> ''"},
+		{&summary.TopReferrers, "referrer", r.pageViewTable, "referrer", "referrer <> ''"},
+		{&summary.Events, "event", r.eventTable, "name", "TRUE"},
+		{&summary.TopProjects, "project", r.eventTable, "params->>'project_id'", fmt.Sprintf("name = '%s'", domain.EventProjectClick)},
+	}
+	for _, top := range tops {
+		if *top.dest, err = r.top(ctx, top.name, top.table, top.key, top.where, dateRange); err != nil {
+			return nil, err
+		}
+	}
+
+	return &summary, nil
+}
+
+// top returns the values of key in table that occur most in dateRange among
+// the rows matching where. Ties are ordered by value so they keep a stable
+// order. name describes the values in errors.
+func (r *analyticsRepository) top(ctx context.Context, name, table, key, where string, dateRange domain.AnalyticsRange) ([]domain.AnalyticsCount, error) {
+	query := fmt.Sprintf(
+		`SELECT %s AS key, COUNT(*) AS views, COUNT(DISTINCT visitor_id)
+		FROM %s
+		WHERE created_at >= $1 AND created_at < $2 AND %s
+		GROUP BY key
+		ORDER BY views DESC, key
+		LIMIT $3`,
+		key,
+		table,
+		where,
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, dateRange.From, dateRange.End(), dateRange.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list top %ss: %w", name, err)
+	}
+	defer rows.Close()
+
+	counts := []domain.AnalyticsCount{}
+	for rows.Next() {
+		var count domain.AnalyticsCount
+
+		if err := rows.Scan(&count.Key, &count.Views, &count.Visitors); err != nil {
+			return nil, fmt.Errorf("failed to scan top %s: %w", name, err)
+		}
+
+		counts = append(counts, count)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Timeseries returns the page views and unique visitors of every UTC day of
+// dateRange, oldest first. Days without page views are included with zero
+// counts.
+//
+// Returns:
+//   - ([]domain.AnalyticsDay, nil) on success, one entry per day.
+//   - (nil, error) on validation, query, scan, or row iteration failures.
+func (r *analyticsRepository) Timeseries(ctx context.Context, dateRange domain.AnalyticsRange) ([]domain.AnalyticsDay, error) {
+	if err := dateRange.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate analytics range: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT (created_at AT TIME ZONE 'UTC')::date AS day, COUNT(*), COUNT(DISTINCT visitor_id)
+		FROM %s
+		WHERE created_at >= $1 AND created_at < $2
+		GROUP BY day
+		ORDER BY day`,
+		r.pageViewTable,
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, dateRange.From, dateRange.End())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list daily page views: %w", err)
+	}
+	defer rows.Close()
+
+	counted := map[string]domain.AnalyticsDay{}
+	for rows.Next() {
+		var day domain.AnalyticsDay
+
+		if err := rows.Scan(&day.Date, &day.PageViews, &day.Visitors); err != nil {
+			return nil, fmt.Errorf("failed to scan daily page views: %w", err)
+		}
+
+		counted[day.Date.Format(time.DateOnly)] = day
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	days := make([]domain.AnalyticsDay, dateRange.Days())
+	for i := range days {
+		date := dateRange.From.AddDate(0, 0, i)
+		day := counted[date.Format(time.DateOnly)]
+		day.Date = date
+		days[i] = day
+	}
+
+	return days, nil
+}
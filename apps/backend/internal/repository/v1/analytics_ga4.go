@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/blackmagiqq/ga4"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+type GA4SinkConfig struct {
+	GoogleMeasurementID string
+	GoogleAPISecret     string
+
+	analyticsAPI client.GoogleAnalyticsAPI
+}
+
+type ga4Sink struct {
+	analyticsAPI client.GoogleAnalyticsAPI
+}
+
+// NewGA4Sink returns an AnalyticsSink forwarding page views and events to Google Analytics 4
+// through the Measurement Protocol, using the given Google Measurement ID and API Secret.
+func NewGA4Sink(cfg GA4SinkConfig) AnalyticsSink {
+	analyticsAPI := cfg.analyticsAPI
+
+	if analyticsAPI == nil {
+		analyticsAPI = client.NewGoogleAnalyticsAPI(
+			cfg.GoogleMeasurementID,
+			cfg.GoogleAPISecret,
+		)
+	}
+
+	return &ga4Sink{
+		analyticsAPI: analyticsAPI,
+	}
+}
+
+// PageView sends a "page_view" event to the configured analytics API.
+// The event includes "page_location", "page_title" and, when set, "page_referrer" parameters
+// along with the session parameters (see sessionParams), and is sent with the client ID of the
+// visitor (see clientID). It logs the attempt and the successful send. Returns an error if
+// sending the event fails.
+func (s *ga4Sink) PageView(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error {
+	log.Printf("Sending page view event on page %s location %s\n", pageView.PageTitle, pageView.PageLocation)
+
+	params := map[string]any{
+		"page_location": pageView.PageLocation,
+		"page_title":    pageView.PageTitle,
+	}
+	if pageView.Referrer != "" {
+		params["page_referrer"] = pageView.Referrer
+	}
+	sessionParams(params, visitor, pageView.EngagementTimeMsec)
+
+	err := s.analyticsAPI.SendEvent(
+		ga4.Event{
+			Name:   "page_view",
+			Params: params,
+		},
+		clientID(visitor),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+
+	log.Printf("Successful page view: pageTitle=%s, pageLocation=%s", pageView.PageTitle, pageView.PageLocation)
+
+	return nil
+}
+
+// Event sends the event to the configured analytics API under its own name, with its params,
+// the session parameters (see sessionParams) and, when set, a "page_location" parameter. It is
+// sent with the client ID of the visitor (see clientID). Returns an error if sending the event fails.
+func (s *ga4Sink) Event(ctx context.Context, event domain.AnalyticsEvent, visitor domain.Visitor) error {
+	params := make(map[string]any, len(event.Params)+3)
+	for key, value := range event.Params {
+		params[key] = value
+	}
+	if event.PageLocation != "" {
+		params["page_location"] = event.PageLocation
+	}
+	sessionParams(params, visitor, event.EngagementTimeMsec)
+
+	err := s.analyticsAPI.SendEvent(
+		ga4.Event{
+			Name:   string(event.Name),
+			Params: params,
+		},
+		clientID(visitor),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to send event: %w", err)
+	}
+
+	return nil
+}
+
+// clientID returns the visitor ID as GA4 client ID, so GA4 recognizes returning visitors,
+// or a generated one when the visitor has none.
+func clientID(visitor domain.Visitor) ga4.ClientID {
+	if visitor.ID == "" {
+		return ga4.ClientID(utils.GenerateKey())
+	}
+	return ga4.ClientID(visitor.ID)
+}
+
+// sessionParams sets the "session_id" parameter, when the visitor has a session, and the
+// "engagement_time_msec" parameter of params. GA4 only counts sessions and active users
+// of events with a positive engagement time, so it is at least 1.
+func sessionParams(params map[string]any, visitor domain.Visitor, engagementTimeMsec int64) {
+	if visitor.SessionID != "" {
+		params["session_id"] = visitor.SessionID
+	}
+	params["engagement_time_msec"] = max(engagementTimeMsec, 1)
+}
@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/blackmagiqq/ga4"
+	client "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type ga4SinkTestFixture struct {
+	t                *testing.T
+	mockAnalyticsAPI *client.MockGoogleAnalyticsAPI
+	ga4Sink          AnalyticsSink
+}
+
+func newGA4SinkTestFixture(t *testing.T) *ga4SinkTestFixture {
+	mockAnalyticsAPI := new(client.MockGoogleAnalyticsAPI)
+
+	return &ga4SinkTestFixture{
+		t:                t,
+		mockAnalyticsAPI: mockAnalyticsAPI,
+		ga4Sink: NewGA4Sink(
+			GA4SinkConfig{
+				analyticsAPI: mockAnalyticsAPI,
+			},
+		),
+	}
+}
+
+func TestGA4Sink_PageView(t *testing.T) {
+	eventErr := errors.New("Event error")
+
+	type Given struct {
+		pageView     domain.PageView
+		visitor      domain.Visitor
+		mockPageView func(m *client.MockGoogleAnalyticsAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful page view": {
+			given: Given{
+				pageView: domain.PageView{
+					PageLocation: "/page",
+					PageTitle:    "Page",
+				},
+				mockPageView: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						mock.AnythingOfType("ga4.Event"),
+						mock.AnythingOfType("ga4.ClientID"),
+					).Return(nil)
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Sends the visitor ID and referrer": {
+			given: Given{
+				pageView: domain.PageView{
+					PageLocation: "https://example.com/projects",
+					PageTitle:    "Projects",
+					Referrer:     "https://news.ycombinator.com/",
+				},
+				visitor: domain.Visitor{ID: "visitor-1"},
+				mockPageView: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						ga4.Event{
+							Name: "page_view",
+							Params: map[string]any{
+								"page_location":        "https://example.com/projects",
+								"page_title":           "Projects",
+								"page_referrer":        "https://news.ycombinator.com/",
+								"engagement_time_msec": int64(1),
+							},
+						},
+						ga4.ClientID("visitor-1"),
+					).Return(nil)
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Sends the session and engagement time": {
+			given: Given{
+				pageView: domain.PageView{
+					PageLocation:       "https://example.com/skills",
+					PageTitle:          "Skills",
+					EngagementTimeMsec: 4200,
+				},
+				visitor: domain.Visitor{ID: "visitor-1", SessionID: "1771000000"},
+				mockPageView: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						ga4.Event{
+							Name: "page_view",
+							Params: map[string]any{
+								"page_location":        "https://example.com/skills",
+								"page_title":           "Skills",
+								"session_id":           "1771000000",
+								"engagement_time_msec": int64(4200),
+							},
+						},
+						ga4.ClientID("visitor-1"),
+					).Return(nil)
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"SendEvent returns error": {
+			given: Given{
+				pageView: domain.PageView{
+					PageLocation: "/error-Page",
+					PageTitle:    "Error Page",
+				},
+				mockPageView: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						mock.AnythingOfType("ga4.Event"),
+						mock.AnythingOfType("ga4.ClientID"),
+					).Return(eventErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to send event: %w", eventErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newGA4SinkTestFixture(t)
+
+			if test.given.mockPageView != nil {
+				test.given.mockPageView(f.mockAnalyticsAPI)
+			}
+
+			err := f.ga4Sink.PageView(context.Background(), test.given.pageView, test.given.visitor)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Empty(t, err)
+			}
+
+			f.mockAnalyticsAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGA4Sink_Event(t *testing.T) {
+	eventErr := errors.New("Event error")
+
+	type Given struct {
+		event     domain.AnalyticsEvent
+		visitor   domain.Visitor
+		mockEvent func(m *client.MockGoogleAnalyticsAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Sends the event with its params and location": {
+			given: Given{
+				event: domain.AnalyticsEvent{
+					Name:         domain.EventProjectClick,
+					PageLocation: "https://example.com/projects",
+					Params:       map[string]string{"project_id": "p1", "project_title": "Portfolio"},
+				},
+				visitor: domain.Visitor{ID: "visitor-1"},
+				mockEvent: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						ga4.Event{
+							Name: "project_click",
+							Params: map[string]any{
+								"project_id":           "p1",
+								"project_title":        "Portfolio",
+								"page_location":        "https://example.com/projects",
+								"engagement_time_msec": int64(1),
+							},
+						},
+						ga4.ClientID("visitor-1"),
+					).Return(nil)
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Sends an event without params or visitor": {
+			given: Given{
+				event: domain.AnalyticsEvent{Name: domain.EventContactSubmit},
+				mockEvent: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						ga4.Event{
+							Name:   "contact_submit",
+							Params: map[string]any{"engagement_time_msec": int64(1)},
+						},
+						mock.MatchedBy(func(clientID ga4.ClientID) bool { return clientID != "" }),
+					).Return(nil)
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Sends the session and engagement time": {
+			given: Given{
+				event: domain.AnalyticsEvent{
+					Name:               domain.EventResumeDownload,
+					Params:             map[string]string{"file_name": "resume.pdf"},
+					EngagementTimeMsec: 12000,
+				},
+				visitor: domain.Visitor{ID: "visitor-1", SessionID: "1771000000"},
+				mockEvent: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						ga4.Event{
+							Name: "resume_download",
+							Params: map[string]any{
+								"file_name":            "resume.pdf",
+								"session_id":           "1771000000",
+								"engagement_time_msec": int64(12000),
+							},
+						},
+						ga4.ClientID("visitor-1"),
+					).Return(nil)
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"SendEvent returns error": {
+			given: Given{
+				event: domain.AnalyticsEvent{Name: domain.EventContactSubmit},
+				mockEvent: func(m *client.MockGoogleAnalyticsAPI) {
+					m.EXPECT().SendEvent(
+						mock.AnythingOfType("ga4.Event"),
+						mock.AnythingOfType("ga4.ClientID"),
+					).Return(eventErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to send event: %w", eventErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newGA4SinkTestFixture(t)
+
+			if test.given.mockEvent != nil {
+				test.given.mockEvent(f.mockAnalyticsAPI)
+			}
+
+			err := f.ga4Sink.Event(context.Background(), test.given.event, test.given.visitor)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.mockAnalyticsAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGA4Sink_StableClientID(t *testing.T) {
+	f := newGA4SinkTestFixture(t)
+
+	var clientIDs []ga4.ClientID
+	f.mockAnalyticsAPI.EXPECT().
+		SendEvent(mock.AnythingOfType("ga4.Event"), mock.AnythingOfType("ga4.ClientID")).
+		Run(func(_ ga4.Event, clientID ga4.ClientID) {
+			clientIDs = append(clientIDs, clientID)
+		}).
+		Return(nil)
+
+	visitor := domain.Visitor{ID: "visitor-1", SessionID: "1771000000"}
+	pageView := domain.PageView{PageLocation: "/", PageTitle: "Home"}
+
+	assert.NoError(t, f.ga4Sink.PageView(context.Background(), pageView, visitor))
+	assert.NoError(t, f.ga4Sink.PageView(context.Background(), pageView, visitor))
+	assert.NoError(t, f.ga4Sink.Event(context.Background(), domain.AnalyticsEvent{Name: domain.EventContactSubmit}, visitor))
+	assert.NoError(t, f.ga4Sink.PageView(context.Background(), pageView, domain.Visitor{}))
+	assert.NoError(t, f.ga4Sink.PageView(context.Background(), pageView, domain.Visitor{}))
+
+	if assert.Len(t, clientIDs, 5) {
+		assert.Equal(t, []ga4.ClientID{"visitor-1", "visitor-1", "visitor-1"}, clientIDs[:3], "one visitor is one GA4 user")
+		assert.NotEqual(t, clientIDs[3], clientIDs[4], "visitors without ID are not merged")
+	}
+
+	f.mockAnalyticsAPI.AssertExpectations(t)
+}
@@ -1,131 +1,727 @@
 package v1
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
-	client "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	databaseMocks "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
+const (
+	testPageViewTable = "test-page-view"
+	testEventTable    = "test-analytics-event"
+)
+
+// analyticsFakeRow scans values into the destinations in order
+type analyticsFakeRow struct {
+	values  []any
+	scanErr error
+}
+
+func (f *analyticsFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != len(f.values) {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	for i, value := range f.values {
+		switch d := dest[i].(type) {
+		case *string:
+			*d = value.(string)
+		case *int:
+			*d = value.(int)
+		case *time.Time:
+			*d = value.(time.Time)
+		default:
+			return fmt.Errorf("unexpected scan destination type: %T", dest[i])
+		}
+	}
+	return nil
+}
+
+type analyticsFakeRows struct {
+	rows   []*analyticsFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *analyticsFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *analyticsFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *analyticsFakeRows) Err() error { return r.rowErr }
+
+func (r *analyticsFakeRows) Close() {}
+
+// recordingAnalyticsSink records the page views and events forwarded to it
+type recordingAnalyticsSink struct {
+	pageViews []domain.PageView
+	events    []domain.AnalyticsEvent
+	visitors  []domain.Visitor
+	err       error
+}
+
+func (s *recordingAnalyticsSink) PageView(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error {
+	s.pageViews = append(s.pageViews, pageView)
+	s.visitors = append(s.visitors, visitor)
+	return s.err
+}
+
+func (s *recordingAnalyticsSink) Event(ctx context.Context, event domain.AnalyticsEvent, visitor domain.Visitor) error {
+	s.events = append(s.events, event)
+	s.visitors = append(s.visitors, visitor)
+	return s.err
+}
+
 type analyticsRepositoryTestFixture struct {
 	t                   *testing.T
-	mockAnalyticsAPI    *client.MockGoogleAnalyticsAPI
-	analyticsRepository analyticsRepository
+	databaseAPI         *databaseMocks.MockDatabaseAPI
+	tx                  *databaseMocks.MockTx
+	sink                *recordingAnalyticsSink
+	analyticsRepository AnalyticsRepository
 }
 
-func newAnalyticsRepositoryTestFixture(t *testing.T) *analyticsRepositoryTestFixture {
-	mockAnalyticsAPI := new(client.MockGoogleAnalyticsAPI)
-	analyticsRepo := &analyticsRepository{
-		analyticsAPI: mockAnalyticsAPI,
-	}
+func newAnalyticsRepositoryTestFixture(t *testing.T, timeProvider domain.TimeProvider) *analyticsRepositoryTestFixture {
+	mockDatabaseAPI := new(databaseMocks.MockDatabaseAPI)
+	mockTx := new(databaseMocks.MockTx)
+	sink := &recordingAnalyticsSink{}
+
+	// Run transactional callbacks directly against the transaction mock
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
 
 	return &analyticsRepositoryTestFixture{
-		t:                   t,
-		mockAnalyticsAPI:    mockAnalyticsAPI,
-		analyticsRepository: *analyticsRepo,
+		t:           t,
+		databaseAPI: mockDatabaseAPI,
+		tx:          mockTx,
+		sink:        sink,
+		analyticsRepository: NewAnalyticsRepository(
+			AnalyticsRepositoryConfig{
+				DatabaseAPI:   mockDatabaseAPI,
+				PageViewTable: testPageViewTable,
+				EventTable:    testEventTable,
+				Sinks:         []AnalyticsSink{sink},
+				timeProvider:  timeProvider,
+			},
+		),
 	}
 }
 
 func TestAnalyticsRepository_PageView(t *testing.T) {
-	eventErr := errors.New("Event error")
-	missingPageLocationErr := errors.New("failed to validate page view: pageLocation missing")
-	missingPageTitleErr := errors.New("failed to validate page view: pageTitle missing")
+	fixedTime := time.Date(2026, 2, 13, 9, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+	sinkErr := errors.New("sink error")
+
+	pageView := domain.PageView{
+		PageLocation: "https://www.example.com/projects?tag=go#top",
+		PageTitle:    "Projects",
+		Referrer:     "https://www.google.com/search?q=portfolio",
+	}
+	visitor := domain.Visitor{ID: "visitor-1", UAFamily: "Firefox", Country: "PH"}
 
 	type Given struct {
-		pageView     domain.PageView
-		mockPageView func(m *client.MockGoogleAnalyticsAPI)
+		pageView domain.PageView
+		mockExec func(m *databaseMocks.MockDatabaseAPI)
+		sinkErr  error
 	}
 
 	type Expected struct {
-		err error
+		forwarded bool
+		err       error
 	}
 
 	tests := map[string]struct {
 		given    Given
 		expected Expected
 	}{
-		"Successful page view": {
+		"Stores the page view and forwards it": {
 			given: Given{
-				pageView: domain.PageView{
-					PageLocation: "/page",
-					PageTitle:    "Page",
-				},
-				mockPageView: func(m *client.MockGoogleAnalyticsAPI) {
-					m.EXPECT().SendEvent(
-						mock.AnythingOfType("ga4.Event"),
-						mock.AnythingOfType("ga4.ClientID"),
-					).Return(nil)
+				pageView: pageView,
+				mockExec: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "INSERT INTO "+testPageViewTable) &&
+									strings.Contains(query, "(id, path, title, referrer, ua_family, country, visitor_id, created_at)")
+							}),
+							mock.MatchedBy(func(args []any) bool {
+								return len(args) == 8 &&
+									args[0].(string) != "" &&
+									args[1] == "/projects" &&
+									args[2] == "Projects" &&
+									args[3] == "google.com" &&
+									args[4] == "Firefox" &&
+									args[5] == "PH" &&
+									args[6] == "visitor-1" &&
+									args[7] == fixedTime
+							}),
+						).
+						Return(nil, nil)
 				},
 			},
 			expected: Expected{
-				err: nil,
+				forwarded: true,
 			},
 		},
-		"SendEvent returns error": {
+		"Sink errors are not returned": {
 			given: Given{
-				pageView: domain.PageView{
-					PageLocation: "/error-Page",
-					PageTitle:    "Error Page",
+				pageView: pageView,
+				mockExec: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().Exec(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
 				},
-				mockPageView: func(m *client.MockGoogleAnalyticsAPI) {
-					m.EXPECT().SendEvent(
-						mock.AnythingOfType("ga4.Event"),
-						mock.AnythingOfType("ga4.ClientID"),
-					).Return(eventErr)
+				sinkErr: sinkErr,
+			},
+			expected: Expected{
+				forwarded: true,
+			},
+		},
+		"Exec fails": {
+			given: Given{
+				pageView: pageView,
+				mockExec: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().Exec(mock.Anything, mock.Anything, mock.Anything).Return(nil, execErr)
 				},
 			},
 			expected: Expected{
-				err: fmt.Errorf("failed to send event: %w", eventErr),
+				err: fmt.Errorf("failed to record page view: %w", execErr),
 			},
 		},
 		"Missing page location": {
 			given: Given{
-				pageView: domain.PageView{
-					PageLocation: "",
-					PageTitle:    "Page",
-				},
-				mockPageView: nil,
+				pageView: domain.PageView{PageTitle: "Page"},
 			},
 			expected: Expected{
-				err: missingPageLocationErr,
+				err: errors.New("failed to validate page view: pageLocation missing"),
 			},
 		},
 		"Missing page title": {
 			given: Given{
-				pageView: domain.PageView{
-					PageLocation: "/page",
-					PageTitle:    "",
+				pageView: domain.PageView{PageLocation: "/page"},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate page view: pageTitle missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+			f.sink.err = test.given.sinkErr
+
+			err := f.analyticsRepository.PageView(context.Background(), test.given.pageView, visitor)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if test.expected.forwarded {
+				assert.Equal(t, []domain.PageView{test.given.pageView}, f.sink.pageViews)
+				assert.Equal(t, []domain.Visitor{visitor}, f.sink.visitors)
+			} else {
+				assert.Empty(t, f.sink.pageViews)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsRepository_Events(t *testing.T) {
+	fixedTime := time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	projectClick := domain.AnalyticsEvent{
+		Name:         domain.EventProjectClick,
+		PageLocation: "https://example.com/projects?tag=go",
+		Params:       map[string]string{"project_id": "p1", "project_title": "Portfolio"},
+	}
+	contactSubmit := domain.AnalyticsEvent{Name: domain.EventContactSubmit}
+	visitor := domain.Visitor{ID: "visitor-1", UAFamily: "Chrome", Country: "US"}
+
+	type Given struct {
+		events   []domain.AnalyticsEvent
+		mockExec func(m *databaseMocks.MockTx)
+		sinkErr  error
+	}
+
+	type Expected struct {
+		forwarded []domain.AnalyticsEvent
+		err       error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Stores the events in one transaction and forwards them": {
+			given: Given{
+				events: []domain.AnalyticsEvent{projectClick, contactSubmit},
+				mockExec: func(m *databaseMocks.MockTx) {
+					insert := mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "INSERT INTO "+testEventTable) &&
+							strings.Contains(query, "(id, name, path, params, ua_family, country, visitor_id, created_at)")
+					})
+
+					m.EXPECT().
+						Exec(mock.Anything, insert, mock.MatchedBy(func(args []any) bool {
+							return len(args) == 8 &&
+								args[0].(string) != "" &&
+								args[1] == domain.EventProjectClick &&
+								args[2] == "/projects" &&
+								assert.ObjectsAreEqual(map[string]string{"project_id": "p1", "project_title": "Portfolio"}, args[3]) &&
+								args[4] == "Chrome" &&
+								args[5] == "US" &&
+								args[6] == "visitor-1" &&
+								args[7] == fixedTime
+						})).
+						Return(nil, nil).
+						Once()
+					m.EXPECT().
+						Exec(mock.Anything, insert, mock.MatchedBy(func(args []any) bool {
+							return len(args) == 8 &&
+								args[1] == domain.EventContactSubmit &&
+								args[2] == "" &&
+								assert.ObjectsAreEqual(map[string]string{}, args[3])
+						})).
+						Return(nil, nil).
+						Once()
+				},
+			},
+			expected: Expected{
+				forwarded: []domain.AnalyticsEvent{projectClick, contactSubmit},
+			},
+		},
+		"Sink errors are not returned": {
+			given: Given{
+				events: []domain.AnalyticsEvent{contactSubmit},
+				mockExec: func(m *databaseMocks.MockTx) {
+					m.EXPECT().Exec(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+				},
+				sinkErr: errors.New("sink error"),
+			},
+			expected: Expected{
+				forwarded: []domain.AnalyticsEvent{contactSubmit},
+			},
+		},
+		"Exec fails": {
+			given: Given{
+				events: []domain.AnalyticsEvent{projectClick, contactSubmit},
+				mockExec: func(m *databaseMocks.MockTx) {
+					m.EXPECT().Exec(mock.Anything, mock.Anything, mock.Anything).Return(nil, execErr).Once()
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to record events: %w", execErr),
+			},
+		},
+		"Invalid event": {
+			given: Given{
+				events: []domain.AnalyticsEvent{contactSubmit, {Name: domain.EventProjectClick}},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate events: event 1: param project_id missing"),
+			},
+		},
+		"No events": {
+			given: Given{},
+			expected: Expected{
+				err: errors.New("failed to validate events: events missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.tx)
+			}
+			f.sink.err = test.given.sinkErr
+
+			err := f.analyticsRepository.Events(context.Background(), test.given.events, visitor)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, test.expected.forwarded, f.sink.events)
+			f.tx.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsRepository_Summary(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 8, 0, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row error")
+
+	expectTotals := func(m *databaseMocks.MockDatabaseAPI) {
+		m.EXPECT().
+			QueryRow(
+				mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "SELECT COUNT(*), COUNT(DISTINCT visitor_id)") &&
+						strings.Contains(query, "FROM "+testPageViewTable) &&
+						strings.Contains(query, "WHERE created_at >= $1 AND created_at < $2")
+				}),
+				[]any{from, end},
+			).
+			Return(&analyticsFakeRow{values: []any{42, 17}})
+	}
+
+	// The table and condition each top list is counted over, by key
+	tops := map[string][2]string{
+		"path":                  {testPageViewTable, "path <> ''"},
+		"referrer":              {testPageViewTable, "referrer <> ''"},
+		"name":                  {testEventTable, "TRUE"},
+		"params->>'project_id'": {testEventTable, "name = 'project_click'"},
+	}
+
+	expectTop := func(m *databaseMocks.MockDatabaseAPI, key string, limit int, rows *analyticsFakeRows, err error) {
+		m.EXPECT().
+			Query(
+				mock.Anything,
+				mock.MatchedBy(func(query string) bool {
+					return strings.Contains(query, "SELECT "+key+" AS key, COUNT(*) AS views") &&
+						strings.Contains(query, "FROM "+tops[key][0]) &&
+						strings.Contains(query, "AND "+tops[key][1]) &&
+						strings.Contains(query, "GROUP BY key") &&
+						strings.Contains(query, "ORDER BY views DESC, key") &&
+						strings.Contains(query, "LIMIT $3")
+				}),
+				[]any{from, end, limit},
+			).
+			Return(rows, err).
+			Once()
+	}
+
+	type Given struct {
+		dateRange domain.AnalyticsRange
+		mockQuery func(m *databaseMocks.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		summary *domain.AnalyticsSummary
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Summarizes the range": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to, Limit: 5},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					expectTotals(m)
+					expectTop(m, "path", 5, &analyticsFakeRows{rows: []*analyticsFakeRow{
+						{values: []any{"/", 30, 15}},
+						{values: []any{"/projects", 12, 6}},
+					}}, nil)
+					expectTop(m, "referrer", 5, &analyticsFakeRows{rows: []*analyticsFakeRow{
+						{values: []any{"google.com", 8, 7}},
+					}}, nil)
+					expectTop(m, "name", 5, &analyticsFakeRows{rows: []*analyticsFakeRow{
+						{values: []any{"project_click", 9, 4}},
+						{values: []any{"resume_download", 2, 2}},
+					}}, nil)
+					expectTop(m, "params->>'project_id'", 5, &analyticsFakeRows{rows: []*analyticsFakeRow{
+						{values: []any{"p1", 6, 3}},
+					}}, nil)
+				},
+			},
+			expected: Expected{
+				summary: &domain.AnalyticsSummary{
+					PageViews: 42,
+					Visitors:  17,
+					TopPages: []domain.AnalyticsCount{
+						{Key: "/", Views: 30, Visitors: 15},
+						{Key: "/projects", Views: 12, Visitors: 6},
+					},
+					TopReferrers: []domain.AnalyticsCount{
+						{Key: "google.com", Views: 8, Visitors: 7},
+					},
+					Events: []domain.AnalyticsCount{
+						{Key: "project_click", Views: 9, Visitors: 4},
+						{Key: "resume_download", Views: 2, Visitors: 2},
+					},
+					TopProjects: []domain.AnalyticsCount{
+						{Key: "p1", Views: 6, Visitors: 3},
+					},
+				},
+			},
+		},
+		"Limit out of range defaults to 10": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to, Limit: 500},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					expectTotals(m)
+					expectTop(m, "path", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "referrer", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "name", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "params->>'project_id'", 10, &analyticsFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				summary: &domain.AnalyticsSummary{
+					PageViews:    42,
+					Visitors:     17,
+					TopPages:     []domain.AnalyticsCount{},
+					TopReferrers: []domain.AnalyticsCount{},
+					Events:       []domain.AnalyticsCount{},
+					TopProjects:  []domain.AnalyticsCount{},
+				},
+			},
+		},
+		"From after to": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: to, To: from},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate analytics range: from must not be after to"),
+			},
+		},
+		"Totals fail": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&analyticsFakeRow{scanErr: queryErr})
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to summarize page views: %w", queryErr),
+			},
+		},
+		"Top pages query fails": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					expectTotals(m)
+					expectTop(m, "path", 10, nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list top paths: %w", queryErr),
+			},
+		},
+		"Top referrers scan fails": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					expectTotals(m)
+					expectTop(m, "path", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "referrer", 10, &analyticsFakeRows{rows: []*analyticsFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan top referrer: %w", scanErr),
+			},
+		},
+		"Top projects query fails": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					expectTotals(m)
+					expectTop(m, "path", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "referrer", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "name", 10, &analyticsFakeRows{}, nil)
+					expectTop(m, "params->>'project_id'", 10, nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list top projects: %w", queryErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					expectTotals(m)
+					expectTop(m, "path", 10, &analyticsFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAnalyticsRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			summary, err := f.analyticsRepository.Summary(context.Background(), test.given.dateRange)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, summary)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.summary, summary)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAnalyticsRepository_Timeseries(t *testing.T) {
+	from := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 2, 4, 0, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row error")
+
+	type Given struct {
+		dateRange domain.AnalyticsRange
+		mockQuery func(m *databaseMocks.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		days []domain.AnalyticsDay
+		err  error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Fills days without page views": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "(created_at AT TIME ZONE 'UTC')::date AS day") &&
+									strings.Contains(query, "FROM "+testPageViewTable) &&
+									strings.Contains(query, "GROUP BY day") &&
+									strings.Contains(query, "ORDER BY day")
+							}),
+							[]any{from, end},
+						).
+						Return(&analyticsFakeRows{rows: []*analyticsFakeRow{
+							{values: []any{from, 5, 3}},
+							{values: []any{to, 2, 2}},
+						}}, nil)
+				},
+			},
+			expected: Expected{
+				days: []domain.AnalyticsDay{
+					{Date: from, PageViews: 5, Visitors: 3},
+					{Date: time.Date(2026, 2, 2, 0, 0, 0, 0, time.UTC)},
+					{Date: to, PageViews: 2, Visitors: 2},
+				},
+			},
+		},
+		"Range too long": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: from.AddDate(2, 0, 0)},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate analytics range: range too long: 731 days, at most 366"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().Query(mock.Anything, mock.Anything, mock.Anything).Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list daily page views: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&analyticsFakeRows{rows: []*analyticsFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan daily page views: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				dateRange: domain.AnalyticsRange{From: from, To: to},
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&analyticsFakeRows{rowErr: rowErr}, nil)
 				},
-				mockPageView: nil,
 			},
 			expected: Expected{
-				err: missingPageTitleErr,
+				err: fmt.Errorf("row iteration error: %w", rowErr),
 			},
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			f := newAnalyticsRepositoryTestFixture(t)
+			f := newAnalyticsRepositoryTestFixture(t, nil)
 
-			if test.given.mockPageView != nil {
-				test.given.mockPageView(f.mockAnalyticsAPI)
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
 			}
 
-			err := f.analyticsRepository.PageView(test.given.pageView)
+			days, err := f.analyticsRepository.Timeseries(context.Background(), test.given.dateRange)
 
 			if test.expected.err != nil {
 				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, days)
 			} else {
 				assert.NoError(t, err)
-				assert.Empty(t, err)
+				assert.Equal(t, test.expected.days, days)
 			}
 
-			f.mockAnalyticsAPI.AssertExpectations(t)
+			f.databaseAPI.AssertExpectations(t)
 		})
 	}
 }
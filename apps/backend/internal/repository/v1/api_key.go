@@ -0,0 +1,310 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *domain.APIKey) error
+	Get(ctx context.Context, id string) (*domain.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error)
+	List(ctx context.Context) ([]domain.APIKey, error)
+	Update(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error)
+	Revoke(ctx context.Context, id string) error
+	Touch(ctx context.Context, id string) error
+	WithTx(tx database.Tx) APIKeyRepository
+}
+
+type APIKeyRepositoryConfig struct {
+	DatabaseAPI database.DatabaseAPI
+	APIKeyTable string
+
+	timeProvider domain.TimeProvider
+}
+
+type apiKeyRepository struct {
+	apiKeyTable  string
+	databaseAPI  database.Querier
+	timeProvider domain.TimeProvider
+}
+
+// apiKeyColumns is the column list every API key query selects, in the order
+// scanAPIKey reads them.
+const apiKeyColumns = "id, name, key_hash, scopes, expires_at, last_used_at, revoked_at, created_at, updated_at"
+
+// NewAPIKeyRepository creates and returns an APIKeyRepository that stores keys
+// in cfg.APIKeyTable using cfg.DatabaseAPI. If cfg.timeProvider is nil the
+// repository defaults to time.Now.
+func NewAPIKeyRepository(cfg APIKeyRepositoryConfig) APIKeyRepository {
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &apiKeyRepository{
+		apiKeyTable:  cfg.APIKeyTable,
+		databaseAPI:  cfg.DatabaseAPI,
+		timeProvider: timeProvider,
+	}
+}
+
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool.
+func (r *apiKeyRepository) WithTx(tx database.Tx) APIKeyRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
+// Create inserts key. It assigns key.ID, key.CreatedAt and key.UpdatedAt.
+//
+// Returns an error when key is nil, its payload is invalid, its hash is
+// missing, or the insert fails.
+func (r *apiKeyRepository) Create(ctx context.Context, key *domain.APIKey) error {
+	if key == nil {
+		return errors.New("failed to validate API key: payload is nil")
+	}
+	if err := key.ValidatePayload(); err != nil {
+		return fmt.Errorf("failed to validate API key: %w", err)
+	}
+	if key.KeyHash == "" {
+		return errors.New("failed to validate API key: key hash missing")
+	}
+
+	key.ID = utils.GenerateKey()
+	key.CreatedAt = r.timeProvider()
+	key.UpdatedAt = key.CreatedAt
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, name, key_hash, scopes, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		r.apiKeyTable,
+	)
+
+	_, err := r.databaseAPI.Exec(
+		ctx,
+		query,
+		key.ID,
+		key.Name,
+		key.KeyHash,
+		scopeStrings(key.Scopes),
+		key.ExpiresAt,
+		key.CreatedAt,
+		key.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the key with the given id, revoked or not. It returns
+// pgx.ErrNoRows (wrapped) when there is none.
+func (r *apiKeyRepository) Get(ctx context.Context, id string) (*domain.APIKey, error) {
+	if id == "" {
+		return nil, errors.New("failed to get API key: ID missing")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s
+		WHERE id = $1`,
+		apiKeyColumns,
+		r.apiKeyTable,
+	)
+
+	key, err := scanAPIKey(r.databaseAPI.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	return key, nil
+}
+
+// GetByHash returns the key stored under keyHash, revoked or not. It returns
+// pgx.ErrNoRows (wrapped) when there is none.
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s
+		WHERE key_hash = $1`,
+		apiKeyColumns,
+		r.apiKeyTable,
+	)
+
+	key, err := scanAPIKey(r.databaseAPI.QueryRow(ctx, query, keyHash))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	return key, nil
+}
+
+// List returns every key, revoked and expired ones included, newest first.
+// The slice is empty when there are none.
+func (r *apiKeyRepository) List(ctx context.Context) ([]domain.APIKey, error) {
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s
+		ORDER BY created_at DESC, id DESC`,
+		apiKeyColumns,
+		r.apiKeyTable,
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list API keys: %w", err)
+	}
+	defer rows.Close()
+
+	keys := []domain.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan API key: %w", err)
+		}
+
+		keys = append(keys, *key)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Update rewrites the name, scopes and expiry of key.ID and sets UpdatedAt
+// from the repository's time provider. The hash, usage and revocation of a
+// key can't be changed this way.
+//
+// Returns:
+//   - (*domain.APIKey, nil) on success with the updated key.
+//   - (nil, nil) if no key with the given id was found.
+//   - (nil, error) on validation or database errors.
+func (r *apiKeyRepository) Update(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	if key == nil {
+		return nil, errors.New("failed to validate API key: payload is nil")
+	}
+	if key.ID == "" {
+		return nil, errors.New("failed to update API key: ID missing")
+	}
+	if err := key.ValidatePayload(); err != nil {
+		return nil, fmt.Errorf("failed to validate API key: %w", err)
+	}
+
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET name=$2,
+			scopes=$3,
+			expires_at=$4,
+			updated_at=$5
+		WHERE id=$1
+		RETURNING %s`,
+		r.apiKeyTable,
+		apiKeyColumns,
+	)
+
+	updatedKey, err := scanAPIKey(r.databaseAPI.QueryRow(
+		ctx,
+		query,
+		key.ID,
+		key.Name,
+		scopeStrings(key.Scopes),
+		key.ExpiresAt,
+		r.timeProvider(),
+	))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update API key: %w", err)
+	}
+
+	return updatedKey, nil
+}
+
+// Revoke marks the key id as revoked. Revoking a revoked key keeps its
+// original revocation time. It returns pgx.ErrNoRows (wrapped) when there is
+// no such key.
+func (r *apiKeyRepository) Revoke(ctx context.Context, id string) error {
+	if id == "" {
+		return errors.New("failed to revoke API key: ID missing")
+	}
+
+	query := fmt.Sprintf(
+		`UPDATE %s SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1`,
+		r.apiKeyTable,
+	)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	if cmdTag == nil || cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("failed to revoke API key: %w", pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+// Touch sets the last-used time of the key id to now.
+func (r *apiKeyRepository) Touch(ctx context.Context, id string) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET last_used_at = $2 WHERE id = $1`,
+		r.apiKeyTable,
+	)
+
+	if _, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider()); err != nil {
+		return fmt.Errorf("failed to record API key use: %w", err)
+	}
+
+	return nil
+}
+
+// scanAPIKey reads a row selected with apiKeyColumns.
+func scanAPIKey(row database.Row) (*domain.APIKey, error) {
+	var key domain.APIKey
+	var scopes []string
+
+	err := row.Scan(
+		&key.ID,
+		&key.Name,
+		&key.KeyHash,
+		&scopes,
+		&key.ExpiresAt,
+		&key.LastUsedAt,
+		&key.RevokedAt,
+		&key.CreatedAt,
+		&key.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Scopes = make([]domain.APIKeyScope, len(scopes))
+	for i, scope := range scopes {
+		key.Scopes[i] = domain.APIKeyScope(scope)
+	}
+
+	return &key, nil
+}
+
+// scopeStrings converts scopes to the TEXT[] they are stored as.
+func scopeStrings(scopes []domain.APIKeyScope) []string {
+	s := make([]string, len(scopes))
+	for i, scope := range scopes {
+		s[i] = string(scope)
+	}
+	return s
+}
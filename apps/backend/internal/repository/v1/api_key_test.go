@@ -0,0 +1,514 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testAPIKeyTable = "test-api-key"
+
+type apiKeyFakeRow struct {
+	key     domain.APIKey
+	scanErr error
+}
+
+func (f *apiKeyFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 9 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.key.ID
+	*dest[1].(*string) = f.key.Name
+	*dest[2].(*string) = f.key.KeyHash
+	*dest[3].(*[]string) = scopeStrings(f.key.Scopes)
+	*dest[4].(**time.Time) = f.key.ExpiresAt
+	*dest[5].(**time.Time) = f.key.LastUsedAt
+	*dest[6].(**time.Time) = f.key.RevokedAt
+	*dest[7].(*time.Time) = f.key.CreatedAt
+	*dest[8].(*time.Time) = f.key.UpdatedAt
+	return nil
+}
+
+type apiKeyFakeRows struct {
+	rows   []*apiKeyFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *apiKeyFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *apiKeyFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *apiKeyFakeRows) Err() error { return r.rowErr }
+
+func (r *apiKeyFakeRows) Close() {}
+
+type apiKeyFakeCommandTag int64
+
+func (f apiKeyFakeCommandTag) RowsAffected() int64 { return int64(f) }
+
+type apiKeyRepositoryTestFixture struct {
+	databaseAPI      *database.MockDatabaseAPI
+	apiKeyRepository APIKeyRepository
+}
+
+func newAPIKeyRepositoryTestFixture(timeProvider domain.TimeProvider) *apiKeyRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+
+	return &apiKeyRepositoryTestFixture{
+		databaseAPI: mockDatabaseAPI,
+		apiKeyRepository: NewAPIKeyRepository(
+			APIKeyRepositoryConfig{
+				DatabaseAPI:  mockDatabaseAPI,
+				APIKeyTable:  testAPIKeyTable,
+				timeProvider: timeProvider,
+			},
+		),
+	}
+}
+
+func TestAPIKeyRepository_Create(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := fixedTime.Add(30 * 24 * time.Hour)
+	execErr := errors.New("exec error")
+
+	valid := func() *domain.APIKey {
+		return &domain.APIKey{
+			Name:      "ci",
+			KeyHash:   "hash",
+			Scopes:    []domain.APIKeyScope{domain.ScopeFilesWrite},
+			ExpiresAt: &expiresAt,
+		}
+	}
+
+	type Given struct {
+		key      *domain.APIKey
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Inserts the key": {
+			given: Given{
+				key: valid(),
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "INSERT INTO "+testAPIKeyTable) &&
+									strings.Contains(query, "(id, name, key_hash, scopes, expires_at, created_at, updated_at)")
+							}),
+							mock.MatchedBy(func(args []any) bool {
+								return len(args) == 7 &&
+									args[0].(string) != "" &&
+									args[1] == "ci" &&
+									args[2] == "hash" &&
+									assert.ObjectsAreEqual([]string{"files:write"}, args[3]) &&
+									args[4] == &expiresAt &&
+									args[5] == fixedTime &&
+									args[6] == fixedTime
+							}),
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Nil key": {
+			expected: Expected{err: errors.New("failed to validate API key: payload is nil")},
+		},
+		"Missing name": {
+			given:    Given{key: &domain.APIKey{KeyHash: "hash", Scopes: []domain.APIKeyScope{domain.ScopeRead}}},
+			expected: Expected{err: errors.New("failed to validate API key: name missing")},
+		},
+		"Unknown scope": {
+			given:    Given{key: &domain.APIKey{Name: "ci", KeyHash: "hash", Scopes: []domain.APIKeyScope{"admin"}}},
+			expected: Expected{err: errors.New("failed to validate API key: scope invalid = admin")},
+		},
+		"Missing hash": {
+			given:    Given{key: &domain.APIKey{Name: "ci", Scopes: []domain.APIKeyScope{domain.ScopeRead}}},
+			expected: Expected{err: errors.New("failed to validate API key: key hash missing")},
+		},
+		"Exec fails": {
+			given: Given{
+				key: valid(),
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to create API key: %w", execErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.apiKeyRepository.Create(context.Background(), test.given.key)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, test.given.key.ID)
+				assert.Equal(t, fixedTime, test.given.key.CreatedAt)
+				assert.Equal(t, fixedTime, test.given.key.UpdatedAt)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyRepository_GetByHash(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	lastUsedAt := fixedTime.Add(time.Hour)
+
+	stored := domain.APIKey{
+		ID:         "key-1",
+		Name:       "ci",
+		KeyHash:    "hash",
+		Scopes:     []domain.APIKeyScope{domain.ScopeRead, domain.ScopeProjectsWrite},
+		LastUsedAt: &lastUsedAt,
+		CreatedAt:  fixedTime,
+		UpdatedAt:  fixedTime,
+	}
+
+	tests := map[string]struct {
+		row     *apiKeyFakeRow
+		wantKey *domain.APIKey
+		wantErr error
+	}{
+		"Returns the key": {
+			row:     &apiKeyFakeRow{key: stored},
+			wantKey: &stored,
+		},
+		"Missing key": {
+			row:     &apiKeyFakeRow{scanErr: pgx.ErrNoRows},
+			wantErr: pgx.ErrNoRows,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyRepositoryTestFixture(nil)
+
+			f.databaseAPI.EXPECT().
+				QueryRow(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "FROM "+testAPIKeyTable) &&
+							strings.Contains(query, "WHERE key_hash = $1")
+					}),
+					[]any{"hash"},
+				).
+				Return(test.row)
+
+			key, err := f.apiKeyRepository.GetByHash(context.Background(), "hash")
+
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+				assert.Nil(t, key)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.wantKey, key)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyRepository_Get(t *testing.T) {
+	f := newAPIKeyRepositoryTestFixture(nil)
+
+	_, err := f.apiKeyRepository.Get(context.Background(), "")
+	assert.EqualError(t, err, "failed to get API key: ID missing")
+
+	f.databaseAPI.EXPECT().
+		QueryRow(
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "FROM "+testAPIKeyTable) &&
+					strings.Contains(query, "WHERE id = $1")
+			}),
+			[]any{"key-1"},
+		).
+		Return(&apiKeyFakeRow{scanErr: pgx.ErrNoRows})
+
+	key, err := f.apiKeyRepository.Get(context.Background(), "key-1")
+	assert.ErrorIs(t, err, pgx.ErrNoRows)
+	assert.Nil(t, key)
+
+	f.databaseAPI.AssertExpectations(t)
+}
+
+func TestAPIKeyRepository_List(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+
+	key := domain.APIKey{
+		ID:        "key-1",
+		Name:      "ci",
+		KeyHash:   "hash",
+		Scopes:    []domain.APIKeyScope{domain.ScopeRead},
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	tests := map[string]struct {
+		rows     *apiKeyFakeRows
+		queryErr error
+		wantKeys []domain.APIKey
+		wantErr  string
+	}{
+		"Returns every key": {
+			rows:     &apiKeyFakeRows{rows: []*apiKeyFakeRow{{key: key}}},
+			wantKeys: []domain.APIKey{key},
+		},
+		"No keys": {
+			rows:     &apiKeyFakeRows{},
+			wantKeys: []domain.APIKey{},
+		},
+		"Query fails": {
+			queryErr: queryErr,
+			wantErr:  "failed to list API keys: query error",
+		},
+		"Scan fails": {
+			rows:    &apiKeyFakeRows{rows: []*apiKeyFakeRow{{scanErr: errors.New("scan error")}}},
+			wantErr: "failed to scan API key: scan error",
+		},
+		"Iteration fails": {
+			rows:    &apiKeyFakeRows{rowErr: errors.New("conn lost")},
+			wantErr: "row iteration error: conn lost",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyRepositoryTestFixture(nil)
+
+			f.databaseAPI.EXPECT().
+				Query(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "FROM "+testAPIKeyTable) &&
+							strings.Contains(query, "ORDER BY created_at DESC, id DESC")
+					}),
+				).
+				Return(test.rows, test.queryErr)
+
+			keys, err := f.apiKeyRepository.List(context.Background())
+
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				assert.Nil(t, keys)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.wantKeys, keys)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyRepository_Update(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	updatedAt := fixedTime.Add(time.Hour)
+
+	updated := domain.APIKey{
+		ID:        "key-1",
+		Name:      "uploads",
+		KeyHash:   "hash",
+		Scopes:    []domain.APIKeyScope{domain.ScopeFilesWrite},
+		CreatedAt: fixedTime,
+		UpdatedAt: updatedAt,
+	}
+
+	type Given struct {
+		key *domain.APIKey
+		row *apiKeyFakeRow
+	}
+
+	type Expected struct {
+		key *domain.APIKey
+		err string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Updates the key": {
+			given: Given{
+				key: &domain.APIKey{ID: "key-1", Name: "uploads", Scopes: []domain.APIKeyScope{domain.ScopeFilesWrite}},
+				row: &apiKeyFakeRow{key: updated},
+			},
+			expected: Expected{key: &updated},
+		},
+		"Missing key": {
+			given: Given{
+				key: &domain.APIKey{ID: "key-1", Name: "uploads", Scopes: []domain.APIKeyScope{domain.ScopeFilesWrite}},
+				row: &apiKeyFakeRow{scanErr: pgx.ErrNoRows},
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				key: &domain.APIKey{ID: "key-1", Name: "uploads", Scopes: []domain.APIKeyScope{domain.ScopeFilesWrite}},
+				row: &apiKeyFakeRow{scanErr: errors.New("scan error")},
+			},
+			expected: Expected{err: "failed to update API key: scan error"},
+		},
+		"Missing ID": {
+			given:    Given{key: &domain.APIKey{Name: "uploads", Scopes: []domain.APIKeyScope{domain.ScopeFilesWrite}}},
+			expected: Expected{err: "failed to update API key: ID missing"},
+		},
+		"Duplicated scope": {
+			given:    Given{key: &domain.APIKey{ID: "key-1", Name: "uploads", Scopes: []domain.APIKeyScope{domain.ScopeRead, domain.ScopeRead}}},
+			expected: Expected{err: "failed to validate API key: scope duplicated = read"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyRepositoryTestFixture(func() time.Time { return updatedAt })
+
+			if test.given.row != nil {
+				f.databaseAPI.EXPECT().
+					QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(query string) bool {
+							return strings.Contains(query, "UPDATE "+testAPIKeyTable) &&
+								strings.Contains(query, "RETURNING "+apiKeyColumns)
+						}),
+						mock.MatchedBy(func(args []any) bool {
+							return len(args) == 5 &&
+								args[0] == "key-1" &&
+								args[1] == "uploads" &&
+								assert.ObjectsAreEqual([]string{"files:write"}, args[2]) &&
+								args[4] == updatedAt
+						}),
+					).
+					Return(test.given.row)
+			}
+
+			key, err := f.apiKeyRepository.Update(context.Background(), test.given.key)
+
+			if test.expected.err != "" {
+				assert.EqualError(t, err, test.expected.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.key, key)
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyRepository_Revoke(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	tests := map[string]struct {
+		cmdTag  apiKeyFakeCommandTag
+		execErr error
+		wantErr error
+	}{
+		"Revokes the key": {
+			cmdTag: 1,
+		},
+		"Missing key": {
+			wantErr: pgx.ErrNoRows,
+		},
+		"Exec fails": {
+			execErr: execErr,
+			wantErr: execErr,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAPIKeyRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			f.databaseAPI.EXPECT().
+				Exec(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "UPDATE "+testAPIKeyTable) &&
+							strings.Contains(query, "SET revoked_at = COALESCE(revoked_at, $2) WHERE id = $1")
+					}),
+					[]any{"key-1", fixedTime},
+				).
+				Return(test.cmdTag, test.execErr)
+
+			err := f.apiKeyRepository.Revoke(context.Background(), "key-1")
+
+			if test.wantErr != nil {
+				assert.ErrorIs(t, err, test.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAPIKeyRepository_Touch(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	f := newAPIKeyRepositoryTestFixture(func() time.Time { return fixedTime })
+
+	f.databaseAPI.EXPECT().
+		Exec(
+			mock.Anything,
+			mock.MatchedBy(func(query string) bool {
+				return strings.Contains(query, "UPDATE "+testAPIKeyTable) &&
+					strings.Contains(query, "SET last_used_at = $2 WHERE id = $1")
+			}),
+			[]any{"key-1", fixedTime},
+		).
+		Return(apiKeyFakeCommandTag(1), nil).
+		Once()
+	f.databaseAPI.EXPECT().
+		Exec(mock.Anything, mock.Anything, []any{"key-2", fixedTime}).
+		Return(nil, errors.New("exec error")).
+		Once()
+
+	assert.NoError(t, f.apiKeyRepository.Touch(context.Background(), "key-1"))
+	assert.EqualError(t, f.apiKeyRepository.Touch(context.Background(), "key-2"), "failed to record API key use: exec error")
+
+	f.databaseAPI.AssertExpectations(t)
+}
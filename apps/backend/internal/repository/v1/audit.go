@@ -0,0 +1,200 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+type AuditRepository interface {
+	Record(ctx context.Context, entry *domain.AuditEntry) error
+	List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+	WithTx(tx database.Tx) AuditRepository
+}
+
+type AuditRepositoryConfig struct {
+	DatabaseAPI database.DatabaseAPI
+	AuditTable  string
+
+	timeProvider domain.TimeProvider
+}
+
+type auditRepository struct {
+	auditTable   string
+	databaseAPI  database.Querier
+	timeProvider domain.TimeProvider
+}
+
+// NewAuditRepository creates and returns an AuditRepository that stores entries
+// in cfg.AuditTable using cfg.DatabaseAPI. If cfg.timeProvider is nil the
+// repository defaults to time.Now for entry timestamps.
+func NewAuditRepository(cfg AuditRepositoryConfig) AuditRepository {
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &auditRepository{
+		auditTable:   cfg.AuditTable,
+		databaseAPI:  cfg.DatabaseAPI,
+		timeProvider: timeProvider,
+	}
+}
+
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool, so an entry is committed or rolled back
+// together with the change it describes.
+func (r *auditRepository) WithTx(tx database.Tx) AuditRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
+// Record inserts entry into the audit table. It assigns entry.ID and
+// entry.CreatedAt; a nil entry.Diff is stored as an empty object.
+//
+// Returns an error when entry is nil, its entity or action is missing, its
+// entity ID is empty, or the insert fails.
+func (r *auditRepository) Record(ctx context.Context, entry *domain.AuditEntry) error {
+	if entry == nil {
+		return errors.New("failed to validate audit entry: payload is nil")
+	}
+	if !entry.Entity.IsValid() {
+		return fmt.Errorf("failed to validate audit entry: entity invalid = %s", entry.Entity)
+	}
+	if entry.EntityID == "" {
+		return errors.New("failed to validate audit entry: entity ID missing")
+	}
+	if entry.Action == "" {
+		return errors.New("failed to validate audit entry: action missing")
+	}
+
+	if entry.Diff == nil {
+		entry.Diff = map[string]domain.AuditChange{}
+	}
+
+	entry.ID = utils.GenerateKey()
+	entry.CreatedAt = r.timeProvider()
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, entity, entity_id, action, diff, request_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		r.auditTable,
+	)
+
+	_, err := r.databaseAPI.Exec(
+		ctx,
+		query,
+		entry.ID,
+		entry.Entity,
+		entry.EntityID,
+		entry.Action,
+		entry.Diff,
+		entry.RequestID,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+
+	return nil
+}
+
+// List returns audit entries matching filter, newest first.
+//
+// Behavior and defaults:
+//   - filter is validated via filter.Validate().
+//   - Entity, EntityID, From (inclusive) and To (exclusive) are only applied when set.
+//   - If filter.Limit <= 0 or > 100 it defaults to 50.
+//
+// Rows are ordered by created_at, then id, so entries written in the same
+// instant keep a stable order.
+//
+// Returns:
+//   - ([]domain.AuditEntry, nil) on success; the slice is empty when nothing matches.
+//   - (nil, error) on validation, query, scan, or row iteration failures.
+func (r *auditRepository) List(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate audit filter: %w", err)
+	}
+
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 50
+	}
+
+	var conditions []string
+	var args []any
+
+	if filter.Entity != nil {
+		args = append(args, *filter.Entity)
+		conditions = append(conditions, fmt.Sprintf("entity = $%d", len(args)))
+	}
+	if filter.EntityID != nil {
+		args = append(args, *filter.EntityID)
+		conditions = append(conditions, fmt.Sprintf("entity_id = $%d", len(args)))
+	}
+	if filter.From != nil {
+		args = append(args, *filter.From)
+		conditions = append(conditions, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.To != nil {
+		args = append(args, *filter.To)
+		conditions = append(conditions, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = "WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	args = append(args, filter.Limit)
+	query := fmt.Sprintf(
+		`SELECT id, entity, entity_id, action, diff, request_id, created_at
+		FROM %s
+		%s
+		ORDER BY created_at DESC, id DESC
+		LIMIT $%d`,
+		r.auditTable,
+		where,
+		len(args),
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	entries := []domain.AuditEntry{}
+	for rows.Next() {
+		var entry domain.AuditEntry
+
+		err := rows.Scan(
+			&entry.ID,
+			&entry.Entity,
+			&entry.EntityID,
+			&entry.Action,
+			&entry.Diff,
+			&entry.RequestID,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan audit entry: %w", err)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,401 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testAuditTable = "test-audit"
+
+// auditFakeRow for List path
+type auditFakeRow struct {
+	entry   domain.AuditEntry
+	scanErr error
+}
+
+func (f *auditFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 7 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.entry.ID
+	*dest[1].(*domain.AuditEntity) = f.entry.Entity
+	*dest[2].(*string) = f.entry.EntityID
+	*dest[3].(*domain.AuditAction) = f.entry.Action
+	*dest[4].(*map[string]domain.AuditChange) = f.entry.Diff
+	*dest[5].(*string) = f.entry.RequestID
+	*dest[6].(*time.Time) = f.entry.CreatedAt
+	return nil
+}
+
+type auditFakeRows struct {
+	rows   []*auditFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *auditFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *auditFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *auditFakeRows) Err() error { return r.rowErr }
+
+func (r *auditFakeRows) Close() {}
+
+type auditRepositoryTestFixture struct {
+	t               *testing.T
+	databaseAPI     *database.MockDatabaseAPI
+	auditRepository AuditRepository
+}
+
+func newAuditRepositoryTestFixture(t *testing.T, timeProvider domain.TimeProvider) *auditRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+	auditRepository := NewAuditRepository(
+		AuditRepositoryConfig{
+			DatabaseAPI:  mockDatabaseAPI,
+			AuditTable:   testAuditTable,
+			timeProvider: timeProvider,
+		},
+	)
+
+	return &auditRepositoryTestFixture{
+		t:               t,
+		databaseAPI:     mockDatabaseAPI,
+		auditRepository: auditRepository,
+	}
+}
+
+func TestAuditRepository_Record(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	diff := map[string]domain.AuditChange{
+		"title": {Before: json.RawMessage(`"Old"`), After: json.RawMessage(`"New"`)},
+	}
+
+	type Given struct {
+		entry    *domain.AuditEntry
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Inserts the entry": {
+			given: Given{
+				entry: &domain.AuditEntry{
+					Entity:    domain.AuditProject,
+					EntityID:  "p1",
+					Action:    domain.AuditUpdate,
+					Diff:      diff,
+					RequestID: "req-1",
+				},
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "INSERT INTO "+testAuditTable) &&
+									strings.Contains(query, "(id, entity, entity_id, action, diff, request_id, created_at)")
+							}),
+							mock.MatchedBy(func(args []any) bool {
+								return len(args) == 7 &&
+									args[0].(string) != "" &&
+									args[1] == domain.AuditProject &&
+									args[2] == "p1" &&
+									args[3] == domain.AuditUpdate &&
+									assert.ObjectsAreEqual(diff, args[4]) &&
+									args[5] == "req-1" &&
+									args[6] == fixedTime
+							}),
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Nil diff is stored as an empty object": {
+			given: Given{
+				entry: &domain.AuditEntry{
+					Entity:   domain.AuditSkill,
+					EntityID: "s1",
+					Action:   domain.AuditPurge,
+				},
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.Anything,
+							mock.MatchedBy(func(args []any) bool {
+								return assert.ObjectsAreEqual(map[string]domain.AuditChange{}, args[4])
+							}),
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Nil entry": {
+			given: Given{},
+			expected: Expected{
+				err: errors.New("failed to validate audit entry: payload is nil"),
+			},
+		},
+		"Invalid entity": {
+			given: Given{
+				entry: &domain.AuditEntry{Entity: "user", EntityID: "u1", Action: domain.AuditCreate},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate audit entry: entity invalid = user"),
+			},
+		},
+		"Missing entity ID": {
+			given: Given{
+				entry: &domain.AuditEntry{Entity: domain.AuditFile, Action: domain.AuditCreate},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate audit entry: entity ID missing"),
+			},
+		},
+		"Missing action": {
+			given: Given{
+				entry: &domain.AuditEntry{Entity: domain.AuditFile, EntityID: "f1"},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate audit entry: action missing"),
+			},
+		},
+		"Exec fails": {
+			given: Given{
+				entry: &domain.AuditEntry{Entity: domain.AuditFile, EntityID: "f1", Action: domain.AuditDelete},
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to record audit entry: %w", execErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.auditRepository.Record(context.Background(), test.given.entry)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, test.given.entry.ID)
+				assert.Equal(t, fixedTime, test.given.entry.CreatedAt)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuditRepository_List(t *testing.T) {
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	from := createdAt.Add(-24 * time.Hour)
+	to := createdAt.Add(24 * time.Hour)
+	entity := domain.AuditProject
+	entityID := "p1"
+
+	entry := domain.AuditEntry{
+		ID:       "a1",
+		Entity:   domain.AuditProject,
+		EntityID: "p1",
+		Action:   domain.AuditCreate,
+		Diff: map[string]domain.AuditChange{
+			"title": {After: json.RawMessage(`"Portfolio"`)},
+		},
+		RequestID: "req-1",
+		CreatedAt: createdAt,
+	}
+
+	type Given struct {
+		filter    domain.AuditFilter
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		entries []domain.AuditEntry
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Lists without filters": {
+			given: Given{
+				filter: domain.AuditFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testAuditTable) &&
+									!strings.Contains(query, "WHERE") &&
+									strings.Contains(query, "ORDER BY created_at DESC, id DESC") &&
+									strings.Contains(query, "LIMIT $1")
+							}),
+							[]any{int32(50)},
+						).
+						Return(&auditFakeRows{rows: []*auditFakeRow{{entry: entry}}}, nil)
+				},
+			},
+			expected: Expected{
+				entries: []domain.AuditEntry{entry},
+			},
+		},
+		"Applies every filter": {
+			given: Given{
+				filter: domain.AuditFilter{
+					Entity:   &entity,
+					EntityID: &entityID,
+					From:     &from,
+					To:       &to,
+					Limit:    10,
+				},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE entity = $1 AND entity_id = $2 AND created_at >= $3 AND created_at < $4") &&
+									strings.Contains(query, "LIMIT $5")
+							}),
+							[]any{entity, entityID, from, to, int32(10)},
+						).
+						Return(&auditFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				entries: []domain.AuditEntry{},
+			},
+		},
+		"Limit out of range defaults to 50": {
+			given: Given{
+				filter: domain.AuditFilter{Limit: 500},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{int32(50)}).
+						Return(&auditFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				entries: []domain.AuditEntry{},
+			},
+		},
+		"Invalid entity": {
+			given: Given{
+				filter: domain.AuditFilter{Entity: func() *domain.AuditEntity { e := domain.AuditEntity("user"); return &e }()},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate audit filter: entity invalid = user"),
+			},
+		},
+		"From not before to": {
+			given: Given{
+				filter: domain.AuditFilter{From: &to, To: &from},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate audit filter: from must be before to"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list audit entries: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&auditFakeRows{rows: []*auditFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan audit entry: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&auditFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			entries, err := f.auditRepository.List(context.Background(), test.given.filter)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, entries)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.entries, entries)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,548 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/middleware"
+	"github.com/jackc/pgx/v5"
+)
+
+// AuditedRepositoryConfig holds what the audited repository wrappers need to
+// write audit entries: the database used to open a transaction when the
+// wrapper is not already bound to one, and the repository the entries go to.
+type AuditedRepositoryConfig struct {
+	DatabaseAPI database.DatabaseAPI
+	AuditRepo   AuditRepository
+}
+
+// auditor is shared by the audited wrappers. A mutation and its audit entry
+// always run in the same transaction: the one the wrapper was bound to with
+// WithTx, or a new one opened for the call.
+type auditor struct {
+	databaseAPI database.DatabaseAPI
+	auditRepo   AuditRepository
+	tx          database.Tx
+}
+
+func newAuditor(cfg AuditedRepositoryConfig) auditor {
+	return auditor{
+		databaseAPI: cfg.DatabaseAPI,
+		auditRepo:   cfg.AuditRepo,
+	}
+}
+
+// run calls fn with the auditor's transaction, or in a new transaction when
+// it has none.
+func (a auditor) run(ctx context.Context, fn func(tx database.Tx) error) error {
+	if a.tx != nil {
+		return fn(a.tx)
+	}
+	return a.databaseAPI.WithTx(ctx, fn)
+}
+
+// record writes an audit entry on tx. before and after are diffed with
+// domain.DiffJSON; the request ID is taken from ctx.
+func (a auditor) record(ctx context.Context, tx database.Tx, entity domain.AuditEntity, entityID string, action domain.AuditAction, before, after any) error {
+	diff, err := domain.DiffJSON(before, after)
+	if err != nil {
+		return fmt.Errorf("failed to diff audit entry: %w", err)
+	}
+
+	return a.auditRepo.WithTx(tx).Record(ctx, &domain.AuditEntry{
+		Entity:    entity,
+		EntityID:  entityID,
+		Action:    action,
+		Diff:      diff,
+		RequestID: middleware.RequestIDFromContext(ctx),
+	})
+}
+
+// lookupBefore loads the current state of a row ahead of a mutation. A
+// missing row is not an error: the mutation itself reports it.
+func lookupBefore[T any](get func() (*T, error)) (*T, error) {
+	before, err := get()
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return nil, err
+	}
+	return before, nil
+}
+
+type auditedProjectRepository struct {
+	ProjectRepository
+	auditor
+}
+
+// NewAuditedProjectRepository wraps repo so that every create, update, delete,
+// restore, purge and skill change is recorded in cfg.AuditRepo. Reads pass
+// straight through to repo.
+func NewAuditedProjectRepository(repo ProjectRepository, cfg AuditedRepositoryConfig) ProjectRepository {
+	return &auditedProjectRepository{
+		ProjectRepository: repo,
+		auditor:           newAuditor(cfg),
+	}
+}
+
+func (r *auditedProjectRepository) WithTx(tx database.Tx) ProjectRepository {
+	txRepo := *r
+	txRepo.ProjectRepository = r.ProjectRepository.WithTx(tx)
+	txRepo.tx = tx
+	return &txRepo
+}
+
+func (r *auditedProjectRepository) Create(ctx context.Context, project *domain.Project) (string, error) {
+	var id string
+	err := r.run(ctx, func(tx database.Tx) error {
+		var err error
+		id, err = r.ProjectRepository.WithTx(tx).Create(ctx, project)
+		if err != nil {
+			return err
+		}
+
+		created := *project
+		created.Id = id
+		return r.record(ctx, tx, domain.AuditProject, id, domain.AuditCreate, nil, created)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *auditedProjectRepository) Update(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	if project == nil {
+		return r.ProjectRepository.Update(ctx, project)
+	}
+
+	var updated *domain.Project
+	err := r.run(ctx, func(tx database.Tx) error {
+		repo := r.ProjectRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.Project, error) { return repo.Get(ctx, project.Id) })
+		if err != nil {
+			return err
+		}
+
+		updated, err = repo.Update(ctx, project)
+		if err != nil || updated == nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditProject, updated.Id, domain.AuditUpdate, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *auditedProjectRepository) Delete(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.ProjectRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.Project, error) { return repo.Get(ctx, id) })
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditProject, id, domain.AuditDelete, before, nil)
+	})
+}
+
+func (r *auditedProjectRepository) Restore(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.ProjectRepository.WithTx(tx)
+
+		if err := repo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		after, err := repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditProject, id, domain.AuditRestore, nil, after)
+	})
+}
+
+func (r *auditedProjectRepository) Purge(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.ProjectRepository.WithTx(tx).Purge(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditProject, id, domain.AuditPurge, nil, nil)
+	})
+}
+
+func (r *auditedProjectRepository) SetSkills(ctx context.Context, projectID string, skillIDs []string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.ProjectRepository.WithTx(tx).SetSkills(ctx, projectID, skillIDs); err != nil {
+			return err
+		}
+
+		after := map[string][]string{"skill_ids": skillIDs}
+		return r.record(ctx, tx, domain.AuditProject, projectID, domain.AuditUpdate, nil, after)
+	})
+}
+
+type auditedEducationRepository struct {
+	EducationRepository
+	auditor
+}
+
+// NewAuditedEducationRepository wraps repo so that every create, update,
+// delete, restore and purge is recorded in cfg.AuditRepo. Reads pass straight
+// through to repo.
+func NewAuditedEducationRepository(repo EducationRepository, cfg AuditedRepositoryConfig) EducationRepository {
+	return &auditedEducationRepository{
+		EducationRepository: repo,
+		auditor:             newAuditor(cfg),
+	}
+}
+
+func (r *auditedEducationRepository) WithTx(tx database.Tx) EducationRepository {
+	txRepo := *r
+	txRepo.EducationRepository = r.EducationRepository.WithTx(tx)
+	txRepo.tx = tx
+	return &txRepo
+}
+
+func (r *auditedEducationRepository) Create(ctx context.Context, education *domain.Education) (string, error) {
+	var id string
+	err := r.run(ctx, func(tx database.Tx) error {
+		var err error
+		id, err = r.EducationRepository.WithTx(tx).Create(ctx, education)
+		if err != nil {
+			return err
+		}
+
+		created := *education
+		created.Id = id
+		return r.record(ctx, tx, domain.AuditEducation, id, domain.AuditCreate, nil, created)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *auditedEducationRepository) Update(ctx context.Context, education *domain.Education) (*domain.Education, error) {
+	if education == nil {
+		return r.EducationRepository.Update(ctx, education)
+	}
+
+	var updated *domain.Education
+	err := r.run(ctx, func(tx database.Tx) error {
+		repo := r.EducationRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.Education, error) { return repo.Get(ctx, education.Id) })
+		if err != nil {
+			return err
+		}
+
+		updated, err = repo.Update(ctx, education)
+		if err != nil || updated == nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditEducation, updated.Id, domain.AuditUpdate, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *auditedEducationRepository) Delete(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.EducationRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.Education, error) { return repo.Get(ctx, id) })
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditEducation, id, domain.AuditDelete, before, nil)
+	})
+}
+
+func (r *auditedEducationRepository) Restore(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.EducationRepository.WithTx(tx)
+
+		if err := repo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		after, err := repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditEducation, id, domain.AuditRestore, nil, after)
+	})
+}
+
+func (r *auditedEducationRepository) Purge(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.EducationRepository.WithTx(tx).Purge(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditEducation, id, domain.AuditPurge, nil, nil)
+	})
+}
+
+type auditedSkillRepository struct {
+	SkillRepository
+	auditor
+}
+
+// NewAuditedSkillRepository wraps repo so that every create, update, delete,
+// restore and purge is recorded in cfg.AuditRepo. Reads pass straight through
+// to repo.
+func NewAuditedSkillRepository(repo SkillRepository, cfg AuditedRepositoryConfig) SkillRepository {
+	return &auditedSkillRepository{
+		SkillRepository: repo,
+		auditor:         newAuditor(cfg),
+	}
+}
+
+func (r *auditedSkillRepository) WithTx(tx database.Tx) SkillRepository {
+	txRepo := *r
+	txRepo.SkillRepository = r.SkillRepository.WithTx(tx)
+	txRepo.tx = tx
+	return &txRepo
+}
+
+func (r *auditedSkillRepository) Create(ctx context.Context, skill *domain.Skill) (string, error) {
+	var id string
+	err := r.run(ctx, func(tx database.Tx) error {
+		var err error
+		id, err = r.SkillRepository.WithTx(tx).Create(ctx, skill)
+		if err != nil {
+			return err
+		}
+
+		created := *skill
+		created.Id = id
+		return r.record(ctx, tx, domain.AuditSkill, id, domain.AuditCreate, nil, created)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *auditedSkillRepository) Update(ctx context.Context, skill *domain.Skill) (*domain.Skill, error) {
+	if skill == nil {
+		return r.SkillRepository.Update(ctx, skill)
+	}
+
+	var updated *domain.Skill
+	err := r.run(ctx, func(tx database.Tx) error {
+		repo := r.SkillRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.Skill, error) { return repo.Get(ctx, skill.Id) })
+		if err != nil {
+			return err
+		}
+
+		updated, err = repo.Update(ctx, skill)
+		if err != nil || updated == nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditSkill, updated.Id, domain.AuditUpdate, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *auditedSkillRepository) Delete(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.SkillRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.Skill, error) { return repo.Get(ctx, id) })
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditSkill, id, domain.AuditDelete, before, nil)
+	})
+}
+
+func (r *auditedSkillRepository) Restore(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.SkillRepository.WithTx(tx)
+
+		if err := repo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		after, err := repo.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditSkill, id, domain.AuditRestore, nil, after)
+	})
+}
+
+func (r *auditedSkillRepository) Purge(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.SkillRepository.WithTx(tx).Purge(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditSkill, id, domain.AuditPurge, nil, nil)
+	})
+}
+
+type auditedFileRepository struct {
+	FileRepository
+	auditor
+}
+
+// NewAuditedFileRepository wraps repo so that every file create, update and
+// delete is recorded in cfg.AuditRepo. Reads pass straight through to repo.
+//
+// The *ByParent operations touch a set of files without reading them back, so
+// each is recorded once under the parent ID, with the parent table and ID as
+// the diff.
+func NewAuditedFileRepository(repo FileRepository, cfg AuditedRepositoryConfig) FileRepository {
+	return &auditedFileRepository{
+		FileRepository: repo,
+		auditor:        newAuditor(cfg),
+	}
+}
+
+func (r *auditedFileRepository) WithTx(tx database.Tx) FileRepository {
+	txRepo := *r
+	txRepo.FileRepository = r.FileRepository.WithTx(tx)
+	txRepo.tx = tx
+	return &txRepo
+}
+
+func (r *auditedFileRepository) Create(ctx context.Context, file domain.File) (string, error) {
+	var id string
+	err := r.run(ctx, func(tx database.Tx) error {
+		var err error
+		id, err = r.FileRepository.WithTx(tx).Create(ctx, file)
+		if err != nil {
+			return err
+		}
+
+		file.ID = id
+		return r.record(ctx, tx, domain.AuditFile, id, domain.AuditCreate, nil, file)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+func (r *auditedFileRepository) Update(ctx context.Context, fileUpdate domain.File) (*domain.File, error) {
+	var updated *domain.File
+	err := r.run(ctx, func(tx database.Tx) error {
+		repo := r.FileRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.File, error) { return repo.FindByID(ctx, fileUpdate.ID) })
+		if err != nil {
+			return err
+		}
+
+		updated, err = repo.Update(ctx, fileUpdate)
+		if err != nil || updated == nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditFile, updated.ID, domain.AuditUpdate, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (r *auditedFileRepository) Delete(ctx context.Context, id string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		repo := r.FileRepository.WithTx(tx)
+
+		before, err := lookupBefore(func() (*domain.File, error) { return repo.FindByID(ctx, id) })
+		if err != nil {
+			return err
+		}
+
+		if err := repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditFile, id, domain.AuditDelete, before, nil)
+	})
+}
+
+func (r *auditedFileRepository) DeleteByParent(ctx context.Context, parentTable string, parentID string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.FileRepository.WithTx(tx).DeleteByParent(ctx, parentTable, parentID); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditFile, parentID, domain.AuditDelete, fileParent(parentTable, parentID), nil)
+	})
+}
+
+func (r *auditedFileRepository) TrashByParent(ctx context.Context, parentTable string, parentID string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.FileRepository.WithTx(tx).TrashByParent(ctx, parentTable, parentID); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditFile, parentID, domain.AuditDelete, fileParent(parentTable, parentID), nil)
+	})
+}
+
+func (r *auditedFileRepository) RestoreByParent(ctx context.Context, parentTable string, parentID string) error {
+	return r.run(ctx, func(tx database.Tx) error {
+		if err := r.FileRepository.WithTx(tx).RestoreByParent(ctx, parentTable, parentID); err != nil {
+			return err
+		}
+
+		return r.record(ctx, tx, domain.AuditFile, parentID, domain.AuditRestore, nil, fileParent(parentTable, parentID))
+	})
+}
+
+// fileParent is the diff side recorded for the *ByParent file operations.
+func fileParent(parentTable, parentID string) map[string]string {
+	return map[string]string{
+		"parent_table": parentTable,
+		"parent_id":    parentID,
+	}
+}
@@ -0,0 +1,556 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	databaseMocks "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// The generated repository mocks import this package, so the audited wrapper
+// tests use small in-package stubs instead. Unstubbed methods panic through
+// the nil embedded interface.
+
+type recordingAuditRepository struct {
+	AuditRepository
+	entries []domain.AuditEntry
+	txs     []database.Tx
+	err     error
+}
+
+func (r *recordingAuditRepository) WithTx(tx database.Tx) AuditRepository {
+	r.txs = append(r.txs, tx)
+	return r
+}
+
+func (r *recordingAuditRepository) Record(ctx context.Context, entry *domain.AuditEntry) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.entries = append(r.entries, *entry)
+	return nil
+}
+
+type stubProjectRepository struct {
+	ProjectRepository
+	project   *domain.Project
+	getErr    error
+	createID  string
+	updated   *domain.Project
+	mutateErr error
+	tx        database.Tx
+}
+
+func (r *stubProjectRepository) WithTx(tx database.Tx) ProjectRepository {
+	txRepo := *r
+	txRepo.tx = tx
+	return &txRepo
+}
+
+func (r *stubProjectRepository) Get(ctx context.Context, id string) (*domain.Project, error) {
+	return r.project, r.getErr
+}
+
+func (r *stubProjectRepository) Create(ctx context.Context, project *domain.Project) (string, error) {
+	return r.createID, r.mutateErr
+}
+
+func (r *stubProjectRepository) Update(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	return r.updated, r.mutateErr
+}
+
+func (r *stubProjectRepository) Delete(ctx context.Context, id string) error  { return r.mutateErr }
+func (r *stubProjectRepository) Restore(ctx context.Context, id string) error { return r.mutateErr }
+func (r *stubProjectRepository) Purge(ctx context.Context, id string) error   { return r.mutateErr }
+
+func (r *stubProjectRepository) SetSkills(ctx context.Context, projectID string, skillIDs []string) error {
+	return r.mutateErr
+}
+
+type stubSkillRepository struct {
+	SkillRepository
+	skill     *domain.Skill
+	updated   *domain.Skill
+	mutateErr error
+}
+
+func (r *stubSkillRepository) WithTx(tx database.Tx) SkillRepository { return r }
+
+func (r *stubSkillRepository) Get(ctx context.Context, id string) (*domain.Skill, error) {
+	return r.skill, nil
+}
+
+func (r *stubSkillRepository) Update(ctx context.Context, skill *domain.Skill) (*domain.Skill, error) {
+	return r.updated, r.mutateErr
+}
+
+type stubFileRepository struct {
+	FileRepository
+	createID  string
+	mutateErr error
+}
+
+func (r *stubFileRepository) WithTx(tx database.Tx) FileRepository { return r }
+
+func (r *stubFileRepository) Create(ctx context.Context, file domain.File) (string, error) {
+	return r.createID, r.mutateErr
+}
+
+func (r *stubFileRepository) TrashByParent(ctx context.Context, parentTable string, parentID string) error {
+	return r.mutateErr
+}
+
+type auditedRepositoryTestFixture struct {
+	t               *testing.T
+	mockDatabaseAPI *databaseMocks.MockDatabaseAPI
+	mockTx          *databaseMocks.MockTx
+	auditRepo       *recordingAuditRepository
+	cfg             AuditedRepositoryConfig
+}
+
+func newAuditedRepositoryTestFixture(t *testing.T) *auditedRepositoryTestFixture {
+	mockDatabaseAPI := new(databaseMocks.MockDatabaseAPI)
+	mockTx := new(databaseMocks.MockTx)
+	auditRepo := &recordingAuditRepository{}
+
+	// Run transactional callbacks directly against the stubs
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+
+	return &auditedRepositoryTestFixture{
+		t:               t,
+		mockDatabaseAPI: mockDatabaseAPI,
+		mockTx:          mockTx,
+		auditRepo:       auditRepo,
+		cfg: AuditedRepositoryConfig{
+			DatabaseAPI: mockDatabaseAPI,
+			AuditRepo:   auditRepo,
+		},
+	}
+}
+
+func diffOf(t *testing.T, fields map[string][2]string) map[string]domain.AuditChange {
+	t.Helper()
+
+	diff := make(map[string]domain.AuditChange, len(fields))
+	for field, sides := range fields {
+		var change domain.AuditChange
+		if sides[0] != "" {
+			change.Before = json.RawMessage(sides[0])
+		}
+		if sides[1] != "" {
+			change.After = json.RawMessage(sides[1])
+		}
+		diff[field] = change
+	}
+	return diff
+}
+
+func TestAuditedProjectRepository_Update(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := &domain.Project{
+		Id:        "p1",
+		Title:     "Old",
+		Tags:      []string{"go"},
+		Type:      domain.Web,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+	after := *before
+	after.Title = "New"
+	after.UpdatedAt = createdAt.Add(time.Hour)
+
+	updateErr := errors.New("update error")
+	getErr := errors.New("get error")
+
+	type Given struct {
+		repo *stubProjectRepository
+	}
+
+	type Expected struct {
+		updated *domain.Project
+		entries []domain.AuditEntry
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Records only the changed fields": {
+			given: Given{
+				repo: &stubProjectRepository{project: before, updated: &after},
+			},
+			expected: Expected{
+				updated: &after,
+				entries: []domain.AuditEntry{
+					{
+						Entity:    domain.AuditProject,
+						EntityID:  "p1",
+						Action:    domain.AuditUpdate,
+						RequestID: "req-1",
+						Diff: diffOf(t, map[string][2]string{
+							"title":      {`"Old"`, `"New"`},
+							"updated_at": {`"2025-01-01T12:00:00Z"`, `"2025-01-01T13:00:00Z"`},
+						}),
+					},
+				},
+			},
+		},
+		"Missing project is not recorded": {
+			given: Given{
+				repo: &stubProjectRepository{getErr: fmt.Errorf("failed to get project: %w", pgx.ErrNoRows)},
+			},
+		},
+		"Update fails": {
+			given: Given{
+				repo: &stubProjectRepository{project: before, mutateErr: updateErr},
+			},
+			expected: Expected{
+				err: updateErr,
+			},
+		},
+		"Lookup fails": {
+			given: Given{
+				repo: &stubProjectRepository{getErr: getErr},
+			},
+			expected: Expected{
+				err: getErr,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditedRepositoryTestFixture(t)
+			repo := NewAuditedProjectRepository(test.given.repo, f.cfg)
+
+			ctx := middleware.ContextWithRequestID(context.Background(), "req-1")
+			updated, err := repo.Update(ctx, &domain.Project{Id: "p1"})
+
+			if test.expected.err != nil {
+				assert.ErrorIs(t, err, test.expected.err)
+				assert.Nil(t, updated)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.updated, updated)
+			}
+			assert.Equal(t, test.expected.entries, f.auditRepo.entries)
+		})
+	}
+}
+
+func TestAuditedProjectRepository_Mutations(t *testing.T) {
+	project := &domain.Project{Id: "p1", Title: "Portfolio"}
+	recordErr := errors.New("record error")
+
+	type Given struct {
+		repo      *stubProjectRepository
+		recordErr error
+		call      func(repo ProjectRepository) error
+	}
+
+	type Expected struct {
+		action domain.AuditAction
+		diff   map[string]domain.AuditChange
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Create records every field as after": {
+			given: Given{
+				repo: &stubProjectRepository{createID: "p1"},
+				call: func(repo ProjectRepository) error {
+					_, err := repo.Create(context.Background(), &domain.Project{Title: "Portfolio"})
+					return err
+				},
+			},
+			expected: Expected{
+				action: domain.AuditCreate,
+				diff: diffOf(t, map[string][2]string{
+					"id":          {"", `"p1"`},
+					"blurhash":    {"", `""`},
+					"title":       {"", `"Portfolio"`},
+					"sub_title":   {"", `""`},
+					"description": {"", `""`},
+					"tags":        {"", `null`},
+					"type":        {"", `""`},
+					"link":        {"", `""`},
+					"created_at":  {"", `"0001-01-01T00:00:00Z"`},
+					"updated_at":  {"", `"0001-01-01T00:00:00Z"`},
+				}),
+			},
+		},
+		"Delete records the removed state as before": {
+			given: Given{
+				repo: &stubProjectRepository{project: &domain.Project{Id: "p1", Title: "Portfolio", Tags: []string{}}},
+				call: func(repo ProjectRepository) error {
+					return repo.Delete(context.Background(), "p1")
+				},
+			},
+			expected: Expected{
+				action: domain.AuditDelete,
+				diff: diffOf(t, map[string][2]string{
+					"id":          {`"p1"`, ""},
+					"blurhash":    {`""`, ""},
+					"title":       {`"Portfolio"`, ""},
+					"sub_title":   {`""`, ""},
+					"description": {`""`, ""},
+					"tags":        {`[]`, ""},
+					"type":        {`""`, ""},
+					"link":        {`""`, ""},
+					"created_at":  {`"0001-01-01T00:00:00Z"`, ""},
+					"updated_at":  {`"0001-01-01T00:00:00Z"`, ""},
+				}),
+			},
+		},
+		"Delete of a missing project is not recorded": {
+			given: Given{
+				repo: &stubProjectRepository{getErr: pgx.ErrNoRows, mutateErr: pgx.ErrNoRows},
+				call: func(repo ProjectRepository) error {
+					return repo.Delete(context.Background(), "p1")
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Restore records the restored state as after": {
+			given: Given{
+				repo: &stubProjectRepository{project: project},
+				call: func(repo ProjectRepository) error {
+					return repo.Restore(context.Background(), "p1")
+				},
+			},
+			expected: Expected{
+				action: domain.AuditRestore,
+				diff: diffOf(t, map[string][2]string{
+					"id":          {"", `"p1"`},
+					"blurhash":    {"", `""`},
+					"title":       {"", `"Portfolio"`},
+					"sub_title":   {"", `""`},
+					"description": {"", `""`},
+					"tags":        {"", `null`},
+					"type":        {"", `""`},
+					"link":        {"", `""`},
+					"created_at":  {"", `"0001-01-01T00:00:00Z"`},
+					"updated_at":  {"", `"0001-01-01T00:00:00Z"`},
+				}),
+			},
+		},
+		"Purge records an empty diff": {
+			given: Given{
+				repo: &stubProjectRepository{},
+				call: func(repo ProjectRepository) error {
+					return repo.Purge(context.Background(), "p1")
+				},
+			},
+			expected: Expected{
+				action: domain.AuditPurge,
+				diff:   map[string]domain.AuditChange{},
+			},
+		},
+		"SetSkills records the new skill IDs": {
+			given: Given{
+				repo: &stubProjectRepository{},
+				call: func(repo ProjectRepository) error {
+					return repo.SetSkills(context.Background(), "p1", []string{"s1", "s2"})
+				},
+			},
+			expected: Expected{
+				action: domain.AuditUpdate,
+				diff: diffOf(t, map[string][2]string{
+					"skill_ids": {"", `["s1","s2"]`},
+				}),
+			},
+		},
+		"Record failure is returned": {
+			given: Given{
+				repo:      &stubProjectRepository{},
+				recordErr: recordErr,
+				call: func(repo ProjectRepository) error {
+					return repo.Purge(context.Background(), "p1")
+				},
+			},
+			expected: Expected{
+				err: recordErr,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditedRepositoryTestFixture(t)
+			f.auditRepo.err = test.given.recordErr
+			repo := NewAuditedProjectRepository(test.given.repo, f.cfg)
+
+			err := test.given.call(repo)
+
+			if test.expected.err != nil {
+				assert.ErrorIs(t, err, test.expected.err)
+				assert.Empty(t, f.auditRepo.entries)
+				return
+			}
+
+			assert.NoError(t, err)
+			if assert.Len(t, f.auditRepo.entries, 1) {
+				entry := f.auditRepo.entries[0]
+				assert.Equal(t, domain.AuditProject, entry.Entity)
+				assert.Equal(t, "p1", entry.EntityID)
+				assert.Equal(t, test.expected.action, entry.Action)
+				assert.Equal(t, test.expected.diff, entry.Diff)
+				assert.Empty(t, entry.RequestID)
+			}
+			assert.Equal(t, []database.Tx{f.mockTx}, f.auditRepo.txs)
+		})
+	}
+}
+
+func TestAuditedProjectRepository_WithTx(t *testing.T) {
+	f := newAuditedRepositoryTestFixture(t)
+	f.mockDatabaseAPI.ExpectedCalls = nil
+
+	inner := &stubProjectRepository{}
+	callerTx := new(databaseMocks.MockTx)
+
+	repo := NewAuditedProjectRepository(inner, f.cfg).WithTx(callerTx)
+	err := repo.Purge(context.Background(), "p1")
+
+	assert.NoError(t, err)
+	assert.Len(t, f.auditRepo.entries, 1)
+	// The entry is written on the caller's transaction; no new one is opened.
+	assert.Equal(t, []database.Tx{callerTx}, f.auditRepo.txs)
+	f.mockDatabaseAPI.AssertNotCalled(t, "WithTx", mock.Anything, mock.Anything)
+}
+
+func TestAuditedSkillRepository_Update(t *testing.T) {
+	f := newAuditedRepositoryTestFixture(t)
+
+	before := &domain.Skill{Id: "s1", Label: "Go", Category: domain.Backend}
+	after := *before
+	after.Category = domain.Tools
+
+	repo := NewAuditedSkillRepository(&stubSkillRepository{skill: before, updated: &after}, f.cfg)
+	updated, err := repo.Update(context.Background(), &after)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &after, updated)
+	assert.Equal(t, []domain.AuditEntry{
+		{
+			Entity:   domain.AuditSkill,
+			EntityID: "s1",
+			Action:   domain.AuditUpdate,
+			Diff: diffOf(t, map[string][2]string{
+				"category": {`"backend"`, `"tools"`},
+			}),
+		},
+	}, f.auditRepo.entries)
+}
+
+func TestAuditedSkillRepository_UpdateNil(t *testing.T) {
+	f := newAuditedRepositoryTestFixture(t)
+	f.mockDatabaseAPI.ExpectedCalls = nil
+
+	nilErr := errors.New("failed to validate skill: payload is nil")
+	repo := NewAuditedSkillRepository(&stubSkillRepository{mutateErr: nilErr}, f.cfg)
+	updated, err := repo.Update(context.Background(), nil)
+
+	assert.ErrorIs(t, err, nilErr)
+	assert.Nil(t, updated)
+	assert.Empty(t, f.auditRepo.entries)
+}
+
+func TestAuditedFileRepository(t *testing.T) {
+	type Given struct {
+		call func(repo FileRepository) error
+	}
+
+	type Expected struct {
+		entry domain.AuditEntry
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Create records the new file": {
+			given: Given{
+				call: func(repo FileRepository) error {
+					_, err := repo.Create(context.Background(), domain.File{
+						ParentTable: domain.ProjectTable,
+						ParentID:    "p1",
+						Role:        domain.Image,
+						Name:        "cover.png",
+					})
+					return err
+				},
+			},
+			expected: Expected{
+				entry: domain.AuditEntry{
+					Entity:   domain.AuditFile,
+					EntityID: "f1",
+					Action:   domain.AuditCreate,
+					Diff: diffOf(t, map[string][2]string{
+						"id":           {"", `"f1"`},
+						"parent_table": {"", `"projects"`},
+						"parent_id":    {"", `"p1"`},
+						"role":         {"", `"image"`},
+						"name":         {"", `"cover.png"`},
+						"url":          {"", `""`},
+						"type":         {"", `""`},
+						"size":         {"", `0`},
+						"created_at":   {"", `"0001-01-01T00:00:00Z"`},
+						"updated_at":   {"", `"0001-01-01T00:00:00Z"`},
+					}),
+				},
+			},
+		},
+		"TrashByParent is recorded under the parent ID": {
+			given: Given{
+				call: func(repo FileRepository) error {
+					return repo.TrashByParent(context.Background(), "project", "p1")
+				},
+			},
+			expected: Expected{
+				entry: domain.AuditEntry{
+					Entity:   domain.AuditFile,
+					EntityID: "p1",
+					Action:   domain.AuditDelete,
+					Diff: diffOf(t, map[string][2]string{
+						"parent_table": {`"project"`, ""},
+						"parent_id":    {`"p1"`, ""},
+					}),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newAuditedRepositoryTestFixture(t)
+			repo := NewAuditedFileRepository(&stubFileRepository{createID: "f1"}, f.cfg)
+
+			err := test.given.call(repo)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []domain.AuditEntry{test.expected.entry}, f.auditRepo.entries)
+		})
+	}
+}
@@ -0,0 +1,452 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/cache"
+)
+
+// defaultCacheCapacity bounds each entity's cache when
+// RepositoryCacheConfig.Capacity is left at zero.
+const defaultCacheCapacity = 256
+
+// RepositoryCacheConfig configures the caches shared by the cached repository
+// wrappers. Capacity is the number of Get and List results kept per entity.
+type RepositoryCacheConfig struct {
+	TTL      time.Duration
+	Capacity int
+}
+
+// RepositoryCacheStats holds the hit and miss counters of each entity's cache.
+type RepositoryCacheStats struct {
+	Projects   cache.Stats
+	Skills     cache.Stats
+	Educations cache.Stats
+}
+
+// RepositoryCache holds the Get and List results of the project, skill and
+// education repositories. One RepositoryCache is meant to be shared by every
+// handler, so a write made through any cached wrapper invalidates the results
+// all of them serve.
+type RepositoryCache struct {
+	projects   *cache.Cache[string, any]
+	skills     *cache.Cache[string, any]
+	educations *cache.Cache[string, any]
+}
+
+// NewRepositoryCache returns an empty RepositoryCache configured with cfg.
+func NewRepositoryCache(cfg RepositoryCacheConfig) *RepositoryCache {
+	capacity := cfg.Capacity
+	if capacity == 0 {
+		capacity = defaultCacheCapacity
+	}
+
+	c := cache.Config{TTL: cfg.TTL, Capacity: capacity}
+
+	return &RepositoryCache{
+		projects:   cache.New[string, any](c),
+		skills:     cache.New[string, any](c),
+		educations: cache.New[string, any](c),
+	}
+}
+
+// Stats returns a snapshot of the hit and miss counters.
+func (c *RepositoryCache) Stats() RepositoryCacheStats {
+	return RepositoryCacheStats{
+		Projects:   c.projects.Stats(),
+		Skills:     c.skills.Stats(),
+		Educations: c.educations.Stats(),
+	}
+}
+
+// invalidate drops every cached result. Writes are rare and the entities
+// reference each other (projects link skills and educations), so a write to
+// any of them clears all three caches rather than tracking what it touched.
+//
+// A write made inside tx is not visible to other readers until tx commits, and
+// a read in between can cache the old rows again. The caches are therefore
+// purged once more after tx commits; tx is nil for writes made outside a
+// transaction.
+func (c *RepositoryCache) invalidate(tx database.Tx) {
+	c.purge()
+	if tx != nil {
+		tx.AfterCommit(c.purge)
+	}
+}
+
+func (c *RepositoryCache) purge() {
+	c.projects.Purge()
+	c.skills.Purge()
+	c.educations.Purge()
+}
+
+// cachedPage is a cached List result.
+type cachedPage[T any] struct {
+	items []T
+	page  domain.PageInfo
+}
+
+// readThrough serves key from c, calling load on a miss. Values are cloned on
+// the way in and out so callers can't modify what is cached.
+func readThrough[T any](c *cache.Cache[string, any], key string, clone func(T) T, load func() (T, error)) (T, error) {
+	value, err := c.GetOrLoad(key, func() (any, error) {
+		v, err := load()
+		if err != nil {
+			return nil, err
+		}
+		return clone(v), nil
+	})
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	return clone(value.(T)), nil
+}
+
+// listKey derives a cache key from a List filter. Filters hold pointers, so
+// they are keyed by their JSON encoding rather than compared directly.
+func listKey(filter any) (string, bool) {
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return "", false
+	}
+	return "list:" + string(b), true
+}
+
+func cloneProject(p *domain.Project) *domain.Project {
+	if p == nil {
+		return nil
+	}
+	clone := *p
+	clone.Tags = slices.Clone(p.Tags)
+	return &clone
+}
+
+func cloneProjectPage(p cachedPage[domain.Project]) cachedPage[domain.Project] {
+	items := slices.Clone(p.items)
+	for i := range items {
+		items[i].Tags = slices.Clone(items[i].Tags)
+	}
+	return cachedPage[domain.Project]{items: items, page: p.page}
+}
+
+func cloneSkill(s *domain.Skill) *domain.Skill {
+	if s == nil {
+		return nil
+	}
+	clone := *s
+	return &clone
+}
+
+func cloneSkillPage(p cachedPage[domain.Skill]) cachedPage[domain.Skill] {
+	return cachedPage[domain.Skill]{items: slices.Clone(p.items), page: p.page}
+}
+
+func cloneEducation(e *domain.Education) *domain.Education {
+	if e == nil {
+		return nil
+	}
+	clone := *e
+	clone.SchoolPeriods = slices.Clone(e.SchoolPeriods)
+	return &clone
+}
+
+func cloneEducationPage(p cachedPage[domain.Education]) cachedPage[domain.Education] {
+	items := slices.Clone(p.items)
+	for i := range items {
+		items[i].SchoolPeriods = slices.Clone(items[i].SchoolPeriods)
+	}
+	return cachedPage[domain.Education]{items: items, page: p.page}
+}
+
+type cachedProjectRepository struct {
+	ProjectRepository
+	cache *RepositoryCache
+	tx    database.Tx
+	inTx  bool
+}
+
+// NewCachedProjectRepository wraps repo so that Get and List are served from
+// c, and every write clears c. A nil c returns repo unchanged.
+func NewCachedProjectRepository(repo ProjectRepository, c *RepositoryCache) ProjectRepository {
+	if c == nil {
+		return repo
+	}
+	return &cachedProjectRepository{ProjectRepository: repo, cache: c}
+}
+
+// WithTx binds the wrapper to tx. Reads inside a transaction bypass the cache,
+// so uncommitted rows are never cached, and writes purge it again once tx
+// commits.
+func (r *cachedProjectRepository) WithTx(tx database.Tx) ProjectRepository {
+	return &cachedProjectRepository{
+		ProjectRepository: r.ProjectRepository.WithTx(tx),
+		cache:             r.cache,
+		tx:                tx,
+		inTx:              true,
+	}
+}
+
+func (r *cachedProjectRepository) Get(ctx context.Context, id string) (*domain.Project, error) {
+	if r.inTx {
+		return r.ProjectRepository.Get(ctx, id)
+	}
+	return readThrough(r.cache.projects, "get:"+id, cloneProject, func() (*domain.Project, error) {
+		return r.ProjectRepository.Get(ctx, id)
+	})
+}
+
+func (r *cachedProjectRepository) List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error) {
+	key, ok := listKey(filter)
+	if r.inTx || !ok {
+		return r.ProjectRepository.List(ctx, filter)
+	}
+
+	page, err := readThrough(r.cache.projects, key, cloneProjectPage, func() (cachedPage[domain.Project], error) {
+		items, page, err := r.ProjectRepository.List(ctx, filter)
+		return cachedPage[domain.Project]{items: items, page: page}, err
+	})
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	return page.items, page.page, nil
+}
+
+func (r *cachedProjectRepository) Create(ctx context.Context, project *domain.Project) (string, error) {
+	id, err := r.ProjectRepository.Create(ctx, project)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return id, err
+}
+
+func (r *cachedProjectRepository) Update(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	updated, err := r.ProjectRepository.Update(ctx, project)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return updated, err
+}
+
+func (r *cachedProjectRepository) Delete(ctx context.Context, id string) error {
+	err := r.ProjectRepository.Delete(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedProjectRepository) Restore(ctx context.Context, id string) error {
+	err := r.ProjectRepository.Restore(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedProjectRepository) Purge(ctx context.Context, id string) error {
+	err := r.ProjectRepository.Purge(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedProjectRepository) SetSkills(ctx context.Context, projectID string, skillIDs []string) error {
+	err := r.ProjectRepository.SetSkills(ctx, projectID, skillIDs)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+type cachedSkillRepository struct {
+	SkillRepository
+	cache *RepositoryCache
+	tx    database.Tx
+	inTx  bool
+}
+
+// NewCachedSkillRepository wraps repo so that Get and List are served from c,
+// and every write clears c. A nil c returns repo unchanged.
+func NewCachedSkillRepository(repo SkillRepository, c *RepositoryCache) SkillRepository {
+	if c == nil {
+		return repo
+	}
+	return &cachedSkillRepository{SkillRepository: repo, cache: c}
+}
+
+// WithTx binds the wrapper to tx. Reads inside a transaction bypass the cache.
+func (r *cachedSkillRepository) WithTx(tx database.Tx) SkillRepository {
+	return &cachedSkillRepository{
+		SkillRepository: r.SkillRepository.WithTx(tx),
+		cache:           r.cache,
+		tx:              tx,
+		inTx:            true,
+	}
+}
+
+func (r *cachedSkillRepository) Get(ctx context.Context, id string) (*domain.Skill, error) {
+	if r.inTx {
+		return r.SkillRepository.Get(ctx, id)
+	}
+	return readThrough(r.cache.skills, "get:"+id, cloneSkill, func() (*domain.Skill, error) {
+		return r.SkillRepository.Get(ctx, id)
+	})
+}
+
+func (r *cachedSkillRepository) List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error) {
+	key, ok := listKey(filter)
+	if r.inTx || !ok {
+		return r.SkillRepository.List(ctx, filter)
+	}
+
+	page, err := readThrough(r.cache.skills, key, cloneSkillPage, func() (cachedPage[domain.Skill], error) {
+		items, page, err := r.SkillRepository.List(ctx, filter)
+		return cachedPage[domain.Skill]{items: items, page: page}, err
+	})
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	return page.items, page.page, nil
+}
+
+func (r *cachedSkillRepository) Create(ctx context.Context, skill *domain.Skill) (string, error) {
+	id, err := r.SkillRepository.Create(ctx, skill)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return id, err
+}
+
+func (r *cachedSkillRepository) Update(ctx context.Context, skill *domain.Skill) (*domain.Skill, error) {
+	updated, err := r.SkillRepository.Update(ctx, skill)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return updated, err
+}
+
+func (r *cachedSkillRepository) Delete(ctx context.Context, id string) error {
+	err := r.SkillRepository.Delete(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedSkillRepository) Restore(ctx context.Context, id string) error {
+	err := r.SkillRepository.Restore(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedSkillRepository) Purge(ctx context.Context, id string) error {
+	err := r.SkillRepository.Purge(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+type cachedEducationRepository struct {
+	EducationRepository
+	cache *RepositoryCache
+	tx    database.Tx
+	inTx  bool
+}
+
+// NewCachedEducationRepository wraps repo so that Get and List are served from
+// c, and every write clears c. A nil c returns repo unchanged.
+func NewCachedEducationRepository(repo EducationRepository, c *RepositoryCache) EducationRepository {
+	if c == nil {
+		return repo
+	}
+	return &cachedEducationRepository{EducationRepository: repo, cache: c}
+}
+
+// WithTx binds the wrapper to tx. Reads inside a transaction bypass the cache.
+func (r *cachedEducationRepository) WithTx(tx database.Tx) EducationRepository {
+	return &cachedEducationRepository{
+		EducationRepository: r.EducationRepository.WithTx(tx),
+		cache:               r.cache,
+		tx:                  tx,
+		inTx:                true,
+	}
+}
+
+func (r *cachedEducationRepository) Get(ctx context.Context, id string) (*domain.Education, error) {
+	if r.inTx {
+		return r.EducationRepository.Get(ctx, id)
+	}
+	return readThrough(r.cache.educations, "get:"+id, cloneEducation, func() (*domain.Education, error) {
+		return r.EducationRepository.Get(ctx, id)
+	})
+}
+
+func (r *cachedEducationRepository) List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, domain.PageInfo, error) {
+	key, ok := listKey(filter)
+	if r.inTx || !ok {
+		return r.EducationRepository.List(ctx, filter)
+	}
+
+	page, err := readThrough(r.cache.educations, key, cloneEducationPage, func() (cachedPage[domain.Education], error) {
+		items, page, err := r.EducationRepository.List(ctx, filter)
+		return cachedPage[domain.Education]{items: items, page: page}, err
+	})
+	if err != nil {
+		return nil, domain.PageInfo{}, err
+	}
+
+	return page.items, page.page, nil
+}
+
+func (r *cachedEducationRepository) Create(ctx context.Context, education *domain.Education) (string, error) {
+	id, err := r.EducationRepository.Create(ctx, education)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return id, err
+}
+
+func (r *cachedEducationRepository) Update(ctx context.Context, education *domain.Education) (*domain.Education, error) {
+	updated, err := r.EducationRepository.Update(ctx, education)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return updated, err
+}
+
+func (r *cachedEducationRepository) Delete(ctx context.Context, id string) error {
+	err := r.EducationRepository.Delete(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedEducationRepository) Restore(ctx context.Context, id string) error {
+	err := r.EducationRepository.Restore(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
+
+func (r *cachedEducationRepository) Purge(ctx context.Context, id string) error {
+	err := r.EducationRepository.Purge(ctx, id)
+	if err == nil {
+		r.cache.invalidate(r.tx)
+	}
+	return err
+}
@@ -0,0 +1,315 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/cache"
+	"github.com/stretchr/testify/assert"
+)
+
+// countingProjectRepository counts the reads that reach it, so tests can tell
+// a cache hit from a miss.
+type countingProjectRepository struct {
+	ProjectRepository
+	gets, lists int
+	project     *domain.Project
+	projects    []domain.Project
+	err         error
+}
+
+func (r *countingProjectRepository) WithTx(tx database.Tx) ProjectRepository { return r }
+
+func (r *countingProjectRepository) Get(ctx context.Context, id string) (*domain.Project, error) {
+	r.gets++
+	return r.project, r.err
+}
+
+func (r *countingProjectRepository) List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error) {
+	r.lists++
+	return r.projects, domain.PageInfo{Total: int64(len(r.projects)), Page: filter.Page, PageSize: filter.PageSize}, r.err
+}
+
+func (r *countingProjectRepository) Create(ctx context.Context, project *domain.Project) (string, error) {
+	return "p2", nil
+}
+
+func (r *countingProjectRepository) Update(ctx context.Context, project *domain.Project) (*domain.Project, error) {
+	return project, nil
+}
+
+func (r *countingProjectRepository) Delete(ctx context.Context, id string) error  { return nil }
+func (r *countingProjectRepository) Restore(ctx context.Context, id string) error { return nil }
+func (r *countingProjectRepository) Purge(ctx context.Context, id string) error   { return nil }
+
+func (r *countingProjectRepository) SetSkills(ctx context.Context, projectID string, skillIDs []string) error {
+	return nil
+}
+
+type countingSkillRepository struct {
+	SkillRepository
+	lists int
+}
+
+func (r *countingSkillRepository) WithTx(tx database.Tx) SkillRepository { return r }
+
+func (r *countingSkillRepository) List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error) {
+	r.lists++
+	return []domain.Skill{{Id: "s1"}}, domain.PageInfo{Total: 1}, nil
+}
+
+func (r *countingSkillRepository) Delete(ctx context.Context, id string) error { return nil }
+
+type countingEducationRepository struct {
+	EducationRepository
+	gets int
+}
+
+func (r *countingEducationRepository) WithTx(tx database.Tx) EducationRepository { return r }
+
+func (r *countingEducationRepository) Get(ctx context.Context, id string) (*domain.Education, error) {
+	r.gets++
+	return &domain.Education{Id: id, SchoolPeriods: []domain.SchoolPeriod{{Name: "School"}}}, nil
+}
+
+func (r *countingEducationRepository) Update(ctx context.Context, education *domain.Education) (*domain.Education, error) {
+	return education, nil
+}
+
+func TestNewCachedRepository_NilCache(t *testing.T) {
+	projectRepo := &countingProjectRepository{}
+	skillRepo := &countingSkillRepository{}
+	educationRepo := &countingEducationRepository{}
+
+	assert.Same(t, ProjectRepository(projectRepo), NewCachedProjectRepository(projectRepo, nil))
+	assert.Same(t, SkillRepository(skillRepo), NewCachedSkillRepository(skillRepo, nil))
+	assert.Same(t, EducationRepository(educationRepo), NewCachedEducationRepository(educationRepo, nil))
+}
+
+func TestCachedProjectRepository_Reads(t *testing.T) {
+	ctx := context.Background()
+	education := "e1"
+
+	tests := map[string]struct {
+		read      func(repo ProjectRepository)
+		wantGets  int
+		wantLists int
+		wantStats cache.Stats
+	}{
+		"repeated Get is served from the cache": {
+			read: func(repo ProjectRepository) {
+				_, _ = repo.Get(ctx, "p1")
+				_, _ = repo.Get(ctx, "p1")
+			},
+			wantGets:  1,
+			wantStats: cache.Stats{Hits: 1, Misses: 1},
+		},
+		"Get is keyed by ID": {
+			read: func(repo ProjectRepository) {
+				_, _ = repo.Get(ctx, "p1")
+				_, _ = repo.Get(ctx, "p2")
+			},
+			wantGets:  2,
+			wantStats: cache.Stats{Misses: 2},
+		},
+		"equal filters share an entry": {
+			read: func(repo ProjectRepository) {
+				_, _, _ = repo.List(ctx, domain.ProjectFilter{Page: 1, PageSize: 10, EducationID: &education})
+				e := "e1"
+				_, _, _ = repo.List(ctx, domain.ProjectFilter{Page: 1, PageSize: 10, EducationID: &e})
+			},
+			wantLists: 1,
+			wantStats: cache.Stats{Hits: 1, Misses: 1},
+		},
+		"different filters are cached apart": {
+			read: func(repo ProjectRepository) {
+				_, _, _ = repo.List(ctx, domain.ProjectFilter{Page: 1, PageSize: 10})
+				_, _, _ = repo.List(ctx, domain.ProjectFilter{Page: 2, PageSize: 10})
+			},
+			wantLists: 2,
+			wantStats: cache.Stats{Misses: 2},
+		},
+		"reads inside a transaction bypass the cache": {
+			read: func(repo ProjectRepository) {
+				txRepo := repo.WithTx(nil)
+				_, _ = txRepo.Get(ctx, "p1")
+				_, _ = txRepo.Get(ctx, "p1")
+				_, _, _ = txRepo.List(ctx, domain.ProjectFilter{})
+			},
+			wantGets:  2,
+			wantLists: 1,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			inner := &countingProjectRepository{
+				project:  &domain.Project{Id: "p1", Tags: []string{"go"}},
+				projects: []domain.Project{{Id: "p1", Tags: []string{"go"}}},
+			}
+			c := NewRepositoryCache(RepositoryCacheConfig{TTL: time.Minute})
+
+			tt.read(NewCachedProjectRepository(inner, c))
+
+			assert.Equal(t, tt.wantGets, inner.gets)
+			assert.Equal(t, tt.wantLists, inner.lists)
+			assert.Equal(t, tt.wantStats, c.Stats().Projects)
+		})
+	}
+}
+
+func TestCachedProjectRepository_ErrorsAreNotCached(t *testing.T) {
+	inner := &countingProjectRepository{err: errors.New("db down")}
+	repo := NewCachedProjectRepository(inner, NewRepositoryCache(RepositoryCacheConfig{TTL: time.Minute}))
+
+	_, err := repo.Get(context.Background(), "p1")
+	assert.EqualError(t, err, "db down")
+	_, _, err = repo.List(context.Background(), domain.ProjectFilter{})
+	assert.EqualError(t, err, "db down")
+
+	inner.err = nil
+	_, _ = repo.Get(context.Background(), "p1")
+	_, _, _ = repo.List(context.Background(), domain.ProjectFilter{})
+
+	assert.Equal(t, 2, inner.gets)
+	assert.Equal(t, 2, inner.lists)
+}
+
+func TestCachedProjectRepository_ReturnsCopies(t *testing.T) {
+	inner := &countingProjectRepository{
+		project:  &domain.Project{Id: "p1", Title: "Portfolio", Tags: []string{"go"}},
+		projects: []domain.Project{{Id: "p1", Tags: []string{"go"}}},
+	}
+	repo := NewCachedProjectRepository(inner, NewRepositoryCache(RepositoryCacheConfig{TTL: time.Minute}))
+
+	got, _ := repo.Get(context.Background(), "p1")
+	got.Title = "Changed"
+	got.Tags[0] = "changed"
+
+	items, _, _ := repo.List(context.Background(), domain.ProjectFilter{})
+	items[0].Tags[0] = "changed"
+
+	got, _ = repo.Get(context.Background(), "p1")
+	assert.Equal(t, &domain.Project{Id: "p1", Title: "Portfolio", Tags: []string{"go"}}, got)
+	items, _, _ = repo.List(context.Background(), domain.ProjectFilter{})
+	assert.Equal(t, []string{"go"}, items[0].Tags)
+	assert.Equal(t, []string{"go"}, inner.project.Tags, "the source value is not aliased either")
+}
+
+func TestCachedProjectRepository_WritesInvalidate(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]func(repo ProjectRepository) error{
+		"Create": func(repo ProjectRepository) error {
+			_, err := repo.Create(ctx, &domain.Project{})
+			return err
+		},
+		"Update": func(repo ProjectRepository) error {
+			_, err := repo.Update(ctx, &domain.Project{Id: "p1"})
+			return err
+		},
+		"Delete":  func(repo ProjectRepository) error { return repo.Delete(ctx, "p1") },
+		"Restore": func(repo ProjectRepository) error { return repo.Restore(ctx, "p1") },
+		"Purge":   func(repo ProjectRepository) error { return repo.Purge(ctx, "p1") },
+		"SetSkills": func(repo ProjectRepository) error {
+			return repo.SetSkills(ctx, "p1", []string{"s1"})
+		},
+		"write inside a transaction": func(repo ProjectRepository) error {
+			return repo.WithTx(nil).Delete(ctx, "p1")
+		},
+	}
+
+	for name, write := range tests {
+		t.Run(name, func(t *testing.T) {
+			inner := &countingProjectRepository{project: &domain.Project{Id: "p1"}}
+			repo := NewCachedProjectRepository(inner, NewRepositoryCache(RepositoryCacheConfig{TTL: time.Minute}))
+
+			_, _ = repo.Get(ctx, "p1")
+			assert.NoError(t, write(repo))
+			_, _ = repo.Get(ctx, "p1")
+
+			assert.Equal(t, 2, inner.gets)
+		})
+	}
+}
+
+// committingTx records AfterCommit callbacks and runs them on Commit, so tests
+// can control when a transaction's writes become visible.
+type committingTx struct {
+	database.Tx
+	afterCommit []func()
+}
+
+func (tx *committingTx) AfterCommit(fn func()) { tx.afterCommit = append(tx.afterCommit, fn) }
+
+func (tx *committingTx) Commit(ctx context.Context) error {
+	for _, fn := range tx.afterCommit {
+		fn()
+	}
+	return nil
+}
+
+func TestCachedProjectRepository_ReadBeforeCommit(t *testing.T) {
+	ctx := context.Background()
+	inner := &countingProjectRepository{project: &domain.Project{Id: "p1", Title: "Old"}}
+	repo := NewCachedProjectRepository(inner, NewRepositoryCache(RepositoryCacheConfig{TTL: time.Minute}))
+	tx := &committingTx{}
+
+	_, err := repo.WithTx(tx).Update(ctx, &domain.Project{Id: "p1", Title: "New"})
+	assert.NoError(t, err)
+
+	// Until the commit, other readers still see and re-cache the old row.
+	got, _ := repo.Get(ctx, "p1")
+	assert.Equal(t, "Old", got.Title)
+
+	inner.project = &domain.Project{Id: "p1", Title: "New"}
+	assert.NoError(t, tx.Commit(ctx))
+
+	got, _ = repo.Get(ctx, "p1")
+	assert.Equal(t, "New", got.Title)
+	assert.Equal(t, 2, inner.gets)
+}
+
+func TestRepositoryCache_SharedAcrossEntities(t *testing.T) {
+	ctx := context.Background()
+	c := NewRepositoryCache(RepositoryCacheConfig{TTL: time.Minute})
+
+	projectInner := &countingProjectRepository{project: &domain.Project{Id: "p1"}}
+	skillInner := &countingSkillRepository{}
+	educationInner := &countingEducationRepository{}
+
+	projects := NewCachedProjectRepository(projectInner, c)
+	skills := NewCachedSkillRepository(skillInner, c)
+	educations := NewCachedEducationRepository(educationInner, c)
+
+	_, _ = projects.Get(ctx, "p1")
+	_, _, _ = skills.List(ctx, domain.SkillFilter{})
+	_, _ = educations.Get(ctx, "e1")
+
+	// A skill write also drops cached projects and educations, which may embed it.
+	assert.NoError(t, skills.Delete(ctx, "s1"))
+
+	_, _ = projects.Get(ctx, "p1")
+	_, _, _ = skills.List(ctx, domain.SkillFilter{})
+	_, _ = educations.Get(ctx, "e1")
+
+	assert.Equal(t, 2, projectInner.gets)
+	assert.Equal(t, 2, skillInner.lists)
+	assert.Equal(t, 2, educationInner.gets)
+
+	_, err := educations.Update(ctx, &domain.Education{Id: "e1"})
+	assert.NoError(t, err)
+	_, _ = projects.Get(ctx, "p1")
+	_, _ = projects.Get(ctx, "p1")
+
+	assert.Equal(t, 3, projectInner.gets)
+	assert.Equal(t, RepositoryCacheStats{
+		Projects:   cache.Stats{Hits: 1, Misses: 3},
+		Skills:     cache.Stats{Misses: 2},
+		Educations: cache.Stats{Misses: 2},
+	}, c.Stats())
+}
@@ -18,7 +18,12 @@ type EducationRepository interface {
 	Get(ctx context.Context, id string) (*domain.Education, error)
 	Update(ctx context.Context, education *domain.Education) (*domain.Education, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+	List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, domain.PageInfo, error)
+	Version(ctx context.Context) (domain.CollectionVersion, error)
+	Exists(ctx context.Context, id string) (bool, error)
+	WithTx(tx database.Tx) EducationRepository
 }
 
 type EducationRepositoryConfig struct {
@@ -30,7 +35,7 @@ type EducationRepositoryConfig struct {
 
 type educationRepository struct {
 	educationTable string
-	databaseAPI    database.DatabaseAPI
+	databaseAPI    database.Querier
 	timeProvider   domain.TimeProvider
 }
 
@@ -54,6 +59,15 @@ func NewEducationRepository(cfg EducationRepositoryConfig) EducationRepository {
 	}
 }
 
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool, so several repository calls can be
+// committed or rolled back together.
+func (r *educationRepository) WithTx(tx database.Tx) EducationRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
 // Create creates a new education record in the repository and returns its generated ID.
 // It validates the provided Education payload, generates a unique ID, marshals the main
 // school and school periods to JSON, sets CreatedAt and UpdatedAt timestamps from the
@@ -149,7 +163,7 @@ func (r *educationRepository) Get(ctx context.Context, id string) (*domain.Educa
 	query := fmt.Sprintf(
 		`SELECT id, main_school, school_periods, level, created_at, updated_at
 		FROM %s
-		WHERE id = $1`,
+		WHERE id = $1 AND deleted_at IS NULL`,
 		r.educationTable,
 	)
 
@@ -200,9 +214,13 @@ func (r *educationRepository) Get(ctx context.Context, id string) (*domain.Educa
 //   - Scans the returned row, unmarshals JSON columns back into the domain.Education,
 //     and returns the updated object.
 //
+// A non-zero education.UpdatedAt on input is treated as the version the caller
+// read; the UPDATE then only matches a row still carrying that updated_at.
+//
 // Returns:
 // - (*domain.Education, nil) on success with the updated record.
-// - (nil, nil) if no row with the given id was found.
+// - (nil, nil) if no live row with the given id was found.
+// - (nil, *domain.ConflictError) if the row has been updated since the given version.
 // - (nil, error) on validation, marshaling, database, or unmarshaling errors.
 func (r *educationRepository) Update(ctx context.Context, education *domain.Education) (*domain.Education, error) {
 	if education == nil {
@@ -217,6 +235,7 @@ func (r *educationRepository) Update(ctx context.Context, education *domain.Educ
 		return nil, fmt.Errorf("failed to validate education: %w", err)
 	}
 
+	expectedVersion := education.UpdatedAt
 	now := r.timeProvider()
 	education.UpdatedAt = now
 
@@ -235,26 +254,33 @@ func (r *educationRepository) Update(ctx context.Context, education *domain.Educ
 		updatedEducation   domain.Education
 	)
 
+	args := []any{
+		education.Id,
+		mainSchoolJSON,
+		schoolPeriodsJSON,
+		education.Level,
+		education.UpdatedAt,
+	}
+
+	versionCheck := ""
+	if !expectedVersion.IsZero() {
+		args = append(args, expectedVersion)
+		versionCheck = fmt.Sprintf(" AND updated_at=$%d", len(args))
+	}
+
 	query := fmt.Sprintf(
 		`UPDATE %s
 		SET main_school=$2,
 			school_periods=$3,
 			level=$4,
 			updated_at=$5
-		WHERE id=$1
+		WHERE id=$1 AND deleted_at IS NULL%s
 		RETURNING id, main_school, school_periods, level, created_at, updated_at`,
 		r.educationTable,
+		versionCheck,
 	)
 
-	err = r.databaseAPI.QueryRow(
-		ctx,
-		query,
-		education.Id,
-		mainSchoolJSON,
-		schoolPeriodsJSON,
-		education.Level,
-		education.UpdatedAt,
-	).Scan(
+	err = r.databaseAPI.QueryRow(ctx, query, args...).Scan(
 		&updatedEducation.Id,
 		&mainSchoolBytes,
 		&schoolPeriodsBytes,
@@ -265,6 +291,9 @@ func (r *educationRepository) Update(ctx context.Context, education *domain.Educ
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			if !expectedVersion.IsZero() {
+				return nil, versionConflict(ctx, r.databaseAPI, r.educationTable, "education", education.Id)
+			}
 			return nil, nil
 		}
 		return nil, fmt.Errorf("failed to update education: %w", err)
@@ -286,13 +315,13 @@ func (r *educationRepository) Update(ctx context.Context, education *domain.Educ
 	return &updatedEducation, nil
 }
 
-// Delete removes the education record with the given id from the repository.
-// It validates the id is not empty, executes a SQL DELETE against the repository's
-// education table, and returns an error if the deletion fails.
+// Delete moves the education record with the given id to the trash by stamping
+// its deleted_at column. The row is kept until it is purged, so it can still be
+// brought back with Restore.
 //
 // The function returns:
 //   - an error wrapping the underlying database error when the Exec fails,
-//   - pgx.ErrNoRows when no row was deleted (i.e., the id does not exist),
+//   - pgx.ErrNoRows when no live row matched (i.e., the id does not exist or is already trashed),
 //   - an error if the provided id is empty.
 //
 // Parameters:
@@ -304,12 +333,13 @@ func (r *educationRepository) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to delete education: ID missing")
 	}
 
-	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1", r.educationTable)
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=$2 WHERE id=$1 AND deleted_at IS NULL", r.educationTable)
 
 	cmdTag, err := r.databaseAPI.Exec(
 		ctx,
 		query,
 		id,
+		r.timeProvider(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to delete education: %w", err)
@@ -322,7 +352,88 @@ func (r *educationRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-func (r *educationRepository) List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, error) {
+// Restore takes an education record out of the trash by clearing its
+// deleted_at column and stamping updated_at, so the education list reads as
+// modified again. It returns pgx.ErrNoRows if no trashed record with the given
+// id exists.
+func (r *educationRepository) Restore(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to restore education: ID missing")
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=NULL, updated_at=$2 WHERE id=$1 AND deleted_at IS NOT NULL", r.educationTable)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to restore education: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Purge permanently removes a trashed education record. Projects that pointed
+// at it keep existing with their education_id cleared by the foreign key. It
+// returns pgx.ErrNoRows if no trashed record with the given id exists.
+func (r *educationRepository) Purge(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to purge education: ID missing")
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1 AND deleted_at IS NOT NULL", r.educationTable)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to purge education: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Exists reports whether a live education record with the given id exists.
+// It is used to validate references from other entities (for example a
+// project's education_id) before they are written. An empty id returns an error.
+func (r *educationRepository) Exists(ctx context.Context, id string) (bool, error) {
+	if id == "" {
+		return false, fmt.Errorf("failed to check education: ID missing")
+	}
+
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id=$1 AND deleted_at IS NULL)", r.educationTable)
+
+	var exists bool
+	if err := r.databaseAPI.QueryRow(ctx, query, id).Scan(&exists); err != nil {
+		return false, fmt.Errorf("failed to check education: %w", err)
+	}
+
+	return exists, nil
+}
+
+// Version reports the domain.CollectionVersion of the education table, which
+// changes whenever a record is created, updated, trashed, restored or purged.
+func (r *educationRepository) Version(ctx context.Context) (domain.CollectionVersion, error) {
+	version, err := collectionVersion(ctx, r.databaseAPI, r.educationTable, "")
+	if err != nil {
+		return domain.CollectionVersion{}, fmt.Errorf("failed to get educations version: %w", err)
+	}
+
+	return version, nil
+}
+
+// List returns a page of education records ordered by the requested column, together with
+// the domain.PageInfo describing the full result set.
+// Page defaults to 1, PageSize defaults to 20 (and is capped at 20) and SortBy defaults to
+// CreatedAt; only created_at and updated_at are accepted as sort columns. Rows are ordered
+// by the sort column and then by id. When filter.Cursor is set the page starts right after
+// the cursor's (created_at, id) pair instead of using OFFSET, which requires sorting by
+// CreatedAt. Trashed records are skipped; PageInfo.Total is the number of live education records.
+func (r *educationRepository) List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, domain.PageInfo, error) {
 	// Set defaults if not provided
 	if filter.Page <= 0 {
 		filter.Page = 1
@@ -335,20 +446,29 @@ func (r *educationRepository) List(ctx context.Context, filter domain.EducationF
 		filter.SortBy = &defaultSort
 	}
 
-	baseQuery := fmt.Sprintf(
-		`SELECT id, main_school, school_periods, level, created_at, updated_at FROM %s`,
-		r.educationTable,
-	)
-
 	// Validate SortBy against allowed columns
 	allowedSortColumns := map[domain.SortBy]bool{
 		domain.CreatedAt: true,
 		domain.UpdatedAt: true,
 	}
 	if !allowedSortColumns[*filter.SortBy] {
-		return nil, fmt.Errorf("invalid sort column: %s", *filter.SortBy)
+		return nil, domain.PageInfo{}, fmt.Errorf("invalid sort column: %s", *filter.SortBy)
+	}
+	if filter.Cursor != nil && *filter.SortBy != domain.CreatedAt {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list education: cursor requires sorting by %s", domain.CreatedAt)
+	}
+
+	var total int64
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE deleted_at IS NULL", r.educationTable)
+	if err := r.databaseAPI.QueryRow(ctx, countQuery).Scan(&total); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to count education: %w", err)
 	}
 
+	baseQuery := fmt.Sprintf(
+		`SELECT id, main_school, school_periods, level, created_at, updated_at FROM %s WHERE deleted_at IS NULL`,
+		r.educationTable,
+	)
+
 	// Add sorting
 	var sortColumn string
 	switch *filter.SortBy {
@@ -357,23 +477,33 @@ func (r *educationRepository) List(ctx context.Context, filter domain.EducationF
 	case domain.UpdatedAt:
 		sortColumn = "updated_at"
 	default:
-		return nil, fmt.Errorf("invalid sort column: %s", *filter.SortBy)
+		return nil, domain.PageInfo{}, fmt.Errorf("invalid sort column: %s", *filter.SortBy)
 	}
 
 	sortOrder := "ASC"
 	if !filter.SortAscending {
 		sortOrder = "DESC"
 	}
-	baseQuery += fmt.Sprintf(" ORDER BY %s %s", sortColumn, sortOrder)
 
-	// Add pagination
-	offset := (filter.Page - 1) * filter.PageSize
-	baseQuery += " LIMIT $1 OFFSET $2"
+	// Add pagination, fetching one extra row to learn whether another page follows
+	var args []any
+	if filter.Cursor != nil {
+		condition, cursorArgs := cursorCondition(*filter.Cursor, filter.SortAscending, 1)
+		baseQuery += " AND " + condition
+		baseQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", sortColumn, sortOrder, sortOrder)
+		baseQuery += " LIMIT $3"
+		args = append(cursorArgs, filter.PageSize+1)
+	} else {
+		offset := (filter.Page - 1) * filter.PageSize
+		baseQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", sortColumn, sortOrder, sortOrder)
+		baseQuery += " LIMIT $1 OFFSET $2"
+		args = []any{filter.PageSize + 1, offset}
+	}
 
 	// Execute query
-	rows, err := r.databaseAPI.Query(ctx, baseQuery, filter.PageSize, offset)
+	rows, err := r.databaseAPI.Query(ctx, baseQuery, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list education: %w", err)
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list education: %w", err)
 	}
 	defer rows.Close()
 
@@ -394,15 +524,15 @@ func (r *educationRepository) List(ctx context.Context, filter domain.EducationF
 			&ed.UpdatedAt,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan education: %w", err)
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to scan education: %w", err)
 		}
 
 		if err = json.Unmarshal(mainSchoolJSON, &ed.MainSchool); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal main school: %w", err)
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to unmarshal main school: %w", err)
 		}
 		if len(schoolPeriodsJSON) > 0 {
 			if err = json.Unmarshal(schoolPeriodsJSON, &ed.SchoolPeriods); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal school periods: %w", err)
+				return nil, domain.PageInfo{}, fmt.Errorf("failed to unmarshal school periods: %w", err)
 			}
 		}
 
@@ -410,8 +540,20 @@ func (r *educationRepository) List(ctx context.Context, filter domain.EducationF
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
+		return nil, domain.PageInfo{}, fmt.Errorf("row iteration error: %w", err)
 	}
 
-	return education, nil
+	fetched := len(education)
+	if fetched > int(filter.PageSize) {
+		education = education[:filter.PageSize]
+	}
+
+	var last domain.Education
+	if len(education) > 0 {
+		last = education[len(education)-1]
+	}
+
+	pageInfo := newPageInfo(filter.Page, filter.PageSize, total, fetched, sortColumn == "created_at", last.CreatedAt, last.Id)
+
+	return education, pageInfo, nil
 }
@@ -83,12 +83,30 @@ func (r *educationGetFakeRow) Scan(dest ...any) error {
 type educationFakeCommandTag string
 
 func (f educationFakeCommandTag) RowsAffected() int64 {
-	if f == "DELETE 1" {
+	if f == "DELETE 1" || f == "UPDATE 1" {
 		return 1
 	}
 	return 0
 }
 
+type educationExistsFakeRow struct {
+	exists  bool
+	scanErr error
+}
+
+func (r *educationExistsFakeRow) Scan(dest ...any) error {
+	if r.scanErr != nil {
+		return r.scanErr
+	}
+	if len(dest) != 1 {
+		return fmt.Errorf("expected 1 scan destination, got %d", len(dest))
+	}
+	if ptr, ok := dest[0].(*bool); ok {
+		*ptr = r.exists
+	}
+	return nil
+}
+
 type educationFakeRow struct {
 	education domain.Education
 	scanErr   error
@@ -749,11 +767,14 @@ func TestEducationRepository_Update(t *testing.T) {
 					m.EXPECT().
 						QueryRow(
 							mock.Anything,
-							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE id=$1 AND deleted_at IS NULL AND updated_at=$6")
+							}),
 							mock.MatchedBy(func(args []any) bool {
-								return len(args) == 5 &&
+								return len(args) == 6 &&
 									args[0] == fixedID &&
-									bytes.Equal(args[1].([]byte), mainSchoolJSON)
+									bytes.Equal(args[1].([]byte), mainSchoolJSON) &&
+									args[5] == fixedTime
 							}),
 						).
 						Return(&educationGetFakeRow{
@@ -778,6 +799,59 @@ func TestEducationRepository_Update(t *testing.T) {
 				err: nil,
 			},
 		},
+		"Unversioned update skips the version check": {
+			given: Given{
+				education: func() domain.Education {
+					e := validEducation
+					e.UpdatedAt = time.Time{}
+					return e
+				}(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return !strings.Contains(query, "AND updated_at=")
+							}),
+							mock.MatchedBy(func(args []any) bool { return len(args) == 5 }),
+						).
+						Return(&educationGetFakeRow{
+							id:                fixedID,
+							mainSchoolJSON:    mainSchoolJSON,
+							schoolPeriodsJSON: schoolPeriodsJSON,
+							level:             domain.College,
+							createdAt:         fixedTime,
+							updatedAt:         fixedTime,
+						})
+				},
+			},
+			expected: Expected{
+				education: &domain.Education{
+					Id:            fixedID,
+					MainSchool:    validMainSchool,
+					SchoolPeriods: []domain.SchoolPeriod{validMainSchool},
+					Level:         domain.College,
+					CreatedAt:     fixedTime,
+					UpdatedAt:     fixedTime,
+				},
+			},
+		},
+		"Stale version returns a conflict": {
+			given: Given{
+				education: validEducation,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.MatchedBy(isUpdate), mock.Anything).
+						Return(&educationGetFakeRow{scanErr: pgx.ErrNoRows})
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.MatchedBy(isVersionLookup), []any{fixedID}).
+						Return(&versionFakeRow{updatedAt: fixedTime.Add(time.Minute)})
+				},
+			},
+			expected: Expected{
+				err: &domain.ConflictError{Entity: "education", ID: fixedID, Current: fixedTime.Add(time.Minute)},
+			},
+		},
 		"Database returns no rows": {
 			given: Given{
 				education: validEducation,
@@ -1129,6 +1203,7 @@ func TestEducationRepository_Update(t *testing.T) {
 
 func TestEducationRepository_Delete(t *testing.T) {
 	fixedId := "123-abc"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 	dbErr := errors.New("db exec error")
 
 	type Given struct {
@@ -1149,8 +1224,15 @@ func TestEducationRepository_Delete(t *testing.T) {
 				id: fixedId,
 				mockExec: func(m *database.MockDatabaseAPI) {
 					m.EXPECT().
-						Exec(mock.Anything, mock.Anything, mock.Anything).
-						Return(educationFakeCommandTag("DELETE 1"), nil)
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testEducationTable+" SET deleted_at=$2") &&
+									strings.Contains(query, "deleted_at IS NULL")
+							}),
+							[]any{fixedId, fixedTime},
+						).
+						Return(educationFakeCommandTag("UPDATE 1"), nil)
 				},
 			},
 			expected: Expected{err: nil},
@@ -1194,7 +1276,7 @@ func TestEducationRepository_Delete(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			f := newEducationRepositoryTestFixture(t, time.Now)
+			f := newEducationRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
 			if test.given.mockExec != nil {
 				test.given.mockExec(f.databaseAPI)
@@ -1213,6 +1295,282 @@ func TestEducationRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestEducationRepository_Restore(t *testing.T) {
+	fixedId := "123-abc"
+	dbErr := errors.New("db exec error")
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful restore": {
+			given: Given{
+				id: fixedId,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testEducationTable+" SET deleted_at=NULL") &&
+									strings.Contains(query, "deleted_at IS NOT NULL")
+							}),
+							[]any{fixedId, fixedTime},
+						).
+						Return(educationFakeCommandTag("UPDATE 1"), nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during restore": {
+			given: Given{
+				id: fixedId,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to restore education: %w", dbErr),
+			},
+		},
+		"Not in trash": {
+			given: Given{
+				id: fixedId,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(educationFakeCommandTag("UPDATE 0"), nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Restore with empty ID": {
+			given: Given{
+				id:       "",
+				mockExec: nil,
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to restore education: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newEducationRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.educationRepository.Restore(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEducationRepository_Purge(t *testing.T) {
+	fixedId := "123-abc"
+	dbErr := errors.New("db exec error")
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful purge": {
+			given: Given{
+				id: fixedId,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "DELETE FROM "+testEducationTable) &&
+									strings.Contains(query, "deleted_at IS NOT NULL")
+							}),
+							[]any{fixedId},
+						).
+						Return(educationFakeCommandTag("DELETE 1"), nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during purge": {
+			given: Given{
+				id: fixedId,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge education: %w", dbErr),
+			},
+		},
+		"Not in trash": {
+			given: Given{
+				id: fixedId,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(educationFakeCommandTag("DELETE 0"), nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Purge with empty ID": {
+			given: Given{
+				id:       "",
+				mockExec: nil,
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge education: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newEducationRepositoryTestFixture(t, time.Now)
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.educationRepository.Purge(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestEducationRepository_Exists(t *testing.T) {
+	fixedId := "123-abc"
+	dbErr := errors.New("db query error")
+
+	type Given struct {
+		id           string
+		mockQueryRow func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		exists bool
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Education exists": {
+			given: Given{
+				id: fixedId,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool { return strings.Contains(query, "SELECT EXISTS") }),
+							[]any{fixedId},
+						).
+						Return(&educationExistsFakeRow{exists: true})
+				},
+			},
+			expected: Expected{exists: true},
+		},
+		"Education does not exist": {
+			given: Given{
+				id: fixedId,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.Anything, []any{fixedId}).
+						Return(&educationExistsFakeRow{exists: false})
+				},
+			},
+			expected: Expected{exists: false},
+		},
+		"Database error": {
+			given: Given{
+				id: fixedId,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.Anything, []any{fixedId}).
+						Return(&educationExistsFakeRow{scanErr: dbErr})
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to check education: %w", dbErr),
+			},
+		},
+		"Empty ID": {
+			given: Given{
+				id: "",
+			},
+			expected: Expected{
+				err: errors.New("failed to check education: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newEducationRepositoryTestFixture(t, time.Now)
+
+			if test.given.mockQueryRow != nil {
+				test.given.mockQueryRow(f.databaseAPI)
+			}
+
+			exists, err := f.educationRepository.Exists(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.exists, exists)
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
 func TestEducationRepository_List(t *testing.T) {
 	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 	scanErr := errors.New("scan error")
@@ -1238,13 +1596,23 @@ func TestEducationRepository_List(t *testing.T) {
 		UpdatedAt:  fixedTime,
 	}
 
+	secondEducation := validEducation
+	secondEducation.Id = "edu-002"
+
+	countErr := errors.New("count error")
+	cursor := domain.Cursor{CreatedAt: fixedTime, ID: "edu-000"}
+
+	defaultPageInfo := domain.PageInfo{Total: 1, Page: 1, PageSize: 20}
+
 	type Given struct {
 		filter    domain.EducationFilter
+		count     *countFakeRow
 		mockQuery func(m *database.MockDatabaseAPI)
 	}
 
 	type Expected struct {
 		education []domain.Education
+		pageInfo  domain.PageInfo
 		err       error
 	}
 
@@ -1268,6 +1636,7 @@ func TestEducationRepository_List(t *testing.T) {
 			},
 			expected: Expected{
 				education: []domain.Education{validEducation},
+				pageInfo:  defaultPageInfo,
 				err:       nil,
 			},
 		},
@@ -1336,6 +1705,67 @@ func TestEducationRepository_List(t *testing.T) {
 			},
 			expected: Expected{
 				education: []domain.Education{},
+				pageInfo:  defaultPageInfo,
+				err:       nil,
+			},
+		},
+		"Count query fails": {
+			given: Given{
+				filter: domain.EducationFilter{},
+				count:  &countFakeRow{scanErr: countErr},
+			},
+			expected: Expected{
+				education: nil,
+				err:       fmt.Errorf("failed to count education: %w", countErr),
+			},
+		},
+		"Extra row sets has next and cursor": {
+			given: Given{
+				filter: domain.EducationFilter{PageSize: 1},
+				count:  &countFakeRow{count: 2},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &educationFakeRows{
+						rows: []*educationFakeRow{
+							{education: validEducation},
+							{education: secondEducation},
+						},
+					}
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{int32(2), int32(0)}).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				education: []domain.Education{validEducation},
+				pageInfo: domain.PageInfo{
+					Total:      2,
+					Page:       1,
+					PageSize:   1,
+					HasNext:    true,
+					NextCursor: domain.Cursor{CreatedAt: validEducation.CreatedAt, ID: validEducation.Id}.Encode(),
+				},
+				err: nil,
+			},
+		},
+		"Cursor adds keyset condition": {
+			given: Given{
+				filter: domain.EducationFilter{Cursor: &cursor},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE deleted_at IS NULL AND (created_at, id) < ($1, $2)") &&
+									strings.HasSuffix(query, "LIMIT $3")
+							}),
+							[]any{cursor.CreatedAt, cursor.ID, int32(21)},
+						).
+						Return(&educationFakeRows{rows: []*educationFakeRow{{education: validEducation}}}, nil)
+				},
+			},
+			expected: Expected{
+				education: []domain.Education{validEducation},
+				pageInfo:  defaultPageInfo,
 				err:       nil,
 			},
 		},
@@ -1345,11 +1775,17 @@ func TestEducationRepository_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			f := newEducationRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
+			count := test.given.count
+			if count == nil {
+				count = &countFakeRow{count: 1}
+			}
+			expectCount(f.databaseAPI, count)
+
 			if test.given.mockQuery != nil {
 				test.given.mockQuery(f.databaseAPI)
 			}
 
-			education, err := f.educationRepository.List(context.Background(), test.given.filter)
+			education, pageInfo, err := f.educationRepository.List(context.Background(), test.given.filter)
 
 			if test.expected.err != nil {
 				assert.EqualError(t, err, test.expected.err.Error())
@@ -1358,6 +1794,7 @@ func TestEducationRepository_List(t *testing.T) {
 				assert.NoError(t, err)
 				assert.NotNil(t, education)
 				assert.Equal(t, test.expected.education, education)
+				assert.Equal(t, test.expected.pageInfo, pageInfo)
 			}
 
 			f.databaseAPI.AssertExpectations(t)
@@ -1,108 +1,97 @@
 package v1
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
-	"io"
-	"log"
-	"maps"
-	"net/http"
+	"net/mail"
 	"time"
 
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/templates"
 )
 
 type EmailRepository interface {
-	Send(send domain.SendEmail) error
+	Send(ctx context.Context, template domain.EmailTemplate, send domain.SendEmail) error
 }
 
 type EmailRepositoryConfig struct {
-	ServiceID      string            `json:"service_id"`
-	TemplateID     string            `json:"template_id"`
-	UserID         string            `json:"user_id"`
-	AccessToken    string            `json:"accessToken,omitempty"`
-	TemplateParams map[string]string `json:"template_params"`
-
-	httpAPI client.HttpAPI
+	// Sender delivers the emails through the configured provider.
+	Sender client.EmailSender
+	// Templates renders the subject and bodies of the emails.
+	Templates templates.EmailRenderer
+	// To is the address owner notifications are delivered to and auto
+	// replies are answered at. Providers with their own templates (EmailJS)
+	// may address their emails from the to_email parameter instead.
+	To string
+
+	timeProvider domain.TimeProvider
 }
 
 type emailRepository struct {
-	payload EmailRepositoryConfig
-	httpAPI client.HttpAPI
+	sender       client.EmailSender
+	templates    templates.EmailRenderer
+	to           string
+	timeProvider domain.TimeProvider
 }
 
 // NewEmailRepository creates and returns a new instance of EmailRepository using the provided configuration.
 // It initializes the repository with the specified EmailRepositoryConfig.
+// If cfg.timeProvider is nil the repository defaults to time.Now.
 func NewEmailRepository(cfg EmailRepositoryConfig) EmailRepository {
-	payload := EmailRepositoryConfig{
-		ServiceID:      cfg.ServiceID,
-		TemplateID:     cfg.TemplateID,
-		UserID:         cfg.UserID,
-		AccessToken:    cfg.AccessToken,
-		TemplateParams: cfg.TemplateParams,
-	}
-
-	if payload.TemplateParams == nil {
-		payload.TemplateParams = make(map[string]string)
-	}
-
-	httpAPI := cfg.httpAPI
-	if httpAPI == nil {
-		httpAPI = client.NewHTTPAPI(30 * time.Second)
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
 	}
 
 	return &emailRepository{
-		payload: payload,
-		httpAPI: httpAPI,
+		sender:       cfg.Sender,
+		templates:    cfg.Templates,
+		to:           cfg.To,
+		timeProvider: timeProvider,
 	}
 }
 
-// Send sends an email using the EmailJS API with the provided name, email, subject, and message.
-// It updates the template parameters with the given values, marshals the payload to JSON,
-// and performs an HTTP POST request to the EmailJS endpoint.
-// Returns an error if the request fails or if the response status is not OK.
-func (r *emailRepository) Send(send domain.SendEmail) error {
+// Send renders the email template for the contact form message send and delivers it
+// with multipart text and HTML bodies. The owner notification goes to the configured
+// recipient with replies going to the sender; the auto reply goes to the sender with
+// replies going to the configured recipient.
+// The message fields, the recipients and the rendered email are also passed as template
+// parameters for providers rendering their own templates.
+// Returns an error if the email is invalid, cannot be rendered or the provider fails to send it.
+func (r *emailRepository) Send(ctx context.Context, template domain.EmailTemplate, send domain.SendEmail) error {
 	if err := send.Validate(); err != nil {
 		return fmt.Errorf("failed to validate send: %w", err)
 	}
 
-	log.Printf("Sending email via EmailJS")
-
-	params := make(map[string]string, len(r.payload.TemplateParams)+4)
-	maps.Copy(params, r.payload.TemplateParams)
-	params["name"] = send.Name
-	params["email"] = send.Email
-	params["subject"] = send.Subject
-	params["message"] = send.Message
-
-	payload := r.payload
-	payload.TemplateParams = params
-
-	body, err := json.Marshal(payload)
-	if err != nil {
-		return fmt.Errorf("failed to marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequest("POST", "https://api.emailjs.com/api/v1.0/email/send", bytes.NewBuffer(body))
+	rendered, err := r.templates.Render(template, domain.NewEmailTemplateData(send, r.timeProvider()))
 	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
+		return fmt.Errorf("failed to render email: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := r.httpAPI.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send HTTP request: %w", err)
-	}
-	defer resp.Body.Close()
+	sender := (&mail.Address{Name: send.Name, Address: send.Email}).String()
 
-	if resp.StatusCode != http.StatusOK {
-		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("failed to send: [status=%s,message=%s]", resp.Status, string(respBody))
+	to, replyTo := r.to, sender
+	if template == domain.EmailAutoReply {
+		to, replyTo = sender, r.to
 	}
 
-	log.Printf("Email sent successfully via EmailJS")
-
-	return nil
+	return r.sender.Send(ctx, client.Email{
+		To:      to,
+		ReplyTo: replyTo,
+		Subject: rendered.Subject,
+		Text:    rendered.Text,
+		HTML:    rendered.HTML,
+		Params: map[string]string{
+			"name":          send.Name,
+			"email":         send.Email,
+			"subject":       send.Subject,
+			"message":       send.Message,
+			"template":      string(template),
+			"to_email":      to,
+			"reply_to":      replyTo,
+			"email_subject": rendered.Subject,
+			"html":          rendered.HTML,
+		},
+	})
 }
@@ -1,47 +1,50 @@
 package v1
 
 import (
-	"bytes"
+	"context"
 	"errors"
-	"fmt"
-	"io"
-	"net/http"
 	"testing"
+	"time"
 
-	client "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
+	clientMocks "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/templates"
+	templatesMocks "github.com/fingertips18/fingertips18.github.io/backend/internal/templates/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
 
 type emailRepositoryTestFixture struct {
-	t               *testing.T
-	mockHttpAPI     *client.MockHttpAPI
-	emailRepository emailRepository
+	t                 *testing.T
+	mockEmailSender   *clientMocks.MockEmailSender
+	mockEmailRenderer *templatesMocks.MockEmailRenderer
+	emailRepository   EmailRepository
 }
 
-func newEmailRepositoryTestFixture(t *testing.T) *emailRepositoryTestFixture {
-	mockHttpAPI := new(client.MockHttpAPI)
-	emailRepository := &emailRepository{
-		payload: EmailRepositoryConfig{
-			ServiceID:      "service_xxx",
-			TemplateID:     "template_xxx",
-			UserID:         "user_xxx",
-			TemplateParams: make(map[string]string),
-		},
-		httpAPI: mockHttpAPI,
-	}
+func newEmailRepositoryTestFixture(t *testing.T, timeProvider domain.TimeProvider) *emailRepositoryTestFixture {
+	mockEmailSender := new(clientMocks.MockEmailSender)
+	mockEmailRenderer := new(templatesMocks.MockEmailRenderer)
 
 	return &emailRepositoryTestFixture{
-		t:               t,
-		mockHttpAPI:     mockHttpAPI,
-		emailRepository: *emailRepository,
+		t:                 t,
+		mockEmailSender:   mockEmailSender,
+		mockEmailRenderer: mockEmailRenderer,
+		emailRepository: NewEmailRepository(
+			EmailRepositoryConfig{
+				Sender:       mockEmailSender,
+				Templates:    mockEmailRenderer,
+				To:           "owner@example.com",
+				timeProvider: timeProvider,
+			},
+		),
 	}
 }
 
 func TestEmailRepository_Send(t *testing.T) {
-	httpErr := errors.New("http error")
-	serverErr := errors.New("server error")
+	fixedTime := time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC)
+	senderErr := errors.New("failed to send: [status=500 Internal Server Error,message=server error]")
+	renderErr := errors.New(`unknown email template "newsletter"`)
 	missingNameErr := errors.New("failed to validate send: name missing")
 	missingEmailErr := errors.New("failed to validate send: email missing")
 	missingSubjectErr := errors.New("failed to validate send: subject missing")
@@ -53,9 +56,46 @@ func TestEmailRepository_Send(t *testing.T) {
 		Message: "Test message...",
 	}
 
+	data := domain.EmailTemplateData{
+		Name:    "Test User",
+		Email:   "test@user.com",
+		Subject: "Test subject",
+		Message: "Test message...",
+		Date:    fixedTime,
+	}
+
+	rendered := templates.Email{
+		Subject: "Rendered subject",
+		Text:    "Rendered text",
+		HTML:    "<p>Rendered HTML</p>",
+	}
+
+	emailOf := func(template domain.EmailTemplate, to, replyTo string) client.Email {
+		return client.Email{
+			To:      to,
+			ReplyTo: replyTo,
+			Subject: "Rendered subject",
+			Text:    "Rendered text",
+			HTML:    "<p>Rendered HTML</p>",
+			Params: map[string]string{
+				"name":          "Test User",
+				"email":         "test@user.com",
+				"subject":       "Test subject",
+				"message":       "Test message...",
+				"template":      string(template),
+				"to_email":      to,
+				"reply_to":      replyTo,
+				"email_subject": "Rendered subject",
+				"html":          "<p>Rendered HTML</p>",
+			},
+		}
+	}
+
 	type Given struct {
-		payload  domain.SendEmail
-		mockSend func(m *client.MockHttpAPI)
+		template   domain.EmailTemplate
+		payload    domain.SendEmail
+		mockRender func(m *templatesMocks.MockEmailRenderer)
+		mockSend   func(m *clientMocks.MockEmailSender)
 	}
 
 	type Expected struct {
@@ -66,60 +106,70 @@ func TestEmailRepository_Send(t *testing.T) {
 		given    Given
 		expected Expected
 	}{
-		"Successful send": {
+		"Owner notification goes to the owner": {
 			given: Given{
-				payload: payload,
-				mockSend: func(m *client.MockHttpAPI) {
-					m.EXPECT().Do(mock.AnythingOfType("*http.Request")).
-						Return(&http.Response{
-							StatusCode: 200,
-							Body:       io.NopCloser(bytes.NewBufferString(`ok`)),
-						}, nil)
+				template: domain.EmailOwnerNotification,
+				payload:  payload,
+				mockRender: func(m *templatesMocks.MockEmailRenderer) {
+					m.EXPECT().Render(domain.EmailOwnerNotification, data).Return(rendered, nil)
+				},
+				mockSend: func(m *clientMocks.MockEmailSender) {
+					m.EXPECT().
+						Send(mock.Anything, emailOf(domain.EmailOwnerNotification, "owner@example.com", `"Test User" <test@user.com>`)).
+						Return(nil)
 				},
 			},
-			expected: Expected{
-				err: nil,
+		},
+		"Auto reply goes to the sender": {
+			given: Given{
+				template: domain.EmailAutoReply,
+				payload:  payload,
+				mockRender: func(m *templatesMocks.MockEmailRenderer) {
+					m.EXPECT().Render(domain.EmailAutoReply, data).Return(rendered, nil)
+				},
+				mockSend: func(m *clientMocks.MockEmailSender) {
+					m.EXPECT().
+						Send(mock.Anything, emailOf(domain.EmailAutoReply, `"Test User" <test@user.com>`, "owner@example.com")).
+						Return(nil)
+				},
 			},
 		},
-		"HTTP client error": {
+		"Sender error": {
 			given: Given{
-				payload: payload,
-				mockSend: func(m *client.MockHttpAPI) {
-					m.EXPECT().Do(mock.AnythingOfType("*http.Request")).
-						Return(&http.Response{
-							StatusCode: 500,
-						}, httpErr)
+				template: domain.EmailOwnerNotification,
+				payload:  payload,
+				mockRender: func(m *templatesMocks.MockEmailRenderer) {
+					m.EXPECT().Render(mock.Anything, mock.Anything).Return(rendered, nil)
+				},
+				mockSend: func(m *clientMocks.MockEmailSender) {
+					m.EXPECT().Send(mock.Anything, mock.Anything).Return(senderErr)
 				},
 			},
 			expected: Expected{
-				err: fmt.Errorf("failed to send HTTP request: %w", httpErr),
+				err: senderErr,
 			},
 		},
-		"Non-200 response": {
+		"Render error": {
 			given: Given{
-				payload: payload,
-				mockSend: func(m *client.MockHttpAPI) {
-					m.EXPECT().Do(mock.AnythingOfType("*http.Request")).
-						Return(&http.Response{
-							StatusCode: 500,
-							Status:     "500 Internal Server Error",
-							Body:       io.NopCloser(bytes.NewBufferString(serverErr.Error())),
-						}, nil)
+				template: "newsletter",
+				payload:  payload,
+				mockRender: func(m *templatesMocks.MockEmailRenderer) {
+					m.EXPECT().Render(domain.EmailTemplate("newsletter"), data).Return(templates.Email{}, renderErr)
 				},
 			},
 			expected: Expected{
-				err: fmt.Errorf("failed to send: [status=500 Internal Server Error,message=%s]", serverErr),
+				err: errors.New(`failed to render email: unknown email template "newsletter"`),
 			},
 		},
 		"Missing name": {
 			given: Given{
+				template: domain.EmailOwnerNotification,
 				payload: domain.SendEmail{
 					Name:    "",
 					Email:   payload.Email,
 					Subject: payload.Subject,
 					Message: payload.Message,
 				},
-				mockSend: nil,
 			},
 			expected: Expected{
 				err: missingNameErr,
@@ -127,13 +177,13 @@ func TestEmailRepository_Send(t *testing.T) {
 		},
 		"Missing email": {
 			given: Given{
+				template: domain.EmailOwnerNotification,
 				payload: domain.SendEmail{
 					Name:    payload.Name,
 					Email:   "",
 					Subject: payload.Subject,
 					Message: payload.Message,
 				},
-				mockSend: nil,
 			},
 			expected: Expected{
 				err: missingEmailErr,
@@ -141,49 +191,32 @@ func TestEmailRepository_Send(t *testing.T) {
 		},
 		"Missing subject": {
 			given: Given{
+				template: domain.EmailOwnerNotification,
 				payload: domain.SendEmail{
 					Name:    payload.Name,
 					Email:   payload.Email,
 					Subject: "",
 					Message: payload.Message,
 				},
-				mockSend: nil,
 			},
 			expected: Expected{
 				err: missingSubjectErr,
 			},
 		},
-		"Missing message": {
-			given: Given{
-				payload: domain.SendEmail{
-					Name:    payload.Name,
-					Email:   payload.Email,
-					Subject: payload.Subject,
-					Message: "",
-				},
-				mockSend: func(m *client.MockHttpAPI) {
-					m.EXPECT().Do(mock.AnythingOfType("*http.Request")).
-						Return(&http.Response{
-							StatusCode: 200,
-							Body:       io.NopCloser(bytes.NewBufferString(`ok`)),
-						}, nil)
-				},
-			},
-			expected: Expected{
-				err: nil,
-			},
-		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			f := newEmailRepositoryTestFixture(t)
+			f := newEmailRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
+			if test.given.mockRender != nil {
+				test.given.mockRender(f.mockEmailRenderer)
+			}
 			if test.given.mockSend != nil {
-				test.given.mockSend(f.mockHttpAPI)
+				test.given.mockSend(f.mockEmailSender)
 			}
 
-			err := f.emailRepository.Send(test.given.payload)
+			err := f.emailRepository.Send(context.Background(), test.given.template, test.given.payload)
 
 			if test.expected.err != nil {
 				assert.EqualError(t, err, test.expected.err.Error())
@@ -191,7 +224,8 @@ func TestEmailRepository_Send(t *testing.T) {
 				assert.NoError(t, err)
 			}
 
-			f.mockHttpAPI.AssertExpectations(t)
+			f.mockEmailRenderer.AssertExpectations(t)
+			f.mockEmailSender.AssertExpectations(t)
 		})
 	}
 }
@@ -0,0 +1,590 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type ExperienceRepository interface {
+	Create(ctx context.Context, experience *domain.Experience) (string, error)
+	Get(ctx context.Context, id string) (*domain.Experience, error)
+	Update(ctx context.Context, experience *domain.Experience) (*domain.Experience, error)
+	Delete(ctx context.Context, id string) error
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+	List(ctx context.Context, filter domain.ExperienceFilter) ([]domain.Experience, domain.PageInfo, error)
+	ListBySkillID(ctx context.Context, skillID string) ([]domain.Experience, error)
+	SetSkills(ctx context.Context, experienceID string, skillIDs []string) error
+	WithTx(tx database.Tx) ExperienceRepository
+}
+
+type ExperienceRepositoryConfig struct {
+	DatabaseAPI          database.DatabaseAPI
+	ExperienceTable      string
+	ExperienceSkillTable string
+
+	timeProvider domain.TimeProvider
+}
+
+type experienceRepository struct {
+	experienceTable      string
+	experienceSkillTable string
+	databaseAPI          database.Querier
+	timeProvider         domain.TimeProvider
+}
+
+// NewExperienceRepository creates and returns a configured ExperienceRepository.
+//
+// It accepts an ExperienceRepositoryConfig and constructs an internal
+// experienceRepository backed by cfg.ExperienceTable and cfg.DatabaseAPI,
+// linking skills through cfg.ExperienceSkillTable.
+// If cfg.timeProvider is nil, the repository defaults to using time.Now
+// as the time provider. The returned value implements the
+// ExperienceRepository interface and is never nil.
+func NewExperienceRepository(cfg ExperienceRepositoryConfig) ExperienceRepository {
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &experienceRepository{
+		experienceTable:      cfg.ExperienceTable,
+		experienceSkillTable: cfg.ExperienceSkillTable,
+		databaseAPI:          cfg.DatabaseAPI,
+		timeProvider:         timeProvider,
+	}
+}
+
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool, so several repository calls can be
+// committed or rolled back together.
+func (r *experienceRepository) WithTx(tx database.Tx) ExperienceRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
+// Create inserts a new experience row into the configured experience table and returns its ID.
+//
+// The method performs the following steps:
+//  1. Validates the provided Experience payload via experience.ValidatePayload().
+//  2. Sets created_at and updated_at timestamps using the configured timeProvider.
+//  3. Executes the INSERT and captures the RETURNING id clause.
+//  4. Validates the returned ID to ensure it is non-empty.
+//
+// A nil EndDate is stored as NULL, marking the position as current. The
+// company logo is not written here; it is a separate file record.
+func (r *experienceRepository) Create(ctx context.Context, experience *domain.Experience) (string, error) {
+	if experience == nil {
+		return "", errors.New("failed to validate experience: payload is nil")
+	}
+
+	if err := experience.ValidatePayload(); err != nil {
+		return "", fmt.Errorf("failed to validate experience: %w", err)
+	}
+
+	id := utils.GenerateKey()
+	now := r.timeProvider()
+
+	experience.CreatedAt = now
+	experience.UpdatedAt = now
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, position, company, link, setup, start_date, end_date, highlights, skills, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`,
+		r.experienceTable,
+	)
+
+	var returnedID string
+	err := r.databaseAPI.QueryRow(
+		ctx,
+		query,
+		id,
+		experience.Position,
+		experience.Company,
+		experience.Link,
+		experience.Setup,
+		experience.StartDate,
+		experience.EndDate,
+		nonNilStrings(experience.Highlights),
+		nonNilStrings(experience.Skills),
+		experience.CreatedAt,
+		experience.UpdatedAt,
+	).Scan(&returnedID)
+
+	if err != nil {
+		return "", fmt.Errorf("failed to create experience: %w", err)
+	}
+
+	if returnedID == "" {
+		return "", errors.New("invalid experience returned: ID missing")
+	}
+
+	return returnedID, nil
+}
+
+// Get retrieves the Experience with the given id from the repository.
+// If the provided id is empty, Get returns an error indicating a missing ID.
+// If no live row matches the id, the underlying pgx.ErrNoRows is wrapped and
+// returned; trashed experiences are not found.
+// After scanning the database row, the returned experience is validated via
+// experience.ValidateResponse(); an error is returned if validation fails.
+func (r *experienceRepository) Get(ctx context.Context, id string) (*domain.Experience, error) {
+	if id == "" {
+		return nil, fmt.Errorf("failed to get experience: ID missing")
+	}
+
+	var experience domain.Experience
+
+	query := fmt.Sprintf(
+		`SELECT id, position, company, link, setup, start_date, end_date, highlights, skills, created_at, updated_at
+		FROM %s
+		WHERE id = $1 AND deleted_at IS NULL`,
+		r.experienceTable,
+	)
+
+	err := r.databaseAPI.QueryRow(
+		ctx,
+		query,
+		id,
+	).Scan(
+		&experience.Id,
+		&experience.Position,
+		&experience.Company,
+		&experience.Link,
+		&experience.Setup,
+		&experience.StartDate,
+		&experience.EndDate,
+		&experience.Highlights,
+		&experience.Skills,
+		&experience.CreatedAt,
+		&experience.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to get experience: %w", err)
+		}
+		return nil, fmt.Errorf("failed to scan experience: %w", err)
+	}
+
+	if err := experience.ValidateResponse(); err != nil {
+		return nil, fmt.Errorf("invalid experience returned: %w", err)
+	}
+
+	return &experience, nil
+}
+
+// Update validates and persists changes to an existing Experience record.
+// It sets UpdatedAt from the repository's time provider, rewrites every
+// column except created_at and returns the updated row.
+//
+// Returns:
+//   - (*domain.Experience, nil) on success with the updated record.
+//   - (nil, nil) if no live row with the given id was found.
+//   - (nil, error) on validation or database errors.
+func (r *experienceRepository) Update(ctx context.Context, experience *domain.Experience) (*domain.Experience, error) {
+	if experience == nil {
+		return nil, errors.New("failed to validate experience: payload is nil")
+	}
+	if experience.Id == "" {
+		return nil, fmt.Errorf("failed to update experience: ID missing")
+	}
+
+	if err := experience.ValidatePayload(); err != nil {
+		return nil, fmt.Errorf("failed to validate experience: %w", err)
+	}
+
+	updatedAt := r.timeProvider()
+
+	var updatedExperience domain.Experience
+
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET position=$2,
+			company=$3,
+			link=$4,
+			setup=$5,
+			start_date=$6,
+			end_date=$7,
+			highlights=$8,
+			skills=$9,
+			updated_at=$10
+		WHERE id=$1 AND deleted_at IS NULL
+		RETURNING id, position, company, link, setup, start_date, end_date, highlights, skills, created_at, updated_at`,
+		r.experienceTable,
+	)
+
+	err := r.databaseAPI.QueryRow(
+		ctx,
+		query,
+		experience.Id,
+		experience.Position,
+		experience.Company,
+		experience.Link,
+		experience.Setup,
+		experience.StartDate,
+		experience.EndDate,
+		nonNilStrings(experience.Highlights),
+		nonNilStrings(experience.Skills),
+		updatedAt,
+	).Scan(
+		&updatedExperience.Id,
+		&updatedExperience.Position,
+		&updatedExperience.Company,
+		&updatedExperience.Link,
+		&updatedExperience.Setup,
+		&updatedExperience.StartDate,
+		&updatedExperience.EndDate,
+		&updatedExperience.Highlights,
+		&updatedExperience.Skills,
+		&updatedExperience.CreatedAt,
+		&updatedExperience.UpdatedAt,
+	)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update experience: %w", err)
+	}
+
+	if err := updatedExperience.ValidateResponse(); err != nil {
+		return nil, fmt.Errorf("invalid experience returned: %w", err)
+	}
+
+	return &updatedExperience, nil
+}
+
+// Delete moves the experience with the given id to the trash by stamping its
+// deleted_at column; the row stays in the database until it is purged and can be
+// brought back with Restore. It returns an error if id is empty, wraps any
+// execution error, and returns pgx.ErrNoRows when no live row matched. The
+// company logo file is not trashed here; callers trash it in the same transaction.
+func (r *experienceRepository) Delete(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to delete experience: ID missing")
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=$2 WHERE id=$1 AND deleted_at IS NULL", r.experienceTable)
+
+	cmdTag, err := r.databaseAPI.Exec(
+		ctx,
+		query,
+		id,
+		r.timeProvider(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete experience: %w", err)
+	}
+	if cmdTag == nil {
+		return fmt.Errorf("failed to delete experience: nil command tag")
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Restore takes an experience out of the trash by clearing its deleted_at
+// column. updated_at is stamped as well, so the experience list reads as
+// modified again. It returns pgx.ErrNoRows if no trashed experience with the
+// given ID exists.
+func (r *experienceRepository) Restore(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to restore experience: ID missing")
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=NULL, updated_at=$2 WHERE id=$1 AND deleted_at IS NOT NULL", r.experienceTable)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to restore experience: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Purge permanently removes a trashed experience; its skill links go with it
+// through the foreign key. Live experiences are left alone, so an experience
+// has to go through Delete first. It returns pgx.ErrNoRows if no trashed
+// experience with the given ID exists.
+func (r *experienceRepository) Purge(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to purge experience: ID missing")
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1 AND deleted_at IS NOT NULL", r.experienceTable)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to purge experience: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// List retrieves a page of domain.Experience from the repository using the provided filter,
+// together with the domain.PageInfo describing the full result set.
+//
+// Behavior and defaults:
+//   - If filter.Page <= 0 it defaults to 1.
+//   - If filter.PageSize <= 0 or > 20 it defaults to 20 (maximum page size is 20).
+//   - If filter.SortBy is nil it defaults to domain.CreatedAt; invalid values fall back to created_at.
+//   - Sort direction is ascending by default; set filter.SortAscending = false for DESC.
+//   - If filter.Setup is non-nil, results are filtered by the given work setup.
+//   - If filter.Cursor is non-nil, Page is ignored and the page starts right after the cursor's
+//     (created_at, id) pair (keyset pagination). A cursor requires sorting by domain.CreatedAt.
+//
+// Trashed experiences are skipped. A COUNT(*) query with the same setup filter produces
+// PageInfo.Total, and LIMIT PageSize+1 is applied so PageInfo.HasNext can be derived
+// without a second query.
+//
+// Returns:
+//   - ([]domain.Experience, domain.PageInfo, nil) on success.
+//   - (nil, domain.PageInfo{}, error) on count, query, scan, or row iteration failures.
+func (r *experienceRepository) List(ctx context.Context, filter domain.ExperienceFilter) ([]domain.Experience, domain.PageInfo, error) {
+	// Set defaults if not provided
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 20 {
+		filter.PageSize = 20
+	}
+	if filter.SortBy == nil {
+		defaultSort := domain.CreatedAt
+		filter.SortBy = &defaultSort
+	}
+	if filter.Cursor != nil && *filter.SortBy != domain.CreatedAt {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list experiences: cursor requires sorting by %s", domain.CreatedAt)
+	}
+
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+	argIdx := 1
+
+	// Add optional setup filter
+	if filter.Setup != nil {
+		if *filter.Setup == "" {
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to list experiences: invalid empty setup")
+		}
+		conditions = append(conditions, fmt.Sprintf("setup = $%d", argIdx))
+		args = append(args, *filter.Setup)
+		argIdx++
+	}
+
+	// Count every matching row before the cursor narrows the window
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", r.experienceTable, strings.Join(conditions, " AND "))
+
+	var total int64
+	if err := r.databaseAPI.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to count experiences: %w", err)
+	}
+
+	// Add optional keyset condition
+	if filter.Cursor != nil {
+		condition, cursorArgs := cursorCondition(*filter.Cursor, filter.SortAscending, argIdx)
+		conditions = append(conditions, condition)
+		args = append(args, cursorArgs...)
+		argIdx += len(cursorArgs)
+	}
+
+	baseQuery := fmt.Sprintf(
+		`SELECT id, position, company, link, setup, start_date, end_date, highlights, skills, created_at, updated_at FROM %s WHERE %s`,
+		r.experienceTable,
+		strings.Join(conditions, " AND "),
+	)
+
+	// Add sorting with allowlist
+	sortOrder := "ASC"
+	if !filter.SortAscending {
+		sortOrder = "DESC"
+	}
+	var orderCol string
+	switch *filter.SortBy {
+	case domain.CreatedAt:
+		orderCol = "created_at"
+	case domain.UpdatedAt:
+		orderCol = "updated_at"
+	default:
+		// fallback to a safe default to avoid invalid column names
+		orderCol = "created_at"
+	}
+	baseQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", orderCol, sortOrder, sortOrder)
+
+	// Add pagination, fetching one extra row to learn whether another page follows
+	if filter.Cursor != nil {
+		baseQuery += fmt.Sprintf(" LIMIT $%d", argIdx)
+		args = append(args, filter.PageSize+1)
+	} else {
+		offset := (filter.Page - 1) * filter.PageSize
+		baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+		args = append(args, filter.PageSize+1, offset)
+	}
+
+	// Execute query
+	rows, err := r.databaseAPI.Query(ctx, baseQuery, args...)
+	if err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list experiences: %w", err)
+	}
+	defer rows.Close()
+
+	experiences := []domain.Experience{}
+	for rows.Next() {
+		var experience domain.Experience
+
+		err := rows.Scan(
+			&experience.Id,
+			&experience.Position,
+			&experience.Company,
+			&experience.Link,
+			&experience.Setup,
+			&experience.StartDate,
+			&experience.EndDate,
+			&experience.Highlights,
+			&experience.Skills,
+			&experience.CreatedAt,
+			&experience.UpdatedAt,
+		)
+		if err != nil {
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to scan experience: %w", err)
+		}
+
+		if err := experience.ValidateResponse(); err != nil {
+			return nil, domain.PageInfo{}, fmt.Errorf("invalid experience returned: %w", err)
+		}
+
+		experiences = append(experiences, experience)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	fetched := len(experiences)
+	if fetched > int(filter.PageSize) {
+		experiences = experiences[:filter.PageSize]
+	}
+
+	var last domain.Experience
+	if len(experiences) > 0 {
+		last = experiences[len(experiences)-1]
+	}
+
+	pageInfo := newPageInfo(filter.Page, filter.PageSize, total, fetched, orderCol == "created_at", last.CreatedAt, last.Id)
+
+	return experiences, pageInfo, nil
+}
+
+// ListBySkillID returns the live experiences linked to the given skill through
+// the experience-skill join table, most recent start first. An empty id returns an
+// error; a skill without links yields an empty slice.
+func (r *experienceRepository) ListBySkillID(ctx context.Context, skillID string) ([]domain.Experience, error) {
+	if skillID == "" {
+		return nil, errors.New("failed to list experiences by skill: ID missing")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT e.id, e.position, e.company, e.link, e.setup, e.start_date, e.end_date, e.highlights, e.skills, e.created_at, e.updated_at
+		FROM %s e
+		JOIN %s es ON es.experience_id = e.id
+		WHERE es.skill_id = $1 AND e.deleted_at IS NULL
+		ORDER BY e.start_date DESC, e.id DESC
+	`, r.experienceTable, r.experienceSkillTable)
+
+	rows, err := r.databaseAPI.Query(ctx, query, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query experiences by skill: %w", err)
+	}
+	defer rows.Close()
+
+	experiences := []domain.Experience{}
+	for rows.Next() {
+		var experience domain.Experience
+
+		err := rows.Scan(
+			&experience.Id,
+			&experience.Position,
+			&experience.Company,
+			&experience.Link,
+			&experience.Setup,
+			&experience.StartDate,
+			&experience.EndDate,
+			&experience.Highlights,
+			&experience.Skills,
+			&experience.CreatedAt,
+			&experience.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan experience: %w", err)
+		}
+
+		experiences = append(experiences, experience)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return experiences, nil
+}
+
+// SetSkills replaces the skills linked to an experience with skillIDs. Existing
+// links are removed first, so an empty skillIDs detaches every skill. Duplicate
+// IDs are ignored. Callers should run it in the same transaction as the
+// experience write (see WithTx) and are expected to have checked that every
+// skill exists.
+func (r *experienceRepository) SetSkills(ctx context.Context, experienceID string, skillIDs []string) error {
+	if experienceID == "" {
+		return errors.New("failed to set experience skills: experience ID missing")
+	}
+
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE experience_id = $1", r.experienceSkillTable)
+	if _, err := r.databaseAPI.Exec(ctx, deleteQuery, experienceID); err != nil {
+		return fmt.Errorf("failed to clear experience skills: %w", err)
+	}
+
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO %s (experience_id, skill_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING
+	`, r.experienceSkillTable)
+	if _, err := r.databaseAPI.Exec(ctx, insertQuery, experienceID, skillIDs); err != nil {
+		return fmt.Errorf("failed to link experience skills: %w", err)
+	}
+
+	return nil
+}
+
+// nonNilStrings returns s, or an empty slice when s is nil, so a TEXT[]
+// NOT NULL column receives '{}' instead of NULL.
+func nonNilStrings(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
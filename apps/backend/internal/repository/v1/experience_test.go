@@ -0,0 +1,1278 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testExperienceTable      = "test-experiences"
+	testExperienceSkillTable = "test-experience-skills"
+)
+
+// experienceFakeRow for Create, Get, and Update paths
+type experienceFakeRow struct {
+	id         string
+	experience *domain.Experience
+	scanErr    error
+}
+
+func (f *experienceFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+
+	switch len(dest) {
+	case 1: // Create() only returns ID
+		*dest[0].(*string) = f.id
+		return nil
+
+	case 11: // Get(), Update() and List() read the full object
+		if f.experience == nil {
+			return fmt.Errorf("experience not provided for scan")
+		}
+		*dest[0].(*string) = f.experience.Id
+		*dest[1].(*string) = f.experience.Position
+		*dest[2].(*string) = f.experience.Company
+		*dest[3].(*string) = f.experience.Link
+		*dest[4].(*domain.WorkSetup) = f.experience.Setup
+		*dest[5].(*time.Time) = f.experience.StartDate
+		*dest[6].(**time.Time) = f.experience.EndDate
+		*dest[7].(*[]string) = f.experience.Highlights
+		*dest[8].(*[]string) = f.experience.Skills
+		*dest[9].(*time.Time) = f.experience.CreatedAt
+		*dest[10].(*time.Time) = f.experience.UpdatedAt
+		return nil
+
+	default:
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+}
+
+// experienceFakeCommandTag satisfies the CommandTag interface for Exec mocking
+type experienceFakeCommandTag struct {
+	rows int64
+}
+
+func (f *experienceFakeCommandTag) RowsAffected() int64 {
+	return f.rows
+}
+
+type experienceFakeRows struct {
+	rows   []*experienceFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *experienceFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *experienceFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *experienceFakeRows) Err() error { return r.rowErr }
+
+func (r *experienceFakeRows) Close() {}
+
+type experienceRepositoryTestFixture struct {
+	t                    *testing.T
+	databaseAPI          *database.MockDatabaseAPI
+	experienceRepository *experienceRepository
+}
+
+func newExperienceRepositoryTestFixture(t *testing.T, timeProvider func() time.Time) *experienceRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+	experienceRepository := &experienceRepository{
+		databaseAPI:          mockDatabaseAPI,
+		timeProvider:         timeProvider,
+		experienceTable:      testExperienceTable,
+		experienceSkillTable: testExperienceSkillTable,
+	}
+
+	return &experienceRepositoryTestFixture{
+		t:                    t,
+		databaseAPI:          mockDatabaseAPI,
+		experienceRepository: experienceRepository,
+	}
+}
+
+func newTestExperience() domain.Experience {
+	endDate := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+
+	return domain.Experience{
+		Position:   "Mobile Application Developer",
+		Company:    "GotWork Digital",
+		Link:       "https://gotwork.digital",
+		Setup:      domain.Remote,
+		StartDate:  time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:    &endDate,
+		Highlights: []string{"Rewrote the app in Flutter"},
+		Skills:     []string{"Flutter", "Riverpod"},
+	}
+}
+
+func TestExperienceRepository_Create(t *testing.T) {
+	fixedID := "test-id"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+
+	validExperience := newTestExperience()
+
+	currentExperience := validExperience
+	currentExperience.EndDate = nil
+	currentExperience.Highlights = nil
+
+	type Given struct {
+		experience   domain.Experience
+		mockQueryRow func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful create experience": {
+			given: Given{
+				experience: validExperience,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(query string) bool {
+							return strings.Contains(query, "INSERT INTO "+testExperienceTable)
+						}),
+						mock.MatchedBy(func(args []any) bool {
+							return len(args) == 11 &&
+								args[1] == validExperience.Position &&
+								args[6] == validExperience.EndDate &&
+								args[9] == fixedTime
+						}),
+					).Return(&experienceFakeRow{id: fixedID})
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Current position stores NULL end date and empty highlights": {
+			given: Given{
+				experience: currentExperience,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.Anything,
+						mock.MatchedBy(func(args []any) bool {
+							endDate, ok := args[6].(*time.Time)
+							highlights, _ := args[7].([]string)
+							return ok && endDate == nil && highlights != nil && len(highlights) == 0
+						}),
+					).Return(&experienceFakeRow{id: fixedID})
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Database scan fails": {
+			given: Given{
+				experience: validExperience,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{scanErr: scanErr})
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to create experience: %w", scanErr),
+			},
+		},
+		"Database returns empty ID": {
+			given: Given{
+				experience: validExperience,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{id: ""})
+				},
+			},
+			expected: Expected{
+				err: errors.New("invalid experience returned: ID missing"),
+			},
+		},
+		"Missing position fails": {
+			given: Given{
+				experience: func() domain.Experience { e := validExperience; e.Position = " "; return e }(),
+			},
+			expected: Expected{
+				err: errors.New("failed to validate experience: position missing"),
+			},
+		},
+		"Invalid setup fails": {
+			given: Given{
+				experience: func() domain.Experience { e := validExperience; e.Setup = "office"; return e }(),
+			},
+			expected: Expected{
+				err: errors.New("failed to validate experience: setup invalid = office"),
+			},
+		},
+		"Invalid link fails": {
+			given: Given{
+				experience: func() domain.Experience { e := validExperience; e.Link = "gotwork"; return e }(),
+			},
+			expected: Expected{
+				err: errors.New("failed to validate experience: link url invalid"),
+			},
+		},
+		"End date before start date fails": {
+			given: Given{
+				experience: func() domain.Experience {
+					e := validExperience
+					endDate := e.StartDate.AddDate(0, -1, 0)
+					e.EndDate = &endDate
+					return e
+				}(),
+			},
+			expected: Expected{
+				err: errors.New("failed to validate experience: end date must be after start date"),
+			},
+		},
+		"Empty highlight fails": {
+			given: Given{
+				experience: func() domain.Experience { e := validExperience; e.Highlights = []string{"ok", ""}; return e }(),
+			},
+			expected: Expected{
+				err: errors.New("failed to validate experience: highlight[1] is empty"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockQueryRow != nil {
+				test.given.mockQueryRow(f.databaseAPI)
+			}
+
+			id, err := f.experienceRepository.Create(context.Background(), &test.given.experience)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, fixedID, id)
+				assert.Equal(t, fixedTime, test.given.experience.CreatedAt)
+				assert.Equal(t, fixedTime, test.given.experience.UpdatedAt)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_Create_NilPayload(t *testing.T) {
+	f := newExperienceRepositoryTestFixture(t, time.Now)
+
+	id, err := f.experienceRepository.Create(context.Background(), nil)
+
+	assert.EqualError(t, err, "failed to validate experience: payload is nil")
+	assert.Empty(t, id)
+}
+
+func TestExperienceRepository_Get(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+
+	validExperience := newTestExperience()
+	validExperience.Id = "experience-123"
+	validExperience.CreatedAt = fixedTime
+	validExperience.UpdatedAt = fixedTime
+
+	type Given struct {
+		id           string
+		mockQueryRow func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		experience *domain.Experience
+		err        error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful get experience": {
+			given: Given{
+				id: validExperience.Id,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(query string) bool {
+							return strings.Contains(query, "FROM "+testExperienceTable) && strings.Contains(query, "WHERE id = $1 AND deleted_at IS NULL")
+						}),
+						[]any{validExperience.Id},
+					).Return(&experienceFakeRow{experience: &validExperience})
+				},
+			},
+			expected: Expected{
+				experience: &validExperience,
+			},
+		},
+		"Missing ID": {
+			given: Given{id: ""},
+			expected: Expected{
+				err: errors.New("failed to get experience: ID missing"),
+			},
+		},
+		"Experience not found": {
+			given: Given{
+				id: "missing-id",
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{scanErr: pgx.ErrNoRows})
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to get experience: %w", pgx.ErrNoRows),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				id: validExperience.Id,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{scanErr: scanErr})
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan experience: %w", scanErr),
+			},
+		},
+		"Returned experience fails validation": {
+			given: Given{
+				id: validExperience.Id,
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					invalid := validExperience
+					invalid.Company = ""
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{experience: &invalid})
+				},
+			},
+			expected: Expected{
+				err: errors.New("invalid experience returned: company missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, nil)
+
+			if test.given.mockQueryRow != nil {
+				test.given.mockQueryRow(f.databaseAPI)
+			}
+
+			experience, err := f.experienceRepository.Get(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, experience)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.experience, experience)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_Update(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+
+	originalExperience := newTestExperience()
+	originalExperience.Id = "existing-id"
+	originalExperience.CreatedAt = fixedTime.Add(-time.Hour)
+
+	updatedExperience := originalExperience
+	updatedExperience.UpdatedAt = fixedTime
+
+	type Given struct {
+		experience   *domain.Experience
+		mockQueryRow func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		result *domain.Experience
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful update": {
+			given: Given{
+				experience: func() *domain.Experience { e := originalExperience; return &e }(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(q string) bool {
+							return strings.Contains(q, "UPDATE "+testExperienceTable) && strings.Contains(q, "WHERE id=$1 AND deleted_at IS NULL")
+						}),
+						mock.MatchedBy(func(args []any) bool {
+							return len(args) == 10 &&
+								args[0] == originalExperience.Id &&
+								args[9] == fixedTime
+						}),
+					).Return(&experienceFakeRow{experience: &updatedExperience})
+				},
+			},
+			expected: Expected{
+				result: &updatedExperience,
+			},
+		},
+		"Nil payload fails": {
+			given: Given{experience: nil},
+			expected: Expected{
+				err: errors.New("failed to validate experience: payload is nil"),
+			},
+		},
+		"Missing ID fails": {
+			given: Given{
+				experience: func() *domain.Experience { e := originalExperience; e.Id = ""; return &e }(),
+			},
+			expected: Expected{
+				err: errors.New("failed to update experience: ID missing"),
+			},
+		},
+		"Validation failure": {
+			given: Given{
+				experience: func() *domain.Experience { e := originalExperience; e.Setup = ""; return &e }(),
+			},
+			expected: Expected{
+				err: errors.New("failed to validate experience: setup missing"),
+			},
+		},
+		"Experience not found returns nil result without error": {
+			given: Given{
+				experience: func() *domain.Experience { e := originalExperience; return &e }(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{scanErr: pgx.ErrNoRows})
+				},
+			},
+			expected: Expected{
+				result: nil,
+				err:    nil,
+			},
+		},
+		"Database scan fails": {
+			given: Given{
+				experience: func() *domain.Experience { e := originalExperience; return &e }(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{scanErr: scanErr})
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to update experience: %w", scanErr),
+			},
+		},
+		"Returned experience fails validation": {
+			given: Given{
+				experience: func() *domain.Experience { e := originalExperience; return &e }(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					invalid := updatedExperience
+					invalid.Position = ""
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRow{experience: &invalid})
+				},
+			},
+			expected: Expected{
+				err: errors.New("invalid experience returned: position missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockQueryRow != nil {
+				test.given.mockQueryRow(f.databaseAPI)
+			}
+
+			result, err := f.experienceRepository.Update(context.Background(), test.given.experience)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.result, result)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_Delete(t *testing.T) {
+	dbErr := errors.New("db exec error")
+	fixedID := "experience-123"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful delete": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "UPDATE "+testExperienceTable+" SET deleted_at=$2") &&
+									strings.Contains(q, "deleted_at IS NULL")
+							}),
+							[]any{fixedID, fixedTime},
+						).
+						Return(&experienceFakeCommandTag{rows: 1}, nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during delete": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to delete experience: %w", dbErr),
+			},
+		},
+		"No rows affected": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeCommandTag{rows: 0}, nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Delete with empty ID": {
+			given: Given{id: ""},
+			expected: Expected{
+				err: errors.New("failed to delete experience: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.experienceRepository.Delete(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_Restore(t *testing.T) {
+	dbErr := errors.New("db exec error")
+	fixedID := "experience-123"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful restore": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "UPDATE "+testExperienceTable+" SET deleted_at=NULL") &&
+									strings.Contains(q, "deleted_at IS NOT NULL")
+							}),
+							[]any{fixedID, fixedTime},
+						).
+						Return(&experienceFakeCommandTag{rows: 1}, nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during restore": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to restore experience: %w", dbErr),
+			},
+		},
+		"Not in trash": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeCommandTag{rows: 0}, nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Restore with empty ID": {
+			given: Given{id: ""},
+			expected: Expected{
+				err: errors.New("failed to restore experience: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.experienceRepository.Restore(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_Purge(t *testing.T) {
+	dbErr := errors.New("db exec error")
+	fixedID := "experience-123"
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful purge": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "DELETE FROM "+testExperienceTable) &&
+									strings.Contains(q, "deleted_at IS NOT NULL")
+							}),
+							[]any{fixedID},
+						).
+						Return(&experienceFakeCommandTag{rows: 1}, nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during purge": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge experience: %w", dbErr),
+			},
+		},
+		"Not in trash": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeCommandTag{rows: 0}, nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Purge with empty ID": {
+			given: Given{id: ""},
+			expected: Expected{
+				err: errors.New("failed to purge experience: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, time.Now)
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.experienceRepository.Purge(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_List(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+	countErr := errors.New("count error")
+
+	remote := domain.Remote
+	cursor := domain.Cursor{CreatedAt: fixedTime, ID: "experience-000"}
+	sortUpdated := domain.UpdatedAt
+
+	mockExperience := newTestExperience()
+	mockExperience.Id = "experience-123"
+	mockExperience.CreatedAt = fixedTime
+	mockExperience.UpdatedAt = fixedTime
+
+	mockExperience2 := mockExperience
+	mockExperience2.Id = "experience-456"
+
+	defaultPageInfo := domain.PageInfo{Total: 1, Page: 1, PageSize: 20}
+
+	type Given struct {
+		filter    domain.ExperienceFilter
+		count     *countFakeRow
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		result   []domain.Experience
+		pageInfo domain.PageInfo
+		err      error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful list without filters": {
+			given: Given{
+				filter: domain.ExperienceFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "FROM "+testExperienceTable+" WHERE deleted_at IS NULL") &&
+									strings.Contains(q, "ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2")
+							}),
+							[]any{int32(21), int32(0)},
+						).
+						Return(&experienceFakeRows{
+							rows: []*experienceFakeRow{{experience: &mockExperience}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Experience{mockExperience},
+				pageInfo: defaultPageInfo,
+			},
+		},
+		"Successful list with setup filter": {
+			given: Given{
+				filter: domain.ExperienceFilter{Setup: &remote},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool { return strings.Contains(q, "WHERE deleted_at IS NULL AND setup = $1") }),
+							[]any{domain.Remote, int32(21), int32(0)},
+						).
+						Return(&experienceFakeRows{
+							rows: []*experienceFakeRow{{experience: &mockExperience}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Experience{mockExperience},
+				pageInfo: defaultPageInfo,
+			},
+		},
+		"Extra row sets has next and cursor": {
+			given: Given{
+				filter: domain.ExperienceFilter{PageSize: 1},
+				count:  &countFakeRow{count: 2},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRows{
+							rows: []*experienceFakeRow{{experience: &mockExperience}, {experience: &mockExperience2}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				result: []domain.Experience{mockExperience},
+				pageInfo: domain.PageInfo{
+					Total:      2,
+					Page:       1,
+					PageSize:   1,
+					HasNext:    true,
+					NextCursor: domain.Cursor{CreatedAt: fixedTime, ID: mockExperience.Id}.Encode(),
+				},
+			},
+		},
+		"Cursor adds keyset condition": {
+			given: Given{
+				filter: domain.ExperienceFilter{Setup: &remote, Cursor: &cursor},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "WHERE deleted_at IS NULL AND setup = $1 AND (created_at, id) < ($2, $3)") &&
+									strings.HasSuffix(q, "LIMIT $4")
+							}),
+							[]any{domain.Remote, cursor.CreatedAt, cursor.ID, int32(21)},
+						).
+						Return(&experienceFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Experience{},
+				pageInfo: domain.PageInfo{Total: 1, Page: 1, PageSize: 20},
+			},
+		},
+		"Cursor with non created_at sort": {
+			given: Given{
+				filter: domain.ExperienceFilter{SortBy: &sortUpdated, Cursor: &cursor},
+			},
+			expected: Expected{
+				err: errors.New("failed to list experiences: cursor requires sorting by created_at"),
+			},
+		},
+		"Empty setup filter": {
+			given: Given{
+				filter: domain.ExperienceFilter{Setup: func() *domain.WorkSetup { s := domain.WorkSetup(""); return &s }()},
+			},
+			expected: Expected{
+				err: errors.New("failed to list experiences: invalid empty setup"),
+			},
+		},
+		"Count fails": {
+			given: Given{
+				filter: domain.ExperienceFilter{},
+				count:  &countFakeRow{scanErr: countErr},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to count experiences: %w", countErr),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				filter: domain.ExperienceFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list experiences: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				filter: domain.ExperienceFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRows{rows: []*experienceFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan experience: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				filter: domain.ExperienceFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&experienceFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			count := test.given.count
+			if count == nil {
+				count = &countFakeRow{count: 1}
+			}
+			expectCount(f.databaseAPI, count)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			experiences, pageInfo, err := f.experienceRepository.List(context.Background(), test.given.filter)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, experiences)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.result, experiences)
+				assert.Equal(t, test.expected.pageInfo, pageInfo)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_ListBySkillID(t *testing.T) {
+	skillID := "skill-123"
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	linked := newTestExperience()
+	linked.Id = "exp-1"
+	linked.CreatedAt = time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	linked.UpdatedAt = linked.CreatedAt
+
+	type Given struct {
+		skillID   string
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		experiences []domain.Experience
+		err         error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful list by skill": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testExperienceTable+" e") &&
+									strings.Contains(query, "JOIN "+testExperienceSkillTable+" es ON es.experience_id = e.id") &&
+									strings.Contains(query, "WHERE es.skill_id = $1 AND e.deleted_at IS NULL")
+							}),
+							[]any{skillID},
+						).
+						Return(&experienceFakeRows{rows: []*experienceFakeRow{{experience: &linked}}}, nil)
+				},
+			},
+			expected: Expected{
+				experiences: []domain.Experience{linked},
+			},
+		},
+		"No linked experiences": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(&experienceFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				experiences: []domain.Experience{},
+			},
+		},
+		"Missing skill ID": {
+			given: Given{
+				skillID: "",
+			},
+			expected: Expected{
+				err: errors.New("failed to list experiences by skill: ID missing"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to query experiences by skill: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(&experienceFakeRows{rows: []*experienceFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan experience: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(&experienceFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			experiences, err := f.experienceRepository.ListBySkillID(context.Background(), test.given.skillID)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, experiences)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.experiences, experiences)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExperienceRepository_SetSkills(t *testing.T) {
+	experienceID := "exp-123"
+	skillIDs := []string{"skill-1", "skill-2"}
+	execErr := errors.New("exec error")
+
+	isDelete := func(query string) bool {
+		return strings.Contains(query, "DELETE FROM "+testExperienceSkillTable+" WHERE experience_id = $1")
+	}
+	isInsert := func(query string) bool {
+		return strings.Contains(query, "INSERT INTO "+testExperienceSkillTable+" (experience_id, skill_id)") &&
+			strings.Contains(query, "unnest($2::uuid[])") &&
+			strings.Contains(query, "ON CONFLICT DO NOTHING")
+	}
+
+	type Given struct {
+		experienceID string
+		skillIDs     []string
+		mockExec     func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful replace": {
+			given: Given{
+				experienceID: experienceID,
+				skillIDs:     skillIDs,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{experienceID}).
+						Return(&experienceFakeCommandTag{rows: 1}, nil).
+						Once()
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isInsert), []any{experienceID, skillIDs}).
+						Return(&experienceFakeCommandTag{rows: 2}, nil).
+						Once()
+				},
+			},
+		},
+		"Empty skills only clears links": {
+			given: Given{
+				experienceID: experienceID,
+				skillIDs:     []string{},
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{experienceID}).
+						Return(&experienceFakeCommandTag{rows: 0}, nil).
+						Once()
+				},
+			},
+		},
+		"Missing experience ID": {
+			given: Given{
+				experienceID: "",
+				skillIDs:     skillIDs,
+			},
+			expected: Expected{
+				err: errors.New("failed to set experience skills: experience ID missing"),
+			},
+		},
+		"Clear fails": {
+			given: Given{
+				experienceID: experienceID,
+				skillIDs:     skillIDs,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{experienceID}).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to clear experience skills: %w", execErr),
+			},
+		},
+		"Link fails": {
+			given: Given{
+				experienceID: experienceID,
+				skillIDs:     skillIDs,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{experienceID}).
+						Return(&experienceFakeCommandTag{rows: 1}, nil)
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isInsert), []any{experienceID, skillIDs}).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to link experience skills: %w", execErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newExperienceRepositoryTestFixture(t, nil)
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.experienceRepository.SetSkills(context.Background(), test.given.experienceID, test.given.skillIDs)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
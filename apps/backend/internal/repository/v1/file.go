@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
@@ -14,11 +15,16 @@ import (
 
 type FileRepository interface {
 	FindByParent(ctx context.Context, parentTable, parentID string, role domain.FileRole) ([]domain.File, error)
+	FindByParents(ctx context.Context, parentTable string, parentIDs []string, role domain.FileRole) (map[string][]domain.File, error)
 	Create(ctx context.Context, file domain.File) (string, error)
 	Update(ctx context.Context, fileUpdate domain.File) (*domain.File, error)
 	Delete(ctx context.Context, id string) error
 	DeleteByParent(ctx context.Context, parentTable string, parentID string) error
+	TrashByParent(ctx context.Context, parentTable string, parentID string) error
+	RestoreByParent(ctx context.Context, parentTable string, parentID string) error
 	FindByID(ctx context.Context, id string) (*domain.File, error)
+	Version(ctx context.Context, parentTable string) (domain.CollectionVersion, error)
+	WithTx(tx database.Tx) FileRepository
 }
 
 type FileRepositoryConfig struct {
@@ -30,7 +36,7 @@ type FileRepositoryConfig struct {
 
 type fileRepository struct {
 	fileTable    string
-	databaseAPI  database.DatabaseAPI
+	databaseAPI  database.Querier
 	timeProvider domain.TimeProvider
 }
 
@@ -54,6 +60,15 @@ func NewFileRepository(cfg FileRepositoryConfig) FileRepository {
 	}
 }
 
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool, so several repository calls can be
+// committed or rolled back together.
+func (r *fileRepository) WithTx(tx database.Tx) FileRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
 // FindByParent retrieves all files for a specific parent entity and role.
 // It queries the database for files matching the provided parentTable, parentID, and role.
 // The results are ordered by created_at in descending order.
@@ -81,7 +96,7 @@ func (r *fileRepository) FindByParent(ctx context.Context, parentTable, parentID
 	query := fmt.Sprintf(
 		`SELECT id, parent_table, parent_id, role, name, url, type, size, created_at, updated_at
         FROM %s
-        WHERE parent_table = $1 AND parent_id = $2 AND role = $3
+        WHERE parent_table = $1 AND parent_id = $2 AND role = $3 AND deleted_at IS NULL
         ORDER BY created_at DESC`,
 		r.fileTable,
 	)
@@ -121,6 +136,79 @@ func (r *fileRepository) FindByParent(ctx context.Context, parentTable, parentID
 	return files, nil
 }
 
+// FindByParents fetches files with the given role for multiple parents of the same table in a
+// single query. Returns a map where the key is the parent ID and the value is a slice of files
+// ordered by created_at in descending order. Every requested parent ID is present in the map,
+// with an empty slice when it has no files.
+func (r *fileRepository) FindByParents(ctx context.Context, parentTable string, parentIDs []string, role domain.FileRole) (map[string][]domain.File, error) {
+	if parentTable == "" {
+		return nil, errors.New("failed to find files: parentTable missing")
+	}
+	if role == "" {
+		return nil, errors.New("failed to find files: role missing")
+	}
+	if len(parentIDs) == 0 {
+		return make(map[string][]domain.File), nil
+	}
+
+	placeholders := make([]string, len(parentIDs))
+	args := make([]any, 0, len(parentIDs)+2)
+	args = append(args, parentTable, role)
+	for i, id := range parentIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+3)
+		args = append(args, id)
+	}
+
+	query := fmt.Sprintf(
+		`SELECT id, parent_table, parent_id, role, name, url, type, size, created_at, updated_at
+        FROM %s
+        WHERE parent_table = $1 AND role = $2 AND parent_id IN (%s) AND deleted_at IS NULL
+        ORDER BY parent_id, created_at DESC`,
+		r.fileTable,
+		strings.Join(placeholders, ", "),
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to batch query files by parent: %w", err)
+	}
+	defer rows.Close()
+
+	filesByParent := make(map[string][]domain.File, len(parentIDs))
+	for _, id := range parentIDs {
+		filesByParent[id] = []domain.File{}
+	}
+
+	for rows.Next() {
+		var file domain.File
+		err := rows.Scan(
+			&file.ID,
+			&file.ParentTable,
+			&file.ParentID,
+			&file.Role,
+			&file.Name,
+			&file.URL,
+			&file.Type,
+			&file.Size,
+			&file.CreatedAt,
+			&file.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan file: %w", err)
+		}
+
+		if _, ok := filesByParent[file.ParentID]; ok {
+			filesByParent[file.ParentID] = append(filesByParent[file.ParentID], file)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return filesByParent, nil
+}
+
 // Create creates a new file record in the repository and returns its generated ID.
 // It validates the provided File payload, generates a unique ID, sets CreatedAt and
 // UpdatedAt timestamps from the repository's time provider, and inserts the record
@@ -218,7 +306,7 @@ func (r *fileRepository) Update(ctx context.Context, fileUpdate domain.File) (*d
 			type=$7,
 			size=$8,
 			updated_at=$9
-		WHERE id=$1
+		WHERE id=$1 AND deleted_at IS NULL
 		RETURNING id, parent_table, parent_id, role, name, url, type, size, created_at, updated_at`,
 		r.fileTable,
 	)
@@ -322,11 +410,78 @@ func (r *fileRepository) DeleteByParent(ctx context.Context, parentTable string,
 	return nil
 }
 
+// TrashByParent soft-deletes every live file attached to a parent entity by
+// stamping deleted_at, so the files follow their parent into the trash. Files are
+// hidden from every read until RestoreByParent brings them back or
+// DeleteByParent purges them.
+//
+// Parameters:
+//   - ctx: The context for controlling cancellation and deadlines.
+//   - parentTable: The name of the parent table (e.g., "projects", "education").
+//   - parentID: The unique identifier of the parent record.
+//
+// Returns:
+//   - error: An error if validation fails or the database update fails.
+func (r *fileRepository) TrashByParent(ctx context.Context, parentTable string, parentID string) error {
+	if parentTable == "" {
+		return errors.New("failed to trash files: parentTable missing")
+	}
+
+	if parentID == "" {
+		return errors.New("failed to trash files: parentID missing")
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE %s SET deleted_at = $3 WHERE parent_table = $1 AND parent_id = $2 AND deleted_at IS NULL",
+		r.fileTable,
+	)
+
+	_, err := r.databaseAPI.Exec(ctx, query, parentTable, parentID, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to trash files by parent: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreByParent brings back every trashed file attached to a parent entity by
+// clearing deleted_at. It is the counterpart of TrashByParent.
+//
+// Parameters:
+//   - ctx: The context for controlling cancellation and deadlines.
+//   - parentTable: The name of the parent table (e.g., "projects", "education").
+//   - parentID: The unique identifier of the parent record.
+//
+// Returns:
+//   - error: An error if validation fails or the database update fails.
+func (r *fileRepository) RestoreByParent(ctx context.Context, parentTable string, parentID string) error {
+	if parentTable == "" {
+		return errors.New("failed to restore files: parentTable missing")
+	}
+
+	if parentID == "" {
+		return errors.New("failed to restore files: parentID missing")
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE %s SET deleted_at = NULL WHERE parent_table = $1 AND parent_id = $2 AND deleted_at IS NOT NULL",
+		r.fileTable,
+	)
+
+	_, err := r.databaseAPI.Exec(ctx, query, parentTable, parentID)
+	if err != nil {
+		return fmt.Errorf("failed to restore files by parent: %w", err)
+	}
+
+	return nil
+}
+
 // FindByID retrieves a file by its unique identifier from the repository's database.
 // The ctx is used for cancellation and deadlines. The id must be non-empty; if it is
 // empty, FindByID returns an error indicating a missing ID. On success, it returns a
 // pointer to a validated domain.File populated from the matching database row.
-// If no row matches the provided id, the returned error will wrap pgx.ErrNoRows.
+// If no live row matches the provided id (files trashed with their parent are
+// skipped), the returned error will wrap pgx.ErrNoRows.
 // Any other query/scan or validation failures are returned wrapped to provide context.
 //
 // Parameters:
@@ -346,7 +501,7 @@ func (r *fileRepository) FindByID(ctx context.Context, id string) (*domain.File,
 	query := fmt.Sprintf(
 		`SELECT id, parent_table, parent_id, role, name, url, type, size, created_at, updated_at
         FROM %s
-        WHERE id = $1`,
+        WHERE id = $1 AND deleted_at IS NULL`,
 		r.fileTable,
 	)
 
@@ -376,3 +531,15 @@ func (r *fileRepository) FindByID(ctx context.Context, id string) (*domain.File,
 
 	return &file, nil
 }
+
+// Version reports the domain.CollectionVersion of the files attached to rows of
+// parentTable. It changes whenever such a file is created, updated, trashed or
+// restored, and, through the live row count, when one is deleted outright.
+func (r *fileRepository) Version(ctx context.Context, parentTable string) (domain.CollectionVersion, error) {
+	version, err := collectionVersion(ctx, r.databaseAPI, r.fileTable, "parent_table = $1", parentTable)
+	if err != nil {
+		return domain.CollectionVersion{}, fmt.Errorf("failed to get files version: %w", err)
+	}
+
+	return version, nil
+}
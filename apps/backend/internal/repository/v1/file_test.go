@@ -0,0 +1,430 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testFileTable = "test-files"
+)
+
+// fileFakeRow for FindByParent and FindByParents paths
+type fileFakeRow struct {
+	file    domain.File
+	scanErr error
+}
+
+func (f *fileFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 10 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.file.ID
+	*dest[1].(*domain.ParentTable) = f.file.ParentTable
+	*dest[2].(*string) = f.file.ParentID
+	*dest[3].(*domain.FileRole) = f.file.Role
+	*dest[4].(*string) = f.file.Name
+	*dest[5].(*string) = f.file.URL
+	*dest[6].(*string) = f.file.Type
+	*dest[7].(*int64) = f.file.Size
+	*dest[8].(*time.Time) = f.file.CreatedAt
+	*dest[9].(*time.Time) = f.file.UpdatedAt
+	return nil
+}
+
+type fileFakeRows struct {
+	rows   []*fileFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *fileFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *fileFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *fileFakeRows) Err() error { return r.rowErr }
+
+func (r *fileFakeRows) Close() {}
+
+type fileRepositoryTestFixture struct {
+	t              *testing.T
+	databaseAPI    *database.MockDatabaseAPI
+	fileRepository *fileRepository
+}
+
+func newFileRepositoryTestFixture(t *testing.T, timeProvider func() time.Time) *fileRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+	fileRepository := &fileRepository{
+		databaseAPI:  mockDatabaseAPI,
+		timeProvider: timeProvider,
+		fileTable:    testFileTable,
+	}
+
+	return &fileRepositoryTestFixture{
+		t:              t,
+		databaseAPI:    mockDatabaseAPI,
+		fileRepository: fileRepository,
+	}
+}
+
+func TestFileRepository_FindByParents(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	newFile := func(id, parentID string) domain.File {
+		return domain.File{
+			ID:          id,
+			ParentTable: "project",
+			ParentID:    parentID,
+			Role:        domain.Image,
+			Name:        id + ".png",
+			URL:         "http://example.com/" + id + ".png",
+			Type:        "image/png",
+			Size:        1024,
+			CreatedAt:   fixedTime,
+			UpdatedAt:   fixedTime,
+		}
+	}
+	file1 := newFile("f1", "p1")
+	file2 := newFile("f2", "p1")
+	file3 := newFile("f3", "p2")
+	stray := newFile("f4", "p9")
+
+	type Given struct {
+		parentTable string
+		parentIDs   []string
+		role        domain.FileRole
+		mockQuery   func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		files map[string][]domain.File
+		err   error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Groups files by parent in a single query": {
+			given: Given{
+				parentTable: "project",
+				parentIDs:   []string{"p1", "p2", "p3"},
+				role:        domain.Image,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE parent_table = $1 AND role = $2 AND parent_id IN ($3, $4, $5)")
+							}),
+							[]any{"project", domain.Image, "p1", "p2", "p3"},
+						).
+						Return(&fileFakeRows{
+							rows: []*fileFakeRow{{file: file1}, {file: file2}, {file: file3}, {file: stray}},
+						}, nil).
+						Once()
+				},
+			},
+			expected: Expected{
+				files: map[string][]domain.File{
+					"p1": {file1, file2},
+					"p2": {file3},
+					"p3": {},
+				},
+			},
+		},
+		"Empty parent IDs skips the query": {
+			given: Given{
+				parentTable: "project",
+				parentIDs:   []string{},
+				role:        domain.Image,
+			},
+			expected: Expected{
+				files: map[string][]domain.File{},
+			},
+		},
+		"Missing parent table": {
+			given: Given{
+				parentIDs: []string{"p1"},
+				role:      domain.Image,
+			},
+			expected: Expected{
+				err: errors.New("failed to find files: parentTable missing"),
+			},
+		},
+		"Missing role": {
+			given: Given{
+				parentTable: "project",
+				parentIDs:   []string{"p1"},
+			},
+			expected: Expected{
+				err: errors.New("failed to find files: role missing"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				parentTable: "project",
+				parentIDs:   []string{"p1"},
+				role:        domain.Image,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to batch query files by parent: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				parentTable: "project",
+				parentIDs:   []string{"p1"},
+				role:        domain.Image,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&fileFakeRows{rows: []*fileFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan file: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				parentTable: "project",
+				parentIDs:   []string{"p1"},
+				role:        domain.Image,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&fileFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFileRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			files, err := f.fileRepository.FindByParents(context.Background(), test.given.parentTable, test.given.parentIDs, test.given.role)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, files)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.files, files)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFileRepository_TrashByParent(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	type Given struct {
+		parentTable string
+		parentID    string
+		mockExec    func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Success": {
+			given: Given{
+				parentTable: "project",
+				parentID:    "p1",
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testFileTable+" SET deleted_at = $3 WHERE parent_table = $1 AND parent_id = $2 AND deleted_at IS NULL")
+							}),
+							[]any{"project", "p1", fixedTime},
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Missing parent table": {
+			given: Given{
+				parentID: "p1",
+			},
+			expected: Expected{
+				err: errors.New("failed to trash files: parentTable missing"),
+			},
+		},
+		"Missing parent ID": {
+			given: Given{
+				parentTable: "project",
+			},
+			expected: Expected{
+				err: errors.New("failed to trash files: parentID missing"),
+			},
+		},
+		"Exec fails": {
+			given: Given{
+				parentTable: "project",
+				parentID:    "p1",
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to trash files by parent: %w", execErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFileRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.fileRepository.TrashByParent(context.Background(), test.given.parentTable, test.given.parentID)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFileRepository_RestoreByParent(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	type Given struct {
+		parentTable string
+		parentID    string
+		mockExec    func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Success": {
+			given: Given{
+				parentTable: "project",
+				parentID:    "p1",
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testFileTable+" SET deleted_at = NULL WHERE parent_table = $1 AND parent_id = $2 AND deleted_at IS NOT NULL")
+							}),
+							[]any{"project", "p1"},
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Missing parent table": {
+			given: Given{
+				parentID: "p1",
+			},
+			expected: Expected{
+				err: errors.New("failed to restore files: parentTable missing"),
+			},
+		},
+		"Missing parent ID": {
+			given: Given{
+				parentTable: "project",
+			},
+			expected: Expected{
+				err: errors.New("failed to restore files: parentID missing"),
+			},
+		},
+		"Exec fails": {
+			given: Given{
+				parentTable: "project",
+				parentID:    "p1",
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to restore files by parent: %w", execErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newFileRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.fileRepository.RestoreByParent(context.Background(), test.given.parentTable, test.given.parentID)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,350 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type MessageRepository interface {
+	Create(ctx context.Context, message *domain.Message) error
+	Get(ctx context.Context, id string) (*domain.Message, error)
+	List(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, domain.PageInfo, error)
+	Update(ctx context.Context, id string, update domain.MessageUpdate) (*domain.Message, error)
+	RecordAttempt(ctx context.Context, id string, status domain.MessageStatus, providerResponse string) error
+	WithTx(tx database.Tx) MessageRepository
+}
+
+type MessageRepositoryConfig struct {
+	DatabaseAPI  database.DatabaseAPI
+	MessageTable string
+
+	timeProvider domain.TimeProvider
+}
+
+type messageRepository struct {
+	messageTable string
+	databaseAPI  database.Querier
+	timeProvider domain.TimeProvider
+}
+
+// messageColumns is the column list every message query selects, in the
+// order scanMessage reads them.
+const messageColumns = "id, name, email, subject, message, status, attempts, COALESCE(provider_response, ''), read_at, archived_at, created_at, updated_at"
+
+// NewMessageRepository creates and returns a MessageRepository that stores
+// messages in cfg.MessageTable using cfg.DatabaseAPI. If cfg.timeProvider is
+// nil the repository defaults to time.Now.
+func NewMessageRepository(cfg MessageRepositoryConfig) MessageRepository {
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &messageRepository{
+		messageTable: cfg.MessageTable,
+		databaseAPI:  cfg.DatabaseAPI,
+		timeProvider: timeProvider,
+	}
+}
+
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool.
+func (r *messageRepository) WithTx(tx database.Tx) MessageRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
+// Create inserts message as pending with no attempts. It assigns message.ID,
+// message.Status, message.CreatedAt and message.UpdatedAt.
+//
+// Returns an error when message is nil, its name, email or subject is
+// missing, or the insert fails.
+func (r *messageRepository) Create(ctx context.Context, message *domain.Message) error {
+	if message == nil {
+		return errors.New("failed to validate message: payload is nil")
+	}
+	if err := message.SendEmail().Validate(); err != nil {
+		return fmt.Errorf("failed to validate message: %w", err)
+	}
+
+	message.ID = utils.GenerateKey()
+	message.Status = domain.MessagePending
+	message.Attempts = 0
+	message.CreatedAt = r.timeProvider()
+	message.UpdatedAt = message.CreatedAt
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, name, email, subject, message, status, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		r.messageTable,
+	)
+
+	_, err := r.databaseAPI.Exec(
+		ctx,
+		query,
+		message.ID,
+		message.Name,
+		message.Email,
+		message.Subject,
+		message.Message,
+		message.Status,
+		message.CreatedAt,
+		message.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create message: %w", err)
+	}
+
+	return nil
+}
+
+// Get returns the message with the given id, archived or not. It returns
+// pgx.ErrNoRows (wrapped) when there is none.
+func (r *messageRepository) Get(ctx context.Context, id string) (*domain.Message, error) {
+	if id == "" {
+		return nil, errors.New("failed to get message: ID missing")
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s
+		WHERE id = $1`,
+		messageColumns,
+		r.messageTable,
+	)
+
+	message, err := scanMessage(r.databaseAPI.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	return message, nil
+}
+
+// List returns a page of messages matching filter, newest first.
+//
+// Behavior and defaults:
+//   - filter is validated via filter.Validate().
+//   - Archived messages are only listed, exclusively, when filter.Archived is set.
+//   - Status and Read are only applied when set.
+//   - If filter.Page <= 0 it defaults to 1; if filter.PageSize <= 0 or > 100 it defaults to 20.
+//   - A filter.Cursor starts the page right after it instead of at filter.Page.
+//
+// Returns:
+//   - ([]domain.Message, domain.PageInfo, nil) on success; the slice is empty when nothing matches.
+//   - (nil, domain.PageInfo{}, error) on validation, query, scan, or row iteration failures.
+func (r *messageRepository) List(ctx context.Context, filter domain.MessageFilter) ([]domain.Message, domain.PageInfo, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to validate message filter: %w", err)
+	}
+
+	if filter.Page <= 0 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 || filter.PageSize > 100 {
+		filter.PageSize = 20
+	}
+
+	conditions := []string{"archived_at IS NULL"}
+	if filter.Archived {
+		conditions[0] = "archived_at IS NOT NULL"
+	}
+
+	var args []any
+	if filter.Status != nil {
+		args = append(args, *filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.Read != nil {
+		if *filter.Read {
+			conditions = append(conditions, "read_at IS NOT NULL")
+		} else {
+			conditions = append(conditions, "read_at IS NULL")
+		}
+	}
+
+	where := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int64
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", r.messageTable, where)
+	if err := r.databaseAPI.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to count messages: %w", err)
+	}
+
+	// Fetch one extra row to learn whether another page follows
+	pagination := ""
+	if filter.Cursor != nil {
+		condition, cursorArgs := cursorCondition(*filter.Cursor, false, len(args)+1)
+		where += " AND " + condition
+		args = append(args, cursorArgs...)
+		args = append(args, filter.PageSize+1)
+		pagination = fmt.Sprintf("LIMIT $%d", len(args))
+	} else {
+		args = append(args, filter.PageSize+1, (filter.Page-1)*filter.PageSize)
+		pagination = fmt.Sprintf("LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s
+		FROM %s
+		%s
+		ORDER BY created_at DESC, id DESC
+		%s`,
+		messageColumns,
+		r.messageTable,
+		where,
+		pagination,
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, args...)
+	if err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list messages: %w", err)
+	}
+	defer rows.Close()
+
+	messages := []domain.Message{}
+	for rows.Next() {
+		message, err := scanMessage(rows)
+		if err != nil {
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to scan message: %w", err)
+		}
+
+		messages = append(messages, *message)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	fetched := len(messages)
+	if fetched > int(filter.PageSize) {
+		messages = messages[:filter.PageSize]
+	}
+
+	var last domain.Message
+	if len(messages) > 0 {
+		last = messages[len(messages)-1]
+	}
+
+	return messages, newPageInfo(filter.Page, filter.PageSize, total, fetched, true, last.CreatedAt, last.ID), nil
+}
+
+// Update marks the message id read or unread and archived or unarchived, as
+// update says, and sets UpdatedAt from the repository's time provider.
+// Marking a read message read again keeps its original read time, and the
+// same goes for archiving.
+//
+// Returns:
+//   - (*domain.Message, nil) on success with the updated message.
+//   - (nil, nil) if no message with the given id was found.
+//   - (nil, error) on validation or database errors.
+func (r *messageRepository) Update(ctx context.Context, id string, update domain.MessageUpdate) (*domain.Message, error) {
+	if id == "" {
+		return nil, errors.New("failed to update message: ID missing")
+	}
+	if err := update.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate message update: %w", err)
+	}
+
+	sets := []string{"updated_at = $2"}
+	for _, field := range []struct {
+		column string
+		value  *bool
+	}{
+		{"read_at", update.Read},
+		{"archived_at", update.Archived},
+	} {
+		if field.value == nil {
+			continue
+		}
+		if *field.value {
+			sets = append(sets, fmt.Sprintf("%s = COALESCE(%s, $2)", field.column, field.column))
+		} else {
+			sets = append(sets, field.column+" = NULL")
+		}
+	}
+
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET %s
+		WHERE id = $1
+		RETURNING %s`,
+		r.messageTable,
+		strings.Join(sets, ", "),
+		messageColumns,
+	)
+
+	message, err := scanMessage(r.databaseAPI.QueryRow(ctx, query, id, r.timeProvider()))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to update message: %w", err)
+	}
+
+	return message, nil
+}
+
+// RecordAttempt stores the outcome of a delivery attempt of the message id:
+// its new status and what the provider answered. The attempt count goes up
+// by one. It returns pgx.ErrNoRows (wrapped) when there is no such message.
+func (r *messageRepository) RecordAttempt(ctx context.Context, id string, status domain.MessageStatus, providerResponse string) error {
+	if !status.IsValid() {
+		return fmt.Errorf("failed to validate message status: status invalid = %s", status)
+	}
+
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET status = $2,
+			attempts = attempts + 1,
+			provider_response = NULLIF($3, ''),
+			updated_at = $4
+		WHERE id = $1`,
+		r.messageTable,
+	)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, status, providerResponse, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to record message attempt: %w", err)
+	}
+	if cmdTag == nil || cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("failed to record message attempt: %w", pgx.ErrNoRows)
+	}
+
+	return nil
+}
+
+// scanMessage reads a row selected with messageColumns.
+func scanMessage(row database.Row) (*domain.Message, error) {
+	var message domain.Message
+
+	err := row.Scan(
+		&message.ID,
+		&message.Name,
+		&message.Email,
+		&message.Subject,
+		&message.Message,
+		&message.Status,
+		&message.Attempts,
+		&message.ProviderResponse,
+		&message.ReadAt,
+		&message.ArchivedAt,
+		&message.CreatedAt,
+		&message.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &message, nil
+}
@@ -0,0 +1,618 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testMessageTable = "test-message"
+
+type messageFakeRow struct {
+	message domain.Message
+	scanErr error
+}
+
+func (f *messageFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 12 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.message.ID
+	*dest[1].(*string) = f.message.Name
+	*dest[2].(*string) = f.message.Email
+	*dest[3].(*string) = f.message.Subject
+	*dest[4].(*string) = f.message.Message
+	*dest[5].(*domain.MessageStatus) = f.message.Status
+	*dest[6].(*int) = f.message.Attempts
+	*dest[7].(*string) = f.message.ProviderResponse
+	*dest[8].(**time.Time) = f.message.ReadAt
+	*dest[9].(**time.Time) = f.message.ArchivedAt
+	*dest[10].(*time.Time) = f.message.CreatedAt
+	*dest[11].(*time.Time) = f.message.UpdatedAt
+	return nil
+}
+
+type messageFakeRows struct {
+	rows   []*messageFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *messageFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *messageFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *messageFakeRows) Err() error { return r.rowErr }
+
+func (r *messageFakeRows) Close() {}
+
+type messageFakeCommandTag int64
+
+func (f messageFakeCommandTag) RowsAffected() int64 { return int64(f) }
+
+type messageRepositoryTestFixture struct {
+	databaseAPI       *database.MockDatabaseAPI
+	messageRepository MessageRepository
+}
+
+func newMessageRepositoryTestFixture(timeProvider domain.TimeProvider) *messageRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+
+	return &messageRepositoryTestFixture{
+		databaseAPI: mockDatabaseAPI,
+		messageRepository: NewMessageRepository(
+			MessageRepositoryConfig{
+				DatabaseAPI:  mockDatabaseAPI,
+				MessageTable: testMessageTable,
+				timeProvider: timeProvider,
+			},
+		),
+	}
+}
+
+func newTestMessage(id string, createdAt time.Time) domain.Message {
+	return domain.Message{
+		ID:        id,
+		Name:      "Jane Doe",
+		Email:     "jane@example.com",
+		Subject:   "Hello",
+		Message:   "Nice portfolio!",
+		Status:    domain.MessageSent,
+		Attempts:  1,
+		CreatedAt: createdAt,
+		UpdatedAt: createdAt,
+	}
+}
+
+func TestMessageRepository_Create(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	valid := func() *domain.Message {
+		message := domain.NewMessage(domain.SendEmail{
+			Name:    "Jane Doe",
+			Email:   "jane@example.com",
+			Subject: "Hello",
+			Message: "Nice portfolio!",
+		})
+		return &message
+	}
+
+	type Given struct {
+		message  *domain.Message
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Inserts the message as pending": {
+			given: Given{
+				message: valid(),
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "INSERT INTO "+testMessageTable) &&
+									strings.Contains(query, "(id, name, email, subject, message, status, created_at, updated_at)")
+							}),
+							mock.MatchedBy(func(args []any) bool {
+								return len(args) == 8 &&
+									args[0].(string) != "" &&
+									args[1] == "Jane Doe" &&
+									args[2] == "jane@example.com" &&
+									args[3] == "Hello" &&
+									args[4] == "Nice portfolio!" &&
+									args[5] == domain.MessagePending &&
+									args[6] == fixedTime &&
+									args[7] == fixedTime
+							}),
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Nil message": {
+			expected: Expected{err: errors.New("failed to validate message: payload is nil")},
+		},
+		"Missing email": {
+			given:    Given{message: &domain.Message{Name: "Jane Doe", Subject: "Hello"}},
+			expected: Expected{err: errors.New("failed to validate message: email missing")},
+		},
+		"Exec fails": {
+			given: Given{
+				message: valid(),
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to create message: %w", execErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.messageRepository.Create(context.Background(), test.given.message)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, test.given.message.ID)
+				assert.Equal(t, domain.MessagePending, test.given.message.Status)
+				assert.Equal(t, fixedTime, test.given.message.CreatedAt)
+				assert.Equal(t, fixedTime, test.given.message.UpdatedAt)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageRepository_Get(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	stored := newTestMessage("message-1", fixedTime)
+
+	type Given struct {
+		id  string
+		row *messageFakeRow
+	}
+
+	type Expected struct {
+		message *domain.Message
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Found": {
+			given:    Given{id: "message-1", row: &messageFakeRow{message: stored}},
+			expected: Expected{message: &stored},
+		},
+		"Not found": {
+			given:    Given{id: "missing", row: &messageFakeRow{scanErr: pgx.ErrNoRows}},
+			expected: Expected{err: fmt.Errorf("failed to get message: %w", pgx.ErrNoRows)},
+		},
+		"Missing ID": {
+			expected: Expected{err: errors.New("failed to get message: ID missing")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageRepositoryTestFixture(nil)
+
+			if test.given.row != nil {
+				f.databaseAPI.EXPECT().
+					QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(query string) bool {
+							return strings.Contains(query, "FROM "+testMessageTable) &&
+								strings.Contains(query, "WHERE id = $1")
+						}),
+						[]any{test.given.id},
+					).
+					Return(test.given.row)
+			}
+
+			message, err := f.messageRepository.Get(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				if test.given.row != nil {
+					assert.ErrorIs(t, err, pgx.ErrNoRows)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.message, message)
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageRepository_List(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	countErr := errors.New("count error")
+
+	failed := domain.MessageFailed
+	unknown := domain.MessageStatus("bounced")
+	unread := false
+	cursor := domain.Cursor{CreatedAt: fixedTime, ID: "message-0"}
+
+	message1 := newTestMessage("message-1", fixedTime)
+	message2 := newTestMessage("message-2", fixedTime.Add(-time.Hour))
+
+	type Given struct {
+		filter    domain.MessageFilter
+		count     *countFakeRow
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		result   []domain.Message
+		pageInfo domain.PageInfo
+		err      error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Lists the inbox by default": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "FROM "+testMessageTable) &&
+									strings.Contains(q, "WHERE archived_at IS NULL") &&
+									strings.Contains(q, "ORDER BY created_at DESC, id DESC") &&
+									strings.Contains(q, "LIMIT $1 OFFSET $2")
+							}),
+							[]any{int32(21), int32(0)},
+						).
+						Return(&messageFakeRows{rows: []*messageFakeRow{{message: message1}}}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Message{message1},
+				pageInfo: domain.PageInfo{Total: 1, Page: 1, PageSize: 20},
+			},
+		},
+		"Filters archived, unread messages by status": {
+			given: Given{
+				filter: domain.MessageFilter{Status: &failed, Read: &unread, Archived: true, Page: 2, PageSize: 5},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "WHERE archived_at IS NOT NULL AND status = $1 AND read_at IS NULL") &&
+									strings.Contains(q, "LIMIT $2 OFFSET $3")
+							}),
+							[]any{domain.MessageFailed, int32(6), int32(5)},
+						).
+						Return(&messageFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Message{},
+				pageInfo: domain.PageInfo{Total: 1, Page: 2, PageSize: 5},
+			},
+		},
+		"Extra row sets has next and cursor": {
+			given: Given{
+				filter: domain.MessageFilter{PageSize: 1},
+				count:  &countFakeRow{count: 2},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{int32(2), int32(0)}).
+						Return(&messageFakeRows{rows: []*messageFakeRow{{message: message1}, {message: message2}}}, nil)
+				},
+			},
+			expected: Expected{
+				result: []domain.Message{message1},
+				pageInfo: domain.PageInfo{
+					Total:      2,
+					Page:       1,
+					PageSize:   1,
+					HasNext:    true,
+					NextCursor: domain.Cursor{CreatedAt: message1.CreatedAt, ID: message1.ID}.Encode(),
+				},
+			},
+		},
+		"Cursor seeks past the previous page": {
+			given: Given{
+				filter: domain.MessageFilter{Status: &failed, Cursor: &cursor},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "status = $1 AND (created_at, id) < ($2, $3)") &&
+									strings.Contains(q, "LIMIT $4") &&
+									!strings.Contains(q, "OFFSET")
+							}),
+							[]any{domain.MessageFailed, fixedTime, "message-0", int32(21)},
+						).
+						Return(&messageFakeRows{rows: []*messageFakeRow{{message: message2}}}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Message{message2},
+				pageInfo: domain.PageInfo{Total: 1, Page: 1, PageSize: 20},
+			},
+		},
+		"Unknown status": {
+			given:    Given{filter: domain.MessageFilter{Status: &unknown}},
+			expected: Expected{err: errors.New("failed to validate message filter: status invalid = bounced")},
+		},
+		"Count fails": {
+			given:    Given{count: &countFakeRow{scanErr: countErr}},
+			expected: Expected{err: fmt.Errorf("failed to count messages: %w", countErr)},
+		},
+		"Query fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to list messages: %w", queryErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageRepositoryTestFixture(nil)
+
+			count := test.given.count
+			if count == nil {
+				count = &countFakeRow{count: 1}
+			}
+			expectCount(f.databaseAPI, count)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			result, pageInfo, err := f.messageRepository.List(context.Background(), test.given.filter)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.result, result)
+				assert.Equal(t, test.expected.pageInfo, pageInfo)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageRepository_Update(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+	yes, no := true, false
+
+	updated := newTestMessage("message-1", fixedTime.Add(-time.Hour))
+	updated.ReadAt = &fixedTime
+	updated.UpdatedAt = fixedTime
+
+	type Given struct {
+		id     string
+		update domain.MessageUpdate
+		set    string
+		row    *messageFakeRow
+	}
+
+	type Expected struct {
+		message *domain.Message
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Marks read": {
+			given: Given{
+				id:     "message-1",
+				update: domain.MessageUpdate{Read: &yes},
+				set:    "SET updated_at = $2, read_at = COALESCE(read_at, $2)",
+				row:    &messageFakeRow{message: updated},
+			},
+			expected: Expected{message: &updated},
+		},
+		"Marks unread and archives": {
+			given: Given{
+				id:     "message-1",
+				update: domain.MessageUpdate{Read: &no, Archived: &yes},
+				set:    "SET updated_at = $2, read_at = NULL, archived_at = COALESCE(archived_at, $2)",
+				row:    &messageFakeRow{message: updated},
+			},
+			expected: Expected{message: &updated},
+		},
+		"Not found": {
+			given: Given{
+				id:     "missing",
+				update: domain.MessageUpdate{Archived: &no},
+				set:    "SET updated_at = $2, archived_at = NULL",
+				row:    &messageFakeRow{scanErr: pgx.ErrNoRows},
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				id:     "message-1",
+				update: domain.MessageUpdate{Read: &yes},
+				set:    "read_at = COALESCE(read_at, $2)",
+				row:    &messageFakeRow{scanErr: scanErr},
+			},
+			expected: Expected{err: fmt.Errorf("failed to update message: %w", scanErr)},
+		},
+		"Empty update": {
+			given:    Given{id: "message-1"},
+			expected: Expected{err: errors.New("failed to validate message update: read or archived missing")},
+		},
+		"Missing ID": {
+			given:    Given{update: domain.MessageUpdate{Read: &yes}},
+			expected: Expected{err: errors.New("failed to update message: ID missing")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.row != nil {
+				f.databaseAPI.EXPECT().
+					QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(query string) bool {
+							return strings.Contains(query, "UPDATE "+testMessageTable) &&
+								strings.Contains(query, test.given.set) &&
+								strings.Contains(query, "WHERE id = $1")
+						}),
+						[]any{test.given.id, fixedTime},
+					).
+					Return(test.given.row)
+			}
+
+			message, err := f.messageRepository.Update(context.Background(), test.given.id, test.given.update)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.message, message)
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMessageRepository_RecordAttempt(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	type Given struct {
+		status   domain.MessageStatus
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Records the attempt": {
+			given: Given{
+				status: domain.MessageFailed,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testMessageTable) &&
+									strings.Contains(query, "attempts = attempts + 1")
+							}),
+							[]any{"message-1", domain.MessageFailed, "bad gateway", fixedTime},
+						).
+						Return(messageFakeCommandTag(1), nil)
+				},
+			},
+		},
+		"Not found": {
+			given: Given{
+				status: domain.MessageFailed,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(messageFakeCommandTag(0), nil)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to record message attempt: %w", pgx.ErrNoRows)},
+		},
+		"Exec fails": {
+			given: Given{
+				status: domain.MessageSent,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to record message attempt: %w", execErr)},
+		},
+		"Unknown status": {
+			given:    Given{status: "bounced"},
+			expected: Expected{err: errors.New("failed to validate message status: status invalid = bounced")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newMessageRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.messageRepository.RecordAttempt(context.Background(), "message-1", test.given.status, "bad gateway")
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -5,6 +5,8 @@
 package v1
 
 import (
+	"context"
+
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -36,17 +38,80 @@ func (_m *MockAnalyticsRepository) EXPECT() *MockAnalyticsRepository_Expecter {
 	return &MockAnalyticsRepository_Expecter{mock: &_m.Mock}
 }
 
+// Events provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Events(ctx context.Context, events []domain.AnalyticsEvent, visitor domain.Visitor) error {
+	ret := _mock.Called(ctx, events, visitor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Events")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []domain.AnalyticsEvent, domain.Visitor) error); ok {
+		r0 = returnFunc(ctx, events, visitor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockAnalyticsRepository_Events_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Events'
+type MockAnalyticsRepository_Events_Call struct {
+	*mock.Call
+}
+
+// Events is a helper method to define mock.On call
+//   - ctx context.Context
+//   - events []domain.AnalyticsEvent
+//   - visitor domain.Visitor
+func (_e *MockAnalyticsRepository_Expecter) Events(ctx interface{}, events interface{}, visitor interface{}) *MockAnalyticsRepository_Events_Call {
+	return &MockAnalyticsRepository_Events_Call{Call: _e.mock.On("Events", ctx, events, visitor)}
+}
+
+func (_c *MockAnalyticsRepository_Events_Call) Run(run func(ctx context.Context, events []domain.AnalyticsEvent, visitor domain.Visitor)) *MockAnalyticsRepository_Events_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []domain.AnalyticsEvent
+		if args[1] != nil {
+			arg1 = args[1].([]domain.AnalyticsEvent)
+		}
+		var arg2 domain.Visitor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Visitor)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Events_Call) Return(err error) *MockAnalyticsRepository_Events_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Events_Call) RunAndReturn(run func(ctx context.Context, events []domain.AnalyticsEvent, visitor domain.Visitor) error) *MockAnalyticsRepository_Events_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PageView provides a mock function for the type MockAnalyticsRepository
-func (_mock *MockAnalyticsRepository) PageView(pageView domain.PageView) error {
-	ret := _mock.Called(pageView)
+func (_mock *MockAnalyticsRepository) PageView(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error {
+	ret := _mock.Called(ctx, pageView, visitor)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PageView")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(domain.PageView) error); ok {
-		r0 = returnFunc(pageView)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.PageView, domain.Visitor) error); ok {
+		r0 = returnFunc(ctx, pageView, visitor)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -59,19 +124,31 @@ type MockAnalyticsRepository_PageView_Call struct {
 }
 
 // PageView is a helper method to define mock.On call
+//   - ctx context.Context
 //   - pageView domain.PageView
-func (_e *MockAnalyticsRepository_Expecter) PageView(pageView interface{}) *MockAnalyticsRepository_PageView_Call {
-	return &MockAnalyticsRepository_PageView_Call{Call: _e.mock.On("PageView", pageView)}
+//   - visitor domain.Visitor
+func (_e *MockAnalyticsRepository_Expecter) PageView(ctx interface{}, pageView interface{}, visitor interface{}) *MockAnalyticsRepository_PageView_Call {
+	return &MockAnalyticsRepository_PageView_Call{Call: _e.mock.On("PageView", ctx, pageView, visitor)}
 }
 
-func (_c *MockAnalyticsRepository_PageView_Call) Run(run func(pageView domain.PageView)) *MockAnalyticsRepository_PageView_Call {
+func (_c *MockAnalyticsRepository_PageView_Call) Run(run func(ctx context.Context, pageView domain.PageView, visitor domain.Visitor)) *MockAnalyticsRepository_PageView_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 domain.PageView
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(domain.PageView)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.PageView
+		if args[1] != nil {
+			arg1 = args[1].(domain.PageView)
+		}
+		var arg2 domain.Visitor
+		if args[2] != nil {
+			arg2 = args[2].(domain.Visitor)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -82,7 +159,143 @@ func (_c *MockAnalyticsRepository_PageView_Call) Return(err error) *MockAnalytic
 	return _c
 }
 
-func (_c *MockAnalyticsRepository_PageView_Call) RunAndReturn(run func(pageView domain.PageView) error) *MockAnalyticsRepository_PageView_Call {
+func (_c *MockAnalyticsRepository_PageView_Call) RunAndReturn(run func(ctx context.Context, pageView domain.PageView, visitor domain.Visitor) error) *MockAnalyticsRepository_PageView_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Summary provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Summary(ctx context.Context, dateRange domain.AnalyticsRange) (*domain.AnalyticsSummary, error) {
+	ret := _mock.Called(ctx, dateRange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Summary")
+	}
+
+	var r0 *domain.AnalyticsSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsRange) (*domain.AnalyticsSummary, error)); ok {
+		return returnFunc(ctx, dateRange)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsRange) *domain.AnalyticsSummary); ok {
+		r0 = returnFunc(ctx, dateRange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.AnalyticsSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsRange) error); ok {
+		r1 = returnFunc(ctx, dateRange)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Summary_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Summary'
+type MockAnalyticsRepository_Summary_Call struct {
+	*mock.Call
+}
+
+// Summary is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dateRange domain.AnalyticsRange
+func (_e *MockAnalyticsRepository_Expecter) Summary(ctx interface{}, dateRange interface{}) *MockAnalyticsRepository_Summary_Call {
+	return &MockAnalyticsRepository_Summary_Call{Call: _e.mock.On("Summary", ctx, dateRange)}
+}
+
+func (_c *MockAnalyticsRepository_Summary_Call) Run(run func(ctx context.Context, dateRange domain.AnalyticsRange)) *MockAnalyticsRepository_Summary_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsRange
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsRange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Summary_Call) Return(analyticsSummary *domain.AnalyticsSummary, err error) *MockAnalyticsRepository_Summary_Call {
+	_c.Call.Return(analyticsSummary, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Summary_Call) RunAndReturn(run func(ctx context.Context, dateRange domain.AnalyticsRange) (*domain.AnalyticsSummary, error)) *MockAnalyticsRepository_Summary_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeseries provides a mock function for the type MockAnalyticsRepository
+func (_mock *MockAnalyticsRepository) Timeseries(ctx context.Context, dateRange domain.AnalyticsRange) ([]domain.AnalyticsDay, error) {
+	ret := _mock.Called(ctx, dateRange)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeseries")
+	}
+
+	var r0 []domain.AnalyticsDay
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsRange) ([]domain.AnalyticsDay, error)); ok {
+		return returnFunc(ctx, dateRange)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.AnalyticsRange) []domain.AnalyticsDay); ok {
+		r0 = returnFunc(ctx, dateRange)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.AnalyticsDay)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.AnalyticsRange) error); ok {
+		r1 = returnFunc(ctx, dateRange)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockAnalyticsRepository_Timeseries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeseries'
+type MockAnalyticsRepository_Timeseries_Call struct {
+	*mock.Call
+}
+
+// Timeseries is a helper method to define mock.On call
+//   - ctx context.Context
+//   - dateRange domain.AnalyticsRange
+func (_e *MockAnalyticsRepository_Expecter) Timeseries(ctx interface{}, dateRange interface{}) *MockAnalyticsRepository_Timeseries_Call {
+	return &MockAnalyticsRepository_Timeseries_Call{Call: _e.mock.On("Timeseries", ctx, dateRange)}
+}
+
+func (_c *MockAnalyticsRepository_Timeseries_Call) Run(run func(ctx context.Context, dateRange domain.AnalyticsRange)) *MockAnalyticsRepository_Timeseries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.AnalyticsRange
+		if args[1] != nil {
+			arg1 = args[1].(domain.AnalyticsRange)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Timeseries_Call) Return(analyticsDays []domain.AnalyticsDay, err error) *MockAnalyticsRepository_Timeseries_Call {
+	_c.Call.Return(analyticsDays, err)
+	return _c
+}
+
+func (_c *MockAnalyticsRepository_Timeseries_Call) RunAndReturn(run func(ctx context.Context, dateRange domain.AnalyticsRange) ([]domain.AnalyticsDay, error)) *MockAnalyticsRepository_Timeseries_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -7,7 +7,9 @@ package v1
 import (
 	"context"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -161,6 +163,72 @@ func (_c *MockEducationRepository_Delete_Call) RunAndReturn(run func(ctx context
 	return _c
 }
 
+// Exists provides a mock function for the type MockEducationRepository
+func (_mock *MockEducationRepository) Exists(ctx context.Context, id string) (bool, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Exists")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEducationRepository_Exists_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exists'
+type MockEducationRepository_Exists_Call struct {
+	*mock.Call
+}
+
+// Exists is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEducationRepository_Expecter) Exists(ctx interface{}, id interface{}) *MockEducationRepository_Exists_Call {
+	return &MockEducationRepository_Exists_Call{Call: _e.mock.On("Exists", ctx, id)}
+}
+
+func (_c *MockEducationRepository_Exists_Call) Run(run func(ctx context.Context, id string)) *MockEducationRepository_Exists_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEducationRepository_Exists_Call) Return(b bool, err error) *MockEducationRepository_Exists_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockEducationRepository_Exists_Call) RunAndReturn(run func(ctx context.Context, id string) (bool, error)) *MockEducationRepository_Exists_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockEducationRepository
 func (_mock *MockEducationRepository) Get(ctx context.Context, id string) (*domain.Education, error) {
 	ret := _mock.Called(ctx, id)
@@ -230,7 +298,7 @@ func (_c *MockEducationRepository_Get_Call) RunAndReturn(run func(ctx context.Co
 }
 
 // List provides a mock function for the type MockEducationRepository
-func (_mock *MockEducationRepository) List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, error) {
+func (_mock *MockEducationRepository) List(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, domain.PageInfo, error) {
 	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
@@ -238,8 +306,9 @@ func (_mock *MockEducationRepository) List(ctx context.Context, filter domain.Ed
 	}
 
 	var r0 []domain.Education
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EducationFilter) ([]domain.Education, error)); ok {
+	var r1 domain.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EducationFilter) ([]domain.Education, domain.PageInfo, error)); ok {
 		return returnFunc(ctx, filter)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EducationFilter) []domain.Education); ok {
@@ -249,12 +318,17 @@ func (_mock *MockEducationRepository) List(ctx context.Context, filter domain.Ed
 			r0 = ret.Get(0).([]domain.Education)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.EducationFilter) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.EducationFilter) domain.PageInfo); ok {
 		r1 = returnFunc(ctx, filter)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(domain.PageInfo)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.EducationFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockEducationRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -287,12 +361,126 @@ func (_c *MockEducationRepository_List_Call) Run(run func(ctx context.Context, f
 	return _c
 }
 
-func (_c *MockEducationRepository_List_Call) Return(educations []domain.Education, err error) *MockEducationRepository_List_Call {
-	_c.Call.Return(educations, err)
+func (_c *MockEducationRepository_List_Call) Return(educations []domain.Education, pageInfo domain.PageInfo, err error) *MockEducationRepository_List_Call {
+	_c.Call.Return(educations, pageInfo, err)
 	return _c
 }
 
-func (_c *MockEducationRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, error)) *MockEducationRepository_List_Call {
+func (_c *MockEducationRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.EducationFilter) ([]domain.Education, domain.PageInfo, error)) *MockEducationRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type MockEducationRepository
+func (_mock *MockEducationRepository) Purge(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEducationRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockEducationRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEducationRepository_Expecter) Purge(ctx interface{}, id interface{}) *MockEducationRepository_Purge_Call {
+	return &MockEducationRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, id)}
+}
+
+func (_c *MockEducationRepository_Purge_Call) Run(run func(ctx context.Context, id string)) *MockEducationRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEducationRepository_Purge_Call) Return(err error) *MockEducationRepository_Purge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEducationRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockEducationRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockEducationRepository
+func (_mock *MockEducationRepository) Restore(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockEducationRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockEducationRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockEducationRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockEducationRepository_Restore_Call {
+	return &MockEducationRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockEducationRepository_Restore_Call) Run(run func(ctx context.Context, id string)) *MockEducationRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEducationRepository_Restore_Call) Return(err error) *MockEducationRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockEducationRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockEducationRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -364,3 +552,116 @@ func (_c *MockEducationRepository_Update_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// Version provides a mock function for the type MockEducationRepository
+func (_mock *MockEducationRepository) Version(ctx context.Context) (domain.CollectionVersion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 domain.CollectionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.CollectionVersion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.CollectionVersion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.CollectionVersion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockEducationRepository_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockEducationRepository_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockEducationRepository_Expecter) Version(ctx interface{}) *MockEducationRepository_Version_Call {
+	return &MockEducationRepository_Version_Call{Call: _e.mock.On("Version", ctx)}
+}
+
+func (_c *MockEducationRepository_Version_Call) Run(run func(ctx context.Context)) *MockEducationRepository_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEducationRepository_Version_Call) Return(collectionVersion domain.CollectionVersion, err error) *MockEducationRepository_Version_Call {
+	_c.Call.Return(collectionVersion, err)
+	return _c
+}
+
+func (_c *MockEducationRepository_Version_Call) RunAndReturn(run func(ctx context.Context) (domain.CollectionVersion, error)) *MockEducationRepository_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function for the type MockEducationRepository
+func (_mock *MockEducationRepository) WithTx(tx database.Tx) v1.EducationRepository {
+	ret := _mock.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 v1.EducationRepository
+	if returnFunc, ok := ret.Get(0).(func(database.Tx) v1.EducationRepository); ok {
+		r0 = returnFunc(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.EducationRepository)
+		}
+	}
+	return r0
+}
+
+// MockEducationRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockEducationRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx database.Tx
+func (_e *MockEducationRepository_Expecter) WithTx(tx interface{}) *MockEducationRepository_WithTx_Call {
+	return &MockEducationRepository_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *MockEducationRepository_WithTx_Call) Run(run func(tx database.Tx)) *MockEducationRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 database.Tx
+		if args[0] != nil {
+			arg0 = args[0].(database.Tx)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockEducationRepository_WithTx_Call) Return(educationRepository v1.EducationRepository) *MockEducationRepository_WithTx_Call {
+	_c.Call.Return(educationRepository)
+	return _c
+}
+
+func (_c *MockEducationRepository_WithTx_Call) RunAndReturn(run func(tx database.Tx) v1.EducationRepository) *MockEducationRepository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
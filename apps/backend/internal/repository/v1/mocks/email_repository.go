@@ -5,6 +5,8 @@
 package v1
 
 import (
+	"context"
+
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -37,16 +39,16 @@ func (_m *MockEmailRepository) EXPECT() *MockEmailRepository_Expecter {
 }
 
 // Send provides a mock function for the type MockEmailRepository
-func (_mock *MockEmailRepository) Send(send domain.SendEmail) error {
-	ret := _mock.Called(send)
+func (_mock *MockEmailRepository) Send(ctx context.Context, template domain.EmailTemplate, send domain.SendEmail) error {
+	ret := _mock.Called(ctx, template, send)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Send")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(domain.SendEmail) error); ok {
-		r0 = returnFunc(send)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.EmailTemplate, domain.SendEmail) error); ok {
+		r0 = returnFunc(ctx, template, send)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -59,19 +61,31 @@ type MockEmailRepository_Send_Call struct {
 }
 
 // Send is a helper method to define mock.On call
+//   - ctx context.Context
+//   - template domain.EmailTemplate
 //   - send domain.SendEmail
-func (_e *MockEmailRepository_Expecter) Send(send interface{}) *MockEmailRepository_Send_Call {
-	return &MockEmailRepository_Send_Call{Call: _e.mock.On("Send", send)}
+func (_e *MockEmailRepository_Expecter) Send(ctx interface{}, template interface{}, send interface{}) *MockEmailRepository_Send_Call {
+	return &MockEmailRepository_Send_Call{Call: _e.mock.On("Send", ctx, template, send)}
 }
 
-func (_c *MockEmailRepository_Send_Call) Run(run func(send domain.SendEmail)) *MockEmailRepository_Send_Call {
+func (_c *MockEmailRepository_Send_Call) Run(run func(ctx context.Context, template domain.EmailTemplate, send domain.SendEmail)) *MockEmailRepository_Send_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 domain.SendEmail
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(domain.SendEmail)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.EmailTemplate
+		if args[1] != nil {
+			arg1 = args[1].(domain.EmailTemplate)
+		}
+		var arg2 domain.SendEmail
+		if args[2] != nil {
+			arg2 = args[2].(domain.SendEmail)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
@@ -82,7 +96,7 @@ func (_c *MockEmailRepository_Send_Call) Return(err error) *MockEmailRepository_
 	return _c
 }
 
-func (_c *MockEmailRepository_Send_Call) RunAndReturn(run func(send domain.SendEmail) error) *MockEmailRepository_Send_Call {
+func (_c *MockEmailRepository_Send_Call) RunAndReturn(run func(ctx context.Context, template domain.EmailTemplate, send domain.SendEmail) error) *MockEmailRepository_Send_Call {
 	_c.Call.Return(run)
 	return _c
 }
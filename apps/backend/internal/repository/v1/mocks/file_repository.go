@@ -7,7 +7,9 @@ package v1
 import (
 	"context"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -372,6 +374,212 @@ func (_c *MockFileRepository_FindByParent_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
+// FindByParents provides a mock function for the type MockFileRepository
+func (_mock *MockFileRepository) FindByParents(ctx context.Context, parentTable string, parentIDs []string, role domain.FileRole) (map[string][]domain.File, error) {
+	ret := _mock.Called(ctx, parentTable, parentIDs, role)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByParents")
+	}
+
+	var r0 map[string][]domain.File
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, domain.FileRole) (map[string][]domain.File, error)); ok {
+		return returnFunc(ctx, parentTable, parentIDs, role)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string, domain.FileRole) map[string][]domain.File); ok {
+		r0 = returnFunc(ctx, parentTable, parentIDs, role)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]domain.File)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, []string, domain.FileRole) error); ok {
+		r1 = returnFunc(ctx, parentTable, parentIDs, role)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFileRepository_FindByParents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByParents'
+type MockFileRepository_FindByParents_Call struct {
+	*mock.Call
+}
+
+// FindByParents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentTable string
+//   - parentIDs []string
+//   - role domain.FileRole
+func (_e *MockFileRepository_Expecter) FindByParents(ctx interface{}, parentTable interface{}, parentIDs interface{}, role interface{}) *MockFileRepository_FindByParents_Call {
+	return &MockFileRepository_FindByParents_Call{Call: _e.mock.On("FindByParents", ctx, parentTable, parentIDs, role)}
+}
+
+func (_c *MockFileRepository_FindByParents_Call) Run(run func(ctx context.Context, parentTable string, parentIDs []string, role domain.FileRole)) *MockFileRepository_FindByParents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		var arg3 domain.FileRole
+		if args[3] != nil {
+			arg3 = args[3].(domain.FileRole)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileRepository_FindByParents_Call) Return(stringToFiles map[string][]domain.File, err error) *MockFileRepository_FindByParents_Call {
+	_c.Call.Return(stringToFiles, err)
+	return _c
+}
+
+func (_c *MockFileRepository_FindByParents_Call) RunAndReturn(run func(ctx context.Context, parentTable string, parentIDs []string, role domain.FileRole) (map[string][]domain.File, error)) *MockFileRepository_FindByParents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RestoreByParent provides a mock function for the type MockFileRepository
+func (_mock *MockFileRepository) RestoreByParent(ctx context.Context, parentTable string, parentID string) error {
+	ret := _mock.Called(ctx, parentTable, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RestoreByParent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, parentTable, parentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFileRepository_RestoreByParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RestoreByParent'
+type MockFileRepository_RestoreByParent_Call struct {
+	*mock.Call
+}
+
+// RestoreByParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentTable string
+//   - parentID string
+func (_e *MockFileRepository_Expecter) RestoreByParent(ctx interface{}, parentTable interface{}, parentID interface{}) *MockFileRepository_RestoreByParent_Call {
+	return &MockFileRepository_RestoreByParent_Call{Call: _e.mock.On("RestoreByParent", ctx, parentTable, parentID)}
+}
+
+func (_c *MockFileRepository_RestoreByParent_Call) Run(run func(ctx context.Context, parentTable string, parentID string)) *MockFileRepository_RestoreByParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileRepository_RestoreByParent_Call) Return(err error) *MockFileRepository_RestoreByParent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFileRepository_RestoreByParent_Call) RunAndReturn(run func(ctx context.Context, parentTable string, parentID string) error) *MockFileRepository_RestoreByParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TrashByParent provides a mock function for the type MockFileRepository
+func (_mock *MockFileRepository) TrashByParent(ctx context.Context, parentTable string, parentID string) error {
+	ret := _mock.Called(ctx, parentTable, parentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TrashByParent")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, parentTable, parentID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockFileRepository_TrashByParent_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TrashByParent'
+type MockFileRepository_TrashByParent_Call struct {
+	*mock.Call
+}
+
+// TrashByParent is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentTable string
+//   - parentID string
+func (_e *MockFileRepository_Expecter) TrashByParent(ctx interface{}, parentTable interface{}, parentID interface{}) *MockFileRepository_TrashByParent_Call {
+	return &MockFileRepository_TrashByParent_Call{Call: _e.mock.On("TrashByParent", ctx, parentTable, parentID)}
+}
+
+func (_c *MockFileRepository_TrashByParent_Call) Run(run func(ctx context.Context, parentTable string, parentID string)) *MockFileRepository_TrashByParent_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileRepository_TrashByParent_Call) Return(err error) *MockFileRepository_TrashByParent_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockFileRepository_TrashByParent_Call) RunAndReturn(run func(ctx context.Context, parentTable string, parentID string) error) *MockFileRepository_TrashByParent_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockFileRepository
 func (_mock *MockFileRepository) Update(ctx context.Context, fileUpdate domain.File) (*domain.File, error) {
 	ret := _mock.Called(ctx, fileUpdate)
@@ -439,3 +647,122 @@ func (_c *MockFileRepository_Update_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// Version provides a mock function for the type MockFileRepository
+func (_mock *MockFileRepository) Version(ctx context.Context, parentTable string) (domain.CollectionVersion, error) {
+	ret := _mock.Called(ctx, parentTable)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 domain.CollectionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (domain.CollectionVersion, error)); ok {
+		return returnFunc(ctx, parentTable)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) domain.CollectionVersion); ok {
+		r0 = returnFunc(ctx, parentTable)
+	} else {
+		r0 = ret.Get(0).(domain.CollectionVersion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, parentTable)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockFileRepository_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockFileRepository_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentTable string
+func (_e *MockFileRepository_Expecter) Version(ctx interface{}, parentTable interface{}) *MockFileRepository_Version_Call {
+	return &MockFileRepository_Version_Call{Call: _e.mock.On("Version", ctx, parentTable)}
+}
+
+func (_c *MockFileRepository_Version_Call) Run(run func(ctx context.Context, parentTable string)) *MockFileRepository_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileRepository_Version_Call) Return(collectionVersion domain.CollectionVersion, err error) *MockFileRepository_Version_Call {
+	_c.Call.Return(collectionVersion, err)
+	return _c
+}
+
+func (_c *MockFileRepository_Version_Call) RunAndReturn(run func(ctx context.Context, parentTable string) (domain.CollectionVersion, error)) *MockFileRepository_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function for the type MockFileRepository
+func (_mock *MockFileRepository) WithTx(tx database.Tx) v1.FileRepository {
+	ret := _mock.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 v1.FileRepository
+	if returnFunc, ok := ret.Get(0).(func(database.Tx) v1.FileRepository); ok {
+		r0 = returnFunc(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.FileRepository)
+		}
+	}
+	return r0
+}
+
+// MockFileRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockFileRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx database.Tx
+func (_e *MockFileRepository_Expecter) WithTx(tx interface{}) *MockFileRepository_WithTx_Call {
+	return &MockFileRepository_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *MockFileRepository_WithTx_Call) Run(run func(tx database.Tx)) *MockFileRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 database.Tx
+		if args[0] != nil {
+			arg0 = args[0].(database.Tx)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockFileRepository_WithTx_Call) Return(fileRepository v1.FileRepository) *MockFileRepository_WithTx_Call {
+	_c.Call.Return(fileRepository)
+	return _c
+}
+
+func (_c *MockFileRepository_WithTx_Call) RunAndReturn(run func(tx database.Tx) v1.FileRepository) *MockFileRepository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,7 +7,9 @@ package v1
 import (
 	"context"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -230,7 +232,7 @@ func (_c *MockProjectRepository_Get_Call) RunAndReturn(run func(ctx context.Cont
 }
 
 // List provides a mock function for the type MockProjectRepository
-func (_mock *MockProjectRepository) List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, error) {
+func (_mock *MockProjectRepository) List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error) {
 	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
@@ -238,8 +240,9 @@ func (_mock *MockProjectRepository) List(ctx context.Context, filter domain.Proj
 	}
 
 	var r0 []domain.Project
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProjectFilter) ([]domain.Project, error)); ok {
+	var r1 domain.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error)); ok {
 		return returnFunc(ctx, filter)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.ProjectFilter) []domain.Project); ok {
@@ -249,12 +252,17 @@ func (_mock *MockProjectRepository) List(ctx context.Context, filter domain.Proj
 			r0 = ret.Get(0).([]domain.Project)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProjectFilter) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.ProjectFilter) domain.PageInfo); ok {
 		r1 = returnFunc(ctx, filter)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(domain.PageInfo)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.ProjectFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockProjectRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -287,12 +295,12 @@ func (_c *MockProjectRepository_List_Call) Run(run func(ctx context.Context, fil
 	return _c
 }
 
-func (_c *MockProjectRepository_List_Call) Return(projects []domain.Project, err error) *MockProjectRepository_List_Call {
-	_c.Call.Return(projects, err)
+func (_c *MockProjectRepository_List_Call) Return(projects []domain.Project, pageInfo domain.PageInfo, err error) *MockProjectRepository_List_Call {
+	_c.Call.Return(projects, pageInfo, err)
 	return _c
 }
 
-func (_c *MockProjectRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, error)) *MockProjectRepository_List_Call {
+func (_c *MockProjectRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error)) *MockProjectRepository_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -433,6 +441,313 @@ func (_c *MockProjectRepository_ListByEducationIDs_Call) RunAndReturn(run func(c
 	return _c
 }
 
+// ListBySkillID provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) ListBySkillID(ctx context.Context, skillID string) ([]domain.Project, error) {
+	ret := _mock.Called(ctx, skillID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBySkillID")
+	}
+
+	var r0 []domain.Project
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Project, error)); ok {
+		return returnFunc(ctx, skillID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Project); ok {
+		r0 = returnFunc(ctx, skillID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Project)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, skillID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_ListBySkillID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBySkillID'
+type MockProjectRepository_ListBySkillID_Call struct {
+	*mock.Call
+}
+
+// ListBySkillID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - skillID string
+func (_e *MockProjectRepository_Expecter) ListBySkillID(ctx interface{}, skillID interface{}) *MockProjectRepository_ListBySkillID_Call {
+	return &MockProjectRepository_ListBySkillID_Call{Call: _e.mock.On("ListBySkillID", ctx, skillID)}
+}
+
+func (_c *MockProjectRepository_ListBySkillID_Call) Run(run func(ctx context.Context, skillID string)) *MockProjectRepository_ListBySkillID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_ListBySkillID_Call) Return(projects []domain.Project, err error) *MockProjectRepository_ListBySkillID_Call {
+	_c.Call.Return(projects, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_ListBySkillID_Call) RunAndReturn(run func(ctx context.Context, skillID string) ([]domain.Project, error)) *MockProjectRepository_ListBySkillID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTags provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) ListTags(ctx context.Context) ([]domain.TagCount, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTags")
+	}
+
+	var r0 []domain.TagCount
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.TagCount, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.TagCount); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.TagCount)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_ListTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTags'
+type MockProjectRepository_ListTags_Call struct {
+	*mock.Call
+}
+
+// ListTags is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjectRepository_Expecter) ListTags(ctx interface{}) *MockProjectRepository_ListTags_Call {
+	return &MockProjectRepository_ListTags_Call{Call: _e.mock.On("ListTags", ctx)}
+}
+
+func (_c *MockProjectRepository_ListTags_Call) Run(run func(ctx context.Context)) *MockProjectRepository_ListTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_ListTags_Call) Return(tagCounts []domain.TagCount, err error) *MockProjectRepository_ListTags_Call {
+	_c.Call.Return(tagCounts, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_ListTags_Call) RunAndReturn(run func(ctx context.Context) ([]domain.TagCount, error)) *MockProjectRepository_ListTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) Purge(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockProjectRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockProjectRepository_Expecter) Purge(ctx interface{}, id interface{}) *MockProjectRepository_Purge_Call {
+	return &MockProjectRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, id)}
+}
+
+func (_c *MockProjectRepository_Purge_Call) Run(run func(ctx context.Context, id string)) *MockProjectRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Purge_Call) Return(err error) *MockProjectRepository_Purge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockProjectRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) Restore(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockProjectRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockProjectRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockProjectRepository_Restore_Call {
+	return &MockProjectRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockProjectRepository_Restore_Call) Run(run func(ctx context.Context, id string)) *MockProjectRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Restore_Call) Return(err error) *MockProjectRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockProjectRepository_Restore_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetSkills provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) SetSkills(ctx context.Context, projectID string, skillIDs []string) error {
+	ret := _mock.Called(ctx, projectID, skillIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSkills")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, projectID, skillIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProjectRepository_SetSkills_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSkills'
+type MockProjectRepository_SetSkills_Call struct {
+	*mock.Call
+}
+
+// SetSkills is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectID string
+//   - skillIDs []string
+func (_e *MockProjectRepository_Expecter) SetSkills(ctx interface{}, projectID interface{}, skillIDs interface{}) *MockProjectRepository_SetSkills_Call {
+	return &MockProjectRepository_SetSkills_Call{Call: _e.mock.On("SetSkills", ctx, projectID, skillIDs)}
+}
+
+func (_c *MockProjectRepository_SetSkills_Call) Run(run func(ctx context.Context, projectID string, skillIDs []string)) *MockProjectRepository_SetSkills_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_SetSkills_Call) Return(err error) *MockProjectRepository_SetSkills_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProjectRepository_SetSkills_Call) RunAndReturn(run func(ctx context.Context, projectID string, skillIDs []string) error) *MockProjectRepository_SetSkills_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type MockProjectRepository
 func (_mock *MockProjectRepository) Update(ctx context.Context, project *domain.Project) (*domain.Project, error) {
 	ret := _mock.Called(ctx, project)
@@ -500,3 +815,116 @@ func (_c *MockProjectRepository_Update_Call) RunAndReturn(run func(ctx context.C
 	_c.Call.Return(run)
 	return _c
 }
+
+// Version provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) Version(ctx context.Context) (domain.CollectionVersion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 domain.CollectionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.CollectionVersion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.CollectionVersion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.CollectionVersion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProjectRepository_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockProjectRepository_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockProjectRepository_Expecter) Version(ctx interface{}) *MockProjectRepository_Version_Call {
+	return &MockProjectRepository_Version_Call{Call: _e.mock.On("Version", ctx)}
+}
+
+func (_c *MockProjectRepository_Version_Call) Run(run func(ctx context.Context)) *MockProjectRepository_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_Version_Call) Return(collectionVersion domain.CollectionVersion, err error) *MockProjectRepository_Version_Call {
+	_c.Call.Return(collectionVersion, err)
+	return _c
+}
+
+func (_c *MockProjectRepository_Version_Call) RunAndReturn(run func(ctx context.Context) (domain.CollectionVersion, error)) *MockProjectRepository_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function for the type MockProjectRepository
+func (_mock *MockProjectRepository) WithTx(tx database.Tx) v1.ProjectRepository {
+	ret := _mock.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 v1.ProjectRepository
+	if returnFunc, ok := ret.Get(0).(func(database.Tx) v1.ProjectRepository); ok {
+		r0 = returnFunc(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.ProjectRepository)
+		}
+	}
+	return r0
+}
+
+// MockProjectRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockProjectRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx database.Tx
+func (_e *MockProjectRepository_Expecter) WithTx(tx interface{}) *MockProjectRepository_WithTx_Call {
+	return &MockProjectRepository_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *MockProjectRepository_WithTx_Call) Run(run func(tx database.Tx)) *MockProjectRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 database.Tx
+		if args[0] != nil {
+			arg0 = args[0].(database.Tx)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProjectRepository_WithTx_Call) Return(projectRepository v1.ProjectRepository) *MockProjectRepository_WithTx_Call {
+	_c.Call.Return(projectRepository)
+	return _c
+}
+
+func (_c *MockProjectRepository_WithTx_Call) RunAndReturn(run func(tx database.Tx) v1.ProjectRepository) *MockProjectRepository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
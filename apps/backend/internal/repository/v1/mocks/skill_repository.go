@@ -7,7 +7,9 @@ package v1
 import (
 	"context"
 
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -161,6 +163,74 @@ func (_c *MockSkillRepository_Delete_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// FindMissing provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) FindMissing(ctx context.Context, ids []string) ([]string, error) {
+	ret := _mock.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindMissing")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) ([]string, error)); ok {
+		return returnFunc(ctx, ids)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) []string); ok {
+		r0 = returnFunc(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillRepository_FindMissing_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindMissing'
+type MockSkillRepository_FindMissing_Call struct {
+	*mock.Call
+}
+
+// FindMissing is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *MockSkillRepository_Expecter) FindMissing(ctx interface{}, ids interface{}) *MockSkillRepository_FindMissing_Call {
+	return &MockSkillRepository_FindMissing_Call{Call: _e.mock.On("FindMissing", ctx, ids)}
+}
+
+func (_c *MockSkillRepository_FindMissing_Call) Run(run func(ctx context.Context, ids []string)) *MockSkillRepository_FindMissing_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_FindMissing_Call) Return(ss []string, err error) *MockSkillRepository_FindMissing_Call {
+	_c.Call.Return(ss, err)
+	return _c
+}
+
+func (_c *MockSkillRepository_FindMissing_Call) RunAndReturn(run func(ctx context.Context, ids []string) ([]string, error)) *MockSkillRepository_FindMissing_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Get provides a mock function for the type MockSkillRepository
 func (_mock *MockSkillRepository) Get(ctx context.Context, id string) (*domain.Skill, error) {
 	ret := _mock.Called(ctx, id)
@@ -230,7 +300,7 @@ func (_c *MockSkillRepository_Get_Call) RunAndReturn(run func(ctx context.Contex
 }
 
 // List provides a mock function for the type MockSkillRepository
-func (_mock *MockSkillRepository) List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, error) {
+func (_mock *MockSkillRepository) List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error) {
 	ret := _mock.Called(ctx, filter)
 
 	if len(ret) == 0 {
@@ -238,8 +308,9 @@ func (_mock *MockSkillRepository) List(ctx context.Context, filter domain.SkillF
 	}
 
 	var r0 []domain.Skill
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SkillFilter) ([]domain.Skill, error)); ok {
+	var r1 domain.PageInfo
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error)); ok {
 		return returnFunc(ctx, filter)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SkillFilter) []domain.Skill); ok {
@@ -249,12 +320,17 @@ func (_mock *MockSkillRepository) List(ctx context.Context, filter domain.SkillF
 			r0 = ret.Get(0).([]domain.Skill)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SkillFilter) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, domain.SkillFilter) domain.PageInfo); ok {
 		r1 = returnFunc(ctx, filter)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(domain.PageInfo)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, domain.SkillFilter) error); ok {
+		r2 = returnFunc(ctx, filter)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockSkillRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
@@ -287,12 +363,262 @@ func (_c *MockSkillRepository_List_Call) Run(run func(ctx context.Context, filte
 	return _c
 }
 
-func (_c *MockSkillRepository_List_Call) Return(skills []domain.Skill, err error) *MockSkillRepository_List_Call {
-	_c.Call.Return(skills, err)
+func (_c *MockSkillRepository_List_Call) Return(skills []domain.Skill, pageInfo domain.PageInfo, err error) *MockSkillRepository_List_Call {
+	_c.Call.Return(skills, pageInfo, err)
 	return _c
 }
 
-func (_c *MockSkillRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, error)) *MockSkillRepository_List_Call {
+func (_c *MockSkillRepository_List_Call) RunAndReturn(run func(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error)) *MockSkillRepository_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByExperienceIDs provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) ListByExperienceIDs(ctx context.Context, experienceIDs []string) (map[string][]domain.Skill, error) {
+	ret := _mock.Called(ctx, experienceIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByExperienceIDs")
+	}
+
+	var r0 map[string][]domain.Skill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string][]domain.Skill, error)); ok {
+		return returnFunc(ctx, experienceIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string][]domain.Skill); ok {
+		r0 = returnFunc(ctx, experienceIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]domain.Skill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, experienceIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillRepository_ListByExperienceIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByExperienceIDs'
+type MockSkillRepository_ListByExperienceIDs_Call struct {
+	*mock.Call
+}
+
+// ListByExperienceIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - experienceIDs []string
+func (_e *MockSkillRepository_Expecter) ListByExperienceIDs(ctx interface{}, experienceIDs interface{}) *MockSkillRepository_ListByExperienceIDs_Call {
+	return &MockSkillRepository_ListByExperienceIDs_Call{Call: _e.mock.On("ListByExperienceIDs", ctx, experienceIDs)}
+}
+
+func (_c *MockSkillRepository_ListByExperienceIDs_Call) Run(run func(ctx context.Context, experienceIDs []string)) *MockSkillRepository_ListByExperienceIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_ListByExperienceIDs_Call) Return(stringToSkills map[string][]domain.Skill, err error) *MockSkillRepository_ListByExperienceIDs_Call {
+	_c.Call.Return(stringToSkills, err)
+	return _c
+}
+
+func (_c *MockSkillRepository_ListByExperienceIDs_Call) RunAndReturn(run func(ctx context.Context, experienceIDs []string) (map[string][]domain.Skill, error)) *MockSkillRepository_ListByExperienceIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByProjectIDs provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) ListByProjectIDs(ctx context.Context, projectIDs []string) (map[string][]domain.Skill, error) {
+	ret := _mock.Called(ctx, projectIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByProjectIDs")
+	}
+
+	var r0 map[string][]domain.Skill
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) (map[string][]domain.Skill, error)); ok {
+		return returnFunc(ctx, projectIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, []string) map[string][]domain.Skill); ok {
+		r0 = returnFunc(ctx, projectIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]domain.Skill)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = returnFunc(ctx, projectIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillRepository_ListByProjectIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByProjectIDs'
+type MockSkillRepository_ListByProjectIDs_Call struct {
+	*mock.Call
+}
+
+// ListByProjectIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - projectIDs []string
+func (_e *MockSkillRepository_Expecter) ListByProjectIDs(ctx interface{}, projectIDs interface{}) *MockSkillRepository_ListByProjectIDs_Call {
+	return &MockSkillRepository_ListByProjectIDs_Call{Call: _e.mock.On("ListByProjectIDs", ctx, projectIDs)}
+}
+
+func (_c *MockSkillRepository_ListByProjectIDs_Call) Run(run func(ctx context.Context, projectIDs []string)) *MockSkillRepository_ListByProjectIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 []string
+		if args[1] != nil {
+			arg1 = args[1].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_ListByProjectIDs_Call) Return(stringToSkills map[string][]domain.Skill, err error) *MockSkillRepository_ListByProjectIDs_Call {
+	_c.Call.Return(stringToSkills, err)
+	return _c
+}
+
+func (_c *MockSkillRepository_ListByProjectIDs_Call) RunAndReturn(run func(ctx context.Context, projectIDs []string) (map[string][]domain.Skill, error)) *MockSkillRepository_ListByProjectIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Purge provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) Purge(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Purge")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSkillRepository_Purge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Purge'
+type MockSkillRepository_Purge_Call struct {
+	*mock.Call
+}
+
+// Purge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSkillRepository_Expecter) Purge(ctx interface{}, id interface{}) *MockSkillRepository_Purge_Call {
+	return &MockSkillRepository_Purge_Call{Call: _e.mock.On("Purge", ctx, id)}
+}
+
+func (_c *MockSkillRepository_Purge_Call) Run(run func(ctx context.Context, id string)) *MockSkillRepository_Purge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_Purge_Call) Return(err error) *MockSkillRepository_Purge_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSkillRepository_Purge_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockSkillRepository_Purge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Restore provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) Restore(ctx context.Context, id string) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Restore")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSkillRepository_Restore_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Restore'
+type MockSkillRepository_Restore_Call struct {
+	*mock.Call
+}
+
+// Restore is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *MockSkillRepository_Expecter) Restore(ctx interface{}, id interface{}) *MockSkillRepository_Restore_Call {
+	return &MockSkillRepository_Restore_Call{Call: _e.mock.On("Restore", ctx, id)}
+}
+
+func (_c *MockSkillRepository_Restore_Call) Run(run func(ctx context.Context, id string)) *MockSkillRepository_Restore_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_Restore_Call) Return(err error) *MockSkillRepository_Restore_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSkillRepository_Restore_Call) RunAndReturn(run func(ctx context.Context, id string) error) *MockSkillRepository_Restore_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -364,3 +690,116 @@ func (_c *MockSkillRepository_Update_Call) RunAndReturn(run func(ctx context.Con
 	_c.Call.Return(run)
 	return _c
 }
+
+// Version provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) Version(ctx context.Context) (domain.CollectionVersion, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Version")
+	}
+
+	var r0 domain.CollectionVersion
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (domain.CollectionVersion, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) domain.CollectionVersion); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(domain.CollectionVersion)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSkillRepository_Version_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Version'
+type MockSkillRepository_Version_Call struct {
+	*mock.Call
+}
+
+// Version is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSkillRepository_Expecter) Version(ctx interface{}) *MockSkillRepository_Version_Call {
+	return &MockSkillRepository_Version_Call{Call: _e.mock.On("Version", ctx)}
+}
+
+func (_c *MockSkillRepository_Version_Call) Run(run func(ctx context.Context)) *MockSkillRepository_Version_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_Version_Call) Return(collectionVersion domain.CollectionVersion, err error) *MockSkillRepository_Version_Call {
+	_c.Call.Return(collectionVersion, err)
+	return _c
+}
+
+func (_c *MockSkillRepository_Version_Call) RunAndReturn(run func(ctx context.Context) (domain.CollectionVersion, error)) *MockSkillRepository_Version_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WithTx provides a mock function for the type MockSkillRepository
+func (_mock *MockSkillRepository) WithTx(tx database.Tx) v1.SkillRepository {
+	ret := _mock.Called(tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTx")
+	}
+
+	var r0 v1.SkillRepository
+	if returnFunc, ok := ret.Get(0).(func(database.Tx) v1.SkillRepository); ok {
+		r0 = returnFunc(tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(v1.SkillRepository)
+		}
+	}
+	return r0
+}
+
+// MockSkillRepository_WithTx_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WithTx'
+type MockSkillRepository_WithTx_Call struct {
+	*mock.Call
+}
+
+// WithTx is a helper method to define mock.On call
+//   - tx database.Tx
+func (_e *MockSkillRepository_Expecter) WithTx(tx interface{}) *MockSkillRepository_WithTx_Call {
+	return &MockSkillRepository_WithTx_Call{Call: _e.mock.On("WithTx", tx)}
+}
+
+func (_c *MockSkillRepository_WithTx_Call) Run(run func(tx database.Tx)) *MockSkillRepository_WithTx_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 database.Tx
+		if args[0] != nil {
+			arg0 = args[0].(database.Tx)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSkillRepository_WithTx_Call) Return(skillRepository v1.SkillRepository) *MockSkillRepository_WithTx_Call {
+	_c.Call.Return(skillRepository)
+	return _c
+}
+
+func (_c *MockSkillRepository_WithTx_Call) RunAndReturn(run func(tx database.Tx) v1.SkillRepository) *MockSkillRepository_WithTx_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,251 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+	"github.com/jackc/pgx/v5"
+)
+
+type OutboxRepository interface {
+	Enqueue(ctx context.Context, message *domain.Message, templates ...domain.EmailTemplate) error
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEmail, error)
+	MarkSent(ctx context.Context, id string) error
+	Retry(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+	MarkDead(ctx context.Context, id string, lastError string) error
+	WithTx(tx database.Tx) OutboxRepository
+}
+
+// OutboxRepositoryConfig configures the email outbox. Enqueue stores the
+// message through MessageRepo, which defaults to a repository on
+// MessageTable, in the same transaction as its outbox row.
+type OutboxRepositoryConfig struct {
+	DatabaseAPI  database.DatabaseAPI
+	OutboxTable  string
+	MessageTable string
+	MessageRepo  MessageRepository
+
+	timeProvider domain.TimeProvider
+}
+
+type outboxRepository struct {
+	outboxTable  string
+	messageTable string
+	databaseAPI  database.DatabaseAPI
+	messageRepo  MessageRepository
+	timeProvider domain.TimeProvider
+	tx           database.Tx
+}
+
+// NewOutboxRepository creates and returns an OutboxRepository that queues
+// emails in cfg.OutboxTable using cfg.DatabaseAPI. If cfg.timeProvider is
+// nil the repository defaults to time.Now.
+func NewOutboxRepository(cfg OutboxRepositoryConfig) OutboxRepository {
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	messageRepo := cfg.MessageRepo
+	if messageRepo == nil {
+		messageRepo = NewMessageRepository(
+			MessageRepositoryConfig{
+				DatabaseAPI:  cfg.DatabaseAPI,
+				MessageTable: cfg.MessageTable,
+				timeProvider: timeProvider,
+			},
+		)
+	}
+
+	return &outboxRepository{
+		outboxTable:  cfg.OutboxTable,
+		messageTable: cfg.MessageTable,
+		databaseAPI:  cfg.DatabaseAPI,
+		messageRepo:  messageRepo,
+		timeProvider: timeProvider,
+	}
+}
+
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool.
+func (r *outboxRepository) WithTx(tx database.Tx) OutboxRepository {
+	txRepo := *r
+	txRepo.tx = tx
+	return &txRepo
+}
+
+// querier returns the transaction the repository is bound to, or the
+// connection pool when it has none.
+func (r *outboxRepository) querier() database.Querier {
+	if r.tx != nil {
+		return r.tx
+	}
+	return r.databaseAPI
+}
+
+// Enqueue stores message, as MessageRepository.Create does, and queues one
+// email per template for immediate delivery; only the owner notification
+// when no template is given. Everything happens in one transaction, so a
+// message is never stored without being queued.
+func (r *outboxRepository) Enqueue(ctx context.Context, message *domain.Message, templates ...domain.EmailTemplate) error {
+	if len(templates) == 0 {
+		templates = []domain.EmailTemplate{domain.EmailOwnerNotification}
+	}
+	for _, template := range templates {
+		if !template.IsValid() {
+			return fmt.Errorf("failed to enqueue email: template %q invalid", template)
+		}
+	}
+
+	enqueue := func(tx database.Tx) error {
+		if err := r.messageRepo.WithTx(tx).Create(ctx, message); err != nil {
+			return err
+		}
+
+		query := fmt.Sprintf(
+			`INSERT INTO %s
+			(id, message_id, template, status, next_attempt_at, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $5, $5)`,
+			r.outboxTable,
+		)
+
+		for _, template := range templates {
+			if _, err := tx.Exec(ctx, query, utils.GenerateKey(), message.ID, template, domain.OutboxPending, message.CreatedAt); err != nil {
+				return fmt.Errorf("failed to enqueue email: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	if r.tx != nil {
+		return enqueue(r.tx)
+	}
+	return r.databaseAPI.WithTx(ctx, enqueue)
+}
+
+// Claim returns up to limit pending emails that are due, oldest first, along
+// with the message each one delivers. Claimed emails are not due again until
+// lease has passed, so an email whose worker stops before recording the
+// attempt is retried instead of lost. Rows claimed by a concurrent worker
+// are skipped.
+//
+// Returns:
+//   - ([]domain.OutboxEmail, nil) on success; the slice is empty when nothing is due.
+//   - (nil, error) on validation, query, scan, or row iteration failures.
+func (r *outboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.OutboxEmail, error) {
+	if limit <= 0 {
+		return nil, errors.New("failed to claim emails: limit must be positive")
+	}
+
+	now := r.timeProvider()
+
+	query := fmt.Sprintf(
+		`UPDATE %s AS o
+		SET next_attempt_at = $2, updated_at = $1
+		FROM %s AS m
+		WHERE o.id IN (
+			SELECT id
+			FROM %s
+			WHERE status = $4 AND next_attempt_at <= $1
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		AND m.id = o.message_id
+		RETURNING o.id, o.message_id, o.template, o.status, o.attempts, o.next_attempt_at, COALESCE(o.last_error, ''),
+			o.created_at, o.updated_at, m.name, m.email, m.subject, m.message`,
+		r.outboxTable,
+		r.messageTable,
+		r.outboxTable,
+	)
+
+	rows, err := r.querier().Query(ctx, query, now, now.Add(lease), limit, domain.OutboxPending)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim emails: %w", err)
+	}
+	defer rows.Close()
+
+	emails := []domain.OutboxEmail{}
+	for rows.Next() {
+		var email domain.OutboxEmail
+
+		err := rows.Scan(
+			&email.ID,
+			&email.MessageID,
+			&email.Template,
+			&email.Status,
+			&email.Attempts,
+			&email.NextAttemptAt,
+			&email.LastError,
+			&email.CreatedAt,
+			&email.UpdatedAt,
+			&email.Email.Name,
+			&email.Email.Email,
+			&email.Email.Subject,
+			&email.Email.Message,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan email: %w", err)
+		}
+
+		emails = append(emails, email)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return emails, nil
+}
+
+// MarkSent records a successful delivery of the email id.
+func (r *outboxRepository) MarkSent(ctx context.Context, id string) error {
+	return r.recordAttempt(ctx, id, domain.OutboxSent, "", r.timeProvider())
+}
+
+// Retry records a failed delivery of the email id, which is attempted again
+// at nextAttemptAt.
+func (r *outboxRepository) Retry(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	return r.recordAttempt(ctx, id, domain.OutboxPending, lastError, nextAttemptAt)
+}
+
+// MarkDead records a failed delivery of the email id and gives up on it.
+func (r *outboxRepository) MarkDead(ctx context.Context, id string, lastError string) error {
+	return r.recordAttempt(ctx, id, domain.OutboxDead, lastError, r.timeProvider())
+}
+
+// recordAttempt moves the email id to status after a delivery attempt. The
+// attempt count goes up by one. It returns pgx.ErrNoRows (wrapped) when
+// there is no such email.
+func (r *outboxRepository) recordAttempt(ctx context.Context, id string, status domain.OutboxStatus, lastError string, nextAttemptAt time.Time) error {
+	if id == "" {
+		return errors.New("failed to record email attempt: ID missing")
+	}
+
+	query := fmt.Sprintf(
+		`UPDATE %s
+		SET status = $2,
+			attempts = attempts + 1,
+			last_error = NULLIF($3, ''),
+			next_attempt_at = $4,
+			updated_at = $5
+		WHERE id = $1`,
+		r.outboxTable,
+	)
+
+	cmdTag, err := r.querier().Exec(ctx, query, id, status, lastError, nextAttemptAt, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to record email attempt: %w", err)
+	}
+	if cmdTag == nil || cmdTag.RowsAffected() == 0 {
+		return fmt.Errorf("failed to record email attempt: %w", pgx.ErrNoRows)
+	}
+
+	return nil
+}
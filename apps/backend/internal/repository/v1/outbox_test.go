@@ -0,0 +1,471 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	databaseMocks "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testOutboxTable = "test-email-outbox"
+
+type outboxFakeRows struct {
+	emails  []domain.OutboxEmail
+	index   int
+	scanErr error
+	rowErr  error
+}
+
+func (r *outboxFakeRows) Next() bool {
+	return r.index < len(r.emails)
+}
+
+func (r *outboxFakeRows) Scan(dest ...any) error {
+	if r.scanErr != nil {
+		return r.scanErr
+	}
+	if len(dest) != 13 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	email := r.emails[r.index]
+	r.index++
+
+	*dest[0].(*string) = email.ID
+	*dest[1].(*string) = email.MessageID
+	*dest[2].(*domain.EmailTemplate) = email.Template
+	*dest[3].(*domain.OutboxStatus) = email.Status
+	*dest[4].(*int) = email.Attempts
+	*dest[5].(*time.Time) = email.NextAttemptAt
+	*dest[6].(*string) = email.LastError
+	*dest[7].(*time.Time) = email.CreatedAt
+	*dest[8].(*time.Time) = email.UpdatedAt
+	*dest[9].(*string) = email.Email.Name
+	*dest[10].(*string) = email.Email.Email
+	*dest[11].(*string) = email.Email.Subject
+	*dest[12].(*string) = email.Email.Message
+	return nil
+}
+
+func (r *outboxFakeRows) Err() error { return r.rowErr }
+
+func (r *outboxFakeRows) Close() {}
+
+type outboxRepositoryTestFixture struct {
+	databaseAPI      *databaseMocks.MockDatabaseAPI
+	tx               *databaseMocks.MockTx
+	outboxRepository OutboxRepository
+}
+
+func newOutboxRepositoryTestFixture(timeProvider domain.TimeProvider) *outboxRepositoryTestFixture {
+	mockDatabaseAPI := new(databaseMocks.MockDatabaseAPI)
+	mockTx := new(databaseMocks.MockTx)
+
+	// Run transactional callbacks directly against the transaction mock
+	mockDatabaseAPI.EXPECT().
+		WithTx(mock.Anything, mock.Anything).
+		RunAndReturn(func(ctx context.Context, fn func(tx database.Tx) error) error {
+			return fn(mockTx)
+		}).
+		Maybe()
+
+	return &outboxRepositoryTestFixture{
+		databaseAPI: mockDatabaseAPI,
+		tx:          mockTx,
+		outboxRepository: NewOutboxRepository(
+			OutboxRepositoryConfig{
+				DatabaseAPI:  mockDatabaseAPI,
+				OutboxTable:  testOutboxTable,
+				MessageTable: testMessageTable,
+				timeProvider: timeProvider,
+			},
+		),
+	}
+}
+
+func TestOutboxRepository_Enqueue(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	isMessageInsert := func(query string) bool {
+		return strings.Contains(query, "INSERT INTO "+testMessageTable)
+	}
+	isOutboxInsert := func(query string) bool {
+		return strings.Contains(query, "INSERT INTO "+testOutboxTable)
+	}
+
+	isOutboxInsertOf := func(template domain.EmailTemplate) func(args []any) bool {
+		return func(args []any) bool {
+			return len(args) == 5 &&
+				args[0].(string) != "" &&
+				args[1].(string) != "" &&
+				args[2] == template &&
+				args[3] == domain.OutboxPending &&
+				args[4] == fixedTime
+		}
+	}
+
+	type Given struct {
+		message   domain.Message
+		templates []domain.EmailTemplate
+		mockExec  func(m *databaseMocks.MockTx)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Stores the message and queues the owner notification by default": {
+			given: Given{
+				message: domain.NewMessage(validSendEmail()),
+				mockExec: func(m *databaseMocks.MockTx) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isMessageInsert), mock.Anything).
+						Return(nil, nil).
+						Once()
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isOutboxInsert), mock.MatchedBy(isOutboxInsertOf(domain.EmailOwnerNotification))).
+						Return(nil, nil).
+						Once()
+				},
+			},
+		},
+		"Queues one email per template": {
+			given: Given{
+				message:   domain.NewMessage(validSendEmail()),
+				templates: []domain.EmailTemplate{domain.EmailOwnerNotification, domain.EmailAutoReply},
+				mockExec: func(m *databaseMocks.MockTx) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isMessageInsert), mock.Anything).
+						Return(nil, nil).
+						Once()
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isOutboxInsert), mock.MatchedBy(isOutboxInsertOf(domain.EmailOwnerNotification))).
+						Return(nil, nil).
+						Once()
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isOutboxInsert), mock.MatchedBy(isOutboxInsertOf(domain.EmailAutoReply))).
+						Return(nil, nil).
+						Once()
+				},
+			},
+		},
+		"Invalid template": {
+			given: Given{
+				message:   domain.NewMessage(validSendEmail()),
+				templates: []domain.EmailTemplate{"newsletter"},
+			},
+			expected: Expected{err: errors.New(`failed to enqueue email: template "newsletter" invalid`)},
+		},
+		"Invalid message": {
+			given:    Given{message: domain.Message{Name: "Jane Doe", Subject: "Hello"}},
+			expected: Expected{err: errors.New("failed to validate message: email missing")},
+		},
+		"Message insert fails": {
+			given: Given{
+				message: domain.NewMessage(validSendEmail()),
+				mockExec: func(m *databaseMocks.MockTx) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isMessageInsert), mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to create message: %w", execErr)},
+		},
+		"Outbox insert fails": {
+			given: Given{
+				message: domain.NewMessage(validSendEmail()),
+				mockExec: func(m *databaseMocks.MockTx) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isMessageInsert), mock.Anything).
+						Return(nil, nil)
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isOutboxInsert), mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to enqueue email: %w", execErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newOutboxRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.tx)
+			}
+
+			err := f.outboxRepository.Enqueue(context.Background(), &test.given.message, test.given.templates...)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, test.given.message.ID)
+			}
+
+			f.tx.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOutboxRepository_Enqueue_BoundToTx(t *testing.T) {
+	f := newOutboxRepositoryTestFixture(nil)
+	callerTx := new(databaseMocks.MockTx)
+	callerTx.EXPECT().Exec(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Twice()
+
+	message := domain.NewMessage(validSendEmail())
+	err := f.outboxRepository.WithTx(callerTx).Enqueue(context.Background(), &message)
+
+	assert.NoError(t, err)
+	callerTx.AssertExpectations(t)
+	f.databaseAPI.AssertNotCalled(t, "WithTx", mock.Anything, mock.Anything)
+}
+
+func TestOutboxRepository_Claim(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+
+	claimed := domain.OutboxEmail{
+		ID:            "email-1",
+		MessageID:     "message-1",
+		Template:      domain.EmailAutoReply,
+		Status:        domain.OutboxPending,
+		Attempts:      2,
+		NextAttemptAt: fixedTime.Add(10 * time.Minute),
+		LastError:     "bad gateway",
+		Email:         validSendEmail(),
+		CreatedAt:     fixedTime.Add(-time.Hour),
+		UpdatedAt:     fixedTime,
+	}
+
+	type Given struct {
+		limit     int
+		mockQuery func(m *databaseMocks.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		emails []domain.OutboxEmail
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Claims the due emails": {
+			given: Given{
+				limit: 10,
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testOutboxTable) &&
+									strings.Contains(query, "FROM "+testMessageTable) &&
+									strings.Contains(query, "FOR UPDATE SKIP LOCKED")
+							}),
+							[]any{fixedTime, fixedTime.Add(10 * time.Minute), 10, domain.OutboxPending},
+						).
+						Return(&outboxFakeRows{emails: []domain.OutboxEmail{claimed}}, nil)
+				},
+			},
+			expected: Expected{emails: []domain.OutboxEmail{claimed}},
+		},
+		"Nothing due": {
+			given: Given{
+				limit: 10,
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&outboxFakeRows{}, nil)
+				},
+			},
+			expected: Expected{emails: []domain.OutboxEmail{}},
+		},
+		"Invalid limit": {
+			expected: Expected{err: errors.New("failed to claim emails: limit must be positive")},
+		},
+		"Query fails": {
+			given: Given{
+				limit: 10,
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to claim emails: %w", queryErr)},
+		},
+		"Row iteration fails": {
+			given: Given{
+				limit: 10,
+				mockQuery: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&outboxFakeRows{rowErr: queryErr}, nil)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("row iteration error: %w", queryErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newOutboxRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			emails, err := f.outboxRepository.Claim(context.Background(), test.given.limit, 10*time.Minute)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, emails)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.emails, emails)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestOutboxRepository_RecordAttempt(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	retryAt := fixedTime.Add(time.Minute)
+	execErr := errors.New("exec error")
+
+	type Given struct {
+		record   func(r OutboxRepository) error
+		mockExec func(m *databaseMocks.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	expectUpdate := func(args []any) func(m *databaseMocks.MockDatabaseAPI) {
+		return func(m *databaseMocks.MockDatabaseAPI) {
+			m.EXPECT().
+				Exec(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "UPDATE "+testOutboxTable) &&
+							strings.Contains(query, "attempts = attempts + 1")
+					}),
+					args,
+				).
+				Return(messageFakeCommandTag(1), nil)
+		}
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Marks sent": {
+			given: Given{
+				record: func(r OutboxRepository) error {
+					return r.MarkSent(context.Background(), "email-1")
+				},
+				mockExec: expectUpdate([]any{"email-1", domain.OutboxSent, "", fixedTime, fixedTime}),
+			},
+		},
+		"Schedules a retry": {
+			given: Given{
+				record: func(r OutboxRepository) error {
+					return r.Retry(context.Background(), "email-1", "bad gateway", retryAt)
+				},
+				mockExec: expectUpdate([]any{"email-1", domain.OutboxPending, "bad gateway", retryAt, fixedTime}),
+			},
+		},
+		"Marks dead": {
+			given: Given{
+				record: func(r OutboxRepository) error {
+					return r.MarkDead(context.Background(), "email-1", "bad gateway")
+				},
+				mockExec: expectUpdate([]any{"email-1", domain.OutboxDead, "bad gateway", fixedTime, fixedTime}),
+			},
+		},
+		"Not found": {
+			given: Given{
+				record: func(r OutboxRepository) error {
+					return r.MarkSent(context.Background(), "missing")
+				},
+				mockExec: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(messageFakeCommandTag(0), nil)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to record email attempt: %w", pgx.ErrNoRows)},
+		},
+		"Exec fails": {
+			given: Given{
+				record: func(r OutboxRepository) error {
+					return r.MarkDead(context.Background(), "email-1", "bad gateway")
+				},
+				mockExec: func(m *databaseMocks.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to record email attempt: %w", execErr)},
+		},
+		"Missing ID": {
+			given: Given{
+				record: func(r OutboxRepository) error {
+					return r.MarkSent(context.Background(), "")
+				},
+			},
+			expected: Expected{err: errors.New("failed to record email attempt: ID missing")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newOutboxRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := test.given.record(f.outboxRepository)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func validSendEmail() domain.SendEmail {
+	return domain.SendEmail{
+		Name:    "Jane Doe",
+		Email:   "jane@example.com",
+		Subject: "Hello",
+		Message: "Nice portfolio!",
+	}
+}
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+)
+
+// cursorCondition returns the WHERE fragment that starts a keyset page right
+// after cursor. Rows are compared on (created_at, id) in the direction of the
+// sort, so the database can seek through the index instead of scanning and
+// discarding OFFSET rows. The fragment uses placeholders $argIdx and $argIdx+1.
+func cursorCondition(cursor domain.Cursor, ascending bool, argIdx int) (string, []any) {
+	op := "<"
+	if ascending {
+		op = ">"
+	}
+
+	return fmt.Sprintf("(created_at, id) %s ($%d, $%d)", op, argIdx, argIdx+1), []any{cursor.CreatedAt, cursor.ID}
+}
+
+// newPageInfo builds the pagination metadata for a page that was fetched with
+// LIMIT pageSize+1. fetched is the number of rows the query returned, so a
+// value above pageSize means at least one more page exists. When the rows are
+// ordered by created_at, lastCreatedAt and lastID (the final row kept on the
+// page) are encoded as the cursor for the next page.
+func newPageInfo(filterPage, pageSize int32, total int64, fetched int, orderedByCreatedAt bool, lastCreatedAt time.Time, lastID string) domain.PageInfo {
+	info := domain.PageInfo{
+		Total:    total,
+		Page:     filterPage,
+		PageSize: pageSize,
+		HasNext:  fetched > int(pageSize),
+	}
+
+	if info.HasNext && orderedByCreatedAt {
+		info.NextCursor = domain.Cursor{CreatedAt: lastCreatedAt, ID: lastID}.Encode()
+	}
+
+	return info
+}
@@ -0,0 +1,166 @@
+package v1
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// countFakeRow is for the COUNT(*) QueryRow issued by every List
+type countFakeRow struct {
+	count   int64
+	scanErr error
+}
+
+func (f *countFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 1 {
+		return fmt.Errorf("expected 1 scan destination, got %d", len(dest))
+	}
+	v, ok := dest[0].(*int64)
+	if !ok {
+		return fmt.Errorf("expected *int64 for count, got %T", dest[0])
+	}
+	*v = f.count
+	return nil
+}
+
+// isCountQuery matches the total-count query issued by List
+func isCountQuery(query string) bool {
+	return strings.HasPrefix(query, "SELECT COUNT(*)")
+}
+
+// expectCount registers row as the result of the List count query, whether it
+// is issued with or without filter args.
+func expectCount(m *database.MockDatabaseAPI, row *countFakeRow) {
+	m.EXPECT().
+		QueryRow(mock.Anything, mock.MatchedBy(isCountQuery)).
+		Return(row).
+		Maybe()
+	m.EXPECT().
+		QueryRow(mock.Anything, mock.MatchedBy(isCountQuery), mock.Anything).
+		Return(row).
+		Maybe()
+}
+
+func TestCursorCondition(t *testing.T) {
+	cursor := domain.Cursor{
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+		ID:        "abc",
+	}
+
+	type Given struct {
+		ascending bool
+		argIdx    int
+	}
+
+	type Expected struct {
+		condition string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Descending seeks backwards": {
+			given: Given{ascending: false, argIdx: 1},
+			expected: Expected{
+				condition: "(created_at, id) < ($1, $2)",
+			},
+		},
+		"Ascending seeks forwards": {
+			given: Given{ascending: true, argIdx: 3},
+			expected: Expected{
+				condition: "(created_at, id) > ($3, $4)",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			condition, args := cursorCondition(cursor, test.given.ascending, test.given.argIdx)
+
+			assert.Equal(t, test.expected.condition, condition)
+			assert.Equal(t, []any{cursor.CreatedAt, cursor.ID}, args)
+		})
+	}
+}
+
+func TestNewPageInfo(t *testing.T) {
+	lastCreatedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type Given struct {
+		fetched            int
+		orderedByCreatedAt bool
+	}
+
+	type Expected struct {
+		pageInfo domain.PageInfo
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Last page": {
+			given: Given{fetched: 2, orderedByCreatedAt: true},
+			expected: Expected{
+				pageInfo: domain.PageInfo{Total: 12, Page: 2, PageSize: 10},
+			},
+		},
+		"More rows follow": {
+			given: Given{fetched: 11, orderedByCreatedAt: true},
+			expected: Expected{
+				pageInfo: domain.PageInfo{
+					Total:      12,
+					Page:       2,
+					PageSize:   10,
+					HasNext:    true,
+					NextCursor: domain.Cursor{CreatedAt: lastCreatedAt, ID: "last"}.Encode(),
+				},
+			},
+		},
+		"More rows follow without keyset order": {
+			given: Given{fetched: 11, orderedByCreatedAt: false},
+			expected: Expected{
+				pageInfo: domain.PageInfo{Total: 12, Page: 2, PageSize: 10, HasNext: true},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			pageInfo := newPageInfo(2, 10, 12, test.given.fetched, test.given.orderedByCreatedAt, lastCreatedAt, "last")
+
+			assert.Equal(t, test.expected.pageInfo, pageInfo)
+		})
+	}
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := domain.Cursor{
+		CreatedAt: time.Date(2025, 1, 1, 12, 0, 0, 123456789, time.UTC),
+		ID:        "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b",
+	}
+
+	decoded, err := domain.DecodeCursor(cursor.Encode())
+
+	assert.NoError(t, err)
+	assert.Equal(t, cursor.ID, decoded.ID)
+	assert.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+
+	_, err = domain.DecodeCursor("not-base64!")
+	assert.EqualError(t, err, "cursor encoding invalid")
+
+	tampered := domain.Cursor{CreatedAt: cursor.CreatedAt, ID: "1' OR '1'='1"}
+	_, err = domain.DecodeCursor(tampered.Encode())
+	assert.EqualError(t, err, "cursor format invalid")
+}
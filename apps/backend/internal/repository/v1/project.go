@@ -20,24 +20,33 @@ type ProjectRepository interface {
 	Get(ctx context.Context, id string) (*domain.Project, error)
 	Update(ctx context.Context, project *domain.Project) (*domain.Project, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+	List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error)
 	ListByEducationID(ctx context.Context, educationID string) ([]domain.Project, error)
 	ListByEducationIDs(ctx context.Context, educationIDs []string) (map[string][]domain.Project, error)
+	ListTags(ctx context.Context) ([]domain.TagCount, error)
+	ListBySkillID(ctx context.Context, skillID string) ([]domain.Project, error)
+	SetSkills(ctx context.Context, projectID string, skillIDs []string) error
+	Version(ctx context.Context) (domain.CollectionVersion, error)
+	WithTx(tx database.Tx) ProjectRepository
 }
 
 type ProjectRepositoryConfig struct {
-	DatabaseAPI  database.DatabaseAPI
-	BlurHashAPI  metadata.BlurHashAPI
-	ProjectTable string
+	DatabaseAPI       database.DatabaseAPI
+	BlurHashAPI       metadata.BlurHashAPI
+	ProjectTable      string
+	ProjectSkillTable string
 
 	timeProvider domain.TimeProvider
 }
 
 type projectRepository struct {
-	projectTable string
-	databaseAPI  database.DatabaseAPI
-	blurHashAPI  metadata.BlurHashAPI
-	timeProvider domain.TimeProvider
+	projectTable      string
+	projectSkillTable string
+	databaseAPI       database.Querier
+	blurHashAPI       metadata.BlurHashAPI
+	timeProvider      domain.TimeProvider
 }
 
 // NewProjectRepository creates and returns a new instance of ProjectRepository.
@@ -58,13 +67,23 @@ func NewProjectRepository(cfg ProjectRepositoryConfig) ProjectRepository {
 	}
 
 	return &projectRepository{
-		projectTable: cfg.ProjectTable,
-		databaseAPI:  cfg.DatabaseAPI,
-		blurHashAPI:  blurHashAPI,
-		timeProvider: timeProvider,
+		projectTable:      cfg.ProjectTable,
+		projectSkillTable: cfg.ProjectSkillTable,
+		databaseAPI:       cfg.DatabaseAPI,
+		blurHashAPI:       blurHashAPI,
+		timeProvider:      timeProvider,
 	}
 }
 
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool, so several repository calls can be
+// committed or rolled back together.
+func (r *projectRepository) WithTx(tx database.Tx) ProjectRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
 // Create validates and persists a new project, returning the newly generated project ID.
 //
 // The method performs the following steps:
@@ -94,8 +113,8 @@ func (r *projectRepository) Create(ctx context.Context, project *domain.Project)
 
 	query := fmt.Sprintf(
 		`INSERT INTO %s
-		(id, blur_hash, title, sub_title, description, tags, type, link, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		(id, blur_hash, title, sub_title, description, tags, type, link, education_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
 		RETURNING id`,
 		r.projectTable,
 	)
@@ -112,6 +131,7 @@ func (r *projectRepository) Create(ctx context.Context, project *domain.Project)
 		project.Tags,
 		project.Type,
 		project.Link,
+		toNullString(project.EducationID),
 		project.CreatedAt,
 		project.UpdatedAt,
 	).Scan(&returnedID)
@@ -131,7 +151,8 @@ func (r *projectRepository) Create(ctx context.Context, project *domain.Project)
 // The ctx is used for cancellation and deadlines. The id must be non-empty; if it is
 // empty, Get returns an error indicating a missing ID. On success, it returns a
 // pointer to a validated domain.Project populated from the matching database row.
-// If no row matches the provided id, the returned error will wrap pgx.ErrNoRows.
+// If no live row matches the provided id (trashed projects are skipped), the
+// returned error will wrap pgx.ErrNoRows.
 // Any other query/scan or validation failures are returned wrapped to provide context.
 func (r *projectRepository) Get(ctx context.Context, id string) (*domain.Project, error) {
 	if id == "" {
@@ -139,11 +160,12 @@ func (r *projectRepository) Get(ctx context.Context, id string) (*domain.Project
 	}
 
 	var project domain.Project
+	var educationID sql.NullString
 
 	query := fmt.Sprintf(
-		`SELECT id, blur_hash, title, sub_title, description, tags, type, link, created_at, updated_at
+		`SELECT id, blur_hash, title, sub_title, description, tags, type, link, education_id, created_at, updated_at
 		FROM %s
-		WHERE id = $1`,
+		WHERE id = $1 AND deleted_at IS NULL`,
 		r.projectTable,
 	)
 
@@ -160,6 +182,7 @@ func (r *projectRepository) Get(ctx context.Context, id string) (*domain.Project
 		&project.Tags,
 		&project.Type,
 		&project.Link,
+		&educationID,
 		&project.CreatedAt,
 		&project.UpdatedAt,
 	)
@@ -171,6 +194,10 @@ func (r *projectRepository) Get(ctx context.Context, id string) (*domain.Project
 		return nil, fmt.Errorf("failed to scan project: %w", err)
 	}
 
+	if educationID.Valid {
+		project.EducationID = educationID.String
+	}
+
 	if err := project.ValidateResponse(r.blurHashAPI); err != nil {
 		return nil, fmt.Errorf("invalid project returned: %w", err)
 	}
@@ -182,7 +209,10 @@ func (r *projectRepository) Get(ctx context.Context, id string) (*domain.Project
 // It validates the provided project payload, updates the UpdatedAt timestamp
 // from the repository's time provider, and persists the project's fields to
 // the database. The method returns the updated project as stored in the
-// database. If no row matches the provided id, it returns (nil, nil).
+// database. If no live row matches the provided id, it returns (nil, nil).
+// A non-zero project.UpdatedAt on input is the version the caller read: the
+// row is only written if its updated_at still matches, otherwise a
+// *domain.ConflictError is returned.
 // Validation errors or other database errors are returned (wrapped) to the
 // caller. The provided context is used for database cancellation and timeouts.
 func (r *projectRepository) Update(ctx context.Context, project *domain.Project) (*domain.Project, error) {
@@ -197,10 +227,31 @@ func (r *projectRepository) Update(ctx context.Context, project *domain.Project)
 		return nil, fmt.Errorf("failed to validate project: %w", err)
 	}
 
+	expectedVersion := project.UpdatedAt
 	now := r.timeProvider()
 	project.UpdatedAt = now
 
 	var updatedProject domain.Project
+	var educationID sql.NullString
+
+	args := []any{
+		project.Id,
+		project.BlurHash,
+		project.Title,
+		project.Subtitle,
+		project.Description,
+		project.Tags,
+		project.Type,
+		project.Link,
+		toNullString(project.EducationID),
+		project.UpdatedAt,
+	}
+
+	versionCheck := ""
+	if !expectedVersion.IsZero() {
+		args = append(args, expectedVersion)
+		versionCheck = fmt.Sprintf(" AND updated_at=$%d", len(args))
+	}
 
 	query := fmt.Sprintf(
 		`UPDATE %s
@@ -211,25 +262,15 @@ func (r *projectRepository) Update(ctx context.Context, project *domain.Project)
 			tags=$6,
 			type=$7,
 			link=$8,
-			updated_at=$9
-		WHERE id=$1
-		RETURNING id, blur_hash, title, sub_title, description, tags, type, link, created_at, updated_at`,
+			education_id=$9,
+			updated_at=$10
+		WHERE id=$1 AND deleted_at IS NULL%s
+		RETURNING id, blur_hash, title, sub_title, description, tags, type, link, education_id, created_at, updated_at`,
 		r.projectTable,
+		versionCheck,
 	)
 
-	err := r.databaseAPI.QueryRow(
-		ctx,
-		query,
-		project.Id,
-		project.BlurHash,
-		project.Title,
-		project.Subtitle,
-		project.Description,
-		project.Tags,
-		project.Type,
-		project.Link,
-		project.UpdatedAt,
-	).Scan(
+	err := r.databaseAPI.QueryRow(ctx, query, args...).Scan(
 		&updatedProject.Id,
 		&updatedProject.BlurHash,
 		&updatedProject.Title,
@@ -238,17 +279,25 @@ func (r *projectRepository) Update(ctx context.Context, project *domain.Project)
 		&updatedProject.Tags,
 		&updatedProject.Type,
 		&updatedProject.Link,
+		&educationID,
 		&updatedProject.CreatedAt,
 		&updatedProject.UpdatedAt,
 	)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			if !expectedVersion.IsZero() {
+				return nil, versionConflict(ctx, r.databaseAPI, r.projectTable, "project", project.Id)
+			}
 			return nil, nil
 		}
 		return nil, fmt.Errorf("failed to update project: %w", err)
 	}
 
+	if educationID.Valid {
+		updatedProject.EducationID = educationID.String
+	}
+
 	if err := updatedProject.ValidateResponse(r.blurHashAPI); err != nil {
 		return nil, fmt.Errorf("invalid project returned: %w", err)
 	}
@@ -256,26 +305,28 @@ func (r *projectRepository) Update(ctx context.Context, project *domain.Project)
 	return &updatedProject, nil
 }
 
-// Delete removes a project from the database by its ID.
-// It returns an error if the deletion fails or if no project with the given ID exists.
+// Delete moves a project to the trash by stamping its deleted_at column; the row
+// stays in the database until it is purged and can be brought back with Restore.
+// It returns an error if the update fails or if no live project with the given ID exists.
 //
 // Parameters:
 //   - ctx: The context for controlling cancellation and deadlines.
 //   - id: The unique identifier of the project to delete.
 //
 // Returns:
-//   - error: An error if the operation fails or if no project is found with the specified ID.
+//   - error: An error if the operation fails, or pgx.ErrNoRows if no live project is found with the specified ID.
 func (r *projectRepository) Delete(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("failed to delete project: ID missing")
 	}
 
-	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1", r.projectTable)
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=$2 WHERE id=$1 AND deleted_at IS NULL", r.projectTable)
 
 	cmdTag, err := r.databaseAPI.Exec(
 		ctx,
 		query,
 		id,
+		r.timeProvider(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to delete project: %w", err)
@@ -288,15 +339,68 @@ func (r *projectRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// List retrieves a paginated, optionally filtered and sorted slice of domain.Project from the repository.
-// It takes a context for cancellation and a ProjectFilter that controls filtering, pagination and sorting.
+// Restore takes a project out of the trash by clearing its deleted_at column.
+// updated_at is stamped as well, so the project list reads as modified again.
+// It returns pgx.ErrNoRows if no trashed project with the given ID exists.
+func (r *projectRepository) Restore(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to restore project: ID missing")
+	}
+
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=NULL, updated_at=$2 WHERE id=$1 AND deleted_at IS NOT NULL", r.projectTable)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to restore project: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Purge permanently removes a trashed project. Live projects are left alone, so a
+// project has to go through Delete first. It returns pgx.ErrNoRows if no trashed
+// project with the given ID exists.
+func (r *projectRepository) Purge(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to purge project: ID missing")
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1 AND deleted_at IS NOT NULL", r.projectTable)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to purge project: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// List retrieves a paginated, optionally filtered and sorted slice of domain.Project from the repository,
+// together with the domain.PageInfo describing the full result set.
 // Defaults are applied when values are not provided: Page defaults to 1, PageSize defaults to 20 (and is capped at 20),
-// and SortBy defaults to CreatedAt. If filter.Type is non-nil, results are restricted to that project type.
-// Sorting is applied by the specified field in ascending order by default; set SortAscending to false for descending.
-// Results are limited to PageSize with an offset of (Page-1)*PageSize.
-// The query is executed with parameterized arguments to avoid SQL injection and the returned slice contains
-// mapped domain.Project values. An error is returned if query execution, row scanning, or row iteration fails.
-func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, error) {
+// and SortBy defaults to CreatedAt. If filter.Type is non-nil, results are restricted to that project type,
+// and if filter.EducationID is non-nil, results are restricted to projects linked to that education.
+// If filter.Tags is non-empty, results are restricted by tag: with domain.TagMatchAll a project must carry
+// every tag (tags @> $n), otherwise at least one of them (tags && $n). Both operators use the GIN index on tags.
+// Trashed projects are never listed. Rows are ordered by the sort column and then by id so that pages are stable.
+//
+// Two pagination modes are supported:
+//   - Offset: results are limited to PageSize with an offset of (Page-1)*PageSize.
+//   - Keyset: when filter.Cursor is set, Page is ignored and the page starts right after the cursor's
+//     (created_at, id) pair. Keyset pagination requires sorting by CreatedAt.
+//
+// PageInfo.Total counts every row that matches the filters, independent of the cursor. When more rows follow
+// and the results are ordered by created_at, PageInfo.NextCursor is set to the cursor of the last returned row.
+// An error is returned if the count or list query fails, a row fails to scan, or row iteration fails.
+func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilter) ([]domain.Project, domain.PageInfo, error) {
 	// Set defaults if not provided
 	if filter.Page <= 0 {
 		filter.Page = 1
@@ -308,12 +412,11 @@ func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilte
 		defaultSort := domain.CreatedAt
 		filter.SortBy = &defaultSort
 	}
+	if filter.Cursor != nil && *filter.SortBy != domain.CreatedAt {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list projects: cursor requires sorting by %s", domain.CreatedAt)
+	}
 
-	baseQuery := fmt.Sprintf(
-		`SELECT id, blur_hash, title, sub_title, description, tags, type, link, created_at, updated_at FROM %s`,
-		r.projectTable,
-	)
-	var conditions []string
+	conditions := []string{"deleted_at IS NULL"}
 	var args []any
 	argIdx := 1
 
@@ -324,11 +427,50 @@ func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilte
 		argIdx++
 	}
 
-	// Append WHERE clause if any filters exist
-	if len(conditions) > 0 {
-		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	// Add optional education filter
+	if filter.EducationID != nil {
+		conditions = append(conditions, fmt.Sprintf("education_id = $%d", argIdx))
+		args = append(args, *filter.EducationID)
+		argIdx++
+	}
+
+	// Add optional tags filter
+	if len(filter.Tags) > 0 {
+		var op string
+		switch filter.TagMatch {
+		case domain.TagMatchAll:
+			op = "@>"
+		case domain.TagMatchAny, "":
+			op = "&&"
+		default:
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to list projects: invalid tag match %q", filter.TagMatch)
+		}
+		conditions = append(conditions, fmt.Sprintf("tags %s $%d", op, argIdx))
+		args = append(args, filter.Tags)
+		argIdx++
+	}
+
+	// Count every matching row before the cursor narrows the window
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", r.projectTable, strings.Join(conditions, " AND "))
+
+	var total int64
+	if err := r.databaseAPI.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to count projects: %w", err)
+	}
+
+	// Add optional keyset condition
+	if filter.Cursor != nil {
+		condition, cursorArgs := cursorCondition(*filter.Cursor, filter.SortAscending, argIdx)
+		conditions = append(conditions, condition)
+		args = append(args, cursorArgs...)
 	}
 
+	baseQuery := fmt.Sprintf(
+		`SELECT id, blur_hash, title, sub_title, description, tags, type, link, education_id, created_at, updated_at FROM %s`,
+		r.projectTable,
+	)
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+
 	// Add sorting
 	sortOrder := "ASC"
 	if !filter.SortAscending {
@@ -345,22 +487,27 @@ func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilte
 		// fallback to a safe default to avoid invalid column names
 		orderCol = "created_at"
 	}
-	baseQuery += fmt.Sprintf(" ORDER BY %s %s", orderCol, sortOrder)
+	baseQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", orderCol, sortOrder, sortOrder)
 
-	// Add pagination
-	offset := (filter.Page - 1) * filter.PageSize
-	baseQuery += fmt.Sprintf(" LIMIT %d OFFSET %d", filter.PageSize, offset)
+	// Add pagination, fetching one extra row to learn whether another page follows
+	if filter.Cursor != nil {
+		baseQuery += fmt.Sprintf(" LIMIT %d", filter.PageSize+1)
+	} else {
+		offset := (filter.Page - 1) * filter.PageSize
+		baseQuery += fmt.Sprintf(" LIMIT %d OFFSET %d", filter.PageSize+1, offset)
+	}
 
 	// Execute query
 	rows, err := r.databaseAPI.Query(ctx, baseQuery, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list projects: %w", err)
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list projects: %w", err)
 	}
 	defer rows.Close()
 
 	projects := []domain.Project{}
 	for rows.Next() {
 		var project domain.Project
+		var educationID sql.NullString
 
 		err := rows.Scan(
 			&project.Id,
@@ -371,21 +518,38 @@ func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilte
 			&project.Tags,
 			&project.Type,
 			&project.Link,
+			&educationID,
 			&project.CreatedAt,
 			&project.UpdatedAt,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan project: %w", err)
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to scan project: %w", err)
+		}
+
+		if educationID.Valid {
+			project.EducationID = educationID.String
 		}
 
 		projects = append(projects, project)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, fmt.Errorf("row iteration error: %w", err)
+		return nil, domain.PageInfo{}, fmt.Errorf("row iteration error: %w", err)
 	}
 
-	return projects, nil
+	fetched := len(projects)
+	if fetched > int(filter.PageSize) {
+		projects = projects[:filter.PageSize]
+	}
+
+	var last domain.Project
+	if len(projects) > 0 {
+		last = projects[len(projects)-1]
+	}
+
+	pageInfo := newPageInfo(filter.Page, filter.PageSize, total, fetched, orderCol == "created_at", last.CreatedAt, last.Id)
+
+	return projects, pageInfo, nil
 }
 
 // ListProjectsByEducationID queries the database for all projects that belong to the
@@ -394,7 +558,7 @@ func (r *projectRepository) List(ctx context.Context, filter domain.ProjectFilte
 // Behavior:
 //   - Executes a SELECT for id, blur_hash, title, sub_title, description,
 //     tags, type, link, education_id, created_at, updated_at from the project table
-//     where education_id = $1.
+//     where education_id = $1, skipping trashed projects.
 //   - Results are ordered by created_at DESC.
 //
 // Parameters:
@@ -414,7 +578,7 @@ func (r *projectRepository) ListByEducationID(ctx context.Context, educationID s
 	query := fmt.Sprintf(`
         SELECT id, blur_hash, title, sub_title, description, tags, type, link, education_id, created_at, updated_at
         FROM %s
-        WHERE education_id = $1
+        WHERE education_id = $1 AND deleted_at IS NULL
         ORDER BY created_at DESC
     `, r.projectTable)
 
@@ -450,6 +614,7 @@ func (r *projectRepository) ListByEducationID(ctx context.Context, educationID s
 
 // ListByEducationIDs fetches projects for multiple education IDs in a single query.
 // Returns a map where the key is the education ID and the value is a slice of projects.
+// Trashed projects are skipped.
 func (r *projectRepository) ListByEducationIDs(ctx context.Context, educationIDs []string) (map[string][]domain.Project, error) {
 	if len(educationIDs) == 0 {
 		return make(map[string][]domain.Project), nil
@@ -465,7 +630,7 @@ func (r *projectRepository) ListByEducationIDs(ctx context.Context, educationIDs
 	query := fmt.Sprintf(`
 		SELECT id, blur_hash, title, sub_title, description, tags, type, link, education_id, created_at, updated_at
 		FROM %s
-		WHERE education_id IN (%s)
+		WHERE education_id IN (%s) AND deleted_at IS NULL
 		ORDER BY education_id, created_at DESC
 	`, r.projectTable, strings.Join(placeholders, ", "))
 
@@ -503,3 +668,135 @@ func (r *projectRepository) ListByEducationIDs(ctx context.Context, educationIDs
 
 	return projectsByEducation, rows.Err()
 }
+
+// Version reports the domain.CollectionVersion of the project table, which
+// changes whenever a project is created, updated, trashed, restored or purged.
+func (r *projectRepository) Version(ctx context.Context) (domain.CollectionVersion, error) {
+	version, err := collectionVersion(ctx, r.databaseAPI, r.projectTable, "")
+	if err != nil {
+		return domain.CollectionVersion{}, fmt.Errorf("failed to get projects version: %w", err)
+	}
+
+	return version, nil
+}
+
+// ListTags returns every distinct project tag with the number of live projects
+// that carry it, ordered by count (most used first) and then alphabetically.
+func (r *projectRepository) ListTags(ctx context.Context) ([]domain.TagCount, error) {
+	query := fmt.Sprintf(`
+		SELECT tag, COUNT(*) AS count
+		FROM %s, unnest(tags) AS tag
+		WHERE deleted_at IS NULL
+		GROUP BY tag
+		ORDER BY count DESC, tag ASC
+	`, r.projectTable)
+
+	rows, err := r.databaseAPI.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list project tags: %w", err)
+	}
+	defer rows.Close()
+
+	tags := []domain.TagCount{}
+	for rows.Next() {
+		var tag domain.TagCount
+
+		if err := rows.Scan(&tag.Tag, &tag.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan project tag: %w", err)
+		}
+
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return tags, nil
+}
+
+// ListBySkillID returns the projects linked to the given skill through the
+// project-skill join table, newest first, skipping trashed projects. An empty id
+// returns an error; a skill without links yields an empty slice.
+func (r *projectRepository) ListBySkillID(ctx context.Context, skillID string) ([]domain.Project, error) {
+	if skillID == "" {
+		return nil, errors.New("failed to list projects by skill: ID missing")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT p.id, p.blur_hash, p.title, p.sub_title, p.description, p.tags, p.type, p.link, p.education_id, p.created_at, p.updated_at
+		FROM %s p
+		JOIN %s ps ON ps.project_id = p.id
+		WHERE ps.skill_id = $1 AND p.deleted_at IS NULL
+		ORDER BY p.created_at DESC, p.id DESC
+	`, r.projectTable, r.projectSkillTable)
+
+	rows, err := r.databaseAPI.Query(ctx, query, skillID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query projects by skill: %w", err)
+	}
+	defer rows.Close()
+
+	projects := []domain.Project{}
+	for rows.Next() {
+		var p domain.Project
+		var educationID sql.NullString
+
+		err := rows.Scan(
+			&p.Id, &p.BlurHash, &p.Title, &p.Subtitle,
+			&p.Description, &p.Tags, &p.Type, &p.Link,
+			&educationID, &p.CreatedAt, &p.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan project: %w", err)
+		}
+
+		if educationID.Valid {
+			p.EducationID = educationID.String
+		}
+
+		projects = append(projects, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return projects, nil
+}
+
+// SetSkills replaces the skills linked to a project with skillIDs. Existing links
+// are removed first, so an empty skillIDs detaches every skill. Duplicate IDs are
+// ignored. Callers should run it in the same transaction as the project write
+// (see WithTx) and are expected to have checked that every skill exists.
+func (r *projectRepository) SetSkills(ctx context.Context, projectID string, skillIDs []string) error {
+	if projectID == "" {
+		return errors.New("failed to set project skills: project ID missing")
+	}
+
+	deleteQuery := fmt.Sprintf("DELETE FROM %s WHERE project_id = $1", r.projectSkillTable)
+	if _, err := r.databaseAPI.Exec(ctx, deleteQuery, projectID); err != nil {
+		return fmt.Errorf("failed to clear project skills: %w", err)
+	}
+
+	if len(skillIDs) == 0 {
+		return nil
+	}
+
+	insertQuery := fmt.Sprintf(`
+		INSERT INTO %s (project_id, skill_id)
+		SELECT $1, unnest($2::uuid[])
+		ON CONFLICT DO NOTHING
+	`, r.projectSkillTable)
+	if _, err := r.databaseAPI.Exec(ctx, insertQuery, projectID, skillIDs); err != nil {
+		return fmt.Errorf("failed to link project skills: %w", err)
+	}
+
+	return nil
+}
+
+// toNullString maps an empty string to SQL NULL so optional foreign keys such
+// as education_id are stored as NULL rather than as an invalid empty UUID.
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -2,6 +2,7 @@ package v1
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"io"
@@ -18,8 +19,10 @@ import (
 )
 
 const (
-	testProjectTable = "test-projects"
-	validBlurHash    = "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
+	testProjectTable      = "test-projects"
+	testProjectSkillTable = "test-project-skills"
+	testEducationID       = "0199a3b4-5c6d-7e8f-9a0b-1c2d3e4f5a6b"
+	validBlurHash         = "LEHV6nWB2yk8pyo0adR*.7kCMdnj"
 )
 
 // projectFakeRow is for QueryRow
@@ -41,20 +44,20 @@ func (f *projectFakeRow) Scan(dest ...any) error {
 		}
 		return fmt.Errorf("expected *string for id, got %T", dest[0])
 
-	case 10: // ← Changed from 11 to 10 (Preview field removed)
+	case 11:
 		*dest[0].(*string) = f.project.Id
-		*dest[1].(*string) = f.project.BlurHash    // ← Shifted from dest[2]
-		*dest[2].(*string) = f.project.Title       // ← Shifted from dest[3]
-		*dest[3].(*string) = f.project.Subtitle    // ← Shifted from dest[4]
-		*dest[4].(*string) = f.project.Description // ← Shifted from dest[5]
+		*dest[1].(*string) = f.project.BlurHash
+		*dest[2].(*string) = f.project.Title
+		*dest[3].(*string) = f.project.Subtitle
+		*dest[4].(*string) = f.project.Description
 
-		if v, ok := dest[5].(*[]string); ok { // ← Shifted from dest[6]
+		if v, ok := dest[5].(*[]string); ok {
 			*v = f.project.Tags
 		} else {
 			return fmt.Errorf("expected *[]string for tags, got %T", dest[5])
 		}
 
-		switch v := dest[6].(type) { // ← Shifted from dest[7]
+		switch v := dest[6].(type) {
 		case *string:
 			*v = string(f.project.Type)
 		case *domain.ProjectType:
@@ -63,9 +66,16 @@ func (f *projectFakeRow) Scan(dest ...any) error {
 			return fmt.Errorf("unexpected type for project.Type: %T", v)
 		}
 
-		*dest[7].(*string) = f.project.Link         // ← Shifted from dest[8]
-		*dest[8].(*time.Time) = f.project.CreatedAt // ← Shifted from dest[9]
-		*dest[9].(*time.Time) = f.project.UpdatedAt // ← Shifted from dest[10]
+		*dest[7].(*string) = f.project.Link
+
+		if v, ok := dest[8].(*sql.NullString); ok {
+			*v = sql.NullString{String: f.project.EducationID, Valid: f.project.EducationID != ""}
+		} else {
+			return fmt.Errorf("expected *sql.NullString for education_id, got %T", dest[8])
+		}
+
+		*dest[9].(*time.Time) = f.project.CreatedAt
+		*dest[10].(*time.Time) = f.project.UpdatedAt
 		return nil
 
 	default:
@@ -76,7 +86,7 @@ func (f *projectFakeRow) Scan(dest ...any) error {
 type projectFakeCommandTag string
 
 func (f projectFakeCommandTag) RowsAffected() int64 {
-	if f == "DELETE 1" {
+	if f == "DELETE 1" || f == "UPDATE 1" {
 		return 1
 	}
 	return 0
@@ -118,10 +128,11 @@ func newProjectRepositoryTestFixture(t *testing.T, timeProvider func() time.Time
 	mockDatabaseAPI := new(database.MockDatabaseAPI)
 	mockBlurHashAPI := new(metadata.MockBlurHashAPI)
 	projectRepository := &projectRepository{
-		databaseAPI:  mockDatabaseAPI,
-		blurHashAPI:  mockBlurHashAPI,
-		timeProvider: timeProvider,
-		projectTable: testProjectTable,
+		databaseAPI:       mockDatabaseAPI,
+		blurHashAPI:       mockBlurHashAPI,
+		timeProvider:      timeProvider,
+		projectTable:      testProjectTable,
+		projectSkillTable: testProjectSkillTable,
 	}
 
 	return &projectRepositoryTestFixture{
@@ -147,6 +158,9 @@ func TestProjectRepository_Create(t *testing.T) {
 		Link:        "http://example.com",
 	}
 
+	linkedProject := validProject
+	linkedProject.EducationID = testEducationID
+
 	invalidBlurHashProject := domain.Project{
 		BlurHash:    "invalid-hash",
 		Title:       "title",
@@ -189,6 +203,61 @@ func TestProjectRepository_Create(t *testing.T) {
 				err: nil,
 			},
 		},
+		"Successful create project linked to education": {
+			given: Given{
+				project: linkedProject,
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(query string) bool { return strings.Contains(query, "education_id") }),
+						mock.MatchedBy(func(args []any) bool {
+							return args[8] == sql.NullString{String: testEducationID, Valid: true}
+						}),
+					).Return(&projectFakeRow{id: fixedID})
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Unlinked project stores NULL education_id": {
+			given: Given{
+				project: validProject,
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.Anything,
+						mock.MatchedBy(func(args []any) bool {
+							return args[8] == sql.NullString{}
+						}),
+					).Return(&projectFakeRow{id: fixedID})
+				},
+			},
+			expected: Expected{
+				err: nil,
+			},
+		},
+		"Invalid education ID fails": {
+			given: Given{
+				project: func() domain.Project {
+					p := validProject
+					p.EducationID = "not-a-uuid"
+					return p
+				}(),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate project: educationId invalid"),
+			},
+		},
 		"Database scan fails": {
 			given: Given{
 				project: validProject,
@@ -464,6 +533,9 @@ func TestProjectRepository_Get(t *testing.T) {
 		UpdatedAt:   time.Now(),
 	}
 
+	linkedProject := validProject
+	linkedProject.EducationID = testEducationID
+
 	type Given struct {
 		id           string
 		mockBlurHash func(m *metadata.MockBlurHashAPI)
@@ -495,6 +567,22 @@ func TestProjectRepository_Get(t *testing.T) {
 				err:     nil,
 			},
 		},
+		"Successful get project linked to education": {
+			given: Given{
+				id: id,
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.Anything, []any{id}).
+						Return(&projectFakeRow{project: linkedProject})
+				},
+			},
+			expected: Expected{
+				project: &linkedProject,
+				err:     nil,
+			},
+		},
 		"Database scan": {
 			given: Given{
 				id: id,
@@ -872,6 +960,9 @@ func TestProjectRepository_Update(t *testing.T) {
 		UpdatedAt:   fixedTime,
 	}
 
+	linkedProject := *validProject
+	linkedProject.EducationID = testEducationID
+
 	type Given struct {
 		project      domain.Project
 		mockBlurHash func(m *metadata.MockBlurHashAPI)
@@ -906,6 +997,31 @@ func TestProjectRepository_Update(t *testing.T) {
 				err:            nil,
 			},
 		},
+		"Successful update project linked to education": {
+			given: Given{
+				project: linkedProject,
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Twice()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool { return strings.Contains(query, "education_id=$9") }),
+							mock.MatchedBy(func(args []any) bool {
+								return args[8] == sql.NullString{String: testEducationID, Valid: true}
+							}),
+						).
+						Return(&projectFakeRow{
+							project: linkedProject,
+						})
+				},
+			},
+			expected: Expected{
+				updatedProject: &linkedProject,
+				err:            nil,
+			},
+		},
 		"Database scan fails": {
 			given: Given{
 				project: *validProject,
@@ -923,6 +1039,71 @@ func TestProjectRepository_Update(t *testing.T) {
 				err:            fmt.Errorf("failed to update project: %w", scanErr),
 			},
 		},
+		"Unversioned update skips the version check": {
+			given: Given{
+				project: func() domain.Project { p := *validProject; p.UpdatedAt = time.Time{}; return p }(),
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Twice()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool { return !strings.Contains(query, "AND updated_at=") }),
+							mock.MatchedBy(func(args []any) bool { return len(args) == 10 }),
+						).
+						Return(&projectFakeRow{
+							project: *validProject,
+						})
+				},
+			},
+			expected: Expected{
+				updatedProject: validProject,
+			},
+		},
+		"Versioned update checks updated_at": {
+			given: Given{
+				project: *validProject,
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Twice()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE id=$1 AND deleted_at IS NULL AND updated_at=$11")
+							}),
+							mock.MatchedBy(func(args []any) bool { return len(args) == 11 && args[10] == fixedTime }),
+						).
+						Return(&projectFakeRow{
+							project: *validProject,
+						})
+				},
+			},
+			expected: Expected{
+				updatedProject: validProject,
+			},
+		},
+		"Stale version returns a conflict": {
+			given: Given{
+				project: *validProject,
+				mockBlurHash: func(m *metadata.MockBlurHashAPI) {
+					m.EXPECT().IsValid(validBlurHash).Return(true).Once()
+				},
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.MatchedBy(isUpdate), mock.Anything).
+						Return(&projectFakeRow{scanErr: pgx.ErrNoRows})
+					m.EXPECT().
+						QueryRow(mock.Anything, mock.MatchedBy(isVersionLookup), []any{validProject.Id}).
+						Return(&versionFakeRow{updatedAt: fixedTime.Add(time.Hour)})
+				},
+			},
+			expected: Expected{
+				err: &domain.ConflictError{Entity: "project", ID: validProject.Id, Current: fixedTime.Add(time.Hour)},
+			},
+		},
 		"Database returns no rows": {
 			given: Given{
 				project: *validProject,
@@ -1173,6 +1354,7 @@ func TestProjectRepository_Update(t *testing.T) {
 
 func TestProjectRepository_Delete(t *testing.T) {
 	dbErr := errors.New("db exec error")
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 
 	type Given struct {
 		id       string
@@ -1192,8 +1374,15 @@ func TestProjectRepository_Delete(t *testing.T) {
 				id: "123-abc",
 				mockExec: func(m *database.MockDatabaseAPI) {
 					m.EXPECT().
-						Exec(mock.Anything, mock.Anything, mock.Anything).
-						Return(projectFakeCommandTag("DELETE 1"), nil)
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testProjectTable+" SET deleted_at=$2") &&
+									strings.Contains(query, "deleted_at IS NULL")
+							}),
+							[]any{"123-abc", fixedTime},
+						).
+						Return(projectFakeCommandTag("UPDATE 1"), nil)
 				},
 			},
 			expected: Expected{err: nil},
@@ -1237,7 +1426,7 @@ func TestProjectRepository_Delete(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			f := newProjectRepositoryTestFixture(t, time.Now)
+			f := newProjectRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
 			if test.given.mockExec != nil {
 				test.given.mockExec(f.databaseAPI)
@@ -1256,107 +1445,75 @@ func TestProjectRepository_Delete(t *testing.T) {
 	}
 }
 
-func TestProjectRepository_List(t *testing.T) {
+func TestProjectRepository_Restore(t *testing.T) {
+	projectID := "123-abc"
+	dbErr := errors.New("db exec error")
 	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
-	scanErr := errors.New("scan error")
-	queryErr := errors.New("query error")
-	rowErr := errors.New("row iteration error")
-
-	validProject := domain.Project{
-		Id:          "123-abc",
-		BlurHash:    validBlurHash,
-		Title:       "test-title",
-		Subtitle:    "test-subtitle",
-		Description: "test-description",
-		Tags:        []string{"tags1"},
-		Type:        domain.Web,
-		Link:        "http://example.com",
-		CreatedAt:   fixedTime,
-		UpdatedAt:   fixedTime,
-	}
 
 	type Given struct {
-		filter    domain.ProjectFilter
-		mockQuery func(m *database.MockDatabaseAPI)
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
 	}
 
 	type Expected struct {
-		projects []domain.Project
-		err      error
+		err error
 	}
 
 	tests := map[string]struct {
 		given    Given
 		expected Expected
 	}{
-		"Successful list projects": {
+		"Successful restore": {
 			given: Given{
-				filter: domain.ProjectFilter{},
-				mockQuery: func(m *database.MockDatabaseAPI) {
-					rows := &projectFakeRows{
-						rows: []*projectFakeRow{
-							{project: validProject},
-						},
-					}
+				id: projectID,
+				mockExec: func(m *database.MockDatabaseAPI) {
 					m.EXPECT().
-						Query(mock.Anything, mock.Anything, mock.Anything).
-						Return(rows, nil)
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "UPDATE "+testProjectTable+" SET deleted_at=NULL") &&
+									strings.Contains(query, "deleted_at IS NOT NULL")
+							}),
+							[]any{projectID, fixedTime},
+						).
+						Return(projectFakeCommandTag("UPDATE 1"), nil)
 				},
 			},
-			expected: Expected{
-				projects: []domain.Project{validProject},
-				err:      nil,
-			},
+			expected: Expected{err: nil},
 		},
-		"Query fails": {
+		"Database error during restore": {
 			given: Given{
-				filter: domain.ProjectFilter{},
-				mockQuery: func(m *database.MockDatabaseAPI) {
+				id: projectID,
+				mockExec: func(m *database.MockDatabaseAPI) {
 					m.EXPECT().
-						Query(mock.Anything, mock.Anything, mock.Anything).
-						Return(nil, queryErr)
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
 				},
 			},
 			expected: Expected{
-				projects: nil,
-				err:      fmt.Errorf("failed to list projects: %w", queryErr),
+				err: fmt.Errorf("failed to restore project: %w", dbErr),
 			},
 		},
-		"Scan fails": {
+		"Not in trash": {
 			given: Given{
-				filter: domain.ProjectFilter{},
-				mockQuery: func(m *database.MockDatabaseAPI) {
-					rows := &projectFakeRows{
-						rows: []*projectFakeRow{
-							{scanErr: scanErr},
-						},
-					}
+				id: projectID,
+				mockExec: func(m *database.MockDatabaseAPI) {
 					m.EXPECT().
-						Query(mock.Anything, mock.Anything, mock.Anything).
-						Return(rows, nil)
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(projectFakeCommandTag("UPDATE 0"), nil)
 				},
 			},
 			expected: Expected{
-				projects: nil,
-				err:      fmt.Errorf("failed to scan project: %w", scanErr),
+				err: pgx.ErrNoRows,
 			},
 		},
-		"Row iteration error": {
+		"Restore with empty ID": {
 			given: Given{
-				filter: domain.ProjectFilter{},
-				mockQuery: func(m *database.MockDatabaseAPI) {
-					rows := &projectFakeRows{
-						rows:   []*projectFakeRow{},
-						rowErr: rowErr,
-					}
-					m.EXPECT().
-						Query(mock.Anything, mock.Anything, mock.Anything).
-						Return(rows, nil)
-				},
+				id:       "",
+				mockExec: nil,
 			},
 			expected: Expected{
-				projects: nil,
-				err:      fmt.Errorf("row iteration error: %w", rowErr),
+				err: fmt.Errorf("failed to restore project: ID missing"),
 			},
 		},
 	}
@@ -1365,19 +1522,839 @@ func TestProjectRepository_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			f := newProjectRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
-			if test.given.mockQuery != nil {
-				test.given.mockQuery(f.databaseAPI)
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
 			}
 
-			projects, err := f.projectRepository.List(context.Background(), test.given.filter)
+			err := f.projectRepository.Restore(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectRepository_Purge(t *testing.T) {
+	projectID := "123-abc"
+	dbErr := errors.New("db exec error")
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful purge": {
+			given: Given{
+				id: projectID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "DELETE FROM "+testProjectTable) &&
+									strings.Contains(query, "deleted_at IS NOT NULL")
+							}),
+							[]any{projectID},
+						).
+						Return(projectFakeCommandTag("DELETE 1"), nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during purge": {
+			given: Given{
+				id: projectID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge project: %w", dbErr),
+			},
+		},
+		"Not in trash": {
+			given: Given{
+				id: projectID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(projectFakeCommandTag("DELETE 0"), nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Purge with empty ID": {
+			given: Given{
+				id:       "",
+				mockExec: nil,
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge project: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectRepositoryTestFixture(t, time.Now)
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.projectRepository.Purge(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectRepository_List(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+	queryErr := errors.New("query error")
+	rowErr := errors.New("row iteration error")
+
+	validProject := domain.Project{
+		Id:          "123-abc",
+		BlurHash:    validBlurHash,
+		Title:       "test-title",
+		Subtitle:    "test-subtitle",
+		Description: "test-description",
+		Tags:        []string{"tags1"},
+		Type:        domain.Web,
+		Link:        "http://example.com",
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	linkedProject := validProject
+	linkedProject.EducationID = testEducationID
+
+	secondProject := validProject
+	secondProject.Id = "456-def"
+
+	countErr := errors.New("count error")
+	cursor := domain.Cursor{CreatedAt: fixedTime, ID: "000-aaa"}
+	sortUpdated := domain.UpdatedAt
+
+	defaultPageInfo := domain.PageInfo{Total: 1, Page: 1, PageSize: 20}
+
+	type Given struct {
+		filter    domain.ProjectFilter
+		count     *countFakeRow
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		projects []domain.Project
+		pageInfo domain.PageInfo
+		err      error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful list projects": {
+			given: Given{
+				filter: domain.ProjectFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{project: validProject},
+						},
+					}
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{validProject},
+				pageInfo: defaultPageInfo,
+				err:      nil,
+			},
+		},
+		"Filter by education ID": {
+			given: Given{
+				filter: domain.ProjectFilter{EducationID: &linkedProject.EducationID},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{project: linkedProject},
+						},
+					}
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE deleted_at IS NULL AND education_id = $1")
+							}),
+							[]any{testEducationID},
+						).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{linkedProject},
+				pageInfo: defaultPageInfo,
+				err:      nil,
+			},
+		},
+		"Query fails": {
+			given: Given{
+				filter: domain.ProjectFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				projects: nil,
+				err:      fmt.Errorf("failed to list projects: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				filter: domain.ProjectFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{scanErr: scanErr},
+						},
+					}
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: nil,
+				err:      fmt.Errorf("failed to scan project: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				filter: domain.ProjectFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows:   []*projectFakeRow{},
+						rowErr: rowErr,
+					}
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: nil,
+				err:      fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+		"Count query fails": {
+			given: Given{
+				filter: domain.ProjectFilter{},
+				count:  &countFakeRow{scanErr: countErr},
+			},
+			expected: Expected{
+				projects: nil,
+				err:      fmt.Errorf("failed to count projects: %w", countErr),
+			},
+		},
+		"Extra row sets has next and cursor": {
+			given: Given{
+				filter: domain.ProjectFilter{PageSize: 1},
+				count:  &countFakeRow{count: 2},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{project: validProject},
+							{project: secondProject},
+						},
+					}
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.HasSuffix(query, "ORDER BY created_at DESC, id DESC LIMIT 2 OFFSET 0")
+							}),
+						).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{validProject},
+				pageInfo: domain.PageInfo{
+					Total:      2,
+					Page:       1,
+					PageSize:   1,
+					HasNext:    true,
+					NextCursor: domain.Cursor{CreatedAt: validProject.CreatedAt, ID: validProject.Id}.Encode(),
+				},
+				err: nil,
+			},
+		},
+		"Cursor adds keyset condition": {
+			given: Given{
+				filter: domain.ProjectFilter{EducationID: &linkedProject.EducationID, Cursor: &cursor},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{project: linkedProject},
+						},
+					}
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE deleted_at IS NULL AND education_id = $1 AND (created_at, id) < ($2, $3)") &&
+									strings.HasSuffix(query, "LIMIT 21")
+							}),
+							[]any{testEducationID, cursor.CreatedAt, cursor.ID},
+						).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{linkedProject},
+				pageInfo: defaultPageInfo,
+				err:      nil,
+			},
+		},
+		"Filter by any of tags": {
+			given: Given{
+				filter: domain.ProjectFilter{Tags: []string{"go", "react"}},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{project: validProject},
+						},
+					}
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool { return strings.Contains(query, "WHERE deleted_at IS NULL AND tags && $1") }),
+							[]any{[]string{"go", "react"}},
+						).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{validProject},
+				pageInfo: defaultPageInfo,
+				err:      nil,
+			},
+		},
+		"Filter by all of tags with education ID": {
+			given: Given{
+				filter: domain.ProjectFilter{
+					EducationID: &linkedProject.EducationID,
+					Tags:        []string{"go", "react"},
+					TagMatch:    domain.TagMatchAll,
+				},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					rows := &projectFakeRows{
+						rows: []*projectFakeRow{
+							{project: linkedProject},
+						},
+					}
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "WHERE deleted_at IS NULL AND education_id = $1 AND tags @> $2")
+							}),
+							[]any{testEducationID, []string{"go", "react"}},
+						).
+						Return(rows, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{linkedProject},
+				pageInfo: defaultPageInfo,
+				err:      nil,
+			},
+		},
+		"Invalid tag match": {
+			given: Given{
+				filter: domain.ProjectFilter{Tags: []string{"go"}, TagMatch: "some"},
+			},
+			expected: Expected{
+				projects: nil,
+				err:      errors.New(`failed to list projects: invalid tag match "some"`),
+			},
+		},
+		"Cursor with non created_at sort": {
+			given: Given{
+				filter: domain.ProjectFilter{SortBy: &sortUpdated, Cursor: &cursor},
+			},
+			expected: Expected{
+				projects: nil,
+				err:      errors.New("failed to list projects: cursor requires sorting by created_at"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			count := test.given.count
+			if count == nil {
+				count = &countFakeRow{count: 1}
+			}
+			expectCount(f.databaseAPI, count)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			projects, pageInfo, err := f.projectRepository.List(context.Background(), test.given.filter)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, projects)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, projects)
+				assert.Equal(t, test.expected.projects, projects)
+				assert.Equal(t, test.expected.pageInfo, pageInfo)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+// projectTagFakeRow for ListTags path
+type projectTagFakeRow struct {
+	tag     domain.TagCount
+	scanErr error
+}
+
+func (f *projectTagFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 2 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.tag.Tag
+	*dest[1].(*int64) = f.tag.Count
+	return nil
+}
+
+type projectTagFakeRows struct {
+	rows   []*projectTagFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *projectTagFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *projectTagFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *projectTagFakeRows) Err() error { return r.rowErr }
+
+func (r *projectTagFakeRows) Close() {}
+
+func TestProjectRepository_ListTags(t *testing.T) {
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	goTag := domain.TagCount{Tag: "go", Count: 3}
+	reactTag := domain.TagCount{Tag: "react", Count: 1}
+
+	type Given struct {
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		tags []domain.TagCount
+		err  error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful list tags": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testProjectTable+", unnest(tags) AS tag") &&
+									strings.Contains(query, "ORDER BY count DESC, tag ASC")
+							}),
+						).
+						Return(&projectTagFakeRows{
+							rows: []*projectTagFakeRow{{tag: goTag}, {tag: reactTag}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				tags: []domain.TagCount{goTag, reactTag},
+			},
+		},
+		"No tags": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything).
+						Return(&projectTagFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				tags: []domain.TagCount{},
+			},
+		},
+		"Query fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list project tags: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything).
+						Return(&projectTagFakeRows{rows: []*projectTagFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan project tag: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything).
+						Return(&projectTagFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectRepositoryTestFixture(t, nil)
+
+			test.given.mockQuery(f.databaseAPI)
+
+			tags, err := f.projectRepository.ListTags(context.Background())
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, tags)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.tags, tags)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectRepository_ListBySkillID(t *testing.T) {
+	skillID := "skill-123"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	linked := domain.Project{
+		Id:          "p1",
+		BlurHash:    validBlurHash,
+		Title:       "title",
+		Subtitle:    "subtitle",
+		Description: "desc",
+		Tags:        []string{"go"},
+		Type:        domain.Web,
+		Link:        "http://example.com",
+		EducationID: testEducationID,
+		CreatedAt:   fixedTime,
+		UpdatedAt:   fixedTime,
+	}
+
+	type Given struct {
+		skillID   string
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		projects []domain.Project
+		err      error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful list by skill": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testProjectTable+" p") &&
+									strings.Contains(query, "JOIN "+testProjectSkillTable+" ps ON ps.project_id = p.id") &&
+									strings.Contains(query, "WHERE ps.skill_id = $1 AND p.deleted_at IS NULL")
+							}),
+							[]any{skillID},
+						).
+						Return(&projectFakeRows{rows: []*projectFakeRow{{project: linked}}}, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{linked},
+			},
+		},
+		"No linked projects": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(&projectFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				projects: []domain.Project{},
+			},
+		},
+		"Missing skill ID": {
+			given: Given{
+				skillID: "",
+			},
+			expected: Expected{
+				err: errors.New("failed to list projects by skill: ID missing"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to query projects by skill: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(&projectFakeRows{rows: []*projectFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan project: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				skillID: skillID,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{skillID}).
+						Return(&projectFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			projects, err := f.projectRepository.ListBySkillID(context.Background(), test.given.skillID)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, projects)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.projects, projects)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestProjectRepository_SetSkills(t *testing.T) {
+	projectID := "project-123"
+	skillIDs := []string{"skill-1", "skill-2"}
+	execErr := errors.New("exec error")
+
+	isDelete := func(query string) bool {
+		return strings.Contains(query, "DELETE FROM "+testProjectSkillTable+" WHERE project_id = $1")
+	}
+	isInsert := func(query string) bool {
+		return strings.Contains(query, "INSERT INTO "+testProjectSkillTable+" (project_id, skill_id)") &&
+			strings.Contains(query, "unnest($2::uuid[])") &&
+			strings.Contains(query, "ON CONFLICT DO NOTHING")
+	}
+
+	type Given struct {
+		projectID string
+		skillIDs  []string
+		mockExec  func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful replace": {
+			given: Given{
+				projectID: projectID,
+				skillIDs:  skillIDs,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{projectID}).
+						Return(projectFakeCommandTag("DELETE 1"), nil).
+						Once()
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isInsert), []any{projectID, skillIDs}).
+						Return(projectFakeCommandTag("INSERT 0 2"), nil).
+						Once()
+				},
+			},
+		},
+		"Empty skills only clears links": {
+			given: Given{
+				projectID: projectID,
+				skillIDs:  []string{},
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{projectID}).
+						Return(projectFakeCommandTag("DELETE 0"), nil).
+						Once()
+				},
+			},
+		},
+		"Missing project ID": {
+			given: Given{
+				projectID: "",
+				skillIDs:  skillIDs,
+			},
+			expected: Expected{
+				err: errors.New("failed to set project skills: project ID missing"),
+			},
+		},
+		"Clear fails": {
+			given: Given{
+				projectID: projectID,
+				skillIDs:  skillIDs,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{projectID}).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to clear project skills: %w", execErr),
+			},
+		},
+		"Link fails": {
+			given: Given{
+				projectID: projectID,
+				skillIDs:  skillIDs,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isDelete), []any{projectID}).
+						Return(projectFakeCommandTag("DELETE 1"), nil)
+					m.EXPECT().
+						Exec(mock.Anything, mock.MatchedBy(isInsert), []any{projectID, skillIDs}).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to link project skills: %w", execErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newProjectRepositoryTestFixture(t, nil)
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.projectRepository.SetSkills(context.Background(), test.given.projectID, test.given.skillIDs)
 
 			if test.expected.err != nil {
 				assert.EqualError(t, err, test.expected.err.Error())
-				assert.Nil(t, projects)
 			} else {
 				assert.NoError(t, err)
-				assert.NotNil(t, projects)
-				assert.Equal(t, test.expected.projects, projects)
 			}
 
 			f.databaseAPI.AssertExpectations(t)
@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/utils"
+)
+
+type RefreshTokenRepository interface {
+	Create(ctx context.Context, token *domain.RefreshToken) error
+	GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	Revoke(ctx context.Context, id string) (bool, error)
+	RevokeFamily(ctx context.Context, familyID string) error
+	WithTx(tx database.Tx) RefreshTokenRepository
+}
+
+type RefreshTokenRepositoryConfig struct {
+	DatabaseAPI       database.DatabaseAPI
+	RefreshTokenTable string
+
+	timeProvider domain.TimeProvider
+}
+
+type refreshTokenRepository struct {
+	refreshTokenTable string
+	databaseAPI       database.Querier
+	timeProvider      domain.TimeProvider
+}
+
+// NewRefreshTokenRepository creates and returns a RefreshTokenRepository that
+// stores tokens in cfg.RefreshTokenTable using cfg.DatabaseAPI. If
+// cfg.timeProvider is nil the repository defaults to time.Now.
+func NewRefreshTokenRepository(cfg RefreshTokenRepositoryConfig) RefreshTokenRepository {
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	return &refreshTokenRepository{
+		refreshTokenTable: cfg.RefreshTokenTable,
+		databaseAPI:       cfg.DatabaseAPI,
+		timeProvider:      timeProvider,
+	}
+}
+
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool.
+func (r *refreshTokenRepository) WithTx(tx database.Tx) RefreshTokenRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
+// Create inserts token. It assigns token.ID and token.CreatedAt.
+//
+// Returns an error when token is nil, its family, subject, hash or expiry is
+// missing, or the insert fails.
+func (r *refreshTokenRepository) Create(ctx context.Context, token *domain.RefreshToken) error {
+	if token == nil {
+		return errors.New("failed to validate refresh token: payload is nil")
+	}
+	if token.FamilyID == "" {
+		return errors.New("failed to validate refresh token: family ID missing")
+	}
+	if token.Subject == "" {
+		return errors.New("failed to validate refresh token: subject missing")
+	}
+	if token.TokenHash == "" {
+		return errors.New("failed to validate refresh token: token hash missing")
+	}
+	if token.ExpiresAt.IsZero() {
+		return errors.New("failed to validate refresh token: expiry missing")
+	}
+
+	token.ID = utils.GenerateKey()
+	token.CreatedAt = r.timeProvider()
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s
+		(id, family_id, subject, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		r.refreshTokenTable,
+	)
+
+	_, err := r.databaseAPI.Exec(
+		ctx,
+		query,
+		token.ID,
+		token.FamilyID,
+		token.Subject,
+		token.TokenHash,
+		token.ExpiresAt,
+		token.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHash returns the token stored under tokenHash, revoked or not. It
+// returns pgx.ErrNoRows (wrapped) when there is none.
+func (r *refreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	query := fmt.Sprintf(
+		`SELECT id, family_id, subject, token_hash, expires_at, revoked_at, created_at
+		FROM %s
+		WHERE token_hash = $1`,
+		r.refreshTokenTable,
+	)
+
+	var token domain.RefreshToken
+	err := r.databaseAPI.QueryRow(ctx, query, tokenHash).Scan(
+		&token.ID,
+		&token.FamilyID,
+		&token.Subject,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.RevokedAt,
+		&token.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+
+	return &token, nil
+}
+
+// Revoke marks the token id as revoked. It reports false when the token was
+// already revoked or does not exist, so two requests racing to rotate the
+// same token can't both succeed.
+func (r *refreshTokenRepository) Revoke(ctx context.Context, id string) (bool, error) {
+	query := fmt.Sprintf(
+		`UPDATE %s SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL`,
+		r.refreshTokenTable,
+	)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider())
+	if err != nil {
+		return false, fmt.Errorf("failed to revoke refresh token: %w", err)
+	}
+
+	return cmdTag.RowsAffected() == 1, nil
+}
+
+// RevokeFamily revokes every token of familyID that is still active.
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, familyID string) error {
+	query := fmt.Sprintf(
+		`UPDATE %s SET revoked_at = $2 WHERE family_id = $1 AND revoked_at IS NULL`,
+		r.refreshTokenTable,
+	)
+
+	if _, err := r.databaseAPI.Exec(ctx, query, familyID, r.timeProvider()); err != nil {
+		return fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+
+	return nil
+}
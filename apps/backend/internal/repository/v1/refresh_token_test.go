@@ -0,0 +1,337 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const testRefreshTokenTable = "test-refresh-token"
+
+type refreshTokenFakeRow struct {
+	token   domain.RefreshToken
+	scanErr error
+}
+
+func (f *refreshTokenFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 7 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.token.ID
+	*dest[1].(*string) = f.token.FamilyID
+	*dest[2].(*string) = f.token.Subject
+	*dest[3].(*string) = f.token.TokenHash
+	*dest[4].(*time.Time) = f.token.ExpiresAt
+	*dest[5].(**time.Time) = f.token.RevokedAt
+	*dest[6].(*time.Time) = f.token.CreatedAt
+	return nil
+}
+
+type refreshTokenFakeCommandTag int64
+
+func (f refreshTokenFakeCommandTag) RowsAffected() int64 { return int64(f) }
+
+type refreshTokenRepositoryTestFixture struct {
+	databaseAPI            *database.MockDatabaseAPI
+	refreshTokenRepository RefreshTokenRepository
+}
+
+func newRefreshTokenRepositoryTestFixture(timeProvider domain.TimeProvider) *refreshTokenRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+
+	return &refreshTokenRepositoryTestFixture{
+		databaseAPI: mockDatabaseAPI,
+		refreshTokenRepository: NewRefreshTokenRepository(
+			RefreshTokenRepositoryConfig{
+				DatabaseAPI:       mockDatabaseAPI,
+				RefreshTokenTable: testRefreshTokenTable,
+				timeProvider:      timeProvider,
+			},
+		),
+	}
+}
+
+func TestRefreshTokenRepository_Create(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := fixedTime.Add(7 * 24 * time.Hour)
+	execErr := errors.New("exec error")
+
+	valid := func() *domain.RefreshToken {
+		return &domain.RefreshToken{FamilyID: "fam-1", Subject: "admin", TokenHash: "hash", ExpiresAt: expiresAt}
+	}
+
+	type Given struct {
+		token    *domain.RefreshToken
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Inserts the token": {
+			given: Given{
+				token: valid(),
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "INSERT INTO "+testRefreshTokenTable) &&
+									strings.Contains(query, "(id, family_id, subject, token_hash, expires_at, created_at)")
+							}),
+							mock.MatchedBy(func(args []any) bool {
+								return len(args) == 6 &&
+									args[0].(string) != "" &&
+									args[1] == "fam-1" &&
+									args[2] == "admin" &&
+									args[3] == "hash" &&
+									args[4] == expiresAt &&
+									args[5] == fixedTime
+							}),
+						).
+						Return(nil, nil)
+				},
+			},
+		},
+		"Nil token": {
+			expected: Expected{err: errors.New("failed to validate refresh token: payload is nil")},
+		},
+		"Missing family": {
+			given:    Given{token: &domain.RefreshToken{Subject: "admin", TokenHash: "hash", ExpiresAt: expiresAt}},
+			expected: Expected{err: errors.New("failed to validate refresh token: family ID missing")},
+		},
+		"Missing subject": {
+			given:    Given{token: &domain.RefreshToken{FamilyID: "fam-1", TokenHash: "hash", ExpiresAt: expiresAt}},
+			expected: Expected{err: errors.New("failed to validate refresh token: subject missing")},
+		},
+		"Missing hash": {
+			given:    Given{token: &domain.RefreshToken{FamilyID: "fam-1", Subject: "admin", ExpiresAt: expiresAt}},
+			expected: Expected{err: errors.New("failed to validate refresh token: token hash missing")},
+		},
+		"Missing expiry": {
+			given:    Given{token: &domain.RefreshToken{FamilyID: "fam-1", Subject: "admin", TokenHash: "hash"}},
+			expected: Expected{err: errors.New("failed to validate refresh token: expiry missing")},
+		},
+		"Exec fails": {
+			given: Given{
+				token: valid(),
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, execErr)
+				},
+			},
+			expected: Expected{err: fmt.Errorf("failed to create refresh token: %w", execErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newRefreshTokenRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.refreshTokenRepository.Create(context.Background(), test.given.token)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, test.given.token.ID)
+				assert.Equal(t, fixedTime, test.given.token.CreatedAt)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRefreshTokenRepository_GetByHash(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := fixedTime.Add(time.Hour)
+
+	stored := domain.RefreshToken{
+		ID:        "rt-1",
+		FamilyID:  "fam-1",
+		Subject:   "admin",
+		TokenHash: "hash",
+		ExpiresAt: fixedTime.Add(24 * time.Hour),
+		RevokedAt: &revokedAt,
+		CreatedAt: fixedTime,
+	}
+
+	type Given struct {
+		row *refreshTokenFakeRow
+	}
+
+	type Expected struct {
+		token *domain.RefreshToken
+		err   error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Returns the token": {
+			given:    Given{row: &refreshTokenFakeRow{token: stored}},
+			expected: Expected{token: &stored},
+		},
+		"Missing token": {
+			given:    Given{row: &refreshTokenFakeRow{scanErr: pgx.ErrNoRows}},
+			expected: Expected{err: pgx.ErrNoRows},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newRefreshTokenRepositoryTestFixture(nil)
+
+			f.databaseAPI.EXPECT().
+				QueryRow(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "FROM "+testRefreshTokenTable) &&
+							strings.Contains(query, "WHERE token_hash = $1")
+					}),
+					[]any{"hash"},
+				).
+				Return(test.given.row)
+
+			token, err := f.refreshTokenRepository.GetByHash(context.Background(), "hash")
+
+			if test.expected.err != nil {
+				assert.ErrorIs(t, err, test.expected.err)
+				assert.Nil(t, token)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.token, token)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRefreshTokenRepository_Revoke(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	type Given struct {
+		cmdTag  refreshTokenFakeCommandTag
+		execErr error
+	}
+
+	type Expected struct {
+		revoked bool
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Revokes an active token": {
+			given:    Given{cmdTag: 1},
+			expected: Expected{revoked: true},
+		},
+		"Token already revoked": {
+			given: Given{cmdTag: 0},
+		},
+		"Exec fails": {
+			given:    Given{execErr: execErr},
+			expected: Expected{err: fmt.Errorf("failed to revoke refresh token: %w", execErr)},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newRefreshTokenRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			f.databaseAPI.EXPECT().
+				Exec(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "UPDATE "+testRefreshTokenTable) &&
+							strings.Contains(query, "WHERE id = $1 AND revoked_at IS NULL")
+					}),
+					[]any{"rt-1", fixedTime},
+				).
+				Return(test.given.cmdTag, test.given.execErr)
+
+			revoked, err := f.refreshTokenRepository.Revoke(context.Background(), "rt-1")
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.revoked, revoked)
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRefreshTokenRepository_RevokeFamily(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	execErr := errors.New("exec error")
+
+	tests := map[string]struct {
+		execErr error
+		wantErr error
+	}{
+		"Revokes the family": {},
+		"Exec fails": {
+			execErr: execErr,
+			wantErr: fmt.Errorf("failed to revoke refresh token family: %w", execErr),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newRefreshTokenRepositoryTestFixture(func() time.Time { return fixedTime })
+
+			f.databaseAPI.EXPECT().
+				Exec(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "UPDATE "+testRefreshTokenTable) &&
+							strings.Contains(query, "WHERE family_id = $1 AND revoked_at IS NULL")
+					}),
+					[]any{"fam-1", fixedTime},
+				).
+				Return(refreshTokenFakeCommandTag(2), test.execErr)
+
+			err := f.refreshTokenRepository.RevokeFamily(context.Background(), "fam-1")
+
+			if test.wantErr != nil {
+				assert.EqualError(t, err, test.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
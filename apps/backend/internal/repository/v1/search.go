@@ -0,0 +1,163 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+)
+
+type SearchRepository interface {
+	Search(ctx context.Context, filter domain.SearchFilter) ([]domain.SearchHit, error)
+}
+
+type SearchRepositoryConfig struct {
+	DatabaseAPI    database.DatabaseAPI
+	ProjectTable   string
+	SkillTable     string
+	EducationTable string
+}
+
+type searchRepository struct {
+	projectTable   string
+	skillTable     string
+	educationTable string
+	databaseAPI    database.Querier
+}
+
+// NewSearchRepository creates and returns a SearchRepository that runs
+// full-text queries against the project, skill and education tables named in
+// cfg using cfg.DatabaseAPI.
+func NewSearchRepository(cfg SearchRepositoryConfig) SearchRepository {
+	return &searchRepository{
+		projectTable:   cfg.ProjectTable,
+		skillTable:     cfg.SkillTable,
+		educationTable: cfg.EducationTable,
+		databaseAPI:    cfg.DatabaseAPI,
+	}
+}
+
+// headlineOptions configures ts_headline so every snippet marks matched terms
+// with <mark></mark> and stays short enough for a result list.
+const headlineOptions = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=20, MinWords=5, FragmentDelimiter=\" … \""
+
+// Search runs a full-text search across projects, skills and education and
+// returns the hits ordered by relevance.
+//
+// Behavior and defaults:
+//   - filter is validated via filter.Validate(); the query is trimmed before use.
+//   - If filter.Types is empty every entity type is searched.
+//   - If filter.Limit <= 0 or > 50 it defaults to 20.
+//
+// Query details:
+//   - The query text is parsed with websearch_to_tsquery, so quoted phrases,
+//     "or" and a leading "-" behave like a web search box.
+//   - Projects match the english-stemmed search_vector over title, subtitle,
+//     tags and description. Skills (label) and education (school names) match
+//     an unstemmed simple search_vector, since they are mostly proper nouns.
+//   - Each entity contributes a SELECT to a single UNION ALL, so one round trip
+//     returns every type. Hits are ranked with ts_rank and ordered by rank,
+//     then type and id for a stable order between equal ranks.
+//   - Trashed rows never match.
+//   - Snippets come from ts_headline with matched terms wrapped in <mark></mark>.
+//     The source text is not HTML-escaped.
+//
+// Returns:
+//   - ([]domain.SearchHit, nil) on success; the slice is empty when nothing matches.
+//   - (nil, error) on validation, query, scan, or row iteration failures.
+func (r *searchRepository) Search(ctx context.Context, filter domain.SearchFilter) ([]domain.SearchHit, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate search: %w", err)
+	}
+
+	if filter.Limit <= 0 || filter.Limit > 50 {
+		filter.Limit = 20
+	}
+
+	types := filter.Types
+	if len(types) == 0 {
+		types = []domain.SearchHitType{domain.ProjectHit, domain.SkillHit, domain.EducationHit}
+	}
+
+	var selects []string
+	seen := make(map[domain.SearchHitType]bool, len(types))
+	for _, t := range types {
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+
+		switch t {
+		case domain.ProjectHit:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'project' AS type, id::text AS id, title AS title,
+					ts_headline('english', concat_ws(' ', title, sub_title, description), q.english, $3) AS snippet,
+					ts_rank(search_vector, q.english)::float8 AS rank
+				FROM %s, q
+				WHERE search_vector @@ q.english AND deleted_at IS NULL`,
+				r.projectTable,
+			))
+		case domain.SkillHit:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'skill' AS type, id::text AS id, label AS title,
+					ts_headline('simple', label, q.simple, $3) AS snippet,
+					ts_rank(search_vector, q.simple)::float8 AS rank
+				FROM %s, q
+				WHERE search_vector @@ q.simple AND deleted_at IS NULL`,
+				r.skillTable,
+			))
+		case domain.EducationHit:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'education' AS type, id::text AS id, main_school->>'name' AS title,
+					ts_headline('simple', concat_ws(' ', main_school->>'name', jsonb_path_query_array(school_periods, '$[*].name')::text), q.simple, $3) AS snippet,
+					ts_rank(search_vector, q.simple)::float8 AS rank
+				FROM %s, q
+				WHERE search_vector @@ q.simple AND deleted_at IS NULL`,
+				r.educationTable,
+			))
+		}
+	}
+
+	query := fmt.Sprintf(
+		`WITH q AS (
+			SELECT websearch_to_tsquery('english', $1) AS english,
+				websearch_to_tsquery('simple', $1) AS simple
+		)
+		%s
+		ORDER BY rank DESC, type, id
+		LIMIT $2`,
+		strings.Join(selects, "\nUNION ALL\n"),
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, strings.TrimSpace(filter.Query), filter.Limit, headlineOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search: %w", err)
+	}
+	defer rows.Close()
+
+	hits := []domain.SearchHit{}
+	for rows.Next() {
+		var hit domain.SearchHit
+
+		err := rows.Scan(
+			&hit.Type,
+			&hit.ID,
+			&hit.Title,
+			&hit.Snippet,
+			&hit.Rank,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+
+		hits = append(hits, hit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return hits, nil
+}
@@ -0,0 +1,263 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testSearchProjectTable   = "test-projects"
+	testSearchSkillTable     = "test-skills"
+	testSearchEducationTable = "test-educations"
+)
+
+// searchFakeRow for Search path
+type searchFakeRow struct {
+	hit     domain.SearchHit
+	scanErr error
+}
+
+func (f *searchFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 5 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*domain.SearchHitType) = f.hit.Type
+	*dest[1].(*string) = f.hit.ID
+	*dest[2].(*string) = f.hit.Title
+	*dest[3].(*string) = f.hit.Snippet
+	*dest[4].(*float64) = f.hit.Rank
+	return nil
+}
+
+type searchFakeRows struct {
+	rows   []*searchFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *searchFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *searchFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *searchFakeRows) Err() error { return r.rowErr }
+
+func (r *searchFakeRows) Close() {}
+
+type searchRepositoryTestFixture struct {
+	t                *testing.T
+	databaseAPI      *database.MockDatabaseAPI
+	searchRepository *searchRepository
+}
+
+func newSearchRepositoryTestFixture(t *testing.T) *searchRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+	searchRepository := &searchRepository{
+		projectTable:   testSearchProjectTable,
+		skillTable:     testSearchSkillTable,
+		educationTable: testSearchEducationTable,
+		databaseAPI:    mockDatabaseAPI,
+	}
+
+	return &searchRepositoryTestFixture{
+		t:                t,
+		databaseAPI:      mockDatabaseAPI,
+		searchRepository: searchRepository,
+	}
+}
+
+func TestSearchRepository_Search(t *testing.T) {
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	projectHit := domain.SearchHit{
+		Type:    domain.ProjectHit,
+		ID:      "p1",
+		Title:   "Portfolio",
+		Snippet: "My <mark>portfolio</mark> site",
+		Rank:    0.6,
+	}
+	educationHit := domain.SearchHit{
+		Type:    domain.EducationHit,
+		ID:      "e1",
+		Title:   "Portfolio Academy",
+		Snippet: "<mark>Portfolio</mark> Academy",
+		Rank:    0.2,
+	}
+
+	type Given struct {
+		filter    domain.SearchFilter
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		hits []domain.SearchHit
+		err  error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Searches every type by default": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "  portfolio  "},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "websearch_to_tsquery('english', $1)") &&
+									strings.Contains(query, "FROM "+testSearchProjectTable+", q") &&
+									strings.Contains(query, "FROM "+testSearchSkillTable+", q") &&
+									strings.Contains(query, "FROM "+testSearchEducationTable+", q") &&
+									strings.Count(query, "UNION ALL") == 2 &&
+									strings.Contains(query, "ORDER BY rank DESC, type, id")
+							}),
+							[]any{"portfolio", int32(20), headlineOptions},
+						).
+						Return(&searchFakeRows{
+							rows: []*searchFakeRow{{hit: projectHit}, {hit: educationHit}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				hits: []domain.SearchHit{projectHit, educationHit},
+			},
+		},
+		"Restricts to requested types": {
+			given: Given{
+				filter: domain.SearchFilter{
+					Query: "go",
+					Types: []domain.SearchHitType{domain.SkillHit, domain.SkillHit},
+					Limit: 5,
+				},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testSearchSkillTable+", q") &&
+									!strings.Contains(query, testSearchProjectTable) &&
+									!strings.Contains(query, testSearchEducationTable) &&
+									!strings.Contains(query, "UNION ALL")
+							}),
+							[]any{"go", int32(5), headlineOptions},
+						).
+						Return(&searchFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				hits: []domain.SearchHit{},
+			},
+		},
+		"Limit out of range defaults to 20": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "go", Limit: 500},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{"go", int32(20), headlineOptions}).
+						Return(&searchFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				hits: []domain.SearchHit{},
+			},
+		},
+		"Empty query": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "   "},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate search: query missing"),
+			},
+		},
+		"Invalid type": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "go", Types: []domain.SearchHitType{"user"}},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate search: type invalid = user"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "go"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to search: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "go"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&searchFakeRows{rows: []*searchFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan search hit: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				filter: domain.SearchFilter{Query: "go"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&searchFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSearchRepositoryTestFixture(t)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			hits, err := f.searchRepository.Search(context.Background(), test.given.filter)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, hits)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.hits, hits)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -18,20 +18,31 @@ type SkillRepository interface {
 	Get(ctx context.Context, id string) (*domain.Skill, error)
 	Update(ctx context.Context, skill *domain.Skill) (*domain.Skill, error)
 	Delete(ctx context.Context, id string) error
-	List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, error)
+	Restore(ctx context.Context, id string) error
+	Purge(ctx context.Context, id string) error
+	List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error)
+	Version(ctx context.Context) (domain.CollectionVersion, error)
+	ListByProjectIDs(ctx context.Context, projectIDs []string) (map[string][]domain.Skill, error)
+	ListByExperienceIDs(ctx context.Context, experienceIDs []string) (map[string][]domain.Skill, error)
+	FindMissing(ctx context.Context, ids []string) ([]string, error)
+	WithTx(tx database.Tx) SkillRepository
 }
 
 type SkillRepositoryConfig struct {
-	DatabaseAPI database.DatabaseAPI
-	SkillTable  string
+	DatabaseAPI          database.DatabaseAPI
+	SkillTable           string
+	ProjectSkillTable    string
+	ExperienceSkillTable string
 
 	timeProvider domain.TimeProvider
 }
 
 type skillRepository struct {
-	skillTable   string
-	databaseAPI  database.DatabaseAPI
-	timeProvider domain.TimeProvider
+	skillTable           string
+	projectSkillTable    string
+	experienceSkillTable string
+	databaseAPI          database.Querier
+	timeProvider         domain.TimeProvider
 }
 
 // NewSkillRepository creates and returns a SkillRepository configured with the
@@ -46,12 +57,23 @@ func NewSkillRepository(cfg SkillRepositoryConfig) SkillRepository {
 	}
 
 	return &skillRepository{
-		skillTable:   cfg.SkillTable,
-		databaseAPI:  cfg.DatabaseAPI,
-		timeProvider: timeProvider,
+		skillTable:           cfg.SkillTable,
+		projectSkillTable:    cfg.ProjectSkillTable,
+		experienceSkillTable: cfg.ExperienceSkillTable,
+		databaseAPI:          cfg.DatabaseAPI,
+		timeProvider:         timeProvider,
 	}
 }
 
+// WithTx returns a copy of the repository that issues its queries on tx
+// instead of the connection pool, so several repository calls can be
+// committed or rolled back together.
+func (r *skillRepository) WithTx(tx database.Tx) SkillRepository {
+	txRepo := *r
+	txRepo.databaseAPI = tx
+	return &txRepo
+}
+
 // Create inserts a new skill row into the configured skill table and returns its ID.
 //
 // The method performs the following steps:
@@ -115,7 +137,8 @@ func (r *skillRepository) Create(ctx context.Context, skill *domain.Skill) (stri
 // Get retrieves the Skill with the given id from the repository.
 // It returns a pointer to domain.Skill on success.
 // If the provided id is empty, Get returns an error indicating a missing ID.
-// If no row matches the id, the underlying pgx.ErrNoRows is wrapped and returned.
+// If no live row matches the id (trashed skills are skipped), the underlying
+// pgx.ErrNoRows is wrapped and returned.
 // After scanning the database row, the returned skill is validated via
 // skill.ValidateResponse(); an error is returned if validation fails.
 // The query selects id, icon, hex_color, label, category, created_at and updated_at
@@ -131,7 +154,7 @@ func (r *skillRepository) Get(ctx context.Context, id string) (*domain.Skill, er
 	query := fmt.Sprintf(
 		`SELECT id, icon, hex_color, label, category, created_at, updated_at
 		FROM %s
-		WHERE id = $1`,
+		WHERE id = $1 AND deleted_at IS NULL`,
 		tableIdent,
 	)
 
@@ -168,8 +191,10 @@ func (r *skillRepository) Get(ctx context.Context, id string) (*domain.Skill, er
 // succeeds), sets the UpdatedAt timestamp using the repository's timeProvider,
 // and performs an SQL UPDATE of the icon, hex_color, label, category and
 // updated_at columns. The updated row is returned as a domain.Skill populated
-// from the database (including created_at and updated_at). If no row matches
-// the given Id, (nil, nil) is returned to indicate "not found". Any validation
+// from the database (including created_at and updated_at). If no live row matches
+// the given Id, (nil, nil) is returned to indicate "not found". A non-zero
+// skill.UpdatedAt on input is the version the caller read; if the stored row
+// has moved past it a *domain.ConflictError is returned instead. Any validation
 // or database error is returned wrapped. The provided context is used for the
 // database operation.
 func (r *skillRepository) Update(ctx context.Context, skill *domain.Skill) (*domain.Skill, error) {
@@ -184,10 +209,26 @@ func (r *skillRepository) Update(ctx context.Context, skill *domain.Skill) (*dom
 		return nil, fmt.Errorf("failed to validate skill: %w", err)
 	}
 
+	expectedVersion := skill.UpdatedAt
 	updatedAt := r.timeProvider()
 
 	var updatedSkill domain.Skill
 
+	args := []any{
+		skill.Id,
+		skill.Icon,
+		skill.HexColor,
+		skill.Label,
+		skill.Category,
+		updatedAt,
+	}
+
+	versionCheck := ""
+	if !expectedVersion.IsZero() {
+		args = append(args, expectedVersion)
+		versionCheck = fmt.Sprintf(" AND updated_at=$%d", len(args))
+	}
+
 	tableIdent := pgx.Identifier{r.skillTable}.Sanitize()
 	query := fmt.Sprintf(
 		`UPDATE %s
@@ -196,21 +237,13 @@ func (r *skillRepository) Update(ctx context.Context, skill *domain.Skill) (*dom
 			label=$4,
 			category=$5,
 			updated_at=$6
-		WHERE id=$1
+		WHERE id=$1 AND deleted_at IS NULL%s
 		RETURNING id, icon, hex_color, label, category, created_at, updated_at`,
 		tableIdent,
+		versionCheck,
 	)
 
-	err := r.databaseAPI.QueryRow(
-		ctx,
-		query,
-		skill.Id,
-		skill.Icon,
-		skill.HexColor,
-		skill.Label,
-		skill.Category,
-		updatedAt,
-	).Scan(
+	err := r.databaseAPI.QueryRow(ctx, query, args...).Scan(
 		&updatedSkill.Id,
 		&updatedSkill.Icon,
 		&updatedSkill.HexColor,
@@ -222,6 +255,9 @@ func (r *skillRepository) Update(ctx context.Context, skill *domain.Skill) (*dom
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
+			if !expectedVersion.IsZero() {
+				return nil, versionConflict(ctx, r.databaseAPI, tableIdent, "skill", skill.Id)
+			}
 			return nil, nil
 		}
 		return nil, fmt.Errorf("failed to update skill: %w", err)
@@ -234,24 +270,26 @@ func (r *skillRepository) Update(ctx context.Context, skill *domain.Skill) (*dom
 	return &updatedSkill, nil
 }
 
-// Delete removes the skill with the given id from the repository's skill table.
-// It requires a non-empty id and uses the provided context for cancellation and timeouts.
-// If id is empty, Delete returns an error indicating the missing ID.
-// The method executes a DELETE statement via the repository's database API and
-// wraps any execution error. If no rows are affected by the DELETE, it returns
-// pgx.ErrNoRows to indicate that no matching record was found.
+// Delete moves the skill with the given id to the trash by stamping its
+// deleted_at column. It requires a non-empty id and uses the provided context for
+// cancellation and timeouts. If id is empty, Delete returns an error indicating the
+// missing ID. The method executes an UPDATE statement via the repository's database
+// API and wraps any execution error. If no live row is affected, it returns
+// pgx.ErrNoRows to indicate that no matching record was found. Project links are
+// kept so that Restore brings the skill back exactly as it was.
 func (r *skillRepository) Delete(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("failed to delete skill: ID missing")
 	}
 
 	tableIdent := pgx.Identifier{r.skillTable}.Sanitize()
-	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1", tableIdent)
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=$2 WHERE id=$1 AND deleted_at IS NULL", tableIdent)
 
 	cmdTag, err := r.databaseAPI.Exec(
 		ctx,
 		query,
 		id,
+		r.timeProvider(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to delete skill: %w", err)
@@ -267,33 +305,100 @@ func (r *skillRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
-// List retrieves a slice of domain.Skill from the repository using the provided filter.
+// Restore takes a skill out of the trash by clearing its deleted_at column and
+// stamping updated_at, so the skill list reads as modified again. It returns pgx.ErrNoRows if no trashed skill with the given id exists.
+func (r *skillRepository) Restore(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to restore skill: ID missing")
+	}
+
+	tableIdent := pgx.Identifier{r.skillTable}.Sanitize()
+	query := fmt.Sprintf("UPDATE %s SET deleted_at=NULL, updated_at=$2 WHERE id=$1 AND deleted_at IS NOT NULL", tableIdent)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id, r.timeProvider())
+	if err != nil {
+		return fmt.Errorf("failed to restore skill: %w", err)
+	}
+	if cmdTag == nil {
+		return fmt.Errorf("failed to restore skill: nil command tag")
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Purge permanently removes a trashed skill together with its project links.
+// It returns pgx.ErrNoRows if no trashed skill with the given id exists.
+func (r *skillRepository) Purge(ctx context.Context, id string) error {
+	if id == "" {
+		return fmt.Errorf("failed to purge skill: ID missing")
+	}
+
+	tableIdent := pgx.Identifier{r.skillTable}.Sanitize()
+	query := fmt.Sprintf("DELETE FROM %s WHERE id=$1 AND deleted_at IS NOT NULL", tableIdent)
+
+	cmdTag, err := r.databaseAPI.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to purge skill: %w", err)
+	}
+	if cmdTag == nil {
+		return fmt.Errorf("failed to purge skill: nil command tag")
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// Version reports the domain.CollectionVersion of the skill table, which
+// changes whenever a skill is created, updated, trashed, restored or purged.
+func (r *skillRepository) Version(ctx context.Context) (domain.CollectionVersion, error) {
+	version, err := collectionVersion(ctx, r.databaseAPI, pgx.Identifier{r.skillTable}.Sanitize(), "")
+	if err != nil {
+		return domain.CollectionVersion{}, fmt.Errorf("failed to get skills version: %w", err)
+	}
+
+	return version, nil
+}
+
+// List retrieves a slice of domain.Skill from the repository using the provided filter,
+// together with the domain.PageInfo describing the full result set.
 //
 // Behavior and defaults:
 //   - If filter.Page <= 0 it defaults to 1.
 //   - If filter.PageSize <= 0 or > 20 it defaults to 20 (maximum page size is 20).
 //   - If filter.SortBy is nil it defaults to domain.CreatedAt.
 //   - Sort direction is ascending by default; set filter.SortAscending = false for DESC.
+//   - Trashed skills are never listed.
 //   - If filter.Category is non-nil, results are filtered by the given category.
+//   - If filter.Cursor is non-nil, Page is ignored and the page starts right after the cursor's
+//     (created_at, id) pair (keyset pagination). A cursor requires sorting by domain.CreatedAt.
 //
 // Query details:
+//   - A COUNT(*) query with the same category filter produces PageInfo.Total.
 //   - The query selects columns: id, icon, hex_color, label, category, created_at, updated_at
 //     from the repository's skill table.
 //   - Category filtering is applied via a parameterized WHERE clause (uses $1, $2, ... placeholders).
 //   - ORDER BY maps filter.SortBy to an allowlisted column name (created_at or updated_at)
-//     to prevent SQL injection; invalid values default to created_at.
-//   - LIMIT and OFFSET are applied for pagination (OFFSET = (Page-1) * PageSize).
+//     to prevent SQL injection; invalid values default to created_at. id is used as a tie-breaker.
+//   - LIMIT PageSize+1 is applied so PageInfo.HasNext can be derived without a second query;
+//     OFFSET = (Page-1) * PageSize is only applied when no cursor is given.
 //
 // Execution and errors:
 //   - The method executes the constructed query using r.databaseAPI.Query with the accumulated args.
 //   - Rows are scanned into domain.Skill values and returned as a slice.
-//   - On query, scan, or row iteration failures the method returns a wrapped error with context
-//     ("failed to list skills", "failed to scan skill", or "row iteration error").
+//   - On count, query, scan, or row iteration failures the method returns a wrapped error with context
+//     ("failed to count skills", "failed to list skills", "failed to scan skill", or "row iteration error").
 //
 // Returns:
-//   - ([]domain.Skill, nil) on success.
-//   - (nil, error) on failure.
-func (r *skillRepository) List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, error) {
+//   - ([]domain.Skill, domain.PageInfo, nil) on success.
+//   - (nil, domain.PageInfo{}, error) on failure.
+func (r *skillRepository) List(ctx context.Context, filter domain.SkillFilter) ([]domain.Skill, domain.PageInfo, error) {
 	// Set defaults if not provided
 	if filter.Page <= 0 {
 		filter.Page = 1
@@ -305,31 +410,47 @@ func (r *skillRepository) List(ctx context.Context, filter domain.SkillFilter) (
 		defaultSort := domain.CreatedAt
 		filter.SortBy = &defaultSort
 	}
+	if filter.Cursor != nil && *filter.SortBy != domain.CreatedAt {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list skills: cursor requires sorting by %s", domain.CreatedAt)
+	}
 
 	tableIdent := pgx.Identifier{r.skillTable}.Sanitize()
-	baseQuery := fmt.Sprintf(
-		`SELECT id, icon, hex_color, label, category, created_at, updated_at FROM %s`,
-		tableIdent,
-	)
-	var conditions []string
+	conditions := []string{"deleted_at IS NULL"}
 	var args []any
 	argIdx := 1
 
 	// Add optional category filter
 	if filter.Category != nil {
 		if *filter.Category == "" {
-			return nil, fmt.Errorf("failed to list skills: invalid empty category")
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to list skills: invalid empty category")
 		}
 		conditions = append(conditions, fmt.Sprintf("category = $%d", argIdx))
 		args = append(args, *filter.Category)
 		argIdx++
 	}
 
-	// Append WHERE clause if any filters exist
-	if len(conditions) > 0 {
-		baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+	// Count every matching row before the cursor narrows the window
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s", tableIdent, strings.Join(conditions, " AND "))
+
+	var total int64
+	if err := r.databaseAPI.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to count skills: %w", err)
 	}
 
+	// Add optional keyset condition
+	if filter.Cursor != nil {
+		condition, cursorArgs := cursorCondition(*filter.Cursor, filter.SortAscending, argIdx)
+		conditions = append(conditions, condition)
+		args = append(args, cursorArgs...)
+		argIdx += len(cursorArgs)
+	}
+
+	baseQuery := fmt.Sprintf(
+		`SELECT id, icon, hex_color, label, category, created_at, updated_at FROM %s`,
+		tableIdent,
+	)
+	baseQuery += " WHERE " + strings.Join(conditions, " AND ")
+
 	// Add sorting with allowlist
 	sortOrder := "ASC"
 	if !filter.SortAscending {
@@ -345,17 +466,22 @@ func (r *skillRepository) List(ctx context.Context, filter domain.SkillFilter) (
 		// fallback to a safe default to avoid invalid column names
 		orderCol = "created_at"
 	}
-	baseQuery += fmt.Sprintf(" ORDER BY %s %s", orderCol, sortOrder)
-
-	// Add pagination
-	offset := (filter.Page - 1) * filter.PageSize
-	baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
-	args = append(args, filter.PageSize, offset)
+	baseQuery += fmt.Sprintf(" ORDER BY %s %s, id %s", orderCol, sortOrder, sortOrder)
+
+	// Add pagination, fetching one extra row to learn whether another page follows
+	if filter.Cursor != nil {
+		baseQuery += fmt.Sprintf(" LIMIT $%d", argIdx)
+		args = append(args, filter.PageSize+1)
+	} else {
+		offset := (filter.Page - 1) * filter.PageSize
+		baseQuery += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIdx, argIdx+1)
+		args = append(args, filter.PageSize+1, offset)
+	}
 
 	// Execute query
 	rows, err := r.databaseAPI.Query(ctx, baseQuery, args...)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list skills: %w", err)
+		return nil, domain.PageInfo{}, fmt.Errorf("failed to list skills: %w", err)
 	}
 	defer rows.Close()
 
@@ -373,19 +499,155 @@ func (r *skillRepository) List(ctx context.Context, filter domain.SkillFilter) (
 			&skill.UpdatedAt,
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to scan skill: %w", err)
+			return nil, domain.PageInfo{}, fmt.Errorf("failed to scan skill: %w", err)
 		}
 
 		if err := skill.ValidateResponse(); err != nil {
-			return nil, fmt.Errorf("invalid skill returned: %w", err)
+			return nil, domain.PageInfo{}, fmt.Errorf("invalid skill returned: %w", err)
 		}
 
 		skills = append(skills, skill)
 	}
 
+	if err := rows.Err(); err != nil {
+		return nil, domain.PageInfo{}, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	fetched := len(skills)
+	if fetched > int(filter.PageSize) {
+		skills = skills[:filter.PageSize]
+	}
+
+	var last domain.Skill
+	if len(skills) > 0 {
+		last = skills[len(skills)-1]
+	}
+
+	pageInfo := newPageInfo(filter.Page, filter.PageSize, total, fetched, orderCol == "created_at", last.CreatedAt, last.Id)
+
+	return skills, pageInfo, nil
+}
+
+// ListByProjectIDs fetches the skills linked to each of the given projects in a
+// single query through the project-skill join table. The returned map holds an
+// entry, possibly empty, for every requested project ID; skills within a project
+// are ordered by label and trashed skills are left out.
+func (r *skillRepository) ListByProjectIDs(ctx context.Context, projectIDs []string) (map[string][]domain.Skill, error) {
+	return r.listByParentIDs(ctx, r.projectSkillTable, "project", projectIDs)
+}
+
+// ListByExperienceIDs fetches the skills linked to each of the given experiences
+// in a single query through the experience-skill join table, like ListByProjectIDs.
+func (r *skillRepository) ListByExperienceIDs(ctx context.Context, experienceIDs []string) (map[string][]domain.Skill, error) {
+	return r.listByParentIDs(ctx, r.experienceSkillTable, "experience", experienceIDs)
+}
+
+// listByParentIDs fetches the live skills linked to each of parentIDs through
+// joinTable, whose parent column is named after parent (e.g. project_id).
+func (r *skillRepository) listByParentIDs(ctx context.Context, joinTable, parent string, parentIDs []string) (map[string][]domain.Skill, error) {
+	if len(parentIDs) == 0 {
+		return make(map[string][]domain.Skill), nil
+	}
+
+	placeholders := make([]string, len(parentIDs))
+	args := make([]any, len(parentIDs))
+	for i, id := range parentIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id
+	}
+
+	skillIdent := pgx.Identifier{r.skillTable}.Sanitize()
+	joinIdent := pgx.Identifier{joinTable}.Sanitize()
+	parentColumn := parent + "_id"
+	query := fmt.Sprintf(
+		`SELECT j.%[3]s, s.id, s.icon, s.hex_color, s.label, s.category, s.created_at, s.updated_at
+		FROM %[1]s s
+		JOIN %[2]s j ON j.skill_id = s.id
+		WHERE j.%[3]s IN (%[4]s) AND s.deleted_at IS NULL
+		ORDER BY j.%[3]s, s.label`,
+		skillIdent,
+		joinIdent,
+		parentColumn,
+		strings.Join(placeholders, ", "),
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to batch query skills by %s: %w", parent, err)
+	}
+	defer rows.Close()
+
+	skillsByParent := make(map[string][]domain.Skill, len(parentIDs))
+	for _, id := range parentIDs {
+		skillsByParent[id] = []domain.Skill{}
+	}
+
+	for rows.Next() {
+		var parentID string
+		var skill domain.Skill
+
+		err := rows.Scan(
+			&parentID,
+			&skill.Id,
+			&skill.Icon,
+			&skill.HexColor,
+			&skill.Label,
+			&skill.Category,
+			&skill.CreatedAt,
+			&skill.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan skill: %w", err)
+		}
+
+		if _, ok := skillsByParent[parentID]; ok {
+			skillsByParent[parentID] = append(skillsByParent[parentID], skill)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return skillsByParent, nil
+}
+
+// FindMissing returns the subset of ids that do not match any live skill, in the
+// order they were given. It lets callers validate skill references (for example
+// the skills attached to a project) before writing them. An empty ids slice
+// returns no missing IDs without querying the database.
+func (r *skillRepository) FindMissing(ctx context.Context, ids []string) ([]string, error) {
+	if len(ids) == 0 {
+		return []string{}, nil
+	}
+
+	tableIdent := pgx.Identifier{r.skillTable}.Sanitize()
+	query := fmt.Sprintf(
+		`SELECT requested.id::text
+		FROM unnest($1::uuid[]) WITH ORDINALITY AS requested(id, position)
+		WHERE NOT EXISTS (SELECT 1 FROM %s s WHERE s.id = requested.id AND s.deleted_at IS NULL)
+		ORDER BY requested.position`,
+		tableIdent,
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check skills: %w", err)
+	}
+	defer rows.Close()
+
+	missing := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan skill id: %w", err)
+		}
+		missing = append(missing, id)
+	}
+
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("row iteration error: %w", err)
 	}
 
-	return skills, nil
+	return missing, nil
 }
@@ -16,7 +16,9 @@ import (
 )
 
 const (
-	testSkillTable = "test-skills"
+	testSkillTable           = "test-skills"
+	testSkillProjectTable    = "test-project-skills"
+	testSkillExperienceTable = "test-experience-skills"
 )
 
 // skillFakeRow for Create, Get, and Update paths
@@ -83,6 +85,52 @@ func (r *skillFakeRows) Err() error { return r.rowErr }
 
 func (r *skillFakeRows) Close() {}
 
+// skillLinkFakeRow is a skill joined to the project or experience it is linked to
+type skillLinkFakeRow struct {
+	parentID string
+	skill    domain.Skill
+	scanErr  error
+}
+
+func (f *skillLinkFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 8 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*string) = f.parentID
+	*dest[1].(*string) = f.skill.Id
+	*dest[2].(*string) = f.skill.Icon
+	*dest[3].(*string) = f.skill.HexColor
+	*dest[4].(*string) = f.skill.Label
+	*dest[5].(*domain.SkillCategory) = f.skill.Category
+	*dest[6].(*time.Time) = f.skill.CreatedAt
+	*dest[7].(*time.Time) = f.skill.UpdatedAt
+	return nil
+}
+
+type skillLinkFakeRows struct {
+	rows   []*skillLinkFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *skillLinkFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *skillLinkFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *skillLinkFakeRows) Err() error { return r.rowErr }
+
+func (r *skillLinkFakeRows) Close() {}
+
 type skillRepositoryTestFixture struct {
 	t               *testing.T
 	databaseAPI     *database.MockDatabaseAPI
@@ -92,9 +140,11 @@ type skillRepositoryTestFixture struct {
 func newSkillRepositoryTestFixture(t *testing.T, timeProvider func() time.Time) *skillRepositoryTestFixture {
 	mockDatabaseAPI := new(database.MockDatabaseAPI)
 	skillRepository := &skillRepository{
-		databaseAPI:  mockDatabaseAPI,
-		timeProvider: timeProvider,
-		skillTable:   testSkillTable,
+		databaseAPI:          mockDatabaseAPI,
+		timeProvider:         timeProvider,
+		skillTable:           testSkillTable,
+		projectSkillTable:    testSkillProjectTable,
+		experienceSkillTable: testSkillExperienceTable,
 	}
 
 	return &skillRepositoryTestFixture{
@@ -449,6 +499,49 @@ func TestSkillRepository_Update(t *testing.T) {
 				err: nil,
 			},
 		},
+		"Versioned update checks updated_at": {
+			given: Given{
+				skill: func() *domain.Skill { s := originalSkill; s.UpdatedAt = fixedTime.Add(-time.Minute); return &s }(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					updatedReturned := updatedSkill
+					updatedReturned.UpdatedAt = fixedTime
+					m.EXPECT().QueryRow(
+						mock.Anything,
+						mock.MatchedBy(func(q string) bool { return strings.Contains(q, "AND deleted_at IS NULL AND updated_at=$7") }),
+						mock.MatchedBy(func(args []any) bool {
+							return len(args) == 7 &&
+								args[5] == fixedTime &&
+								args[6] == fixedTime.Add(-time.Minute)
+						}),
+					).Return(&skillFakeRow{skill: &updatedReturned})
+				},
+			},
+			expected: Expected{
+				result: &domain.Skill{
+					Id:        originalSkill.Id,
+					Icon:      originalSkill.Icon,
+					HexColor:  originalSkill.HexColor,
+					Label:     originalSkill.Label,
+					Category:  originalSkill.Category,
+					CreatedAt: originalSkill.CreatedAt,
+					UpdatedAt: fixedTime,
+				},
+			},
+		},
+		"Stale version returns a conflict": {
+			given: Given{
+				skill: func() *domain.Skill { s := originalSkill; s.UpdatedAt = fixedTime.Add(-time.Minute); return &s }(),
+				mockQueryRow: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().QueryRow(mock.Anything, mock.MatchedBy(isUpdate), mock.Anything).
+						Return(&skillFakeRow{scanErr: pgx.ErrNoRows})
+					m.EXPECT().QueryRow(mock.Anything, mock.MatchedBy(isVersionLookup), []any{originalSkill.Id}).
+						Return(&versionFakeRow{updatedAt: fixedTime.Add(-time.Second)})
+				},
+			},
+			expected: Expected{
+				err: &domain.ConflictError{Entity: "skill", ID: originalSkill.Id, Current: fixedTime.Add(-time.Second)},
+			},
+		},
 		"Nil payload fails": {
 			given: Given{
 				skill:        nil,
@@ -569,6 +662,7 @@ func TestSkillRepository_Update(t *testing.T) {
 func TestSkillRepository_Delete(t *testing.T) {
 	dbErr := errors.New("db exec error")
 	fixedID := "skill-123"
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 
 	type Given struct {
 		id       string
@@ -591,9 +685,10 @@ func TestSkillRepository_Delete(t *testing.T) {
 						Exec(
 							mock.Anything,
 							mock.MatchedBy(func(q string) bool {
-								return strings.Contains(q, fmt.Sprintf("DELETE FROM %s", pgx.Identifier{testSkillTable}.Sanitize()))
+								return strings.Contains(q, fmt.Sprintf("UPDATE %s SET deleted_at=$2", pgx.Identifier{testSkillTable}.Sanitize())) &&
+									strings.Contains(q, "deleted_at IS NULL")
 							}),
-							mock.Anything,
+							[]any{fixedID, fixedTime},
 						).
 						Return(&skillFakeCommandTag{rows: 1}, nil)
 				},
@@ -636,7 +731,7 @@ func TestSkillRepository_Delete(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			f := newSkillRepositoryTestFixture(t, time.Now)
+			f := newSkillRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
 			if test.given.mockExec != nil {
 				test.given.mockExec(f.databaseAPI)
@@ -655,6 +750,187 @@ func TestSkillRepository_Delete(t *testing.T) {
 	}
 }
 
+func TestSkillRepository_Restore(t *testing.T) {
+	dbErr := errors.New("db exec error")
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	fixedID := "skill-123"
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful restore": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, fmt.Sprintf("UPDATE %s SET deleted_at=NULL", pgx.Identifier{testSkillTable}.Sanitize())) &&
+									strings.Contains(q, "deleted_at IS NOT NULL")
+							}),
+							[]any{fixedID, fixedTime},
+						).
+						Return(&skillFakeCommandTag{rows: 1}, nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during restore": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to restore skill: %w", dbErr),
+			},
+		},
+		"Skill not in trash": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(&skillFakeCommandTag{rows: 0}, nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Restore with empty ID": {
+			given: Given{id: ""},
+			expected: Expected{
+				err: fmt.Errorf("failed to restore skill: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillRepositoryTestFixture(t, func() time.Time { return fixedTime })
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.skillRepository.Restore(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSkillRepository_Purge(t *testing.T) {
+	dbErr := errors.New("db exec error")
+	fixedID := "skill-123"
+
+	type Given struct {
+		id       string
+		mockExec func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful purge": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, fmt.Sprintf("DELETE FROM %s", pgx.Identifier{testSkillTable}.Sanitize())) &&
+									strings.Contains(q, "deleted_at IS NOT NULL")
+							}),
+							[]any{fixedID},
+						).
+						Return(&skillFakeCommandTag{rows: 1}, nil)
+				},
+			},
+			expected: Expected{err: nil},
+		},
+		"Database error during purge": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, dbErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge skill: %w", dbErr),
+			},
+		},
+		"Skill not in trash": {
+			given: Given{
+				id: fixedID,
+				mockExec: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Exec(mock.Anything, mock.Anything, mock.Anything).
+						Return(&skillFakeCommandTag{rows: 0}, nil)
+				},
+			},
+			expected: Expected{
+				err: pgx.ErrNoRows,
+			},
+		},
+		"Purge with empty ID": {
+			given: Given{id: ""},
+			expected: Expected{
+				err: fmt.Errorf("failed to purge skill: ID missing"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillRepositoryTestFixture(t, time.Now)
+
+			if test.given.mockExec != nil {
+				test.given.mockExec(f.databaseAPI)
+			}
+
+			err := f.skillRepository.Purge(context.Background(), test.given.id)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
 func TestSkillRepository_List(t *testing.T) {
 	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
 	dbErr := errors.New("db error")
@@ -673,14 +949,25 @@ func TestSkillRepository_List(t *testing.T) {
 		UpdatedAt: fixedTime,
 	}
 
+	countErr := errors.New("count error")
+	cursor := domain.Cursor{CreatedAt: fixedTime, ID: "skill-000"}
+	sortUpdated := domain.UpdatedAt
+
+	mockSkill2 := mockSkill
+	mockSkill2.Id = "skill-456"
+
+	defaultPageInfo := domain.PageInfo{Total: 1, Page: 1, PageSize: 20}
+
 	type Given struct {
 		filter   domain.SkillFilter
+		count    *countFakeRow
 		mockRows func(m *database.MockDatabaseAPI)
 	}
 
 	type Expected struct {
-		result []domain.Skill
-		err    error
+		result   []domain.Skill
+		pageInfo domain.PageInfo
+		err      error
 	}
 
 	tests := map[string]struct {
@@ -699,8 +986,9 @@ func TestSkillRepository_List(t *testing.T) {
 				},
 			},
 			expected: Expected{
-				result: []domain.Skill{mockSkill},
-				err:    nil,
+				result:   []domain.Skill{mockSkill},
+				pageInfo: defaultPageInfo,
+				err:      nil,
 			},
 		},
 		"Successful list with category filter": {
@@ -714,7 +1002,7 @@ func TestSkillRepository_List(t *testing.T) {
 							mock.Anything,
 							mock.Anything,
 							mock.MatchedBy(func(args []any) bool {
-								// With category filter: args = [category, pageSize, offset]
+								// With category filter: args = [category, pageSize+1, offset]
 								if len(args) != 3 {
 									return false
 								}
@@ -735,8 +1023,9 @@ func TestSkillRepository_List(t *testing.T) {
 				},
 			},
 			expected: Expected{
-				result: []domain.Skill{mockSkill},
-				err:    nil,
+				result:   []domain.Skill{mockSkill},
+				pageInfo: defaultPageInfo,
+				err:      nil,
 			},
 		},
 		"Database query error": {
@@ -801,8 +1090,9 @@ func TestSkillRepository_List(t *testing.T) {
 				},
 			},
 			expected: Expected{
-				result: []domain.Skill{mockSkill},
-				err:    nil,
+				result:   []domain.Skill{mockSkill},
+				pageInfo: defaultPageInfo,
+				err:      nil,
 			},
 		},
 		"Sorting applied correctly": {
@@ -818,7 +1108,7 @@ func TestSkillRepository_List(t *testing.T) {
 								return strings.Contains(q, "ORDER BY created_at DESC")
 							}),
 							mock.MatchedBy(func(args []any) bool {
-								// When no category filter, args contains [pageSize, offset]
+								// When no category filter, args contains [pageSize+1, offset]
 								return len(args) == 2
 							}),
 						).
@@ -828,13 +1118,15 @@ func TestSkillRepository_List(t *testing.T) {
 				},
 			},
 			expected: Expected{
-				result: []domain.Skill{mockSkill},
-				err:    nil,
+				result:   []domain.Skill{mockSkill},
+				pageInfo: defaultPageInfo,
+				err:      nil,
 			},
 		},
 		"Empty result set": {
 			given: Given{
 				filter: domain.SkillFilter{},
+				count:  &countFakeRow{count: 0},
 				mockRows: func(m *database.MockDatabaseAPI) {
 					m.EXPECT().
 						Query(mock.Anything, mock.Anything, mock.Anything).
@@ -843,9 +1135,84 @@ func TestSkillRepository_List(t *testing.T) {
 						}, nil)
 				},
 			},
+			expected: Expected{
+				result:   nil,
+				pageInfo: domain.PageInfo{Total: 0, Page: 1, PageSize: 20},
+				err:      nil,
+			},
+		},
+		"Count query error": {
+			given: Given{
+				filter: domain.SkillFilter{},
+				count:  &countFakeRow{scanErr: countErr},
+			},
 			expected: Expected{
 				result: nil,
-				err:    nil,
+				err:    fmt.Errorf("failed to count skills: %w", countErr),
+			},
+		},
+		"Extra row sets has next and cursor": {
+			given: Given{
+				filter: domain.SkillFilter{PageSize: 1},
+				count:  &countFakeRow{count: 2},
+				mockRows: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "LIMIT $1 OFFSET $2")
+							}),
+							[]any{int32(2), int32(0)},
+						).
+						Return(&skillFakeRows{
+							rows: []*skillFakeRow{{skill: &mockSkill}, {skill: &mockSkill2}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				result: []domain.Skill{mockSkill},
+				pageInfo: domain.PageInfo{
+					Total:      2,
+					Page:       1,
+					PageSize:   1,
+					HasNext:    true,
+					NextCursor: domain.Cursor{CreatedAt: mockSkill.CreatedAt, ID: mockSkill.Id}.Encode(),
+				},
+				err: nil,
+			},
+		},
+		"Cursor adds keyset condition": {
+			given: Given{
+				filter: domain.SkillFilter{Category: &category, Cursor: &cursor},
+				mockRows: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(q string) bool {
+								return strings.Contains(q, "WHERE deleted_at IS NULL AND category = $1 AND (created_at, id) < ($2, $3)") &&
+									strings.Contains(q, "LIMIT $4") &&
+									!strings.Contains(q, "OFFSET")
+							}),
+							[]any{category, cursor.CreatedAt, cursor.ID, int32(21)},
+						).
+						Return(&skillFakeRows{
+							rows: []*skillFakeRow{{skill: &mockSkill}},
+						}, nil)
+				},
+			},
+			expected: Expected{
+				result:   []domain.Skill{mockSkill},
+				pageInfo: defaultPageInfo,
+				err:      nil,
+			},
+		},
+		"Cursor with non created_at sort": {
+			given: Given{
+				filter: domain.SkillFilter{SortBy: &sortUpdated, Cursor: &cursor},
+			},
+			expected: Expected{
+				result: nil,
+				err:    errors.New("failed to list skills: cursor requires sorting by created_at"),
 			},
 		},
 	}
@@ -854,11 +1221,17 @@ func TestSkillRepository_List(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			f := newSkillRepositoryTestFixture(t, func() time.Time { return fixedTime })
 
+			count := test.given.count
+			if count == nil {
+				count = &countFakeRow{count: 1}
+			}
+			expectCount(f.databaseAPI, count)
+
 			if test.given.mockRows != nil {
 				test.given.mockRows(f.databaseAPI)
 			}
 
-			result, err := f.skillRepository.List(context.Background(), test.given.filter)
+			result, pageInfo, err := f.skillRepository.List(context.Background(), test.given.filter)
 
 			if test.expected.err != nil {
 				assert.EqualError(t, err, test.expected.err.Error())
@@ -866,6 +1239,372 @@ func TestSkillRepository_List(t *testing.T) {
 			} else {
 				assert.NoError(t, err)
 				assert.Equal(t, test.expected.result, result)
+				assert.Equal(t, test.expected.pageInfo, pageInfo)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSkillRepository_ListByProjectIDs(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	goSkill := domain.Skill{
+		Id:        "skill-1",
+		Icon:      "go.svg",
+		HexColor:  "#00ADD8",
+		Label:     "Go",
+		Category:  domain.Backend,
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+	reactSkill := domain.Skill{
+		Id:        "skill-2",
+		Icon:      "react.svg",
+		HexColor:  "#61DAFB",
+		Label:     "React",
+		Category:  domain.Frontend,
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	type Given struct {
+		projectIDs []string
+		mockQuery  func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		skills map[string][]domain.Skill
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful batch lookup": {
+			given: Given{
+				projectIDs: []string{"p1", "p2", "p3"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, `FROM "`+testSkillTable+`" s`) &&
+									strings.Contains(query, `JOIN "`+testSkillProjectTable+`" j ON j.skill_id = s.id`) &&
+									strings.Contains(query, "WHERE j.project_id IN ($1, $2, $3) AND s.deleted_at IS NULL")
+							}),
+							[]any{"p1", "p2", "p3"},
+						).
+						Return(&skillLinkFakeRows{rows: []*skillLinkFakeRow{
+							{parentID: "p1", skill: goSkill},
+							{parentID: "p1", skill: reactSkill},
+							{parentID: "p2", skill: goSkill},
+						}}, nil)
+				},
+			},
+			expected: Expected{
+				skills: map[string][]domain.Skill{
+					"p1": {goSkill, reactSkill},
+					"p2": {goSkill},
+					"p3": {},
+				},
+			},
+		},
+		"No project IDs": {
+			given: Given{
+				projectIDs: nil,
+			},
+			expected: Expected{
+				skills: map[string][]domain.Skill{},
+			},
+		},
+		"Query fails": {
+			given: Given{
+				projectIDs: []string{"p1"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{"p1"}).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to batch query skills by project: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				projectIDs: []string{"p1"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{"p1"}).
+						Return(&skillLinkFakeRows{rows: []*skillLinkFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan skill: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				projectIDs: []string{"p1"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{"p1"}).
+						Return(&skillLinkFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			skills, err := f.skillRepository.ListByProjectIDs(context.Background(), test.given.projectIDs)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, skills)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.skills, skills)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSkillRepository_ListByExperienceIDs(t *testing.T) {
+	fixedTime := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+
+	goSkill := domain.Skill{
+		Id:        "skill-1",
+		Icon:      "go.svg",
+		HexColor:  "#00ADD8",
+		Label:     "Go",
+		Category:  domain.Backend,
+		CreatedAt: fixedTime,
+		UpdatedAt: fixedTime,
+	}
+
+	type Given struct {
+		experienceIDs []string
+		mockQuery     func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		skills map[string][]domain.Skill
+		err    error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Successful batch lookup": {
+			given: Given{
+				experienceIDs: []string{"e1", "e2"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, `FROM "`+testSkillTable+`" s`) &&
+									strings.Contains(query, `JOIN "`+testSkillExperienceTable+`" j ON j.skill_id = s.id`) &&
+									strings.Contains(query, "WHERE j.experience_id IN ($1, $2) AND s.deleted_at IS NULL")
+							}),
+							[]any{"e1", "e2"},
+						).
+						Return(&skillLinkFakeRows{rows: []*skillLinkFakeRow{
+							{parentID: "e1", skill: goSkill},
+						}}, nil)
+				},
+			},
+			expected: Expected{
+				skills: map[string][]domain.Skill{
+					"e1": {goSkill},
+					"e2": {},
+				},
+			},
+		},
+		"No experience IDs": {
+			given: Given{
+				experienceIDs: nil,
+			},
+			expected: Expected{
+				skills: map[string][]domain.Skill{},
+			},
+		},
+		"Query fails": {
+			given: Given{
+				experienceIDs: []string{"e1"},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{"e1"}).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to batch query skills by experience: %w", queryErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			skills, err := f.skillRepository.ListByExperienceIDs(context.Background(), test.given.experienceIDs)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, skills)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.skills, skills)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSkillRepository_FindMissing(t *testing.T) {
+	ids := []string{"skill-1", "skill-2", "skill-3"}
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	type Given struct {
+		ids       []string
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		missing []string
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Reports missing skills": {
+			given: Given{
+				ids: ids,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM unnest($1::uuid[]) WITH ORDINALITY AS requested(id, position)") &&
+									strings.Contains(query, `NOT EXISTS (SELECT 1 FROM "`+testSkillTable+`" s WHERE s.id = requested.id AND s.deleted_at IS NULL)`)
+							}),
+							[]any{ids},
+						).
+						Return(&skillFakeRows{rows: []*skillFakeRow{{id: "skill-2"}}}, nil)
+				},
+			},
+			expected: Expected{
+				missing: []string{"skill-2"},
+			},
+		},
+		"All skills exist": {
+			given: Given{
+				ids: ids,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{ids}).
+						Return(&skillFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				missing: []string{},
+			},
+		},
+		"No IDs": {
+			given: Given{
+				ids: nil,
+			},
+			expected: Expected{
+				missing: []string{},
+			},
+		},
+		"Query fails": {
+			given: Given{
+				ids: ids,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{ids}).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to check skills: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				ids: ids,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{ids}).
+						Return(&skillFakeRows{rows: []*skillFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan skill id: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				ids: ids,
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{ids}).
+						Return(&skillFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newSkillRepositoryTestFixture(t, nil)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			missing, err := f.skillRepository.FindMissing(context.Background(), test.given.ids)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, missing)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.missing, missing)
 			}
 
 			f.databaseAPI.AssertExpectations(t)
@@ -0,0 +1,149 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+)
+
+type TrashRepository interface {
+	List(ctx context.Context, filter domain.TrashFilter) ([]domain.TrashItem, error)
+}
+
+type TrashRepositoryConfig struct {
+	DatabaseAPI     database.DatabaseAPI
+	ProjectTable    string
+	SkillTable      string
+	EducationTable  string
+	ExperienceTable string
+}
+
+type trashRepository struct {
+	projectTable    string
+	skillTable      string
+	educationTable  string
+	experienceTable string
+	databaseAPI     database.Querier
+}
+
+// NewTrashRepository creates and returns a TrashRepository that lists the
+// soft-deleted rows of the project, skill, education and experience tables
+// named in cfg using cfg.DatabaseAPI.
+func NewTrashRepository(cfg TrashRepositoryConfig) TrashRepository {
+	return &trashRepository{
+		projectTable:    cfg.ProjectTable,
+		skillTable:      cfg.SkillTable,
+		educationTable:  cfg.EducationTable,
+		experienceTable: cfg.ExperienceTable,
+		databaseAPI:     cfg.DatabaseAPI,
+	}
+}
+
+// List returns the trashed projects, skills, education records and
+// experiences, most recently deleted first.
+//
+// Behavior and defaults:
+//   - filter is validated via filter.Validate().
+//   - If filter.Entities is empty every entity type is listed.
+//   - If filter.Limit <= 0 or > 100 it defaults to 50.
+//
+// Each entity contributes a SELECT to a single UNION ALL, so one round trip
+// returns every type. Rows are ordered by deleted_at, then entity and id for a
+// stable order between rows deleted at the same instant.
+//
+// Returns:
+//   - ([]domain.TrashItem, nil) on success; the slice is empty when the trash is empty.
+//   - (nil, error) on validation, query, scan, or row iteration failures.
+func (r *trashRepository) List(ctx context.Context, filter domain.TrashFilter) ([]domain.TrashItem, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate trash filter: %w", err)
+	}
+
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		filter.Limit = 50
+	}
+
+	entities := filter.Entities
+	if len(entities) == 0 {
+		entities = []domain.TrashEntity{domain.TrashedProject, domain.TrashedSkill, domain.TrashedEducation, domain.TrashedExperience}
+	}
+
+	var selects []string
+	seen := make(map[domain.TrashEntity]bool, len(entities))
+	for _, e := range entities {
+		if seen[e] {
+			continue
+		}
+		seen[e] = true
+
+		switch e {
+		case domain.TrashedProject:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'project' AS entity, id::text AS id, title AS title, deleted_at
+				FROM %s
+				WHERE deleted_at IS NOT NULL`,
+				r.projectTable,
+			))
+		case domain.TrashedSkill:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'skill' AS entity, id::text AS id, label AS title, deleted_at
+				FROM %s
+				WHERE deleted_at IS NOT NULL`,
+				r.skillTable,
+			))
+		case domain.TrashedEducation:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'education' AS entity, id::text AS id, main_school->>'name' AS title, deleted_at
+				FROM %s
+				WHERE deleted_at IS NOT NULL`,
+				r.educationTable,
+			))
+		case domain.TrashedExperience:
+			selects = append(selects, fmt.Sprintf(
+				`SELECT 'experience' AS entity, id::text AS id, position || ' at ' || company AS title, deleted_at
+				FROM %s
+				WHERE deleted_at IS NOT NULL`,
+				r.experienceTable,
+			))
+		}
+	}
+
+	query := fmt.Sprintf(
+		`%s
+		ORDER BY deleted_at DESC, entity, id
+		LIMIT $1`,
+		strings.Join(selects, "\nUNION ALL\n"),
+	)
+
+	rows, err := r.databaseAPI.Query(ctx, query, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+	defer rows.Close()
+
+	items := []domain.TrashItem{}
+	for rows.Next() {
+		var item domain.TrashItem
+
+		err := rows.Scan(
+			&item.Entity,
+			&item.ID,
+			&item.Title,
+			&item.DeletedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan trash item: %w", err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
+
+	return items, nil
+}
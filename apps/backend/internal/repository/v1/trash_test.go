@@ -0,0 +1,253 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testTrashProjectTable    = "test-projects"
+	testTrashSkillTable      = "test-skills"
+	testTrashEducationTable  = "test-educations"
+	testTrashExperienceTable = "test-experiences"
+)
+
+// trashFakeRow for List path
+type trashFakeRow struct {
+	item    domain.TrashItem
+	scanErr error
+}
+
+func (f *trashFakeRow) Scan(dest ...any) error {
+	if f.scanErr != nil {
+		return f.scanErr
+	}
+	if len(dest) != 4 {
+		return fmt.Errorf("unexpected number of scan destinations: %d", len(dest))
+	}
+
+	*dest[0].(*domain.TrashEntity) = f.item.Entity
+	*dest[1].(*string) = f.item.ID
+	*dest[2].(*string) = f.item.Title
+	*dest[3].(*time.Time) = f.item.DeletedAt
+	return nil
+}
+
+type trashFakeRows struct {
+	rows   []*trashFakeRow
+	index  int
+	rowErr error
+}
+
+func (r *trashFakeRows) Next() bool {
+	return r.index < len(r.rows)
+}
+
+func (r *trashFakeRows) Scan(dest ...any) error {
+	err := r.rows[r.index].Scan(dest...)
+	r.index++
+	return err
+}
+
+func (r *trashFakeRows) Err() error { return r.rowErr }
+
+func (r *trashFakeRows) Close() {}
+
+type trashRepositoryTestFixture struct {
+	t               *testing.T
+	databaseAPI     *database.MockDatabaseAPI
+	trashRepository *trashRepository
+}
+
+func newTrashRepositoryTestFixture(t *testing.T) *trashRepositoryTestFixture {
+	mockDatabaseAPI := new(database.MockDatabaseAPI)
+	trashRepository := &trashRepository{
+		projectTable:    testTrashProjectTable,
+		skillTable:      testTrashSkillTable,
+		educationTable:  testTrashEducationTable,
+		experienceTable: testTrashExperienceTable,
+		databaseAPI:     mockDatabaseAPI,
+	}
+
+	return &trashRepositoryTestFixture{
+		t:               t,
+		databaseAPI:     mockDatabaseAPI,
+		trashRepository: trashRepository,
+	}
+}
+
+func TestTrashRepository_List(t *testing.T) {
+	queryErr := errors.New("query error")
+	scanErr := errors.New("scan error")
+	rowErr := errors.New("row iteration error")
+
+	deletedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	projectItem := domain.TrashItem{
+		Entity:    domain.TrashedProject,
+		ID:        "p1",
+		Title:     "Portfolio",
+		DeletedAt: deletedAt,
+	}
+	skillItem := domain.TrashItem{
+		Entity:    domain.TrashedSkill,
+		ID:        "s1",
+		Title:     "Go",
+		DeletedAt: deletedAt.Add(-time.Hour),
+	}
+
+	type Given struct {
+		filter    domain.TrashFilter
+		mockQuery func(m *database.MockDatabaseAPI)
+	}
+
+	type Expected struct {
+		items []domain.TrashItem
+		err   error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Lists every entity by default": {
+			given: Given{
+				filter: domain.TrashFilter{},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testTrashProjectTable) &&
+									strings.Contains(query, "FROM "+testTrashSkillTable) &&
+									strings.Contains(query, "FROM "+testTrashEducationTable) &&
+									strings.Contains(query, "FROM "+testTrashExperienceTable) &&
+									strings.Count(query, "WHERE deleted_at IS NOT NULL") == 4 &&
+									strings.Count(query, "UNION ALL") == 3 &&
+									strings.Contains(query, "ORDER BY deleted_at DESC, entity, id")
+							}),
+							[]any{int32(50)},
+						).
+						Return(&trashFakeRows{rows: []*trashFakeRow{{item: projectItem}, {item: skillItem}}}, nil)
+				},
+			},
+			expected: Expected{
+				items: []domain.TrashItem{projectItem, skillItem},
+			},
+		},
+		"Restricts to the requested entities": {
+			given: Given{
+				filter: domain.TrashFilter{
+					Entities: []domain.TrashEntity{domain.TrashedSkill, domain.TrashedSkill},
+					Limit:    5,
+				},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(
+							mock.Anything,
+							mock.MatchedBy(func(query string) bool {
+								return strings.Contains(query, "FROM "+testTrashSkillTable) &&
+									!strings.Contains(query, testTrashProjectTable) &&
+									!strings.Contains(query, testTrashEducationTable) &&
+									!strings.Contains(query, testTrashExperienceTable) &&
+									!strings.Contains(query, "UNION ALL")
+							}),
+							[]any{int32(5)},
+						).
+						Return(&trashFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				items: []domain.TrashItem{},
+			},
+		},
+		"Limit out of range defaults to 50": {
+			given: Given{
+				filter: domain.TrashFilter{Limit: 500},
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, []any{int32(50)}).
+						Return(&trashFakeRows{}, nil)
+				},
+			},
+			expected: Expected{
+				items: []domain.TrashItem{},
+			},
+		},
+		"Invalid entity": {
+			given: Given{
+				filter: domain.TrashFilter{Entities: []domain.TrashEntity{"user"}},
+			},
+			expected: Expected{
+				err: errors.New("failed to validate trash filter: entity invalid = user"),
+			},
+		},
+		"Query fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(nil, queryErr)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to list trash: %w", queryErr),
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&trashFakeRows{rows: []*trashFakeRow{{scanErr: scanErr}}}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to scan trash item: %w", scanErr),
+			},
+		},
+		"Row iteration error": {
+			given: Given{
+				mockQuery: func(m *database.MockDatabaseAPI) {
+					m.EXPECT().
+						Query(mock.Anything, mock.Anything, mock.Anything).
+						Return(&trashFakeRows{rowErr: rowErr}, nil)
+				},
+			},
+			expected: Expected{
+				err: fmt.Errorf("row iteration error: %w", rowErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newTrashRepositoryTestFixture(t)
+
+			if test.given.mockQuery != nil {
+				test.given.mockQuery(f.databaseAPI)
+			}
+
+			items, err := f.trashRepository.List(context.Background(), test.given.filter)
+
+			if test.expected.err != nil {
+				assert.EqualError(t, err, test.expected.err.Error())
+				assert.Nil(t, items)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected.items, items)
+			}
+
+			f.databaseAPI.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+)
+
+// versionConflict explains why a versioned UPDATE on table matched no row. It
+// returns nil when no live row with id exists, so the caller can report the row
+// as missing, and a *domain.ConflictError carrying the current updated_at when
+// the row exists but has moved on to another version.
+func versionConflict(ctx context.Context, q database.Querier, table, entity, id string) error {
+	query := fmt.Sprintf(
+		`SELECT updated_at
+		FROM %s
+		WHERE id = $1 AND deleted_at IS NULL`,
+		table,
+	)
+
+	var current time.Time
+	if err := q.QueryRow(ctx, query, id).Scan(&current); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to check %s version: %w", entity, err)
+	}
+
+	return &domain.ConflictError{Entity: entity, ID: id, Current: current}
+}
+
+// collectionVersion reads the domain.CollectionVersion of table: the newest
+// updated_at or deleted_at across all of its rows and the number of live rows.
+// where, when not empty, narrows the rows considered and may reference args.
+func collectionVersion(ctx context.Context, q database.Querier, table, where string, args ...any) (domain.CollectionVersion, error) {
+	if where != "" {
+		where = " WHERE " + where
+	}
+	query := fmt.Sprintf(
+		`SELECT MAX(GREATEST(updated_at, deleted_at)), COUNT(*) FILTER (WHERE deleted_at IS NULL)
+		FROM %s%s`,
+		table,
+		where,
+	)
+
+	var (
+		lastModified sql.NullTime
+		version      domain.CollectionVersion
+	)
+	if err := q.QueryRow(ctx, query, args...).Scan(&lastModified, &version.Count); err != nil {
+		return domain.CollectionVersion{}, err
+	}
+	version.LastModified = lastModified.Time
+
+	return version, nil
+}
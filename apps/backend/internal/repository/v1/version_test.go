@@ -0,0 +1,316 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	database "github.com/fingertips18/fingertips18.github.io/backend/internal/database/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// versionFakeRow answers the updated_at lookup made after a versioned UPDATE
+// matched no row.
+type versionFakeRow struct {
+	updatedAt time.Time
+	scanErr   error
+}
+
+func (r *versionFakeRow) Scan(dest ...any) error {
+	if r.scanErr != nil {
+		return r.scanErr
+	}
+	if len(dest) != 1 {
+		return fmt.Errorf("expected 1 scan destination, got %d", len(dest))
+	}
+
+	*dest[0].(*time.Time) = r.updatedAt
+	return nil
+}
+
+// isVersionLookup matches the query issued by versionConflict.
+func isVersionLookup(query string) bool {
+	return strings.Contains(query, "SELECT updated_at")
+}
+
+// isUpdate matches a repository UPDATE statement.
+func isUpdate(query string) bool {
+	return strings.HasPrefix(strings.TrimSpace(query), "UPDATE")
+}
+
+func TestVersionConflict(t *testing.T) {
+	current := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+
+	type Given struct {
+		row *versionFakeRow
+	}
+
+	type Expected struct {
+		err error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Row exists with another version": {
+			given: Given{
+				row: &versionFakeRow{updatedAt: current},
+			},
+			expected: Expected{
+				err: &domain.ConflictError{Entity: "project", ID: "p1", Current: current},
+			},
+		},
+		"Row is missing": {
+			given: Given{
+				row: &versionFakeRow{scanErr: pgx.ErrNoRows},
+			},
+		},
+		"Lookup fails": {
+			given: Given{
+				row: &versionFakeRow{scanErr: scanErr},
+			},
+			expected: Expected{
+				err: fmt.Errorf("failed to check project version: %w", scanErr),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := new(database.MockDatabaseAPI)
+			m.EXPECT().
+				QueryRow(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "FROM test-projects") &&
+							strings.Contains(query, "WHERE id = $1 AND deleted_at IS NULL")
+					}),
+					[]any{"p1"},
+				).
+				Return(test.given.row)
+
+			err := versionConflict(context.Background(), m, "test-projects", "project", "p1")
+
+			if test.expected.err != nil {
+				assert.Equal(t, test.expected.err, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+// queryArgs turns the args of an expected query into the trailing arguments
+// of a mock expectation: the generated mocks record variadic query args as a
+// single slice, and only when there are any.
+func queryArgs(args []any) []any {
+	if len(args) == 0 {
+		return nil
+	}
+	return []any{args}
+}
+
+// collectionVersionFakeRow answers the aggregate query issued by collectionVersion.
+type collectionVersionFakeRow struct {
+	lastModified sql.NullTime
+	count        int64
+	scanErr      error
+}
+
+func (r *collectionVersionFakeRow) Scan(dest ...any) error {
+	if r.scanErr != nil {
+		return r.scanErr
+	}
+	if len(dest) != 2 {
+		return fmt.Errorf("expected 2 scan destinations, got %d", len(dest))
+	}
+
+	*dest[0].(*sql.NullTime) = r.lastModified
+	*dest[1].(*int64) = r.count
+	return nil
+}
+
+func TestCollectionVersion(t *testing.T) {
+	lastModified := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	scanErr := errors.New("scan error")
+
+	type Given struct {
+		where string
+		args  []any
+		row   *collectionVersionFakeRow
+	}
+
+	type Expected struct {
+		query   string
+		version domain.CollectionVersion
+		err     error
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Reads the newest write and live count": {
+			given: Given{
+				row: &collectionVersionFakeRow{lastModified: sql.NullTime{Time: lastModified, Valid: true}, count: 3},
+			},
+			expected: Expected{
+				query:   "FROM test-projects",
+				version: domain.CollectionVersion{LastModified: lastModified, Count: 3},
+			},
+		},
+		"Narrows the rows": {
+			given: Given{
+				where: "parent_table = $1",
+				args:  []any{"project"},
+				row:   &collectionVersionFakeRow{lastModified: sql.NullTime{Time: lastModified, Valid: true}, count: 1},
+			},
+			expected: Expected{
+				query:   "FROM test-projects WHERE parent_table = $1",
+				version: domain.CollectionVersion{LastModified: lastModified, Count: 1},
+			},
+		},
+		"Empty table yields the zero version": {
+			given: Given{
+				row: &collectionVersionFakeRow{},
+			},
+			expected: Expected{
+				query: "FROM test-projects",
+			},
+		},
+		"Scan fails": {
+			given: Given{
+				row: &collectionVersionFakeRow{scanErr: scanErr},
+			},
+			expected: Expected{
+				query: "FROM test-projects",
+				err:   scanErr,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := new(database.MockDatabaseAPI)
+			m.EXPECT().
+				QueryRow(
+					mock.Anything,
+					mock.MatchedBy(func(query string) bool {
+						return strings.Contains(query, "MAX(GREATEST(updated_at, deleted_at))") &&
+							strings.Contains(query, "COUNT(*) FILTER (WHERE deleted_at IS NULL)") &&
+							strings.HasSuffix(query, test.expected.query)
+					}),
+					queryArgs(test.given.args)...,
+				).
+				Return(test.given.row)
+
+			version, err := collectionVersion(context.Background(), m, "test-projects", test.given.where, test.given.args...)
+
+			if test.expected.err != nil {
+				assert.ErrorIs(t, err, test.expected.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expected.version, version)
+
+			m.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRepository_Version(t *testing.T) {
+	lastModified := time.Date(2025, 1, 2, 12, 0, 0, 0, time.UTC)
+	queryErr := errors.New("query error")
+
+	type Given struct {
+		version func(m *database.MockDatabaseAPI) (domain.CollectionVersion, error)
+		table   string
+		args    []any
+	}
+
+	type Expected struct {
+		errPrefix string
+	}
+
+	tests := map[string]struct {
+		given    Given
+		expected Expected
+	}{
+		"Project": {
+			given: Given{
+				version: func(m *database.MockDatabaseAPI) (domain.CollectionVersion, error) {
+					return NewProjectRepository(ProjectRepositoryConfig{DatabaseAPI: m, ProjectTable: testProjectTable}).Version(context.Background())
+				},
+				table: testProjectTable,
+			},
+			expected: Expected{errPrefix: "failed to get projects version"},
+		},
+		"Education": {
+			given: Given{
+				version: func(m *database.MockDatabaseAPI) (domain.CollectionVersion, error) {
+					return NewEducationRepository(EducationRepositoryConfig{DatabaseAPI: m, EducationTable: testEducationTable}).Version(context.Background())
+				},
+				table: testEducationTable,
+			},
+			expected: Expected{errPrefix: "failed to get educations version"},
+		},
+		"Skill": {
+			given: Given{
+				version: func(m *database.MockDatabaseAPI) (domain.CollectionVersion, error) {
+					return NewSkillRepository(SkillRepositoryConfig{DatabaseAPI: m, SkillTable: testSkillTable}).Version(context.Background())
+				},
+				table: pgx.Identifier{testSkillTable}.Sanitize(),
+			},
+			expected: Expected{errPrefix: "failed to get skills version"},
+		},
+		"File": {
+			given: Given{
+				version: func(m *database.MockDatabaseAPI) (domain.CollectionVersion, error) {
+					return NewFileRepository(FileRepositoryConfig{DatabaseAPI: m, FileTable: testFileTable}).Version(context.Background(), "project")
+				},
+				table: testFileTable + " WHERE parent_table = $1",
+				args:  []any{"project"},
+			},
+			expected: Expected{errPrefix: "failed to get files version"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			isVersionQuery := mock.MatchedBy(func(query string) bool {
+				return strings.HasSuffix(query, "FROM "+test.given.table)
+			})
+
+			m := new(database.MockDatabaseAPI)
+			m.EXPECT().
+				QueryRow(mock.Anything, isVersionQuery, queryArgs(test.given.args)...).
+				Return(&collectionVersionFakeRow{lastModified: sql.NullTime{Time: lastModified, Valid: true}, count: 2}).
+				Once()
+
+			version, err := test.given.version(m)
+			assert.NoError(t, err)
+			assert.Equal(t, domain.CollectionVersion{LastModified: lastModified, Count: 2}, version)
+
+			m.EXPECT().
+				QueryRow(mock.Anything, isVersionQuery, queryArgs(test.given.args)...).
+				Return(&collectionVersionFakeRow{scanErr: queryErr}).
+				Once()
+
+			_, err = test.given.version(m)
+			assert.EqualError(t, err, test.expected.errPrefix+": "+queryErr.Error())
+
+			m.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package server
+
+import (
+	"net/http"
+	"strings"
+)
+
+// route is a method and path pattern a handler serves. Path segments written
+// as "*" match any single non-empty segment, and a trailing slash on the
+// request path is ignored.
+type route struct {
+	method string
+	path   string
+}
+
+// matches reports whether r is a request for the route.
+func (rt route) matches(r *http.Request) bool {
+	if r.Method != rt.method {
+		return false
+	}
+
+	want := strings.Split(strings.Trim(rt.path, "/"), "/")
+	got := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(want) != len(got) {
+		return false
+	}
+
+	for i, segment := range want {
+		if got[i] == "" || (segment != "*" && segment != got[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// scopedRoute is a route that API keys holding scope may call in addition to
+// admins.
+type scopedRoute struct {
+	route
+	scope string
+}
+
+// authorize returns handler guarded by requireScope, except for requests
+// matching one of the public routes, which reach handler directly. Requests
+// matching a scoped route are guarded with that route's scope; every other
+// request is guarded with the empty scope, which only admins hold.
+func authorize(handler http.Handler, public []route, scoped []scopedRoute, requireScope func(scope string) func(http.Handler) http.Handler) http.Handler {
+	admin := requireScope("")(handler)
+
+	guarded := make([]http.Handler, len(scoped))
+	for i, rt := range scoped {
+		guarded[i] = requireScope(rt.scope)(handler)
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for _, rt := range public {
+			if rt.matches(r) {
+				handler.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		for i, rt := range scoped {
+			if rt.matches(r) {
+				guarded[i].ServeHTTP(w, r)
+				return
+			}
+		}
+
+		admin.ServeHTTP(w, r)
+	})
+}
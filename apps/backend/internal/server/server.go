@@ -8,46 +8,101 @@ import (
 	"time"
 
 	_ "github.com/fingertips18/fingertips18.github.io/backend/docs"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
 	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
 	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/handler/v1"
+	repository "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/templates"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/worker"
 	"github.com/fingertips18/fingertips18.github.io/backend/pkg/middleware"
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/token"
 	httpSwagger "github.com/swaggo/http-swagger"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type Server struct {
-	httpServer *http.Server
-	port       string
+	httpServer   *http.Server
+	port         string
+	outboxWorker worker.Worker
 }
 
 type Config struct {
-	ClientURL            string
-	Environment          string
-	Port                 string
-	AuthToken            string
-	EmailJSServiceID     string
-	EmailJSTemplateID    string
-	EmailJSPublicKey     string
-	EmailJSPrivateKey    string
-	GoogleMeasurementID  string
-	GoogleAPISecret      string
-	Username             string
-	Password             string
-	UploadthingSecretKey string
-	DatabaseAPI          database.DatabaseAPI
+	ClientURL               string
+	Environment             string
+	Port                    string
+	AuthToken               string
+	EmailProvider           string
+	EmailFrom               string
+	EmailTo                 string
+	EmailDir                string
+	EmailTemplateDir        string
+	EmailAutoReply          bool
+	EmailJSServiceID        string
+	EmailJSTemplateID       string
+	EmailJSPublicKey        string
+	EmailJSPrivateKey       string
+	SMTPHost                string
+	SMTPPort                int
+	SMTPUsername            string
+	SMTPPassword            string
+	GoogleMeasurementID     string
+	GoogleAPISecret         string
+	AnalyticsSalt           string
+	AnalyticsCountryHeader  string
+	Username                string
+	PasswordHash            string
+	JWTSecret               string
+	AccessTokenTTL          time.Duration
+	RefreshTokenTTL         time.Duration
+	UploadthingSecretKey    string
+	CacheMaxAge             time.Duration
+	CacheStale              time.Duration
+	RepositoryCacheTTL      time.Duration
+	EmailRateLimit          middleware.RateLimit
+	AnalyticsRateLimit      middleware.RateLimit
+	AnalyticsBatchRateLimit middleware.RateLimit
+	AuthRateLimit           middleware.RateLimit
+	TrustedProxies          []string
+	CaptchaProvider         string
+	CaptchaSecret           string
+	SpamBlocklist           []string
+	SpamMaxLinks            int
+	SpamThreshold           int
+	OutboxPollInterval      time.Duration
+	OutboxMaxAttempts       int
+	DatabaseAPI             database.DatabaseAPI
 }
 
+// handlerConfig mounts handler on paths. The routes listed in public can be
+// called by anyone and those in scoped by API keys holding the route's scope;
+// every other request to handler needs the admin token.
 type handlerConfig struct {
 	paths   []string
 	handler http.Handler
+	public  []route
+	scoped  []scopedRoute
 }
 
 // New creates and returns a new Server instance configured with the provided Config.
 // It initializes the HTTP handlers, sets up the HTTP server with the specified port and timeouts,
-// and logs the environment in which the server is starting.
+// starts the worker delivering the email outbox, and logs the environment in which the server is starting.
 func New(cfg Config) *Server {
 	log.Printf("Starting server with environment: %s", cfg.Environment)
 
-	authInterceptor := middleware.NewAuthInterceptor(cfg.AuthToken)
+	tokenAPI := newTokenAPI(cfg)
+	apiKeyHandler := v1.NewAPIKeyServiceHandler(
+		v1.APIKeyServiceConfig{
+			DatabaseAPI: cfg.DatabaseAPI,
+		},
+	)
+	authInterceptor := middleware.NewAuthInterceptor(
+		middleware.AuthInterceptor{
+			ValidToken: cfg.AuthToken,
+			Verifier:   tokenAPI,
+			APIKeys:    apiKeyHandler,
+		},
+	)
 	corsInterceptor := middleware.NewCorsInterceptor(
 		middleware.CorsInterceptor{
 			ClientURL: cfg.ClientURL,
@@ -55,16 +110,16 @@ func New(cfg Config) *Server {
 		},
 	)
 
-	handlers := createHandlers(cfg)
-	mux := setupHandlers(handlers...)
+	handlers := createHandlers(cfg, tokenAPI, apiKeyHandler)
+	mux := setupHandlers(authInterceptor.RequireScope, handlers...)
 
-	// Chain: CORS → Auth → Mux
-	appChain := corsInterceptor.CorsMiddleware(
-		authInterceptor.MiddlewareFunc(mux),
+	// Chain: Request ID → CORS → Mux → Auth (per route, see createHandlers)
+	appChain := middleware.RequestIDMiddleware(
+		corsInterceptor.CorsMiddleware(mux),
 	)
 
 	// Swagger UI (BasicAuth protected)
-	swaggerHandler := basicAuth(cfg.Username, cfg.Password, httpSwagger.WrapHandler)
+	swaggerHandler := basicAuth(cfg.Username, cfg.PasswordHash, httpSwagger.WrapHandler)
 
 	// Top-level mux
 	rootMux := http.NewServeMux()
@@ -82,51 +137,154 @@ func New(cfg Config) *Server {
 		appChain.ServeHTTP(w, r)
 	})
 
+	// Contact form emails are queued by the email handler and sent here
+	outboxWorker := worker.NewOutboxWorker(
+		worker.OutboxWorkerConfig{
+			DatabaseAPI: cfg.DatabaseAPI,
+			Email: client.EmailConfig{
+				Provider: cfg.EmailProvider,
+				From:     cfg.EmailFrom,
+				EmailJS: client.EmailJSConfig{
+					ServiceID:  cfg.EmailJSServiceID,
+					TemplateID: cfg.EmailJSTemplateID,
+					PublicKey:  cfg.EmailJSPublicKey,
+					PrivateKey: cfg.EmailJSPrivateKey,
+				},
+				SMTP: client.SMTPConfig{
+					Host:     cfg.SMTPHost,
+					Port:     cfg.SMTPPort,
+					Username: cfg.SMTPUsername,
+					Password: cfg.SMTPPassword,
+				},
+				Dir: cfg.EmailDir,
+			},
+			EmailTo:        cfg.EmailTo,
+			EmailTemplates: newEmailRenderer(cfg),
+			PollInterval:   cfg.OutboxPollInterval,
+			MaxAttempts:    cfg.OutboxMaxAttempts,
+		},
+	)
+	outboxWorker.Start()
+
 	return &Server{
 		httpServer: &http.Server{
 			Addr:              ":" + cfg.Port,
 			Handler:           rootMux,
 			ReadHeaderTimeout: 30 * time.Second,
 		},
-		port: cfg.Port,
+		port:         cfg.Port,
+		outboxWorker: outboxWorker,
 	}
 }
 
 // createHandlers initializes and returns a slice of handlerConfig structs,
 // each representing an HTTP handler for the server. It configures the handlers
 // using the provided Config, such as setting up the email service handler with
-// the necessary credentials and service IDs.
-func createHandlers(cfg Config) []handlerConfig {
+// its captcha and spam settings. Each entry also lists the routes
+// the public frontend may call without the admin token: reads of portfolio
+// content, page-view and event tracking and the contact form. Audit, trash,
+// the contact form inbox, email template previews, the analytics dashboards
+// and all mutations stay admin-only, except for the routes API keys are
+// scoped to: project writes (projects:write), file writes and image uploads
+// (files:write), and the trash, audit and analytics reads (read). API keys
+// themselves are only managed by admins. The contact form and page-view and
+// event tracking are rate limited per client, since each call is forwarded
+// to a paid API.
+func createHandlers(cfg Config, tokenAPI token.TokenAPI, apiKeyHandler v1.APIKeyHandler) []handlerConfig {
+	// Public lists answer conditional GETs, so even with a zero max age
+	// clients only pay for a 304 when nothing changed.
+	cacheControl := middleware.NewCacheControlInterceptor(
+		middleware.CacheControl{
+			MaxAge:               cfg.CacheMaxAge,
+			StaleWhileRevalidate: cfg.CacheStale,
+		},
+	)
+
+	// Every handler shares one repository cache, so a write made through any of
+	// them invalidates what the others serve. A zero TTL disables it.
+	var repositoryCache *repository.RepositoryCache
+	if cfg.RepositoryCacheTTL > 0 {
+		repositoryCache = repository.NewRepositoryCache(
+			repository.RepositoryCacheConfig{
+				TTL: cfg.RepositoryCacheTTL,
+			},
+		)
+	}
+
+	// The public routes that reach third-party APIs are throttled per client
+	// IP, so a single visitor cannot exhaust the EmailJS or GA4 quota. Event
+	// batches have a limit of their own, since one carries up to
+	// domain.MaxAnalyticsEvents events but spends a single token. Login
+	// and refresh are throttled too, so the admin password and refresh tokens
+	// cannot be guessed at request speed.
+	rateLimiter := middleware.NewRateLimiter(
+		middleware.RateLimiter{
+			Limits: map[string]middleware.RateLimit{
+				"POST /email/send":          cfg.EmailRateLimit,
+				"POST /analytics/page-view": cfg.AnalyticsRateLimit,
+				"POST /analytics/event":     cfg.AnalyticsRateLimit,
+				"POST /analytics/events":    cfg.AnalyticsBatchRateLimit,
+				"POST /auth/login":          cfg.AuthRateLimit,
+				"POST /auth/refresh":        cfg.AuthRateLimit,
+			},
+			TrustedProxies: cfg.TrustedProxies,
+		},
+	)
+
 	emailHandler := v1.NewEmailServiceHandler(
 		v1.EmailServiceConfig{
-			ServiceID:   cfg.EmailJSServiceID,
-			TemplateID:  cfg.EmailJSTemplateID,
-			UserID:      cfg.EmailJSPublicKey,
-			AccessToken: cfg.EmailJSPrivateKey,
+			CaptchaProvider: cfg.CaptchaProvider,
+			CaptchaSecret:   cfg.CaptchaSecret,
+			SpamRules: domain.SpamRules{
+				MaxLinks:  cfg.SpamMaxLinks,
+				Blocklist: cfg.SpamBlocklist,
+			},
+			SpamThreshold:  cfg.SpamThreshold,
+			AutoReply:      cfg.EmailAutoReply,
+			EmailTemplates: newEmailRenderer(cfg),
+			DatabaseAPI:    cfg.DatabaseAPI,
+		},
+	)
+
+	messageHandler := v1.NewMessageServiceHandler(
+		v1.MessageServiceConfig{
+			DatabaseAPI: cfg.DatabaseAPI,
 		},
 	)
 
 	analyticsHandler := v1.NewAnalyticsServiceHandler(
 		v1.AnalyticsServiceConfig{
+			DatabaseAPI:         cfg.DatabaseAPI,
 			GoogleMeasurementID: cfg.GoogleMeasurementID,
 			GoogleAPISecret:     cfg.GoogleAPISecret,
+			VisitorSalt:         cfg.AnalyticsSalt,
+			CountryHeader:       cfg.AnalyticsCountryHeader,
 		},
 	)
 
 	projectHandler := v1.NewProjectServiceHandler(
 		v1.ProjectServiceConfig{
-			DatabaseAPI: cfg.DatabaseAPI,
+			DatabaseAPI:     cfg.DatabaseAPI,
+			RepositoryCache: repositoryCache,
 		},
 	)
 
 	educationHandler := v1.NewEducationServiceHandler(
 		v1.EducationServiceConfig{
-			DatabaseAPI: cfg.DatabaseAPI,
+			DatabaseAPI:     cfg.DatabaseAPI,
+			RepositoryCache: repositoryCache,
 		},
 	)
 
 	skillHandler := v1.NewSkillServiceHandler(
 		v1.SkillServiceConfig{
+			DatabaseAPI:     cfg.DatabaseAPI,
+			RepositoryCache: repositoryCache,
+		},
+	)
+
+	experienceHandler := v1.NewExperienceServiceHandler(
+		v1.ExperienceServiceConfig{
 			DatabaseAPI: cfg.DatabaseAPI,
 		},
 	)
@@ -143,34 +301,168 @@ func createHandlers(cfg Config) []handlerConfig {
 		},
 	)
 
+	searchHandler := v1.NewSearchServiceHandler(
+		v1.SearchServiceConfig{
+			DatabaseAPI: cfg.DatabaseAPI,
+		},
+	)
+
+	trashHandler := v1.NewTrashServiceHandler(
+		v1.TrashServiceConfig{
+			DatabaseAPI:     cfg.DatabaseAPI,
+			RepositoryCache: repositoryCache,
+		},
+	)
+
+	authHandler := v1.NewAuthServiceHandler(
+		v1.AuthServiceConfig{
+			DatabaseAPI:     cfg.DatabaseAPI,
+			Username:        cfg.Username,
+			PasswordHash:    cfg.PasswordHash,
+			TokenAPI:        tokenAPI,
+			RefreshTokenTTL: cfg.RefreshTokenTTL,
+		},
+	)
+
+	auditHandler := v1.NewAuditServiceHandler(
+		v1.AuditServiceConfig{
+			DatabaseAPI: cfg.DatabaseAPI,
+		},
+	)
+
+	cacheHandler := v1.NewCacheServiceHandler(
+		v1.CacheServiceConfig{
+			RepositoryCache: repositoryCache,
+		},
+	)
+
 	handlers := []handlerConfig{
 		{
 			paths:   []string{"/email", "/email/"},
-			handler: emailHandler,
+			handler: rateLimiter.RateLimitMiddleware(emailHandler),
+			public: []route{
+				{method: http.MethodPost, path: "/email/send"},
+			},
+		},
+		{
+			paths:   []string{"/message", "/message/", "/messages", "/messages/"},
+			handler: messageHandler,
 		},
 		{
 			paths:   []string{"/analytics", "/analytics/"},
-			handler: analyticsHandler,
+			handler: rateLimiter.RateLimitMiddleware(analyticsHandler),
+			public: []route{
+				{method: http.MethodPost, path: "/analytics/page-view"},
+				{method: http.MethodPost, path: "/analytics/event"},
+				{method: http.MethodPost, path: "/analytics/events"},
+			},
+			scoped: []scopedRoute{
+				{route{method: http.MethodGet, path: "/analytics/summary"}, string(domain.ScopeRead)},
+				{route{method: http.MethodGet, path: "/analytics/timeseries"}, string(domain.ScopeRead)},
+			},
 		},
 		{
 			paths:   []string{"/project", "/project/", "/projects", "/projects/"},
-			handler: projectHandler,
+			handler: cacheControl.CacheControlMiddleware(projectHandler),
+			public: []route{
+				{method: http.MethodGet, path: "/projects"},
+				{method: http.MethodGet, path: "/projects/tags"},
+				{method: http.MethodGet, path: "/project/*"},
+			},
+			scoped: []scopedRoute{
+				{route{method: http.MethodPost, path: "/project"}, string(domain.ScopeProjectsWrite)},
+				{route{method: http.MethodPut, path: "/project"}, string(domain.ScopeProjectsWrite)},
+				{route{method: http.MethodDelete, path: "/project/*"}, string(domain.ScopeProjectsWrite)},
+				{route{method: http.MethodPost, path: "/project/*/restore"}, string(domain.ScopeProjectsWrite)},
+			},
 		},
 		{
 			paths:   []string{"/education", "/education/", "/educations", "/educations/"},
-			handler: educationHandler,
+			handler: cacheControl.CacheControlMiddleware(educationHandler),
+			public: []route{
+				{method: http.MethodGet, path: "/educations"},
+				{method: http.MethodGet, path: "/education/*"},
+			},
 		},
 		{
 			paths:   []string{"/skill", "/skill/", "/skills", "/skills/"},
-			handler: skillHandler,
+			handler: cacheControl.CacheControlMiddleware(skillHandler),
+			public: []route{
+				{method: http.MethodGet, path: "/skills"},
+				{method: http.MethodGet, path: "/skill/*"},
+				{method: http.MethodGet, path: "/skill/*/projects"},
+				{method: http.MethodGet, path: "/skill/*/experiences"},
+			},
+		},
+		{
+			paths:   []string{"/experience", "/experience/", "/experiences", "/experiences/"},
+			handler: experienceHandler,
+			public: []route{
+				{method: http.MethodGet, path: "/experiences"},
+				{method: http.MethodGet, path: "/experience/*"},
+			},
 		},
 		{
 			paths:   []string{"/image", "/image/"},
 			handler: imageHandler,
+			scoped: []scopedRoute{
+				{route{method: http.MethodPost, path: "/image/upload"}, string(domain.ScopeFilesWrite)},
+			},
 		},
 		{
 			paths:   []string{"/file", "/file/", "/files", "/files/"},
 			handler: fileHandler,
+			public: []route{
+				{method: http.MethodGet, path: "/files"},
+				{method: http.MethodGet, path: "/file/*"},
+			},
+			scoped: []scopedRoute{
+				{route{method: http.MethodPost, path: "/file"}, string(domain.ScopeFilesWrite)},
+				{route{method: http.MethodPut, path: "/file"}, string(domain.ScopeFilesWrite)},
+				{route{method: http.MethodDelete, path: "/file/*"}, string(domain.ScopeFilesWrite)},
+				{route{method: http.MethodDelete, path: "/files"}, string(domain.ScopeFilesWrite)},
+			},
+		},
+		{
+			paths:   []string{"/search", "/search/"},
+			handler: searchHandler,
+			public: []route{
+				{method: http.MethodGet, path: "/search"},
+			},
+		},
+		{
+			paths:   []string{"/trash", "/trash/"},
+			handler: trashHandler,
+			scoped: []scopedRoute{
+				{route{method: http.MethodGet, path: "/trash"}, string(domain.ScopeRead)},
+			},
+		},
+		{
+			paths:   []string{"/audit", "/audit/"},
+			handler: auditHandler,
+			scoped: []scopedRoute{
+				{route{method: http.MethodGet, path: "/audit"}, string(domain.ScopeRead)},
+			},
+		},
+		{
+			paths:   []string{"/cache", "/cache/"},
+			handler: cacheHandler,
+			scoped: []scopedRoute{
+				{route{method: http.MethodGet, path: "/cache/stats"}, string(domain.ScopeRead)},
+			},
+		},
+		{
+			paths:   []string{"/auth", "/auth/"},
+			handler: rateLimiter.RateLimitMiddleware(authHandler),
+			public: []route{
+				{method: http.MethodPost, path: "/auth/login"},
+				{method: http.MethodPost, path: "/auth/refresh"},
+				{method: http.MethodPost, path: "/auth/logout"},
+			},
+		},
+		{
+			paths:   []string{"/api-keys", "/api-keys/"},
+			handler: apiKeyHandler,
 		},
 	}
 
@@ -179,14 +471,17 @@ func createHandlers(cfg Config) []handlerConfig {
 
 // setupHandlers registers multiple HTTP handlers to their respective paths on a new http.ServeMux.
 // It accepts a variadic list of handlerConfig, where each handlerConfig contains one or more paths
-// and an associated http.Handler. Each path in the handlerConfig is mapped to the provided handler.
+// and an associated http.Handler. Each path in the handlerConfig is mapped to the provided handler,
+// guarded by requireScope for every route not listed as public: with the route's scope for scoped
+// routes and with the empty, admin-only scope for the rest.
 // Returns the configured *http.ServeMux.
-func setupHandlers(h ...handlerConfig) *http.ServeMux {
+func setupHandlers(requireScope func(scope string) func(http.Handler) http.Handler, h ...handlerConfig) *http.ServeMux {
 	mux := http.NewServeMux()
 
 	for _, handleCfg := range h {
+		handler := authorize(handleCfg.handler, handleCfg.public, handleCfg.scoped, requireScope)
 		for _, path := range handleCfg.paths {
-			mux.Handle(path, handleCfg.handler)
+			mux.Handle(path, handler)
 		}
 	}
 
@@ -194,14 +489,15 @@ func setupHandlers(h ...handlerConfig) *http.ServeMux {
 }
 
 // basicAuth is a middleware that enforces HTTP Basic Authentication on incoming requests.
-// It checks the provided username and password against the server's configured credentials.
+// It checks the provided username and password against the server's configured credentials,
+// the password by comparing it with the bcrypt passwordHash.
 // If authentication fails, it responds with a 401 Unauthorized status and a WWW-Authenticate header.
 // On successful authentication, it sets an Authorization header with a Bearer token and calls the next handler.
-func basicAuth(username, password string, next http.Handler) http.Handler {
+func basicAuth(username, passwordHash string, next http.Handler) http.Handler {
 	return http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			u, p, ok := r.BasicAuth()
-			if !ok || u != username || p != password {
+			if !ok || u != username || bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte(p)) != nil {
 				w.Header().Set("WWW-Authenticate", `Basic realm="Restricted"`)
 				http.Error(w, "Unauthorized", http.StatusUnauthorized)
 				return
@@ -212,6 +508,31 @@ func basicAuth(username, password string, next http.Handler) http.Handler {
 	)
 }
 
+// newTokenAPI returns the TokenAPI that signs and verifies admin access tokens.
+func newTokenAPI(cfg Config) token.TokenAPI {
+	return token.NewTokenAPI(
+		token.Config{
+			Secret: []byte(cfg.JWTSecret),
+			Issuer: "fingertips18-backend",
+			TTL:    cfg.AccessTokenTTL,
+		},
+	)
+}
+
+// newEmailRenderer creates the renderer of the email templates, with the
+// overrides in cfg.EmailTemplateDir. It panics if a template does not parse.
+func newEmailRenderer(cfg Config) templates.EmailRenderer {
+	renderer, err := templates.NewEmailRenderer(
+		templates.EmailRendererConfig{
+			Dir: cfg.EmailTemplateDir,
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	return renderer
+}
+
 // Run starts the HTTP server and listens for incoming requests on the configured port.
 // It logs the server startup and returns an error if the server fails to start,
 // except when the error is due to the server being closed gracefully.
@@ -227,14 +548,21 @@ func (s *Server) Run() error {
 
 // Shutdown gracefully shuts down the HTTP server associated with the Server instance.
 // It attempts to shut down the server using the provided context, allowing for any
-// in-flight requests to complete before termination. If an error occurs during shutdown,
-// it logs the error and returns it.
+// in-flight requests to complete before termination, and then stops the outbox worker
+// once the email it is sending has been recorded. The worker is stopped even when
+// the HTTP server fails to shut down; errors are logged and returned joined.
 func (s *Server) Shutdown(ctx context.Context) error {
 	// Shutdown the HTTP server
-	err := s.httpServer.Shutdown(ctx)
-	if err != nil {
-		log.Fatalf("Error shutting down HTTP server: %v", err)
+	httpErr := s.httpServer.Shutdown(ctx)
+	if httpErr != nil {
+		log.Printf("Error shutting down HTTP server: %v", httpErr)
+	}
+
+	// Stop the outbox worker; unsent emails stay queued for the next start
+	workerErr := s.outboxWorker.Stop(ctx)
+	if workerErr != nil {
+		log.Printf("Error stopping email outbox worker: %v", workerErr)
 	}
 
-	return err
+	return errors.Join(httpErr, workerErr)
 }
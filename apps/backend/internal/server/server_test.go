@@ -0,0 +1,381 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/pkg/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+const testAuthToken = "admin-token"
+
+// TestCreateHandlers_AuthorizationPolicy sends every route/method pair the
+// handlers serve through the mux built by setupHandlers, with the handlers
+// swapped for a stub, and checks which ones need the admin token.
+func TestCreateHandlers_AuthorizationPolicy(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		public bool
+	}{
+		// Email and analytics
+		{http.MethodPost, "/email/send", true},
+		{http.MethodPost, "/analytics/page-view", true},
+		{http.MethodPost, "/analytics/event", true},
+		{http.MethodPost, "/analytics/events", true},
+		{http.MethodGet, "/email/templates/auto_reply/preview", false},
+
+		// The contact form inbox is admin-only
+		{http.MethodGet, "/messages", false},
+		{http.MethodGet, "/message/m1", false},
+		{http.MethodPatch, "/message/m1", false},
+
+		// Projects
+		{http.MethodGet, "/projects", true},
+		{http.MethodGet, "/projects/", true},
+		{http.MethodGet, "/projects/tags", true},
+		{http.MethodGet, "/project/p1", true},
+		{http.MethodPost, "/project", false},
+		{http.MethodPut, "/project", false},
+		{http.MethodDelete, "/project/p1", false},
+		{http.MethodPost, "/project/p1/restore", false},
+
+		// Educations
+		{http.MethodGet, "/educations", true},
+		{http.MethodGet, "/education/e1", true},
+		{http.MethodPost, "/education", false},
+		{http.MethodPut, "/education", false},
+		{http.MethodDelete, "/education/e1", false},
+		{http.MethodPost, "/education/e1/restore", false},
+
+		// Skills
+		{http.MethodGet, "/skills", true},
+		{http.MethodGet, "/skill/s1", true},
+		{http.MethodGet, "/skill/s1/projects", true},
+		{http.MethodGet, "/skill/s1/experiences", true},
+		{http.MethodPost, "/skill", false},
+		{http.MethodPut, "/skill", false},
+		{http.MethodDelete, "/skill/s1", false},
+		{http.MethodPost, "/skill/s1/restore", false},
+
+		// Experiences
+		{http.MethodGet, "/experiences", true},
+		{http.MethodGet, "/experience/x1", true},
+		{http.MethodPost, "/experience", false},
+		{http.MethodPut, "/experience", false},
+		{http.MethodDelete, "/experience/x1", false},
+		{http.MethodPost, "/experience/x1/restore", false},
+
+		// Files
+		{http.MethodGet, "/files", true},
+		{http.MethodGet, "/file/f1", true},
+		{http.MethodDelete, "/files", false},
+		{http.MethodPost, "/file", false},
+		{http.MethodPut, "/file", false},
+		{http.MethodDelete, "/file/f1", false},
+
+		// Images
+		{http.MethodPost, "/image/upload", false},
+
+		// Search
+		{http.MethodGet, "/search", true},
+
+		// Trash, audit log, cache stats and analytics dashboards are admin-only, reads included
+		{http.MethodGet, "/trash", false},
+		{http.MethodDelete, "/trash/project/p1", false},
+		{http.MethodGet, "/audit", false},
+		{http.MethodGet, "/cache/stats", false},
+		{http.MethodGet, "/analytics/summary", false},
+		{http.MethodGet, "/analytics/timeseries", false},
+
+		// Auth: a refresh token is the credential for refresh and logout
+		{http.MethodPost, "/auth/login", true},
+		{http.MethodPost, "/auth/refresh", true},
+		{http.MethodPost, "/auth/logout", true},
+
+		// API keys are managed by admins only
+		{http.MethodGet, "/api-keys", false},
+		{http.MethodPost, "/api-keys", false},
+		{http.MethodGet, "/api-keys/k1", false},
+		{http.MethodPut, "/api-keys/k1", false},
+		{http.MethodDelete, "/api-keys/k1", false},
+
+		// A public path is only public for its method
+		{http.MethodDelete, "/projects", false},
+		{http.MethodGet, "/email/send", false},
+		{http.MethodGet, "/analytics/page-view", false},
+		{http.MethodGet, "/analytics/events", false},
+		{http.MethodPut, "/project/p1", false},
+
+		// Wildcards match exactly one non-empty segment
+		{http.MethodGet, "/project/p1/extra", false},
+	}
+
+	mux, handlers := newPolicyTestMux()
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			anonymous := httptest.NewRecorder()
+			mux.ServeHTTP(anonymous, httptest.NewRequest(tt.method, tt.path, nil))
+
+			if tt.public {
+				assert.Equal(t, http.StatusTeapot, anonymous.Code, "public route must not need a token")
+			} else {
+				assert.Equal(t, http.StatusUnauthorized, anonymous.Code, "admin route must reject anonymous requests")
+			}
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+testAuthToken)
+			admin := httptest.NewRecorder()
+			mux.ServeHTTP(admin, req)
+
+			assert.Equal(t, http.StatusTeapot, admin.Code, "admin token must reach every route")
+		})
+	}
+
+	// Every public route in the policy table must be exercised above, so a
+	// route can't be opened up without a test saying so.
+	for _, h := range handlers {
+		for _, rt := range h.public {
+			covered := false
+			for _, tt := range tests {
+				if tt.public && tt.method == rt.method && rt.matches(httptest.NewRequest(tt.method, tt.path, nil)) {
+					covered = true
+					break
+				}
+			}
+			assert.True(t, covered, "public route %s %s has no test case", rt.method, rt.path)
+		}
+	}
+}
+
+func TestRoute_Matches(t *testing.T) {
+	tests := []struct {
+		name   string
+		route  route
+		method string
+		path   string
+		want   bool
+	}{
+		{"exact path", route{http.MethodGet, "/projects"}, http.MethodGet, "/projects", true},
+		{"trailing slash", route{http.MethodGet, "/projects"}, http.MethodGet, "/projects/", true},
+		{"other method", route{http.MethodGet, "/projects"}, http.MethodPost, "/projects", false},
+		{"wildcard segment", route{http.MethodGet, "/project/*"}, http.MethodGet, "/project/p1", true},
+		{"wildcard needs a segment", route{http.MethodGet, "/project/*"}, http.MethodGet, "/project/", false},
+		{"longer path", route{http.MethodGet, "/project/*"}, http.MethodGet, "/project/p1/restore", false},
+		{"shorter path", route{http.MethodGet, "/skill/*/projects"}, http.MethodGet, "/skill/s1", false},
+		{"literal after wildcard", route{http.MethodGet, "/skill/*/projects"}, http.MethodGet, "/skill/s1/projects", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.route.matches(httptest.NewRequest(tt.method, tt.path, nil)))
+		})
+	}
+}
+
+// stubAPIKeys resolves one API key per scope, named "fk_" plus the scope.
+type stubAPIKeys struct{}
+
+func (stubAPIKeys) ResolveAPIKey(ctx context.Context, key string) ([]string, bool, error) {
+	switch key {
+	case "fk_read", "fk_projects:write", "fk_files:write":
+		return []string{key[len("fk_"):]}, true, nil
+	default:
+		return nil, false, nil
+	}
+}
+
+// newPolicyTestMux builds the mux of createHandlers with every handler swapped
+// for a stub answering 418, guarded by an interceptor that accepts
+// testAuthToken and the keys of stubAPIKeys.
+func newPolicyTestMux() (*http.ServeMux, []handlerConfig) {
+	handlers := createHandlers(Config{}, newTokenAPI(Config{}), nil)
+	for i := range handlers {
+		handlers[i].handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+	}
+
+	authInterceptor := middleware.NewAuthInterceptor(
+		middleware.AuthInterceptor{
+			ValidToken: testAuthToken,
+			APIKeys:    stubAPIKeys{},
+		},
+	)
+
+	return setupHandlers(authInterceptor.RequireScope, handlers...), handlers
+}
+
+// TestCreateHandlers_APIKeyScopes checks which routes accept an API key of
+// each scope. Routes not listed here must reject every key.
+func TestCreateHandlers_APIKeyScopes(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		scope  string
+	}{
+		// Projects
+		{http.MethodPost, "/project", "projects:write"},
+		{http.MethodPut, "/project", "projects:write"},
+		{http.MethodDelete, "/project/p1", "projects:write"},
+		{http.MethodPost, "/project/p1/restore", "projects:write"},
+
+		// Files and uploads
+		{http.MethodPost, "/file", "files:write"},
+		{http.MethodPut, "/file", "files:write"},
+		{http.MethodDelete, "/file/f1", "files:write"},
+		{http.MethodDelete, "/files", "files:write"},
+		{http.MethodPost, "/image/upload", "files:write"},
+
+		// Admin reads
+		{http.MethodGet, "/trash", "read"},
+		{http.MethodGet, "/audit", "read"},
+		{http.MethodGet, "/cache/stats", "read"},
+		{http.MethodGet, "/analytics/summary", "read"},
+		{http.MethodGet, "/analytics/timeseries", "read"},
+
+		// Admin-only routes
+		{http.MethodPost, "/skill", ""},
+		{http.MethodPost, "/education", ""},
+		{http.MethodPost, "/experience", ""},
+		{http.MethodDelete, "/trash/project/p1", ""},
+		{http.MethodGet, "/api-keys", ""},
+		{http.MethodPost, "/api-keys", ""},
+		{http.MethodDelete, "/api-keys/k1", ""},
+	}
+
+	mux, handlers := newPolicyTestMux()
+	scopes := []string{"read", "projects:write", "files:write"}
+
+	for _, tt := range tests {
+		for _, scope := range scopes {
+			t.Run(tt.method+" "+tt.path+" with "+scope, func(t *testing.T) {
+				req := httptest.NewRequest(tt.method, tt.path, nil)
+				req.Header.Set("Authorization", "Bearer fk_"+scope)
+				rec := httptest.NewRecorder()
+				mux.ServeHTTP(rec, req)
+
+				if scope == tt.scope {
+					assert.Equal(t, http.StatusTeapot, rec.Code, "key with the route's scope must reach it")
+				} else {
+					assert.Equal(t, http.StatusForbidden, rec.Code, "key without the route's scope must be refused")
+				}
+			})
+		}
+	}
+
+	// Every scoped route in the policy table must be exercised above.
+	for _, h := range handlers {
+		for _, rt := range h.scoped {
+			covered := false
+			for _, tt := range tests {
+				if tt.scope == rt.scope && rt.matches(httptest.NewRequest(tt.method, tt.path, nil)) {
+					covered = true
+					break
+				}
+			}
+			assert.True(t, covered, "scoped route %s %s has no test case", rt.method, rt.path)
+		}
+	}
+}
+
+// TestCreateHandlers_RateLimits checks that the routes forwarding to EmailJS
+// and GA4, and the login and refresh routes, are throttled with the configured
+// limits. The first request is
+// rejected by the handler itself for its empty body, but still spends the
+// only token.
+func TestCreateHandlers_RateLimits(t *testing.T) {
+	limit := middleware.RateLimit{Requests: 1, Window: time.Minute}
+	handlers := createHandlers(
+		Config{EmailRateLimit: limit, AnalyticsRateLimit: limit, AnalyticsBatchRateLimit: limit, AuthRateLimit: limit},
+		newTokenAPI(Config{}),
+		nil,
+	)
+
+	mux := http.NewServeMux()
+	for _, h := range handlers {
+		if h.paths[0] != "/email" && h.paths[0] != "/analytics" && h.paths[0] != "/auth" {
+			continue
+		}
+		for _, path := range h.paths {
+			mux.Handle(path, h.handler)
+		}
+	}
+
+	for _, path := range []string{"/email/send", "/analytics/page-view", "/analytics/event", "/analytics/events", "/auth/login", "/auth/refresh"} {
+		t.Run(path, func(t *testing.T) {
+			first := httptest.NewRecorder()
+			mux.ServeHTTP(first, httptest.NewRequest(http.MethodPost, path, nil))
+			assert.NotEqual(t, http.StatusTooManyRequests, first.Code)
+			assert.Equal(t, "1", first.Header().Get("RateLimit-Limit"))
+
+			second := httptest.NewRecorder()
+			mux.ServeHTTP(second, httptest.NewRequest(http.MethodPost, path, nil))
+			assert.Equal(t, http.StatusTooManyRequests, second.Code)
+			assert.Equal(t, "60", second.Header().Get("Retry-After"))
+		})
+	}
+}
+
+// stubWorker records whether it was stopped and fails with err.
+type stubWorker struct {
+	stopped bool
+	err     error
+}
+
+func (w *stubWorker) Start() {}
+
+func (w *stubWorker) Stop(ctx context.Context) error {
+	w.stopped = true
+	return w.err
+}
+
+func TestServer_Shutdown(t *testing.T) {
+	t.Run("stops the worker", func(t *testing.T) {
+		w := &stubWorker{}
+		s := &Server{httpServer: &http.Server{}, outboxWorker: w}
+
+		assert.NoError(t, s.Shutdown(context.Background()))
+		assert.True(t, w.stopped)
+	})
+
+	t.Run("stops the worker when the HTTP server fails to shut down", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		entered := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		httpServer := &http.Server{
+			Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				close(entered)
+				<-release
+			}),
+		}
+		go httpServer.Serve(ln)
+		go http.Get("http://" + ln.Addr().String())
+		<-entered
+
+		workerErr := errors.New("worker error")
+		w := &stubWorker{err: workerErr}
+		s := &Server{httpServer: httpServer, outboxWorker: w}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = s.Shutdown(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.ErrorIs(t, err, workerErr)
+		assert.True(t, w.stopped)
+	})
+}
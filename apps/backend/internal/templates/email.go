@@ -0,0 +1,156 @@
+package templates
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+)
+
+// emailFS holds the default email templates. Every domain.EmailTemplate has a
+// <name>.txt.tmpl text/template, defining the "subject" template next to the
+// text body, and a <name>.html.tmpl html/template with the HTML body.
+//
+//go:embed email/*.tmpl
+var emailFS embed.FS
+
+// Email is a rendered email.
+type Email struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type EmailRenderer interface {
+	Render(name domain.EmailTemplate, data domain.EmailTemplateData) (Email, error)
+}
+
+type EmailRendererConfig struct {
+	// Dir holds templates overriding the embedded ones with the same file
+	// name, e.g. auto_reply.html.tmpl. Overrides are read again on every
+	// render, so they can be edited while previewing them. Only the
+	// embedded templates are used when empty.
+	Dir string
+}
+
+type emailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+type emailRenderer struct {
+	dir       string
+	templates map[domain.EmailTemplate]emailTemplate
+}
+
+// NewEmailRenderer creates and returns an EmailRenderer of the embedded email
+// templates and the overrides in cfg.Dir. It returns an error if a template
+// cannot be read or parsed, so broken overrides are caught on startup.
+func NewEmailRenderer(cfg EmailRendererConfig) (EmailRenderer, error) {
+	r := &emailRenderer{
+		dir:       cfg.Dir,
+		templates: make(map[domain.EmailTemplate]emailTemplate, len(domain.EmailTemplates)),
+	}
+
+	for _, name := range domain.EmailTemplates {
+		tmpl, err := r.parse(name)
+		if err != nil {
+			return nil, err
+		}
+		r.templates[name] = tmpl
+	}
+
+	return r, nil
+}
+
+// Render renders the subject, text and HTML body of the email name with data.
+// The subject is collapsed to a single line.
+func (r *emailRenderer) Render(name domain.EmailTemplate, data domain.EmailTemplateData) (Email, error) {
+	tmpl, ok := r.templates[name]
+	if !ok {
+		return Email{}, fmt.Errorf("unknown email template %q", name)
+	}
+
+	if r.dir != "" {
+		var err error
+		if tmpl, err = r.parse(name); err != nil {
+			return Email{}, err
+		}
+	}
+
+	var subject, text, html bytes.Buffer
+	if err := tmpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return Email{}, fmt.Errorf("failed to render email subject: %w", err)
+	}
+	if err := tmpl.text.Execute(&text, data); err != nil {
+		return Email{}, fmt.Errorf("failed to render email text: %w", err)
+	}
+	if err := tmpl.html.Execute(&html, data); err != nil {
+		return Email{}, fmt.Errorf("failed to render email HTML: %w", err)
+	}
+
+	return Email{
+		Subject: strings.Join(strings.Fields(subject.String()), " "),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
+
+// parse reads and parses the text and HTML templates of the email name.
+func (r *emailRenderer) parse(name domain.EmailTemplate) (emailTemplate, error) {
+	textFile := string(name) + ".txt.tmpl"
+	textSource, err := r.read(textFile)
+	if err != nil {
+		return emailTemplate{}, err
+	}
+
+	text, err := texttemplate.New(textFile).Parse(textSource)
+	if err != nil {
+		return emailTemplate{}, fmt.Errorf("failed to parse email template: %w", err)
+	}
+	if text.Lookup("subject") == nil {
+		return emailTemplate{}, fmt.Errorf("failed to parse email template: %s does not define a subject", textFile)
+	}
+
+	htmlFile := string(name) + ".html.tmpl"
+	htmlSource, err := r.read(htmlFile)
+	if err != nil {
+		return emailTemplate{}, err
+	}
+
+	html, err := htmltemplate.New(htmlFile).Parse(htmlSource)
+	if err != nil {
+		return emailTemplate{}, fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	return emailTemplate{text: text, html: html}, nil
+}
+
+// read returns the template file name from the override directory if it is
+// there, from the embedded templates otherwise.
+func (r *emailRenderer) read(name string) (string, error) {
+	if r.dir != "" {
+		data, err := os.ReadFile(filepath.Join(r.dir, name))
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", fmt.Errorf("failed to read email template: %w", err)
+		}
+	}
+
+	data, err := emailFS.ReadFile("email/" + name)
+	if err != nil {
+		return "", fmt.Errorf("failed to read email template: %w", err)
+	}
+
+	return string(data), nil
+}
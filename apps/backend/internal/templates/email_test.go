@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEmailTemplateData = domain.EmailTemplateData{
+	Name:    "Jane <Doe>",
+	Email:   "jane@example.com",
+	Subject: "Hello\r\nBcc: everyone@example.com",
+	Message: "Nice portfolio!\n<script>alert(1)</script>",
+	Date:    time.Date(2026, 2, 12, 9, 0, 0, 0, time.UTC),
+}
+
+func TestEmailRenderer_Render(t *testing.T) {
+	renderer, err := NewEmailRenderer(EmailRendererConfig{})
+	require.NoError(t, err)
+
+	t.Run("owner notification", func(t *testing.T) {
+		email, err := renderer.Render(domain.EmailOwnerNotification, testEmailTemplateData)
+		require.NoError(t, err)
+
+		assert.Equal(t, "New message from Jane <Doe>: Hello Bcc: everyone@example.com", email.Subject)
+		assert.Contains(t, email.Text, "Jane <Doe> <jane@example.com> sent a message through the contact form on Thu, 12 Feb 2026 at 09:00 UTC.")
+		assert.Contains(t, email.Text, "Nice portfolio!\n<script>alert(1)</script>")
+		assert.Contains(t, email.HTML, "New message from Jane &lt;Doe&gt;")
+		assert.Contains(t, email.HTML, `<a href="mailto:jane@example.com"`)
+		assert.Contains(t, email.HTML, "&lt;script&gt;alert(1)&lt;/script&gt;")
+		assert.NotContains(t, email.HTML, "<script>")
+	})
+
+	t.Run("auto reply", func(t *testing.T) {
+		email, err := renderer.Render(domain.EmailAutoReply, testEmailTemplateData)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Thanks for your message", email.Subject)
+		assert.Contains(t, email.Text, "Hi Jane <Doe>,")
+		assert.Contains(t, email.Text, "Your message of Thu, 12 Feb 2026 at 09:00 UTC arrived safely")
+		assert.Contains(t, email.HTML, "Hi Jane &lt;Doe&gt;,")
+		// The sender's own words are never echoed back to the address they gave
+		for _, body := range []string{email.Text, email.HTML} {
+			assert.NotContains(t, body, "Hello")
+			assert.NotContains(t, body, "Nice portfolio!")
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		_, err := renderer.Render("newsletter", testEmailTemplateData)
+		assert.EqualError(t, err, `unknown email template "newsletter"`)
+	})
+}
+
+func TestEmailRenderer_Overrides(t *testing.T) {
+	dir := t.TempDir()
+	override := filepath.Join(dir, "auto_reply.txt.tmpl")
+	require.NoError(t, os.WriteFile(override, []byte(`{{define "subject"}}Got it{{end}}Thanks {{.Name}}`), 0o600))
+
+	renderer, err := NewEmailRenderer(EmailRendererConfig{Dir: dir})
+	require.NoError(t, err)
+
+	email, err := renderer.Render(domain.EmailAutoReply, testEmailTemplateData)
+	require.NoError(t, err)
+	assert.Equal(t, "Got it", email.Subject)
+	assert.Equal(t, "Thanks Jane <Doe>", email.Text)
+	// Files without an override fall back to the embedded templates
+	assert.Contains(t, email.HTML, "Hi Jane &lt;Doe&gt;,")
+
+	// Overrides are read again on every render
+	require.NoError(t, os.WriteFile(override, []byte(`{{define "subject"}}Got it again{{end}}`), 0o600))
+	email, err = renderer.Render(domain.EmailAutoReply, testEmailTemplateData)
+	require.NoError(t, err)
+	assert.Equal(t, "Got it again", email.Subject)
+
+	require.NoError(t, os.WriteFile(override, []byte(`{{.Name`), 0o600))
+	_, err = renderer.Render(domain.EmailAutoReply, testEmailTemplateData)
+	assert.ErrorContains(t, err, "failed to parse email template")
+}
+
+func TestNewEmailRenderer_InvalidOverride(t *testing.T) {
+	tests := map[string]struct {
+		file    string
+		content string
+		err     string
+	}{
+		"syntax error": {
+			file:    "owner_notification.html.tmpl",
+			content: `{{if .Name}}`,
+			err:     "failed to parse email template",
+		},
+		"subject missing": {
+			file:    "owner_notification.txt.tmpl",
+			content: `{{.Message}}`,
+			err:     "failed to parse email template: owner_notification.txt.tmpl does not define a subject",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, tt.file), []byte(tt.content), 0o600))
+
+			renderer, err := NewEmailRenderer(EmailRendererConfig{Dir: dir})
+
+			assert.ErrorContains(t, err, tt.err)
+			assert.Nil(t, renderer)
+		})
+	}
+}
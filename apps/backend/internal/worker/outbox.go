@@ -0,0 +1,308 @@
+package worker
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/database"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	v1 "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/templates"
+)
+
+const (
+	DefaultOutboxPollInterval = 5 * time.Second
+	DefaultOutboxBatchSize    = 10
+	DefaultOutboxMaxAttempts  = 8
+	DefaultOutboxBaseBackoff  = 30 * time.Second
+	DefaultOutboxMaxBackoff   = time.Hour
+	DefaultOutboxSendTimeout  = 30 * time.Second
+
+	// outboxLease is how long a claimed email is left alone before another
+	// poll may claim it again. It covers a full batch of sends timing out
+	// with the default send timeout.
+	outboxLease = 10 * time.Minute
+)
+
+// Worker is a background job started along with the server.
+type Worker interface {
+	Start()
+	Stop(ctx context.Context) error
+}
+
+// OutboxWorkerConfig configures the worker delivering the email outbox
+// through the Email provider. Zero tunables fall back to the DefaultOutbox*
+// values.
+type OutboxWorkerConfig struct {
+	DatabaseAPI database.DatabaseAPI
+	// Email selects and configures the provider emails are sent with.
+	Email client.EmailConfig
+	// EmailTo is the address contact form emails are delivered to.
+	EmailTo string
+	// EmailTemplates renders the emails; the embedded templates are used
+	// when nil.
+	EmailTemplates templates.EmailRenderer
+
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	// SendTimeout bounds each attempt to send an email.
+	SendTimeout time.Duration
+
+	outboxRepo   v1.OutboxRepository
+	messageRepo  v1.MessageRepository
+	timeProvider domain.TimeProvider
+}
+
+type outboxWorker struct {
+	emailRepo    v1.EmailRepository
+	outboxRepo   v1.OutboxRepository
+	messageRepo  v1.MessageRepository
+	timeProvider domain.TimeProvider
+	pollInterval time.Duration
+	batchSize    int
+	maxAttempts  int
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	sendTimeout  time.Duration
+
+	// ctx is the context the worker runs with; cancel aborts the work in
+	// flight when Stop runs out of time.
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewOutboxWorker creates and returns a Worker that delivers the emails
+// queued in the outbox. If cfg.outboxRepo or cfg.messageRepo is nil, the
+// repositories are constructed on cfg.DatabaseAPI. It panics when the email
+// provider is unknown or misconfigured.
+func NewOutboxWorker(cfg OutboxWorkerConfig) Worker {
+	sender, err := client.NewEmailSender(cfg.Email)
+	if err != nil {
+		panic(err)
+	}
+
+	emailTemplates := cfg.EmailTemplates
+	if emailTemplates == nil {
+		emailTemplates, err = templates.NewEmailRenderer(templates.EmailRendererConfig{})
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	emailRepo := v1.NewEmailRepository(
+		v1.EmailRepositoryConfig{
+			Sender:    sender,
+			Templates: emailTemplates,
+			To:        cfg.EmailTo,
+		},
+	)
+
+	messageRepo := cfg.messageRepo
+	if messageRepo == nil {
+		messageRepo = v1.NewMessageRepository(
+			v1.MessageRepositoryConfig{
+				DatabaseAPI:  cfg.DatabaseAPI,
+				MessageTable: "message",
+			},
+		)
+	}
+
+	outboxRepo := cfg.outboxRepo
+	if outboxRepo == nil {
+		outboxRepo = v1.NewOutboxRepository(
+			v1.OutboxRepositoryConfig{
+				DatabaseAPI:  cfg.DatabaseAPI,
+				OutboxTable:  "email_outbox",
+				MessageTable: "message",
+				MessageRepo:  messageRepo,
+			},
+		)
+	}
+
+	timeProvider := cfg.timeProvider
+	if timeProvider == nil {
+		timeProvider = time.Now
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	w := &outboxWorker{
+		emailRepo:    emailRepo,
+		outboxRepo:   outboxRepo,
+		messageRepo:  messageRepo,
+		timeProvider: timeProvider,
+		pollInterval: cfg.PollInterval,
+		batchSize:    cfg.BatchSize,
+		maxAttempts:  cfg.MaxAttempts,
+		baseBackoff:  cfg.BaseBackoff,
+		maxBackoff:   cfg.MaxBackoff,
+		sendTimeout:  cfg.SendTimeout,
+		ctx:          ctx,
+		cancel:       cancel,
+		stop:         make(chan struct{}),
+		done:         make(chan struct{}),
+	}
+
+	if w.pollInterval <= 0 {
+		w.pollInterval = DefaultOutboxPollInterval
+	}
+	if w.batchSize <= 0 {
+		w.batchSize = DefaultOutboxBatchSize
+	}
+	if w.maxAttempts <= 0 {
+		w.maxAttempts = DefaultOutboxMaxAttempts
+	}
+	if w.baseBackoff <= 0 {
+		w.baseBackoff = DefaultOutboxBaseBackoff
+	}
+	if w.maxBackoff <= 0 {
+		w.maxBackoff = DefaultOutboxMaxBackoff
+	}
+	if w.sendTimeout <= 0 {
+		w.sendTimeout = DefaultOutboxSendTimeout
+	}
+
+	return w
+}
+
+// Start drains the outbox right away and then every poll interval, in a
+// goroutine of its own, until Stop is called.
+func (w *outboxWorker) Start() {
+	log.Printf("Starting email outbox worker, polling every %s", w.pollInterval)
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			w.drain(w.ctx)
+
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop asks the worker to stop and waits until the email being sent, if
+// any, has been recorded, or until ctx is done, when the work in flight is
+// cancelled. Emails claimed but not yet sent are picked up again once their
+// lease expires.
+func (w *outboxWorker) Stop(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.stop) })
+	defer w.cancel()
+
+	select {
+	case <-w.done:
+		log.Println("Email outbox worker stopped")
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// drain delivers due emails batch by batch until a batch comes back short,
+// or the worker is stopping.
+func (w *outboxWorker) drain(ctx context.Context) {
+	for {
+		emails, err := w.outboxRepo.Claim(ctx, w.batchSize, outboxLease)
+		if err != nil {
+			log.Printf("Failed to claim outbox emails: %v", err)
+			return
+		}
+
+		for _, email := range emails {
+			if w.stopping() {
+				return
+			}
+			w.deliver(ctx, email)
+		}
+
+		if len(emails) < w.batchSize {
+			return
+		}
+	}
+}
+
+// deliver sends email, giving up after the send timeout, and records the
+// outcome on it and on its message. A failed send is retried with
+// exponential backoff until the email runs out of attempts, when it is
+// dead-lettered and its message marked failed. Recording errors are only
+// logged: the email comes back after its lease.
+func (w *outboxWorker) deliver(ctx context.Context, email domain.OutboxEmail) {
+	sendCtx, cancel := context.WithTimeout(ctx, w.sendTimeout)
+	sendErr := w.emailRepo.Send(sendCtx, email.Template, email.Email)
+	cancel()
+	if sendErr == nil {
+		if err := w.outboxRepo.MarkSent(ctx, email.ID); err != nil {
+			log.Printf("Failed to mark outbox email %s sent: %v", email.ID, err)
+		}
+		w.recordAttempt(ctx, email, domain.MessageSent, "")
+		return
+	}
+
+	attempts := email.Attempts + 1
+	if attempts >= w.maxAttempts {
+		log.Printf("Giving up on outbox email %s after %d attempts: %v", email.ID, attempts, sendErr)
+		if err := w.outboxRepo.MarkDead(ctx, email.ID, sendErr.Error()); err != nil {
+			log.Printf("Failed to mark outbox email %s dead: %v", email.ID, err)
+		}
+		w.recordAttempt(ctx, email, domain.MessageFailed, sendErr.Error())
+		return
+	}
+
+	delay := w.backoff(attempts)
+	log.Printf("Failed to send outbox email %s (attempt %d), retrying in %s: %v", email.ID, attempts, delay, sendErr)
+	if err := w.outboxRepo.Retry(ctx, email.ID, sendErr.Error(), w.timeProvider().Add(delay)); err != nil {
+		log.Printf("Failed to reschedule outbox email %s: %v", email.ID, err)
+	}
+	w.recordAttempt(ctx, email, domain.MessagePending, sendErr.Error())
+}
+
+// recordAttempt stores the outcome of an attempt to deliver email on its
+// message, so the admin inbox shows it. Only owner notifications count: the
+// message status tracks its delivery to the owner, not the auto reply. An
+// error is only logged.
+func (w *outboxWorker) recordAttempt(ctx context.Context, email domain.OutboxEmail, status domain.MessageStatus, providerResponse string) {
+	if email.Template != domain.EmailOwnerNotification {
+		return
+	}
+
+	if err := w.messageRepo.RecordAttempt(ctx, email.MessageID, status, providerResponse); err != nil {
+		log.Printf("Failed to record attempt of message %s: %v", email.MessageID, err)
+	}
+}
+
+// backoff returns how long to wait after the given number of failed
+// attempts: the base backoff, doubled for every attempt after the first,
+// capped at the max backoff.
+func (w *outboxWorker) backoff(attempts int) time.Duration {
+	delay := w.baseBackoff
+	for i := 1; i < attempts && delay < w.maxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, w.maxBackoff)
+}
+
+// stopping reports whether Stop has been called.
+func (w *outboxWorker) stopping() bool {
+	select {
+	case <-w.stop:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,411 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/client"
+	mockClient "github.com/fingertips18/fingertips18.github.io/backend/internal/client/mocks"
+	"github.com/fingertips18/fingertips18.github.io/backend/internal/domain"
+	mockRepo "github.com/fingertips18/fingertips18.github.io/backend/internal/repository/v1/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var outboxTestNow = time.Date(2026, 2, 11, 9, 0, 0, 0, time.UTC)
+
+type outboxWorkerTestFixture struct {
+	t               *testing.T
+	mockHttpAPI     *mockClient.MockHttpAPI
+	mockOutboxRepo  *mockRepo.MockOutboxRepository
+	mockMessageRepo *mockRepo.MockMessageRepository
+	worker          *outboxWorker
+}
+
+func newOutboxWorkerTestFixture(t *testing.T) *outboxWorkerTestFixture {
+	mockHttpAPI := new(mockClient.MockHttpAPI)
+	mockOutboxRepo := new(mockRepo.MockOutboxRepository)
+	mockMessageRepo := new(mockRepo.MockMessageRepository)
+
+	worker := NewOutboxWorker(
+		OutboxWorkerConfig{
+			Email: client.EmailConfig{
+				Provider: client.EmailProviderEmailJS,
+				EmailJS: client.EmailJSConfig{
+					ServiceID:  "service_xxx",
+					TemplateID: "template_xxx",
+					PublicKey:  "user_xxx",
+				},
+				HttpAPI: mockHttpAPI,
+			},
+			EmailTo:      "owner@example.com",
+			PollInterval: time.Hour,
+			BatchSize:    2,
+			MaxAttempts:  3,
+			BaseBackoff:  time.Minute,
+			MaxBackoff:   10 * time.Minute,
+			outboxRepo:   mockOutboxRepo,
+			messageRepo:  mockMessageRepo,
+			timeProvider: func() time.Time { return outboxTestNow },
+		},
+	)
+
+	return &outboxWorkerTestFixture{
+		t:               t,
+		mockHttpAPI:     mockHttpAPI,
+		mockOutboxRepo:  mockOutboxRepo,
+		mockMessageRepo: mockMessageRepo,
+		worker:          worker.(*outboxWorker),
+	}
+}
+
+func newTestOutboxEmail(id string, attempts int) domain.OutboxEmail {
+	return domain.OutboxEmail{
+		ID:        id,
+		MessageID: "message-" + id,
+		Template:  domain.EmailOwnerNotification,
+		Status:    domain.OutboxPending,
+		Attempts:  attempts,
+		Email: domain.SendEmail{
+			Name:    "Jane Doe",
+			Email:   "jane@example.com",
+			Subject: "Hello",
+			Message: "Nice portfolio!",
+		},
+	}
+}
+
+func emailJSResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Status:     http.StatusText(status),
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func newTestAutoReply(id string, attempts int) domain.OutboxEmail {
+	email := newTestOutboxEmail(id, attempts)
+	email.Template = domain.EmailAutoReply
+	return email
+}
+
+// emailJSParams returns the template params of an EmailJS send request.
+func emailJSParams(req *http.Request) map[string]string {
+	var payload struct {
+		TemplateParams map[string]string `json:"template_params"`
+	}
+	body, _ := io.ReadAll(req.Body)
+	json.Unmarshal(body, &payload)
+	return payload.TemplateParams
+}
+
+func TestOutboxWorker_Deliver(t *testing.T) {
+	const badGateway = "failed to send: [status=Bad Gateway,message=upstream down]"
+
+	type Given struct {
+		email    domain.OutboxEmail
+		mockSend func(m *mockClient.MockHttpAPI)
+		mockRepo func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository)
+	}
+
+	tests := map[string]struct {
+		given Given
+	}{
+		"sent": {
+			given: Given{
+				email: newTestOutboxEmail("1", 0),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().
+						Do(mock.MatchedBy(func(req *http.Request) bool {
+							params := emailJSParams(req)
+							deadline, ok := req.Context().Deadline()
+							return ok && !deadline.After(time.Now().Add(DefaultOutboxSendTimeout)) &&
+								req.Method == http.MethodPost &&
+								req.URL.String() == "https://api.emailjs.com/api/v1.0/email/send" &&
+								params["template"] == "owner_notification" &&
+								params["to_email"] == "owner@example.com" &&
+								params["email_subject"] == "New message from Jane Doe: Hello"
+						})).
+						Return(emailJSResponse(http.StatusOK, "OK"), nil)
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().MarkSent(mock.Anything, "1").Return(nil)
+					message.EXPECT().RecordAttempt(mock.Anything, "message-1", domain.MessageSent, "").Return(nil)
+				},
+			},
+		},
+		"provider error is retried with backoff": {
+			given: Given{
+				email: newTestOutboxEmail("1", 1),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().Do(mock.Anything).Return(emailJSResponse(http.StatusBadGateway, "upstream down"), nil)
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().Retry(mock.Anything, "1", badGateway, outboxTestNow.Add(2*time.Minute)).Return(nil)
+					message.EXPECT().RecordAttempt(mock.Anything, "message-1", domain.MessagePending, badGateway).Return(nil)
+				},
+			},
+		},
+		"network error is retried with backoff": {
+			given: Given{
+				email: newTestOutboxEmail("1", 0),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().Do(mock.Anything).Return(nil, errors.New("connection reset"))
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().
+						Retry(mock.Anything, "1", "failed to send HTTP request: connection reset", outboxTestNow.Add(time.Minute)).
+						Return(nil)
+					message.EXPECT().
+						RecordAttempt(mock.Anything, "message-1", domain.MessagePending, "failed to send HTTP request: connection reset").
+						Return(nil)
+				},
+			},
+		},
+		"last attempt is dead-lettered": {
+			given: Given{
+				email: newTestOutboxEmail("1", 2),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().Do(mock.Anything).Return(emailJSResponse(http.StatusBadGateway, "upstream down"), nil)
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().MarkDead(mock.Anything, "1", badGateway).Return(nil)
+					message.EXPECT().RecordAttempt(mock.Anything, "message-1", domain.MessageFailed, badGateway).Return(nil)
+				},
+			},
+		},
+		"auto reply is sent without touching the message": {
+			given: Given{
+				email: newTestAutoReply("2", 0),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().
+						Do(mock.MatchedBy(func(req *http.Request) bool {
+							params := emailJSParams(req)
+							return params["template"] == "auto_reply" &&
+								params["to_email"] == `"Jane Doe" <jane@example.com>` &&
+								params["reply_to"] == "owner@example.com" &&
+								params["email_subject"] == "Thanks for your message"
+						})).
+						Return(emailJSResponse(http.StatusOK, "OK"), nil)
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().MarkSent(mock.Anything, "2").Return(nil)
+				},
+			},
+		},
+		"auto reply is dead-lettered without failing the message": {
+			given: Given{
+				email: newTestAutoReply("2", 2),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().Do(mock.Anything).Return(emailJSResponse(http.StatusBadGateway, "upstream down"), nil)
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().MarkDead(mock.Anything, "2", badGateway).Return(nil)
+				},
+			},
+		},
+		"recording errors are only logged": {
+			given: Given{
+				email: newTestOutboxEmail("1", 0),
+				mockSend: func(m *mockClient.MockHttpAPI) {
+					m.EXPECT().Do(mock.Anything).Return(emailJSResponse(http.StatusOK, "OK"), nil)
+				},
+				mockRepo: func(outbox *mockRepo.MockOutboxRepository, message *mockRepo.MockMessageRepository) {
+					outbox.EXPECT().MarkSent(mock.Anything, "1").Return(errors.New("db error"))
+					message.EXPECT().
+						RecordAttempt(mock.Anything, "message-1", domain.MessageSent, "").
+						Return(errors.New("db error"))
+				},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := newOutboxWorkerTestFixture(t)
+			tt.given.mockSend(f.mockHttpAPI)
+			tt.given.mockRepo(f.mockOutboxRepo, f.mockMessageRepo)
+
+			f.worker.deliver(context.Background(), tt.given.email)
+
+			f.mockHttpAPI.AssertExpectations(t)
+			f.mockOutboxRepo.AssertExpectations(t)
+			f.mockMessageRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestNewOutboxWorker_InvalidEmailProvider(t *testing.T) {
+	assert.PanicsWithError(t, `unknown email provider "sendgrid"`, func() {
+		NewOutboxWorker(OutboxWorkerConfig{Email: client.EmailConfig{Provider: "sendgrid"}})
+	})
+
+	assert.PanicsWithError(t, "smtp host missing", func() {
+		NewOutboxWorker(OutboxWorkerConfig{Email: client.EmailConfig{Provider: client.EmailProviderSMTP}})
+	})
+}
+
+func TestOutboxWorker_Backoff(t *testing.T) {
+	f := newOutboxWorkerTestFixture(t)
+
+	tests := map[int]time.Duration{
+		1:   time.Minute,
+		2:   2 * time.Minute,
+		3:   4 * time.Minute,
+		4:   8 * time.Minute,
+		5:   10 * time.Minute,
+		100: 10 * time.Minute,
+	}
+
+	for attempts, expected := range tests {
+		assert.Equal(t, expected, f.worker.backoff(attempts), "attempts = %d", attempts)
+	}
+}
+
+func TestOutboxWorker_Drain(t *testing.T) {
+	t.Run("claims batches until one comes back short", func(t *testing.T) {
+		f := newOutboxWorkerTestFixture(t)
+
+		f.mockOutboxRepo.EXPECT().
+			Claim(mock.Anything, 2, outboxLease).
+			Return([]domain.OutboxEmail{newTestOutboxEmail("1", 0), newTestOutboxEmail("2", 0)}, nil).
+			Once()
+		f.mockOutboxRepo.EXPECT().
+			Claim(mock.Anything, 2, outboxLease).
+			Return([]domain.OutboxEmail{newTestOutboxEmail("3", 0)}, nil).
+			Once()
+		f.mockHttpAPI.EXPECT().
+			Do(mock.Anything).
+			RunAndReturn(func(*http.Request) (*http.Response, error) {
+				return emailJSResponse(http.StatusOK, "OK"), nil
+			}).
+			Times(3)
+		f.mockOutboxRepo.EXPECT().MarkSent(mock.Anything, mock.Anything).Return(nil).Times(3)
+		f.mockMessageRepo.EXPECT().RecordAttempt(mock.Anything, mock.Anything, domain.MessageSent, "").Return(nil).Times(3)
+
+		f.worker.drain(context.Background())
+
+		f.mockHttpAPI.AssertExpectations(t)
+		f.mockOutboxRepo.AssertExpectations(t)
+		f.mockMessageRepo.AssertExpectations(t)
+	})
+
+	t.Run("claim error ends the drain", func(t *testing.T) {
+		f := newOutboxWorkerTestFixture(t)
+
+		f.mockOutboxRepo.EXPECT().
+			Claim(mock.Anything, 2, outboxLease).
+			Return(nil, errors.New("db error")).
+			Once()
+
+		f.worker.drain(context.Background())
+
+		f.mockOutboxRepo.AssertExpectations(t)
+	})
+
+	t.Run("stopped worker sends nothing more", func(t *testing.T) {
+		f := newOutboxWorkerTestFixture(t)
+		close(f.worker.stop)
+
+		f.mockOutboxRepo.EXPECT().
+			Claim(mock.Anything, 2, outboxLease).
+			Return([]domain.OutboxEmail{newTestOutboxEmail("1", 0)}, nil).
+			Once()
+
+		f.worker.drain(context.Background())
+
+		f.mockOutboxRepo.AssertExpectations(t)
+		f.mockHttpAPI.AssertNotCalled(t, "Do", mock.Anything)
+	})
+}
+
+func TestOutboxWorker_StartStop(t *testing.T) {
+	f := newOutboxWorkerTestFixture(t)
+
+	claimed := make(chan struct{}, 1)
+	f.mockOutboxRepo.EXPECT().
+		Claim(mock.Anything, 2, outboxLease).
+		RunAndReturn(func(context.Context, int, time.Duration) ([]domain.OutboxEmail, error) {
+			select {
+			case claimed <- struct{}{}:
+			default:
+			}
+			return []domain.OutboxEmail{}, nil
+		})
+
+	f.worker.Start()
+
+	select {
+	case <-claimed:
+	case <-time.After(time.Second):
+		t.Fatal("worker did not drain the outbox on start")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.NoError(t, f.worker.Stop(ctx))
+	// Stopping twice is harmless
+	assert.NoError(t, f.worker.Stop(ctx))
+}
+
+func TestOutboxWorker_StopTimeout(t *testing.T) {
+	f := newOutboxWorkerTestFixture(t)
+
+	release := make(chan struct{})
+	defer close(release)
+
+	started := make(chan struct{})
+	f.mockOutboxRepo.EXPECT().
+		Claim(mock.Anything, 2, outboxLease).
+		RunAndReturn(func(context.Context, int, time.Duration) ([]domain.OutboxEmail, error) {
+			close(started)
+			<-release
+			return []domain.OutboxEmail{}, nil
+		}).
+		Once()
+
+	f.worker.Start()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, f.worker.Stop(ctx), context.DeadlineExceeded)
+}
+
+func TestOutboxWorker_StopTimeoutCancelsWork(t *testing.T) {
+	f := newOutboxWorkerTestFixture(t)
+
+	started := make(chan struct{})
+	cancelled := make(chan struct{})
+	f.mockOutboxRepo.EXPECT().
+		Claim(mock.Anything, 2, outboxLease).
+		RunAndReturn(func(ctx context.Context, _ int, _ time.Duration) ([]domain.OutboxEmail, error) {
+			close(started)
+			<-ctx.Done()
+			close(cancelled)
+			return nil, ctx.Err()
+		}).
+		Once()
+
+	f.worker.Start()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.ErrorIs(t, f.worker.Stop(ctx), context.DeadlineExceeded)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("work in flight was not cancelled")
+	}
+}
@@ -0,0 +1,184 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Config bounds a Cache. Entries expire TTL after they were stored, and once
+// Capacity entries are held the least recently used one is evicted to make
+// room. A non-positive Capacity leaves the cache unbounded.
+type Config struct {
+	TTL      time.Duration
+	Capacity int
+
+	now func() time.Time
+}
+
+// Stats counts the lookups a Cache answered from memory (Hits) and the ones
+// that found no live entry (Misses).
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// Cache is an in-memory key/value store with per-entry expiry and LRU
+// eviction. It is safe for concurrent use.
+type Cache[K comparable, V any] struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	capacity   int
+	now        func() time.Time
+	entries    map[K]*list.Element
+	order      *list.List // front is the most recently used entry
+	generation uint64
+	stats      Stats
+}
+
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time
+}
+
+// New returns an empty Cache configured with cfg.
+func New[K comparable, V any](cfg Config) *Cache[K, V] {
+	now := cfg.now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Cache[K, V]{
+		ttl:      cfg.TTL,
+		capacity: cfg.Capacity,
+		now:      now,
+		entries:  make(map[K]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns the live value stored under key and marks it as recently used.
+// Expired entries are dropped and reported as a miss.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.lookup(key)
+	if ok {
+		c.stats.Hits++
+	} else {
+		c.stats.Misses++
+	}
+
+	return value, ok
+}
+
+// Set stores value under key, evicting the least recently used entry when the
+// cache is full.
+func (c *Cache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.store(key, value)
+}
+
+// GetOrLoad returns the value cached under key, or calls load and caches its
+// result. Errors from load are returned as is and never cached. A value that
+// was loaded while Purge ran is returned but not stored, since it may predate
+// the write that triggered the purge.
+func (c *Cache[K, V]) GetOrLoad(key K, load func() (V, error)) (V, error) {
+	c.mu.Lock()
+	if value, ok := c.lookup(key); ok {
+		c.stats.Hits++
+		c.mu.Unlock()
+		return value, nil
+	}
+	c.stats.Misses++
+	generation := c.generation
+	c.mu.Unlock()
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+
+	c.mu.Lock()
+	if c.generation == generation {
+		c.store(key, value)
+	}
+	c.mu.Unlock()
+
+	return value, nil
+}
+
+// Purge drops every entry. The hit and miss counters are kept.
+func (c *Cache[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.entries)
+	c.order.Init()
+	c.generation++
+}
+
+// Len returns the number of entries held, including expired ones not yet
+// dropped.
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// Stats returns a snapshot of the hit and miss counters.
+func (c *Cache[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// lookup must be called with c.mu held.
+func (c *Cache[K, V]) lookup(key K) (V, bool) {
+	var zero V
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return zero, false
+	}
+
+	e := elem.Value.(*entry[K, V])
+	if !c.now().Before(e.expiresAt) {
+		c.remove(elem)
+		return zero, false
+	}
+
+	c.order.MoveToFront(elem)
+	return e.value, true
+}
+
+// store must be called with c.mu held.
+func (c *Cache[K, V]) store(key K, value V) {
+	expiresAt := c.now().Add(c.ttl)
+
+	if elem, ok := c.entries[key]; ok {
+		e := elem.Value.(*entry[K, V])
+		e.value = value
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.capacity > 0 && c.order.Len() >= c.capacity {
+		c.remove(c.order.Back())
+	}
+
+	c.entries[key] = c.order.PushFront(&entry[K, V]{key: key, value: value, expiresAt: expiresAt})
+}
+
+// remove must be called with c.mu held.
+func (c *Cache[K, V]) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*entry[K, V]).key)
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock is a manually advanced time source.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func newTestCache(ttl time.Duration, capacity int) (*Cache[string, int], *fakeClock) {
+	clock := &fakeClock{now: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)}
+	return New[string, int](Config{TTL: ttl, Capacity: capacity, now: clock.Now}), clock
+}
+
+func TestCache_GetSet(t *testing.T) {
+	c, _ := newTestCache(time.Minute, 0)
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+
+	c.Set("a", 1)
+	value, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	c.Set("a", 2)
+	value, _ = c.Get("a")
+	assert.Equal(t, 2, value)
+	assert.Equal(t, 1, c.Len())
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 1}, c.Stats())
+}
+
+func TestCache_Expiry(t *testing.T) {
+	c, clock := newTestCache(time.Minute, 0)
+
+	c.Set("a", 1)
+
+	clock.now = clock.now.Add(59 * time.Second)
+	_, ok := c.Get("a")
+	assert.True(t, ok, "entry is live until its TTL elapses")
+
+	clock.now = clock.now.Add(time.Second)
+	_, ok = c.Get("a")
+	assert.False(t, ok, "entry expires once its TTL elapses")
+	assert.Equal(t, 0, c.Len(), "expired entry is dropped on lookup")
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	c, _ := newTestCache(time.Minute, 2)
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a") // b is now the least recently used
+	c.Set("c", 3)
+
+	_, ok := c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("a")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 2, c.Len())
+}
+
+func TestCache_Purge(t *testing.T) {
+	c, _ := newTestCache(time.Minute, 0)
+
+	c.Set("a", 1)
+	c.Get("a")
+	c.Purge()
+
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, Stats{Hits: 1, Misses: 1}, c.Stats(), "purge keeps the counters")
+}
+
+func TestCache_GetOrLoad(t *testing.T) {
+	loadErr := errors.New("load failed")
+
+	tests := map[string]struct {
+		load      func(c *Cache[string, int]) (int, error)
+		wantValue int
+		wantErr   error
+		wantCache bool
+	}{
+		"loads and stores a miss": {
+			load:      func(*Cache[string, int]) (int, error) { return 7, nil },
+			wantValue: 7,
+			wantCache: true,
+		},
+		"does not store errors": {
+			load:    func(*Cache[string, int]) (int, error) { return 0, loadErr },
+			wantErr: loadErr,
+		},
+		"does not store a value loaded across a purge": {
+			load: func(c *Cache[string, int]) (int, error) {
+				c.Purge()
+				return 7, nil
+			},
+			wantValue: 7,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, _ := newTestCache(time.Minute, 0)
+
+			value, err := c.GetOrLoad("a", func() (int, error) { return tt.load(c) })
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantValue, value)
+
+			cached, ok := c.Get("a")
+			assert.Equal(t, tt.wantCache, ok)
+			if tt.wantCache {
+				assert.Equal(t, tt.wantValue, cached)
+			}
+		})
+	}
+}
+
+func TestCache_GetOrLoad_Hit(t *testing.T) {
+	c, _ := newTestCache(time.Minute, 0)
+	c.Set("a", 1)
+
+	value, err := c.GetOrLoad("a", func() (int, error) {
+		t.Fatal("load must not run on a hit")
+		return 0, nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, value)
+	assert.Equal(t, Stats{Hits: 1}, c.Stats())
+}
@@ -1,22 +1,53 @@
 package middleware
 
 import (
+	"context"
 	"crypto/subtle"
 	"net/http"
+	"slices"
 	"strings"
 )
 
+// AccessTokenVerifier checks a signed access token and returns the subject it
+// was issued to.
+type AccessTokenVerifier interface {
+	Verify(token string) (string, error)
+}
+
+// APIKeyResolver looks up an API key presented as a bearer token.
+type APIKeyResolver interface {
+	// ResolveAPIKey returns the scopes of the active API key matching key. ok
+	// is false when there is none, e.g. the key is unknown, expired or revoked.
+	ResolveAPIKey(ctx context.Context, key string) (scopes []string, ok bool, err error)
+}
+
+// AuthInterceptor configures the bearer tokens an authInterceptor accepts:
+// the static ValidToken used by machine clients, and, when Verifier is set,
+// access tokens issued on admin login. Both grant admin access. When APIKeys
+// is set, scoped API keys are accepted on the routes their scopes allow.
+type AuthInterceptor struct {
+	ValidToken string
+	Verifier   AccessTokenVerifier
+	APIKeys    APIKeyResolver
+}
+
 type authInterceptor struct {
 	validToken string
+	verifier   AccessTokenVerifier
+	apiKeys    APIKeyResolver
 }
 
-// NewAuthInterceptor creates a new instance of authInterceptor with the provided validToken.
+// NewAuthInterceptor creates a new instance of authInterceptor from the provided config.
 // The interceptor can be used to validate authentication tokens in incoming requests.
 //
-// validToken: the token string that will be considered valid for authentication.
-// Returns: a pointer to an authInterceptor initialized with the given token.
-func NewAuthInterceptor(validToken string) *authInterceptor {
-	return &authInterceptor{validToken: validToken}
+// c: the static token, optional access token verifier and optional API key resolver considered valid for authentication.
+// Returns: a pointer to an authInterceptor initialized with the given config.
+func NewAuthInterceptor(c AuthInterceptor) *authInterceptor {
+	return &authInterceptor{
+		validToken: c.ValidToken,
+		verifier:   c.Verifier,
+		apiKeys:    c.APIKeys,
+	}
 }
 
 // abortWithStatus sends an HTTP error response with the specified status code and message.
@@ -31,28 +62,80 @@ func (a *authInterceptor) abortWithStatus(w http.ResponseWriter, code int, messa
 }
 
 // MiddlewareFunc returns an HTTP middleware that checks for a valid Bearer token in the Authorization header.
+// The token must either equal the static token or be an access token the verifier accepts.
 // If the header is missing or the token is invalid, it responds with HTTP 401 Unauthorized and an error message.
+// API keys are recognised but rejected with HTTP 403 Forbidden, whatever their scopes.
 // Otherwise, it calls the next handler in the chain.
 func (a *authInterceptor) MiddlewareFunc(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		authHeader := r.Header.Get("Authorization")
-		if authHeader == "" {
-			a.abortWithStatus(w, http.StatusUnauthorized, "Missing authorization header")
-			return
-		}
+	return a.RequireScope("")(next)
+}
 
-		const prefix = "Bearer "
-		if !strings.HasPrefix(authHeader, prefix) {
-			a.abortWithStatus(w, http.StatusUnauthorized, "Invalid token")
-			return
-		}
+// RequireScope returns an HTTP middleware like MiddlewareFunc that also admits
+// API keys holding scope. An API key without it receives HTTP 403 Forbidden.
+// An empty scope admits no API key. If the key lookup fails, it responds with
+// HTTP 500 Internal Server Error. A key that is used has its use recorded by
+// the resolver.
+func (a *authInterceptor) RequireScope(scope string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			authHeader := r.Header.Get("Authorization")
+			if authHeader == "" {
+				a.abortWithStatus(w, http.StatusUnauthorized, "Missing authorization header")
+				return
+			}
+
+			const prefix = "Bearer "
+			if !strings.HasPrefix(authHeader, prefix) {
+				a.abortWithStatus(w, http.StatusUnauthorized, "Invalid token")
+				return
+			}
+
+			token := authHeader[len(prefix):]
+			if a.valid(token) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if token == "" || a.apiKeys == nil {
+				a.abortWithStatus(w, http.StatusUnauthorized, "Invalid token")
+				return
+			}
+
+			scopes, ok, err := a.apiKeys.ResolveAPIKey(r.Context(), token)
+			if err != nil {
+				a.abortWithStatus(w, http.StatusInternalServerError, "Failed to verify API key")
+				return
+			}
+			if !ok {
+				a.abortWithStatus(w, http.StatusUnauthorized, "Invalid token")
+				return
+			}
+			if scope == "" || !slices.Contains(scopes, scope) {
+				a.abortWithStatus(w, http.StatusForbidden, "Insufficient scope")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// valid reports whether token is the static token or a verified access token.
+// An empty token is never valid, even when no static token is configured.
+func (a *authInterceptor) valid(token string) bool {
+	if token == "" {
+		return false
+	}
+
+	if a.validToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.validToken)) == 1 {
+		return true
+	}
 
-		token := authHeader[len(prefix):]
-		if subtle.ConstantTimeCompare([]byte(token), []byte(a.validToken)) != 1 {
-			a.abortWithStatus(w, http.StatusUnauthorized, "Invalid token")
-			return
+	if a.verifier != nil {
+		if _, err := a.verifier.Verify(token); err == nil {
+			return true
 		}
+	}
 
-		next.ServeHTTP(w, r)
-	})
+	return false
 }
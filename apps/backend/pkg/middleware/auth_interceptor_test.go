@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,7 +12,7 @@ import (
 
 func TestAuthInterceptor_MiddlewareFunc(t *testing.T) {
 	const validToken = "secret123"
-	interceptor := NewAuthInterceptor(validToken)
+	interceptor := NewAuthInterceptor(AuthInterceptor{ValidToken: validToken})
 
 	tests := []struct {
 		name       string
@@ -110,3 +112,195 @@ func TestAuthInterceptor_MiddlewareFunc(t *testing.T) {
 		})
 	}
 }
+
+// stubVerifier accepts a single access token.
+type stubVerifier struct {
+	token string
+}
+
+func (v stubVerifier) Verify(token string) (string, error) {
+	if token != v.token {
+		return "", errors.New("invalid access token")
+	}
+	return "admin", nil
+}
+
+func TestAuthInterceptor_MiddlewareFunc_AccessTokens(t *testing.T) {
+	const (
+		validToken  = "secret123"
+		accessToken = "header.payload.signature"
+	)
+
+	tests := []struct {
+		name       string
+		config     AuthInterceptor
+		authHeader string
+		wantCode   int
+	}{
+		{
+			name:       "access token",
+			config:     AuthInterceptor{ValidToken: validToken, Verifier: stubVerifier{token: accessToken}},
+			authHeader: "Bearer " + accessToken,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "static token still accepted",
+			config:     AuthInterceptor{ValidToken: validToken, Verifier: stubVerifier{token: accessToken}},
+			authHeader: "Bearer " + validToken,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "rejected access token",
+			config:     AuthInterceptor{ValidToken: validToken, Verifier: stubVerifier{token: accessToken}},
+			authHeader: "Bearer other.access.token",
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "access token without a verifier",
+			config:     AuthInterceptor{ValidToken: validToken},
+			authHeader: "Bearer " + accessToken,
+			wantCode:   http.StatusUnauthorized,
+		},
+		{
+			name:       "access token without a static token",
+			config:     AuthInterceptor{Verifier: stubVerifier{token: accessToken}},
+			authHeader: "Bearer " + accessToken,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "empty token never matches an unset static token",
+			config:     AuthInterceptor{},
+			authHeader: "Bearer ",
+			wantCode:   http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", tt.authHeader)
+			rec := httptest.NewRecorder()
+
+			NewAuthInterceptor(tt.config).MiddlewareFunc(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+// stubAPIKeys resolves the keys in scopes; err, when set, fails every lookup.
+type stubAPIKeys struct {
+	scopes map[string][]string
+	err    error
+}
+
+func (s stubAPIKeys) ResolveAPIKey(ctx context.Context, key string) ([]string, bool, error) {
+	if s.err != nil {
+		return nil, false, s.err
+	}
+	scopes, ok := s.scopes[key]
+	return scopes, ok, nil
+}
+
+func TestAuthInterceptor_RequireScope(t *testing.T) {
+	const validToken = "secret123"
+
+	apiKeys := stubAPIKeys{scopes: map[string][]string{
+		"fk_upload": {"files:write"},
+		"fk_reader": {"read"},
+	}}
+
+	tests := []struct {
+		name       string
+		config     AuthInterceptor
+		scope      string
+		authHeader string
+		wantCode   int
+		wantBody   string
+	}{
+		{
+			name:       "key holding the scope",
+			config:     AuthInterceptor{ValidToken: validToken, APIKeys: apiKeys},
+			scope:      "files:write",
+			authHeader: "Bearer fk_upload",
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "key lacking the scope",
+			config:     AuthInterceptor{ValidToken: validToken, APIKeys: apiKeys},
+			scope:      "files:write",
+			authHeader: "Bearer fk_reader",
+			wantCode:   http.StatusForbidden,
+			wantBody:   "Insufficient scope\n",
+		},
+		{
+			name:       "key on an admin-only route",
+			config:     AuthInterceptor{ValidToken: validToken, APIKeys: apiKeys},
+			authHeader: "Bearer fk_upload",
+			wantCode:   http.StatusForbidden,
+			wantBody:   "Insufficient scope\n",
+		},
+		{
+			name:       "unknown key",
+			config:     AuthInterceptor{ValidToken: validToken, APIKeys: apiKeys},
+			scope:      "files:write",
+			authHeader: "Bearer fk_other",
+			wantCode:   http.StatusUnauthorized,
+			wantBody:   "Invalid token\n",
+		},
+		{
+			name:       "key without a resolver",
+			config:     AuthInterceptor{ValidToken: validToken},
+			scope:      "files:write",
+			authHeader: "Bearer fk_upload",
+			wantCode:   http.StatusUnauthorized,
+			wantBody:   "Invalid token\n",
+		},
+		{
+			name:       "lookup fails",
+			config:     AuthInterceptor{ValidToken: validToken, APIKeys: stubAPIKeys{err: errors.New("db down")}},
+			scope:      "files:write",
+			authHeader: "Bearer fk_upload",
+			wantCode:   http.StatusInternalServerError,
+			wantBody:   "Failed to verify API key\n",
+		},
+		{
+			name:       "static token needs no scope",
+			config:     AuthInterceptor{ValidToken: validToken, APIKeys: apiKeys},
+			scope:      "files:write",
+			authHeader: "Bearer " + validToken,
+			wantCode:   http.StatusOK,
+		},
+		{
+			name:       "empty token is not looked up",
+			config:     AuthInterceptor{APIKeys: stubAPIKeys{err: errors.New("must not be called")}},
+			scope:      "read",
+			authHeader: "Bearer ",
+			wantCode:   http.StatusUnauthorized,
+			wantBody:   "Invalid token\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.Header.Set("Authorization", tt.authHeader)
+			rec := httptest.NewRecorder()
+
+			NewAuthInterceptor(tt.config).RequireScope(tt.scope)(next).ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantBody != "" {
+				assert.Equal(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
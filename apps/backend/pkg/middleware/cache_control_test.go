@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheControl_headerValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		config CacheControl
+		want   string
+	}{
+		{
+			name:   "zero config revalidates every read",
+			config: CacheControl{},
+			want:   "no-cache",
+		},
+		{
+			name:   "private without max age",
+			config: CacheControl{Private: true},
+			want:   "private, no-cache",
+		},
+		{
+			name:   "public max age",
+			config: CacheControl{MaxAge: time.Minute},
+			want:   "public, max-age=60",
+		},
+		{
+			name:   "stale while revalidate",
+			config: CacheControl{MaxAge: 30 * time.Second, StaleWhileRevalidate: 5 * time.Minute},
+			want:   "public, max-age=30, stale-while-revalidate=300",
+		},
+		{
+			name:   "private max age",
+			config: CacheControl{MaxAge: time.Hour, Private: true},
+			want:   "private, max-age=3600",
+		},
+		{
+			name:   "stale while revalidate needs a max age",
+			config: CacheControl{StaleWhileRevalidate: time.Minute},
+			want:   "no-cache",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.config.headerValue())
+		})
+	}
+}
+
+func TestCacheControlInterceptor_CacheControlMiddleware(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		status     int
+		handlerSet string
+		writeBody  bool
+		want       string
+	}{
+		{
+			name:   "GET 200",
+			method: http.MethodGet,
+			status: http.StatusOK,
+			want:   "public, max-age=60",
+		},
+		{
+			name:      "GET with implicit 200",
+			method:    http.MethodGet,
+			writeBody: true,
+			want:      "public, max-age=60",
+		},
+		{
+			name:   "HEAD 200",
+			method: http.MethodHead,
+			status: http.StatusOK,
+			want:   "public, max-age=60",
+		},
+		{
+			name:   "GET 304",
+			method: http.MethodGet,
+			status: http.StatusNotModified,
+			want:   "public, max-age=60",
+		},
+		{
+			name:   "GET 404 is not cached",
+			method: http.MethodGet,
+			status: http.StatusNotFound,
+		},
+		{
+			name:   "GET 500 is not cached",
+			method: http.MethodGet,
+			status: http.StatusInternalServerError,
+		},
+		{
+			name:   "POST is not cached",
+			method: http.MethodPost,
+			status: http.StatusOK,
+		},
+		{
+			name:       "handler header wins",
+			method:     http.MethodGet,
+			status:     http.StatusOK,
+			handlerSet: "no-store",
+			want:       "no-store",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.handlerSet != "" {
+					w.Header().Set("Cache-Control", tt.handlerSet)
+				}
+				if tt.writeBody {
+					_, _ = w.Write([]byte("[]"))
+					return
+				}
+				w.WriteHeader(tt.status)
+			})
+
+			handler := NewCacheControlInterceptor(CacheControl{MaxAge: time.Minute}).CacheControlMiddleware(next)
+
+			req := httptest.NewRequest(tt.method, "/", nil)
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.want, rec.Header().Get("Cache-Control"))
+		})
+	}
+}
@@ -23,16 +23,23 @@ func NewCorsInterceptor(c CorsInterceptor) *corsInterceptor {
 
 func (c *corsInterceptor) CorsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Locally any origin is allowed. It is echoed rather than "*", which
+		// browsers reject for credentialed requests; "*" is left for
+		// requests without an Origin.
 		var origin string
 		if c.local {
-			origin = "*"
+			origin = r.Header.Get("Origin")
+			if origin == "" {
+				origin = "*"
+			}
 		} else {
 			origin = c.clientURL
 		}
 
 		w.Header().Set("Access-Control-Allow-Origin", origin)
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match, If-None-Match, If-Modified-Since")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, Retry-After, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy")
 		w.Header().Set("Vary", "Origin")
 
 		if !c.local && c.clientURL == "" {
@@ -40,7 +47,7 @@ func (c *corsInterceptor) CorsMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		if !c.local {
+		if origin != "*" {
 			w.Header().Set("Access-Control-Allow-Credentials", "true")
 		}
 
@@ -14,6 +14,7 @@ func TestCorsInterceptor_CorsMiddleware(t *testing.T) {
 		config         CorsInterceptor
 		method         string
 		reqOrigin      string
+		noOrigin       bool
 		wantOrigin     string
 		wantCreds      string
 		wantCode       int
@@ -26,8 +27,8 @@ func TestCorsInterceptor_CorsMiddleware(t *testing.T) {
 				Local:     true,
 			},
 			method:         http.MethodGet,
-			wantOrigin:     "*",
-			wantCreds:      "",
+			wantOrigin:     "http://localhost",
+			wantCreds:      "true",
 			wantCode:       http.StatusOK,
 			wantNextCalled: true,
 		},
@@ -62,10 +63,36 @@ func TestCorsInterceptor_CorsMiddleware(t *testing.T) {
 				Local:     true,
 			},
 			method:         http.MethodOptions,
+			wantOrigin:     "http://localhost",
+			wantCreds:      "true",
+			wantCode:       http.StatusOK,
+			wantNextCalled: false,
+		},
+		{
+			name: "local request with custom Origin header",
+			config: CorsInterceptor{
+				ClientURL: "http://prod-client.com",
+				Local:     true,
+			},
+			method:         http.MethodPost,
+			reqOrigin:      "http://localhost:5173",
+			wantOrigin:     "http://localhost:5173",
+			wantCreds:      "true",
+			wantCode:       http.StatusOK,
+			wantNextCalled: true,
+		},
+		{
+			name: "local request without Origin header",
+			config: CorsInterceptor{
+				ClientURL: "http://prod-client.com",
+				Local:     true,
+			},
+			method:         http.MethodGet,
+			noOrigin:       true,
 			wantOrigin:     "*",
 			wantCreds:      "",
 			wantCode:       http.StatusOK,
-			wantNextCalled: false,
+			wantNextCalled: true,
 		},
 		{
 			name: "non-local POST request",
@@ -91,6 +118,18 @@ func TestCorsInterceptor_CorsMiddleware(t *testing.T) {
 			wantCode:       http.StatusOK,
 			wantNextCalled: true,
 		},
+		{
+			name: "non-local PATCH request",
+			config: CorsInterceptor{
+				ClientURL: "http://prod-client.com",
+				Local:     false,
+			},
+			method:         http.MethodPatch,
+			wantOrigin:     "http://prod-client.com",
+			wantCreds:      "true",
+			wantCode:       http.StatusOK,
+			wantNextCalled: true,
+		},
 		{
 			name: "non-local DELETE request",
 			config: CorsInterceptor{
@@ -152,12 +191,15 @@ func TestCorsInterceptor_CorsMiddleware(t *testing.T) {
 			handler := NewCorsInterceptor(tt.config).CorsMiddleware(next)
 
 			req := httptest.NewRequest(tt.method, "/", nil)
-			if tt.reqOrigin != "" {
+			switch {
+			case tt.noOrigin:
+				// Non-browser clients send no Origin
+			case tt.reqOrigin != "":
 				req.Header.Set("Origin", tt.reqOrigin)
-			} else if !tt.config.Local {
+			case !tt.config.Local:
 				// Simulate a default non-local Origin
 				req.Header.Set("Origin", "http://some-origin.com")
-			} else {
+			default:
 				// Optional: simulate local origin
 				req.Header.Set("Origin", "http://localhost")
 			}
@@ -170,14 +212,15 @@ func TestCorsInterceptor_CorsMiddleware(t *testing.T) {
 
 			assert.Equal(t, tt.wantCode, res.StatusCode)
 			assert.Equal(t, tt.wantOrigin, res.Header.Get("Access-Control-Allow-Origin"))
-			assert.Equal(t, "GET, POST, PUT, DELETE, OPTIONS", res.Header.Get("Access-Control-Allow-Methods"))
-			assert.Equal(t, "Content-Type, Authorization", res.Header.Get("Access-Control-Allow-Headers"))
+			assert.Equal(t, "GET, POST, PUT, PATCH, DELETE, OPTIONS", res.Header.Get("Access-Control-Allow-Methods"))
+			assert.Equal(t, "Content-Type, Authorization, If-Match, If-None-Match, If-Modified-Since", res.Header.Get("Access-Control-Allow-Headers"))
+			assert.Equal(t, "ETag, Retry-After, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, RateLimit-Policy", res.Header.Get("Access-Control-Expose-Headers"))
 			assert.Equal(t, "Origin", res.Header.Get("Vary"))
 
 			if tt.wantCreds != "" {
 				assert.Equal(t, tt.wantCreds, res.Header.Get("Access-Control-Allow-Credentials"))
 			} else {
-				// For local requests or empty ClientURL, credentials should not be set
+				// Without an Origin or with an empty ClientURL, credentials should not be set
 				_, exists := res.Header["Access-Control-Allow-Credentials"]
 				assert.False(t, exists, "credentials header should not be set when wantCreds is empty")
 			}
@@ -0,0 +1,303 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateLimit is a token bucket: a client may send Requests requests at once,
+// and the bucket refills evenly so that Requests more are allowed per Window.
+// A zero RateLimit does not limit. Its text form, used by flags, is
+// "<requests>/<window>", e.g. "5/10m".
+type RateLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// String renders l in the form UnmarshalText accepts.
+func (l RateLimit) String() string {
+	if !l.enabled() {
+		return "0"
+	}
+	return strconv.Itoa(l.Requests) + "/" + l.Window.String()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (l RateLimit) MarshalText() ([]byte, error) {
+	return []byte(l.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. "0" and the empty
+// string disable the limit.
+func (l *RateLimit) UnmarshalText(text []byte) error {
+	s := strings.TrimSpace(string(text))
+	if s == "" || s == "0" {
+		*l = RateLimit{}
+		return nil
+	}
+
+	requests, window, ok := strings.Cut(s, "/")
+	if !ok {
+		return fmt.Errorf("rate limit %q: want <requests>/<window>", s)
+	}
+
+	n, err := strconv.Atoi(requests)
+	if err != nil || n < 0 {
+		return fmt.Errorf("rate limit %q: requests must be a non-negative integer", s)
+	}
+
+	d, err := time.ParseDuration(window)
+	if err != nil || d <= 0 {
+		return fmt.Errorf("rate limit %q: window must be a positive duration", s)
+	}
+
+	*l = RateLimit{Requests: n, Window: d}
+	return nil
+}
+
+func (l RateLimit) enabled() bool {
+	return l.Requests > 0 && l.Window > 0
+}
+
+// perSecond is the rate the bucket refills at.
+func (l RateLimit) perSecond() float64 {
+	return float64(l.Requests) / l.Window.Seconds()
+}
+
+// RateLimiter configures which routes RateLimitMiddleware limits and how it
+// tells clients apart.
+type RateLimiter struct {
+	// Limits maps a route, written "METHOD /path", to its limit. Requests to
+	// other routes are not limited. A trailing slash on the request path is
+	// ignored.
+	Limits map[string]RateLimit
+	// TrustedProxies lists the IPs and CIDRs of the reverse proxies in front of
+	// the server. Only requests arriving from one of them have their
+	// X-Forwarded-For header honoured; anyone else could forge it.
+	TrustedProxies []string
+}
+
+type rateLimiter struct {
+	limits         map[string]RateLimit
+	trustedProxies []*net.IPNet
+	now            func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// bucket is the state of one client on one route.
+type bucket struct {
+	tokens  float64
+	updated time.Time
+	limit   RateLimit
+}
+
+// sweepInterval is how often buckets that have refilled completely, and so
+// are equivalent to no bucket, are dropped.
+const sweepInterval = time.Minute
+
+// NewRateLimiter creates a rateLimiter from c. Entries of c.TrustedProxies
+// that are neither an IP nor a CIDR are logged and skipped.
+func NewRateLimiter(c RateLimiter) *rateLimiter {
+	limits := make(map[string]RateLimit, len(c.Limits))
+	for route, limit := range c.Limits {
+		if limit.enabled() {
+			limits[route] = limit
+		}
+	}
+
+	var trusted []*net.IPNet
+	for _, proxy := range c.TrustedProxies {
+		network, err := parseProxy(proxy)
+		if err != nil {
+			log.Printf("Ignoring trusted proxy: %v", err)
+			continue
+		}
+		trusted = append(trusted, network)
+	}
+
+	return &rateLimiter{
+		limits:         limits,
+		trustedProxies: trusted,
+		now:            time.Now,
+		buckets:        make(map[string]*bucket),
+	}
+}
+
+// parseProxy parses an IP or CIDR into the network it covers.
+func parseProxy(proxy string) (*net.IPNet, error) {
+	proxy = strings.TrimSpace(proxy)
+	if strings.Contains(proxy, "/") {
+		_, network, err := net.ParseCIDR(proxy)
+		return network, err
+	}
+
+	ip := net.ParseIP(proxy)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP or CIDR %q", proxy)
+	}
+	bits := 8 * len(ip.To16())
+	if ip.To4() != nil {
+		ip, bits = ip.To4(), 32
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+// RateLimitMiddleware limits requests to the configured routes per client IP
+// and route. Every limited response carries RateLimit-Limit,
+// RateLimit-Remaining, RateLimit-Reset and RateLimit-Policy headers; a
+// request over the limit is answered with 429 Too Many Requests and a
+// Retry-After header instead of reaching next. The client IP is stored in
+// the request context, where ClientIPFromContext can read it.
+func (rl *rateLimiter) RateLimitMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := rl.clientIP(r)
+		r = r.WithContext(ContextWithClientIP(r.Context(), ip))
+
+		route := r.Method + " " + strings.TrimSuffix(r.URL.Path, "/")
+		if route == r.Method+" " {
+			route += "/"
+		}
+
+		limit, ok := rl.limits[route]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		allowed, remaining, reset, retryAfter := rl.take(ip+" "+route, limit)
+
+		h := w.Header()
+		h.Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+		h.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+		h.Set("RateLimit-Reset", strconv.Itoa(reset))
+		h.Set("RateLimit-Policy", fmt.Sprintf("%d;w=%d", limit.Requests, int(math.Ceil(limit.Window.Seconds()))))
+
+		if !allowed {
+			h.Set("Retry-After", strconv.Itoa(retryAfter))
+			http.Error(w, "Too many requests: retry in "+strconv.Itoa(retryAfter)+"s", http.StatusTooManyRequests)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// take removes a token from the bucket at key. It returns whether one was
+// available, the whole tokens left, the seconds until the bucket is full
+// again and, when refused, the seconds until the next token.
+func (rl *rateLimiter) take(key string, limit RateLimit) (allowed bool, remaining, reset, retryAfter int) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	rl.sweep(now)
+
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Requests), updated: now, limit: limit}
+		rl.buckets[key] = b
+	}
+
+	rate := limit.perSecond()
+	if elapsed := now.Sub(b.updated).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Requests), b.tokens+elapsed*rate)
+		b.updated = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		allowed = true
+	} else {
+		retryAfter = ceilSeconds((1 - b.tokens) / rate)
+	}
+
+	remaining = int(math.Floor(b.tokens))
+	reset = ceilSeconds((float64(limit.Requests) - b.tokens) / rate)
+
+	return allowed, remaining, reset, retryAfter
+}
+
+// ceilSeconds rounds seconds up to a whole second, ignoring the float error
+// refilling accumulates so that a wait of exactly 10s is not reported as 11.
+func ceilSeconds(seconds float64) int {
+	return int(math.Ceil(seconds - 1e-9))
+}
+
+// sweep drops the buckets that have refilled completely by now, at most once
+// per sweepInterval. The caller holds rl.mu.
+func (rl *rateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < sweepInterval {
+		return
+	}
+	rl.lastSweep = now
+
+	for key, b := range rl.buckets {
+		missing := float64(b.limit.Requests) - b.tokens
+		if now.Sub(b.updated).Seconds()*b.limit.perSecond() >= missing {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+type clientIPKey struct{}
+
+// ContextWithClientIP returns a copy of ctx carrying the client IP ip.
+func ContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, ip)
+}
+
+// ClientIPFromContext returns the client IP stored by RateLimitMiddleware, or
+// an empty string when ctx carries none.
+func ClientIPFromContext(ctx context.Context) string {
+	ip, _ := ctx.Value(clientIPKey{}).(string)
+	return ip
+}
+
+// clientIP returns the IP the request came from. When the peer is a trusted
+// proxy, X-Forwarded-For is walked from the right, past any further trusted
+// proxies, to the first address they did not add themselves.
+func (rl *rateLimiter) clientIP(r *http.Request) string {
+	peer := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(peer); err == nil {
+		peer = host
+	}
+
+	ip := net.ParseIP(peer)
+	if ip == nil || !rl.trusted(ip) {
+		return peer
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			break
+		}
+		ip = hop
+		if !rl.trusted(hop) {
+			break
+		}
+	}
+
+	return ip.String()
+}
+
+func (rl *rateLimiter) trusted(ip net.IP) bool {
+	for _, network := range rl.trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
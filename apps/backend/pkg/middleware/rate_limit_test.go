@@ -0,0 +1,318 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    RateLimit
+		wantErr bool
+	}{
+		{
+			name: "requests per window",
+			text: "5/10m",
+			want: RateLimit{Requests: 5, Window: 10 * time.Minute},
+		},
+		{
+			name: "surrounding whitespace",
+			text: " 60/1m ",
+			want: RateLimit{Requests: 60, Window: time.Minute},
+		},
+		{
+			name: "zero disables",
+			text: "0",
+			want: RateLimit{},
+		},
+		{
+			name: "empty disables",
+			text: "",
+			want: RateLimit{},
+		},
+		{
+			name:    "missing window",
+			text:    "5",
+			wantErr: true,
+		},
+		{
+			name:    "negative requests",
+			text:    "-1/1m",
+			wantErr: true,
+		},
+		{
+			name:    "invalid window",
+			text:    "5/soon",
+			wantErr: true,
+		},
+		{
+			name:    "zero window",
+			text:    "5/0s",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got RateLimit
+			err := got.UnmarshalText([]byte(tt.text))
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestRateLimit_String(t *testing.T) {
+	limit := RateLimit{Requests: 5, Window: 10 * time.Minute}
+
+	var parsed RateLimit
+	require.NoError(t, parsed.UnmarshalText([]byte(limit.String())))
+
+	assert.Equal(t, "5/10m0s", limit.String())
+	assert.Equal(t, limit, parsed)
+	assert.Equal(t, "0", RateLimit{}.String())
+}
+
+// newRateLimitTestLimiter returns a limiter allowing 2 requests per minute on
+// POST /email/send, with a clock the test moves by hand.
+func newRateLimitTestLimiter(trustedProxies ...string) (*rateLimiter, *time.Time) {
+	now := time.Date(2026, 2, 10, 9, 0, 0, 0, time.UTC)
+	rl := NewRateLimiter(
+		RateLimiter{
+			Limits: map[string]RateLimit{
+				"POST /email/send": {Requests: 2, Window: time.Minute},
+			},
+			TrustedProxies: trustedProxies,
+		},
+	)
+	rl.now = func() time.Time { return now }
+	return rl, &now
+}
+
+func sendRateLimited(h http.Handler, method, path, remoteAddr, forwardedFor string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.RemoteAddr = remoteAddr
+	if forwardedFor != "" {
+		req.Header.Set("X-Forwarded-For", forwardedFor)
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimiter_RateLimitMiddleware(t *testing.T) {
+	okHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("allows the burst and rejects the next request", func(t *testing.T) {
+		rl, _ := newRateLimitTestLimiter()
+		h := rl.RateLimitMiddleware(okHandler)
+
+		first := sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, "2", first.Header().Get("RateLimit-Limit"))
+		assert.Equal(t, "1", first.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "30", first.Header().Get("RateLimit-Reset"))
+		assert.Equal(t, "2;w=60", first.Header().Get("RateLimit-Policy"))
+		assert.Empty(t, first.Header().Get("Retry-After"))
+
+		second := sendRateLimited(h, http.MethodPost, "/email/send/", "203.0.113.7:5001", "")
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, "0", second.Header().Get("RateLimit-Remaining"))
+		assert.Equal(t, "60", second.Header().Get("RateLimit-Reset"))
+
+		third := sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5002", "")
+		assert.Equal(t, http.StatusTooManyRequests, third.Code)
+		assert.Equal(t, "30", third.Header().Get("Retry-After"))
+		assert.Equal(t, "0", third.Header().Get("RateLimit-Remaining"))
+		assert.Contains(t, third.Body.String(), "Too many requests")
+	})
+
+	t.Run("refills over the window", func(t *testing.T) {
+		rl, now := newRateLimitTestLimiter()
+		h := rl.RateLimitMiddleware(okHandler)
+
+		sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+		sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+
+		*now = now.Add(20 * time.Second)
+		rejected := sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+		assert.Equal(t, http.StatusTooManyRequests, rejected.Code)
+		assert.Equal(t, "10", rejected.Header().Get("Retry-After"))
+
+		*now = now.Add(10 * time.Second)
+		allowed := sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+		assert.Equal(t, http.StatusOK, allowed.Code)
+	})
+
+	t.Run("limits each client separately", func(t *testing.T) {
+		rl, _ := newRateLimitTestLimiter()
+		h := rl.RateLimitMiddleware(okHandler)
+
+		sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+		sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+
+		other := sendRateLimited(h, http.MethodPost, "/email/send", "198.51.100.2:5000", "")
+		assert.Equal(t, http.StatusOK, other.Code)
+	})
+
+	t.Run("stores the client IP in the context", func(t *testing.T) {
+		rl, _ := newRateLimitTestLimiter("10.0.0.0/8")
+
+		var got string
+		h := rl.RateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = ClientIPFromContext(r.Context())
+		}))
+
+		sendRateLimited(h, http.MethodGet, "/projects", "10.0.0.1:5000", "192.0.2.1")
+		assert.Equal(t, "192.0.2.1", got)
+	})
+
+	t.Run("passes routes without a limit through", func(t *testing.T) {
+		rl, _ := newRateLimitTestLimiter()
+		h := rl.RateLimitMiddleware(okHandler)
+
+		for range 3 {
+			rec := sendRateLimited(h, http.MethodGet, "/email/send", "203.0.113.7:5000", "")
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Empty(t, rec.Header().Get("RateLimit-Limit"))
+		}
+	})
+
+	t.Run("ignores X-Forwarded-For from untrusted peers", func(t *testing.T) {
+		rl, _ := newRateLimitTestLimiter()
+		h := rl.RateLimitMiddleware(okHandler)
+
+		sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "192.0.2.1")
+		sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "192.0.2.2")
+
+		rec := sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "192.0.2.3")
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	})
+
+	t.Run("honours X-Forwarded-For from trusted proxies", func(t *testing.T) {
+		rl, _ := newRateLimitTestLimiter("10.0.0.0/8", "invalid")
+		h := rl.RateLimitMiddleware(okHandler)
+
+		sendRateLimited(h, http.MethodPost, "/email/send", "10.0.0.1:5000", "192.0.2.1")
+		sendRateLimited(h, http.MethodPost, "/email/send", "10.0.0.2:5000", "192.0.2.1")
+
+		limited := sendRateLimited(h, http.MethodPost, "/email/send", "10.0.0.1:5000", "192.0.2.1")
+		assert.Equal(t, http.StatusTooManyRequests, limited.Code)
+
+		other := sendRateLimited(h, http.MethodPost, "/email/send", "10.0.0.1:5000", "192.0.2.2")
+		assert.Equal(t, http.StatusOK, other.Code)
+	})
+}
+
+func TestRateLimiter_clientIP(t *testing.T) {
+	rl := NewRateLimiter(
+		RateLimiter{
+			TrustedProxies: []string{"10.0.0.0/8", "2001:db8::1"},
+		},
+	)
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:       "untrusted peer",
+			remoteAddr: "203.0.113.7:5000",
+			want:       "203.0.113.7",
+		},
+		{
+			name:         "untrusted peer with forged header",
+			remoteAddr:   "203.0.113.7:5000",
+			forwardedFor: []string{"192.0.2.1"},
+			want:         "203.0.113.7",
+		},
+		{
+			name:         "trusted proxy",
+			remoteAddr:   "10.0.0.1:5000",
+			forwardedFor: []string{"192.0.2.1"},
+			want:         "192.0.2.1",
+		},
+		{
+			name:         "client spoofs the leftmost hop",
+			remoteAddr:   "10.0.0.1:5000",
+			forwardedFor: []string{"198.51.100.9, 192.0.2.1"},
+			want:         "192.0.2.1",
+		},
+		{
+			name:         "chain of trusted proxies",
+			remoteAddr:   "10.0.0.1:5000",
+			forwardedFor: []string{"192.0.2.1, 10.0.0.3", "10.0.0.2"},
+			want:         "192.0.2.1",
+		},
+		{
+			name:       "trusted proxy without header",
+			remoteAddr: "10.0.0.1:5000",
+			want:       "10.0.0.1",
+		},
+		{
+			name:         "trusted IPv6 proxy",
+			remoteAddr:   "[2001:db8::1]:5000",
+			forwardedFor: []string{"192.0.2.1"},
+			want:         "192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/email/send", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, value := range tt.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			assert.Equal(t, tt.want, rl.clientIP(req))
+		})
+	}
+}
+
+func TestRateLimiter_sweep(t *testing.T) {
+	rl, now := newRateLimitTestLimiter()
+	h := rl.RateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+	sendRateLimited(h, http.MethodPost, "/email/send", "198.51.100.2:5000", "")
+	require.Len(t, rl.buckets, 2)
+
+	*now = now.Add(2 * time.Minute)
+	sendRateLimited(h, http.MethodPost, "/email/send", "192.0.2.1:5000", "")
+
+	assert.Len(t, rl.buckets, 1)
+	assert.Contains(t, rl.buckets, "192.0.2.1 POST /email/send")
+}
+
+func TestNewRateLimiter_disabledLimits(t *testing.T) {
+	rl := NewRateLimiter(
+		RateLimiter{
+			Limits: map[string]RateLimit{
+				"POST /email/send": {},
+			},
+		},
+	)
+	h := rl.RateLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	for range 10 {
+		rec := sendRateLimited(h, http.MethodPost, "/email/send", "203.0.113.7:5000", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	}
+}
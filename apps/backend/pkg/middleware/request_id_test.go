@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name         string
+		incomingID   string
+		wantIncoming bool
+	}{
+		{
+			name:         "generates an ID when none is sent",
+			incomingID:   "",
+			wantIncoming: false,
+		},
+		{
+			name:         "keeps a well-formed incoming ID",
+			incomingID:   "req-123",
+			wantIncoming: true,
+		},
+		{
+			name:         "replaces an ID containing spaces",
+			incomingID:   "req 123",
+			wantIncoming: false,
+		},
+		{
+			name:         "replaces an overly long ID",
+			incomingID:   strings.Repeat("a", maxRequestIDLength+1),
+			wantIncoming: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxID string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxID = RequestIDFromContext(r.Context())
+				w.WriteHeader(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.incomingID != "" {
+				req.Header.Set(RequestIDHeader, tt.incomingID)
+			}
+
+			rec := httptest.NewRecorder()
+			RequestIDMiddleware(next).ServeHTTP(rec, req)
+
+			headerID := rec.Header().Get(RequestIDHeader)
+			assert.Equal(t, ctxID, headerID)
+
+			if tt.wantIncoming {
+				assert.Equal(t, tt.incomingID, headerID)
+			} else {
+				_, err := uuid.Parse(headerID)
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequestIDFromContext_Missing(t *testing.T) {
+	assert.Equal(t, "", RequestIDFromContext(context.Background()))
+}
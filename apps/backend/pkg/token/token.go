@@ -0,0 +1,103 @@
+// Package token issues and verifies the HMAC-signed JWT access tokens handed
+// out to admins on login.
+package token
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type TokenAPI interface {
+	Issue(subject string) (string, time.Time, error)
+	Verify(token string) (string, error)
+}
+
+// Config configures a TokenAPI. Secret signs tokens with HS256 and must not be
+// empty; TTL is how long an issued token stays valid.
+type Config struct {
+	Secret []byte
+	Issuer string
+	TTL    time.Duration
+
+	now func() time.Time
+}
+
+type tokenImpl struct {
+	secret []byte
+	issuer string
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewTokenAPI returns a TokenAPI configured with cfg. If cfg.now is nil it
+// defaults to time.Now.
+func NewTokenAPI(cfg Config) TokenAPI {
+	now := cfg.now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &tokenImpl{
+		secret: cfg.Secret,
+		issuer: cfg.Issuer,
+		ttl:    cfg.TTL,
+		now:    now,
+	}
+}
+
+// Issue signs an access token for subject and returns it together with the
+// time it expires.
+func (t *tokenImpl) Issue(subject string) (string, time.Time, error) {
+	if len(t.secret) == 0 {
+		return "", time.Time{}, errors.New("failed to issue token: signing secret is empty")
+	}
+
+	issuedAt := t.now()
+	expiresAt := issuedAt.Add(t.ttl)
+
+	claims := jwt.RegisteredClaims{
+		Issuer:    t.issuer,
+		Subject:   subject,
+		IssuedAt:  jwt.NewNumericDate(issuedAt),
+		NotBefore: jwt.NewNumericDate(issuedAt),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(t.secret)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to issue token: %w", err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// Verify checks the signature, issuer and validity window of token and
+// returns its subject. Only HS256 tokens are accepted.
+func (t *tokenImpl) Verify(token string) (string, error) {
+	if len(t.secret) == 0 {
+		return "", errors.New("failed to verify token: signing secret is empty")
+	}
+
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(
+		token,
+		&claims,
+		func(*jwt.Token) (any, error) { return t.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(t.issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(t.now),
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return "", errors.New("failed to verify token: subject missing")
+	}
+
+	return claims.Subject, nil
+}
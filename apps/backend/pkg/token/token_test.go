@@ -0,0 +1,120 @@
+package token
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenAPI_IssueVerify(t *testing.T) {
+	issuedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	secret := []byte("test-secret")
+
+	issue := func(cfg Config) string {
+		cfg.now = func() time.Time { return issuedAt }
+		token, _, err := NewTokenAPI(cfg).Issue("admin")
+		assert.NoError(t, err)
+		return token
+	}
+
+	tests := []struct {
+		name        string
+		token       func() string
+		verifyAt    time.Time
+		wantSubject string
+		wantErr     string
+	}{
+		{
+			name:        "valid token",
+			token:       func() string { return issue(Config{Secret: secret, Issuer: "backend", TTL: time.Minute}) },
+			verifyAt:    issuedAt.Add(30 * time.Second),
+			wantSubject: "admin",
+		},
+		{
+			name:     "expired token",
+			token:    func() string { return issue(Config{Secret: secret, Issuer: "backend", TTL: time.Minute}) },
+			verifyAt: issuedAt.Add(2 * time.Minute),
+			wantErr:  "token is expired",
+		},
+		{
+			name:     "other secret",
+			token:    func() string { return issue(Config{Secret: []byte("other"), Issuer: "backend", TTL: time.Minute}) },
+			verifyAt: issuedAt,
+			wantErr:  "signature is invalid",
+		},
+		{
+			name:     "other issuer",
+			token:    func() string { return issue(Config{Secret: secret, Issuer: "elsewhere", TTL: time.Minute}) },
+			verifyAt: issuedAt,
+			wantErr:  "invalid issuer",
+		},
+		{
+			name: "unsigned token",
+			token: func() string {
+				claims := jwt.RegisteredClaims{Issuer: "backend", Subject: "admin", ExpiresAt: jwt.NewNumericDate(issuedAt.Add(time.Hour))}
+				s, _ := jwt.NewWithClaims(jwt.SigningMethodNone, claims).SignedString(jwt.UnsafeAllowNoneSignatureType)
+				return s
+			},
+			verifyAt: issuedAt,
+			wantErr:  "signing method none is invalid",
+		},
+		{
+			name: "missing expiry",
+			token: func() string {
+				claims := jwt.RegisteredClaims{Issuer: "backend", Subject: "admin"}
+				s, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(secret)
+				return s
+			},
+			verifyAt: issuedAt,
+			wantErr:  "token is missing required claim",
+		},
+		{
+			name:     "malformed token",
+			token:    func() string { return "not-a-jwt" },
+			verifyAt: issuedAt,
+			wantErr:  "token is malformed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := NewTokenAPI(Config{
+				Secret: secret,
+				Issuer: "backend",
+				TTL:    time.Minute,
+				now:    func() time.Time { return tt.verifyAt },
+			})
+
+			subject, err := api.Verify(tt.token())
+
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), tt.wantErr), "got %v", err)
+				assert.Empty(t, subject)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSubject, subject)
+		})
+	}
+}
+
+func TestTokenAPI_Issue(t *testing.T) {
+	issuedAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	token, expiresAt, err := NewTokenAPI(Config{
+		Secret: []byte("test-secret"),
+		TTL:    15 * time.Minute,
+		now:    func() time.Time { return issuedAt },
+	}).Issue("admin")
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, token)
+	assert.Equal(t, issuedAt.Add(15*time.Minute), expiresAt)
+
+	_, _, err = NewTokenAPI(Config{TTL: time.Minute}).Issue("admin")
+	assert.EqualError(t, err, "failed to issue token: signing secret is empty")
+}
@@ -17,9 +17,13 @@ set +a
   --emailjs-template-id="${EMAILJS_TEMPLATE_ID}" \
   --emailjs-public-key="${EMAILJS_PUBLIC_KEY}" \
   --emailjs-private-key="${EMAILJS_PRIVATE_KEY}" \
+  --smtp-password="${SMTP_PASSWORD}" \
   --google-measurement-id="${GOOGLE_MEASUREMENT_ID}" \
   --google-api-secret="${GOOGLE_API_SECRET}" \
+  --analytics-salt="${ANALYTICS_SALT}" \
   --database-url="${DATABASE_URL}" \
   --username="${USERNAME}" \
-  --password="${PASSWORD}" \
-  --uploadthing-secret-key="${UPLOADTHING_SECRET_KEY}"
+  --password-hash="${PASSWORD_HASH}" \
+  --jwt-secret="${JWT_SECRET}" \
+  --uploadthing-secret-key="${UPLOADTHING_SECRET_KEY}" \
+  --captcha-secret="${CAPTCHA_SECRET}"
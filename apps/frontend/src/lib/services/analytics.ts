@@ -1,5 +1,42 @@
 import { APIRoutes } from '@/routes/api-routes';
 
+const CLIENT_ID_KEY = 'analytics-client-id';
+const SESSION_KEY = 'analytics-session';
+// Sessions end after 30 idle minutes, as on the server and in GA4
+const SESSION_TIMEOUT_MS = 30 * 60 * 1000;
+
+// getAnalyticsClient returns the client ID of this browser and its current
+// session ID, both kept in local storage. They are undefined when storage is
+// unavailable, and the server falls back to its cookies.
+const getAnalyticsClient = () => {
+  try {
+    let clientId = localStorage.getItem(CLIENT_ID_KEY);
+    if (!clientId) {
+      clientId = crypto.randomUUID();
+      localStorage.setItem(CLIENT_ID_KEY, clientId);
+    }
+
+    const now = Date.now();
+    const stored = localStorage.getItem(SESSION_KEY);
+    const session: { id: string; lastActive: number } | null = stored
+      ? JSON.parse(stored)
+      : null;
+
+    const sessionId =
+      session && now - session.lastActive < SESSION_TIMEOUT_MS
+        ? session.id
+        : String(Math.floor(now / 1000));
+    localStorage.setItem(
+      SESSION_KEY,
+      JSON.stringify({ id: sessionId, lastActive: now }),
+    );
+
+    return { client_id: clientId, session_id: sessionId };
+  } catch {
+    return {};
+  }
+};
+
 export const AnalyticsService = {
   pageView: async ({
     location,
@@ -14,9 +51,12 @@ export const AnalyticsService = {
         headers: {
           'Content-Type': 'application/json',
         },
+        // Lets the server's fallback cookies through cross-origin
+        credentials: 'include',
         body: JSON.stringify({
           location,
           title,
+          ...getAnalyticsClient(),
         }),
       });
 